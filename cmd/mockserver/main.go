@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -51,6 +52,33 @@ func main() {
 		json.NewEncoder(w).Encode(response)
 	})
 
+	// GitLab API mock
+	http.HandleFunc("/mock-gitlab/", mockGitLabHandler)
+
+	// Jenkins API mock
+	http.HandleFunc("/mock-jenkins/", mockJenkinsHandler)
+
+	// PagerDuty API mock
+	http.HandleFunc("/mock-pagerduty/", mockPagerDutyHandler)
+
+	// Jira API mock
+	http.HandleFunc("/mock-jira/", mockJiraHandler)
+
+	// Slack Web API mock
+	http.HandleFunc("/mock-slack/", mockSlackHandler)
+
+	// Argo CD API mock
+	http.HandleFunc("/mock-argocd/", mockArgoCDHandler)
+
+	// Prometheus and Alertmanager API mock
+	http.HandleFunc("/mock-prometheus/", mockPrometheusHandler)
+
+	// Terraform Cloud API mock
+	http.HandleFunc("/mock-terraform/", mockTerraformHandler)
+
+	// Generic mock for adapters generated from OpenAPI documents
+	http.HandleFunc("/mock-openapi/", mockOpenAPIHandler)
+
 	// Harness API mock
 	http.HandleFunc("/mock-harness/", func(w http.ResponseWriter, r *http.Request) {
 		log.Printf("Mock Harness request: %s %s", r.Method, r.URL.Path)
@@ -292,3 +320,757 @@ func main() {
 	// Start the server
 	log.Fatal(http.ListenAndServe(":8081", nil))
 }
+
+// mockGitLabHandler serves canned GitLab REST API v4 responses
+func mockGitLabHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock GitLab request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	
+	path := strings.TrimPrefix(r.URL.Path, "/mock-gitlab")
+	now := time.Now().Format(time.RFC3339)
+	
+	project := map[string]interface{}{
+		"id": 42,
+		"name": "example-repo",
+		"path_with_namespace": "example-group/example-repo",
+		"default_branch": "main",
+		"visibility": "private",
+		"web_url": "https://gitlab.example.com/example-group/example-repo",
+	}
+	issue := map[string]interface{}{
+		"id": 1001,
+		"iid": 1,
+		"project_id": 42,
+		"title": "Example Issue",
+		"description": "Example issue description",
+		"state": "opened",
+		"labels": []string{"bug"},
+		"author": map[string]interface{}{"username": "example-user"},
+		"web_url": "https://gitlab.example.com/example-group/example-repo/-/issues/1",
+		"created_at": now,
+		"updated_at": now,
+	}
+	mergeRequest := map[string]interface{}{
+		"id": 2001,
+		"iid": 1,
+		"project_id": 42,
+		"title": "Example Merge Request",
+		"state": "opened",
+		"source_branch": "feature",
+		"target_branch": "main",
+		"merge_status": "can_be_merged",
+		"author": map[string]interface{}{"username": "example-user"},
+		"web_url": "https://gitlab.example.com/example-group/example-repo/-/merge_requests/1",
+		"created_at": now,
+		"updated_at": now,
+	}
+	pipeline := map[string]interface{}{
+		"id": 3001,
+		"project_id": 42,
+		"status": "success",
+		"ref": "main",
+		"sha": "a1b2c3d4",
+		"web_url": "https://gitlab.example.com/example-group/example-repo/-/pipelines/3001",
+		"created_at": now,
+		"updated_at": now,
+	}
+	job := map[string]interface{}{
+		"id": 4001,
+		"name": "test",
+		"stage": "test",
+		"status": "success",
+		"ref": "main",
+		"pipeline": map[string]interface{}{"id": 3001},
+		"created_at": now,
+	}
+	note := map[string]interface{}{
+		"id": 5001,
+		"body": "Example comment",
+		"author": map[string]interface{}{"username": "example-user"},
+		"created_at": now,
+	}
+	
+	var response interface{}
+	switch {
+	case path == "/health":
+		response = map[string]interface{}{
+			"status": "ok",
+			"timestamp": now,
+		}
+	case path == "/api/v4/version":
+		response = map[string]interface{}{
+			"version": "16.0.0-ee",
+			"revision": "mock",
+		}
+	case path == "/api/v4/projects":
+		response = []interface{}{project}
+	case strings.HasSuffix(path, "/trace"):
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("Running with gitlab-runner\nJob succeeded\n"))
+		return
+	case strings.HasSuffix(path, "/notes"):
+		if r.Method == http.MethodPost {
+			response = note
+		} else {
+			response = []interface{}{note}
+		}
+	case strings.HasSuffix(path, "/jobs"):
+		response = []interface{}{job}
+	case strings.Contains(path, "/jobs/"):
+		response = job
+	case strings.HasSuffix(path, "/pipelines"):
+		response = []interface{}{pipeline}
+	case strings.Contains(path, "/pipelines/"):
+		response = pipeline
+	case strings.HasSuffix(path, "/merge_requests"):
+		if r.Method == http.MethodPost {
+			response = mergeRequest
+		} else {
+			response = []interface{}{mergeRequest}
+		}
+	case strings.Contains(path, "/merge_requests/"):
+		if strings.HasSuffix(path, "/merge") {
+			mergeRequest["state"] = "merged"
+			mergeRequest["merged_at"] = now
+		}
+		response = mergeRequest
+	case strings.HasSuffix(path, "/issues"):
+		if r.Method == http.MethodPost {
+			response = issue
+		} else {
+			response = []interface{}{issue}
+		}
+	case strings.Contains(path, "/issues/"):
+		if r.Method == http.MethodPut {
+			issue["state"] = "closed"
+			issue["closed_at"] = now
+		}
+		response = issue
+	case strings.HasPrefix(path, "/api/v4/projects/"):
+		response = project
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		response = map[string]interface{}{
+			"message": "404 Not Found",
+		}
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
+// mockJenkinsHandler serves a minimal Jenkins JSON API. Triggered builds are
+// queued as item 1, which immediately resolves to build 1.
+func mockJenkinsHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock Jenkins request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	
+	path := strings.TrimPrefix(r.URL.Path, "/mock-jenkins")
+	
+	build := map[string]interface{}{
+		"number": 1,
+		"fullDisplayName": "example-job #1",
+		"result": "SUCCESS",
+		"building": false,
+		"timestamp": time.Now().Add(-2 * time.Minute).UnixMilli(),
+		"duration": 60000,
+		"queueId": 1,
+		"url": "http://localhost:8081/mock-jenkins/job/example-job/1/",
+	}
+	
+	var response interface{}
+	switch {
+	case path == "/health":
+		response = map[string]interface{}{
+			"status": "ok",
+			"timestamp": time.Now().Format(time.RFC3339),
+		}
+	case path == "/api/json":
+		response = map[string]interface{}{
+			"mode": "NORMAL",
+		}
+	case path == "/crumbIssuer/api/json":
+		response = map[string]interface{}{
+			"crumb": "mock-crumb",
+			"crumbRequestField": "Jenkins-Crumb",
+		}
+	case strings.HasSuffix(path, "/build") || strings.HasSuffix(path, "/buildWithParameters"):
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Location", "http://localhost:8081/mock-jenkins/queue/item/1/")
+		w.WriteHeader(http.StatusCreated)
+		return
+	case strings.HasPrefix(path, "/queue/item/"):
+		response = map[string]interface{}{
+			"id": 1,
+			"task": map[string]interface{}{"name": "example-job"},
+			"executable": map[string]interface{}{
+				"number": 1,
+				"url": "http://localhost:8081/mock-jenkins/job/example-job/1/",
+			},
+		}
+	case strings.HasSuffix(path, "/logText/progressiveText"):
+		text := "Started by user mock\nFinished: SUCCESS\n"
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("X-Text-Size", strconv.Itoa(len(text)))
+		w.Write([]byte(text))
+		return
+	case strings.HasSuffix(path, "/testReport/api/json"):
+		response = map[string]interface{}{
+			"duration": 1.5,
+			"passCount": 10,
+			"failCount": 0,
+			"skipCount": 0,
+			"suites": []interface{}{},
+		}
+	case strings.HasSuffix(path, "/stop"):
+		w.WriteHeader(http.StatusOK)
+		return
+	case strings.HasPrefix(path, "/job/") && strings.HasSuffix(path, "/api/json"):
+		jobPath := strings.TrimSuffix(path, "/api/json")
+		if _, err := strconv.Atoi(jobPath[strings.LastIndex(jobPath, "/")+1:]); err == nil || strings.HasSuffix(jobPath, "Build") {
+			response = build
+		} else {
+			response = map[string]interface{}{
+				"name": "example-job",
+				"fullName": "example-job",
+				"url": "http://localhost:8081/mock-jenkins/job/example-job/",
+				"buildable": true,
+				"color": "blue",
+				"lastBuild": build,
+				"builds": []interface{}{build},
+			}
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		response = map[string]interface{}{
+			"message": "Not Found",
+		}
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
+// mockPagerDutyHandler serves a minimal PagerDuty REST and Events v2 API
+func mockPagerDutyHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock PagerDuty request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	
+	path := strings.TrimPrefix(r.URL.Path, "/mock-pagerduty")
+	now := time.Now().UTC().Format(time.RFC3339)
+	
+	incident := map[string]interface{}{
+		"id": "PMOCK01",
+		"incident_number": 1,
+		"title": "Example incident",
+		"status": "triggered",
+		"urgency": "high",
+		"html_url": "https://example.pagerduty.com/incidents/PMOCK01",
+		"created_at": now,
+		"service": map[string]interface{}{"id": "PSVC01", "summary": "example-service"},
+		"escalation_policy": map[string]interface{}{"id": "PEP01", "summary": "Example Policy"},
+	}
+	user := map[string]interface{}{"id": "PUSER01", "summary": "Example User", "email": "user@example.com"}
+	
+	var response interface{}
+	switch {
+	case path == "/health":
+		response = map[string]interface{}{
+			"status": "ok",
+			"timestamp": now,
+		}
+	case path == "/abilities":
+		response = map[string]interface{}{
+			"abilities": []string{"teams", "read_only_users"},
+		}
+	case path == "/v2/enqueue":
+		w.WriteHeader(http.StatusAccepted)
+		response = map[string]interface{}{
+			"status": "success",
+			"message": "Event processed",
+			"dedup_key": "mock-dedup-key",
+		}
+	case path == "/incidents" && r.Method == http.MethodPut:
+		var body struct {
+			Incidents []map[string]interface{} `json:"incidents"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body.Incidents) > 0 {
+			incident["status"] = body.Incidents[0]["status"]
+		}
+		response = map[string]interface{}{"incidents": []interface{}{incident}}
+	case path == "/incidents":
+		response = map[string]interface{}{"incidents": []interface{}{incident}, "limit": 25, "more": false}
+	case strings.HasSuffix(path, "/notes"):
+		note := map[string]interface{}{
+			"id": "PNOTE01",
+			"content": "Example note",
+			"created_at": now,
+			"user": user,
+		}
+		if r.Method == http.MethodPost {
+			response = map[string]interface{}{"note": note}
+		} else {
+			response = map[string]interface{}{"notes": []interface{}{note}}
+		}
+	case strings.HasPrefix(path, "/incidents/"):
+		response = map[string]interface{}{"incident": incident}
+	case path == "/oncalls":
+		response = map[string]interface{}{
+			"oncalls": []interface{}{
+				map[string]interface{}{
+					"escalation_level": 1,
+					"user": user,
+					"schedule": map[string]interface{}{"id": "PSCHED01", "summary": "Primary"},
+					"escalation_policy": map[string]interface{}{"id": "PEP01", "summary": "Example Policy"},
+				},
+			},
+		}
+	case strings.HasPrefix(path, "/schedules/"):
+		response = map[string]interface{}{
+			"schedule": map[string]interface{}{
+				"id": "PSCHED01",
+				"name": "Primary",
+				"time_zone": "UTC",
+				"final_schedule": map[string]interface{}{
+					"rendered_schedule_entries": []interface{}{
+						map[string]interface{}{
+							"start": now,
+							"end": time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339),
+							"user": user,
+						},
+					},
+				},
+			},
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		response = map[string]interface{}{
+			"error": map[string]interface{}{"message": "Not Found", "code": 2100},
+		}
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
+// mockJiraHandler serves a minimal Jira REST API v2
+func mockJiraHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock Jira request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	
+	path := strings.TrimPrefix(r.URL.Path, "/mock-jira/rest/api/2")
+	now := time.Now().UTC().Format("2006-01-02T15:04:05.000-0700")
+	
+	issue := map[string]interface{}{
+		"id": "10001",
+		"key": "MOCK-1",
+		"fields": map[string]interface{}{
+			"summary": "Example issue",
+			"description": "Created by the mock server",
+			"status": map[string]interface{}{"name": "To Do"},
+			"issuetype": map[string]interface{}{"name": "Task"},
+			"project": map[string]interface{}{"key": "MOCK"},
+			"priority": map[string]interface{}{"name": "Medium"},
+			"labels": []string{},
+			"created": now,
+			"updated": now,
+		},
+	}
+	
+	var response interface{}
+	switch {
+	case path == "/serverInfo":
+		response = map[string]interface{}{
+			"baseUrl": "http://localhost:8081/mock-jira",
+			"version": "9.12.0",
+			"deploymentType": "Server",
+			"serverTime": now,
+		}
+	case path == "/field":
+		response = []interface{}{
+			map[string]interface{}{"id": "summary", "name": "Summary", "custom": false},
+			map[string]interface{}{"id": "customfield_10010", "name": "Change Risk", "custom": true},
+		}
+	case path == "/search":
+		response = map[string]interface{}{
+			"total": 1,
+			"startAt": 0,
+			"maxResults": 50,
+			"issues": []interface{}{issue},
+		}
+	case path == "/issue" && r.Method == http.MethodPost:
+		w.WriteHeader(http.StatusCreated)
+		response = map[string]interface{}{
+			"id": "10002",
+			"key": "MOCK-2",
+			"self": "http://localhost:8081/mock-jira/rest/api/2/issue/10002",
+		}
+	case path == "/issueLink" || (strings.HasSuffix(path, "/transitions") && r.Method == http.MethodPost):
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case strings.HasSuffix(path, "/transitions"):
+		response = map[string]interface{}{
+			"transitions": []interface{}{
+				map[string]interface{}{"id": "21", "name": "Start Progress", "to": map[string]interface{}{"name": "In Progress"}},
+				map[string]interface{}{"id": "31", "name": "Done", "to": map[string]interface{}{"name": "Done"}},
+			},
+		}
+	case strings.HasSuffix(path, "/comment") && r.Method == http.MethodPost:
+		var body struct {
+			Body string `json:"body"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		response = map[string]interface{}{
+			"id": "100",
+			"body": body.Body,
+			"author": map[string]interface{}{"displayName": "Mock User"},
+			"created": now,
+		}
+	case strings.HasPrefix(path, "/issue/"):
+		response = issue
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		response = map[string]interface{}{
+			"errorMessages": []string{"Not Found"},
+			"errors": map[string]interface{}{},
+		}
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
+// mockSlackHandler serves a minimal Slack Web API. Like Slack, errors are
+// reported with ok=false in a 200 response.
+func mockSlackHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock Slack request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	
+	method := strings.TrimPrefix(r.URL.Path, "/mock-slack/")
+	ts := strconv.FormatInt(time.Now().Unix(), 10) + ".000100"
+	
+	var response map[string]interface{}
+	switch {
+	case method == "auth.test":
+		response = map[string]interface{}{
+			"ok": true,
+			"team": "Mock Team",
+			"user": "mcp-bot",
+			"bot_id": "B0MOCK",
+		}
+	case method == "chat.postMessage" || method == "chat.update":
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["channel"] == nil || body["channel"] == "" {
+			response = map[string]interface{}{"ok": false, "error": "channel_not_found"}
+			break
+		}
+		if method == "chat.update" {
+			ts, _ = body["ts"].(string)
+		}
+		response = map[string]interface{}{
+			"ok": true,
+			"channel": body["channel"],
+			"ts": ts,
+			"message": map[string]interface{}{
+				"text": body["text"],
+				"ts": ts,
+				"thread_ts": body["thread_ts"],
+			},
+		}
+	case method == "files.getUploadURLExternal":
+		response = map[string]interface{}{
+			"ok": true,
+			"upload_url": "http://" + r.Host + "/mock-slack/upload/F0MOCK",
+			"file_id": "F0MOCK",
+		}
+	case strings.HasPrefix(method, "upload/"):
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("OK - " + strconv.FormatInt(r.ContentLength, 10)))
+		return
+	case method == "files.completeUploadExternal":
+		response = map[string]interface{}{
+			"ok": true,
+			"files": []interface{}{map[string]interface{}{"id": "F0MOCK", "title": "snippet"}},
+		}
+	default:
+		response = map[string]interface{}{"ok": false, "error": "unknown_method"}
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
+// mockArgoCDHandler serves a minimal Argo CD API with a single application
+func mockArgoCDHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock Argo CD request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	
+	path := strings.TrimPrefix(r.URL.Path, "/mock-argocd")
+	now := time.Now().UTC().Format(time.RFC3339)
+	
+	app := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": "guestbook",
+			"namespace": "argocd",
+			"labels": map[string]interface{}{"environment": "staging"},
+		},
+		"spec": map[string]interface{}{
+			"project": "default",
+			"source": map[string]interface{}{
+				"repoURL": "https://github.com/argoproj/argocd-example-apps.git",
+				"path": "guestbook",
+				"targetRevision": "HEAD",
+			},
+			"destination": map[string]interface{}{
+				"server": "https://kubernetes.default.svc",
+				"namespace": "guestbook",
+			},
+		},
+		"status": map[string]interface{}{
+			"sync": map[string]interface{}{"status": "OutOfSync", "revision": "53e28ff"},
+			"health": map[string]interface{}{"status": "Healthy"},
+			"history": []interface{}{
+				map[string]interface{}{"id": 1, "revision": "4e0b9a1", "deployedAt": now},
+			},
+		},
+	}
+	
+	var response interface{}
+	switch {
+	case path == "/api/version":
+		response = map[string]interface{}{"Version": "v2.12.0+mock"}
+	case path == "/api/v1/applications":
+		response = map[string]interface{}{"items": []interface{}{app}}
+	case path == "/api/v1/applications/guestbook" && r.Method == http.MethodGet:
+		response = app
+	case path == "/api/v1/applications/guestbook/managed-resources":
+		response = map[string]interface{}{
+			"items": []interface{}{
+				map[string]interface{}{
+					"kind": "Deployment",
+					"namespace": "guestbook",
+					"name": "guestbook-ui",
+					"targetState": `{"spec":{"replicas":2}}`,
+					"liveState": `{"spec":{"replicas":1}}`,
+					"modified": true,
+				},
+			},
+		}
+	case (path == "/api/v1/applications/guestbook/sync" || path == "/api/v1/applications/guestbook/rollback") && r.Method == http.MethodPost:
+		app["status"].(map[string]interface{})["operationState"] = map[string]interface{}{
+			"phase": "Running",
+			"startedAt": now,
+		}
+		response = app
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		response = map[string]interface{}{
+			"error": "application not found",
+			"code": 5,
+			"message": "application not found",
+		}
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
+// mockPrometheusHandler serves a minimal Prometheus (/api/v1) and
+// Alertmanager (/api/v2) API from the same base URL
+func mockPrometheusHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock Prometheus request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	
+	path := strings.TrimPrefix(r.URL.Path, "/mock-prometheus")
+	now := time.Now().Unix()
+	
+	var response interface{}
+	switch {
+	case path == "/api/v1/status/buildinfo":
+		response = map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{"version": "2.44.0"},
+		}
+	case path == "/api/v1/query":
+		if r.URL.Query().Get("query") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			response = map[string]interface{}{
+				"status": "error",
+				"errorType": "bad_data",
+				"error": "invalid parameter \"query\": empty query",
+			}
+			break
+		}
+		response = map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"resultType": "vector",
+				"result": []interface{}{
+					map[string]interface{}{
+						"metric": map[string]interface{}{"job": "mcp-server", "instance": "mcp-server:8080"},
+						"value": []interface{}{now, "1"},
+					},
+				},
+			},
+		}
+	case path == "/api/v1/query_range":
+		values := []interface{}{}
+		for i := int64(0); i < 10; i++ {
+			values = append(values, []interface{}{now - (9-i)*60, strconv.FormatInt(i%3, 10)})
+		}
+		response = map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"resultType": "matrix",
+				"result": []interface{}{
+					map[string]interface{}{
+						"metric": map[string]interface{}{"job": "mcp-server"},
+						"values": values,
+					},
+				},
+			},
+		}
+	case path == "/api/v1/series":
+		response = map[string]interface{}{
+			"status": "success",
+			"data": []interface{}{
+				map[string]interface{}{"__name__": "up", "job": "mcp-server", "instance": "mcp-server:8080"},
+				map[string]interface{}{"__name__": "up", "job": "prometheus", "instance": "localhost:9090"},
+			},
+		}
+	case path == "/api/v1/labels":
+		response = map[string]interface{}{
+			"status": "success",
+			"data": []string{"__name__", "instance", "job"},
+		}
+	case strings.HasPrefix(path, "/api/v1/label/"):
+		response = map[string]interface{}{
+			"status": "success",
+			"data": []string{"mcp-server", "prometheus"},
+		}
+	case path == "/api/v2/status":
+		response = map[string]interface{}{
+			"cluster": map[string]interface{}{"status": "ready"},
+			"versionInfo": map[string]interface{}{"version": "0.27.0"},
+		}
+	case path == "/api/v2/alerts":
+		response = []interface{}{
+			map[string]interface{}{
+				"labels": map[string]interface{}{"alertname": "HighErrorRate", "severity": "warning", "job": "mcp-server"},
+				"annotations": map[string]interface{}{"summary": "Error rate above 5%"},
+				"startsAt": time.Now().Add(-10 * time.Minute).UTC().Format(time.RFC3339),
+				"endsAt": time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+				"fingerprint": "6f2c1a",
+				"status": map[string]interface{}{"state": "active", "silencedBy": []string{}, "inhibitedBy": []string{}},
+			},
+		}
+	case path == "/api/v2/silences" && r.Method == http.MethodPost:
+		response = map[string]interface{}{"silenceID": "mock-silence-1"}
+	case path == "/api/v2/silences":
+		response = []interface{}{}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		response = map[string]interface{}{
+			"status": "error",
+			"errorType": "not_found",
+			"error": "unknown endpoint",
+		}
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
+// mockTerraformHandler mocks the Terraform Cloud API endpoints used by the Terraform adapter
+func mockTerraformHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock Terraform request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	
+	path := strings.TrimPrefix(r.URL.Path, "/mock-terraform")
+	run := map[string]interface{}{
+		"id": "run-mock-1",
+		"type": "runs",
+		"attributes": map[string]interface{}{
+			"status": "planned",
+			"message": "Triggered via mock",
+			"source": "tfe-api",
+			"has-changes": true,
+			"is-destroy": false,
+			"created-at": time.Now().Add(-5 * time.Minute).UTC().Format(time.RFC3339),
+		},
+		"relationships": map[string]interface{}{
+			"plan": map[string]interface{}{"data": map[string]interface{}{"id": "plan-mock-1", "type": "plans"}},
+			"workspace": map[string]interface{}{"data": map[string]interface{}{"id": "ws-mock-1", "type": "workspaces"}},
+		},
+	}
+	
+	var response interface{}
+	switch {
+	case path == "/api/v2/account/details":
+		response = map[string]interface{}{
+			"data": map[string]interface{}{"id": "user-mock-1", "type": "users", "attributes": map[string]interface{}{"username": "mcp-server"}},
+		}
+	case strings.HasPrefix(path, "/api/v2/organizations/") && strings.Contains(path, "/workspaces/"):
+		name := path[strings.LastIndex(path, "/")+1:]
+		response = map[string]interface{}{
+			"data": map[string]interface{}{"id": "ws-mock-1", "type": "workspaces", "attributes": map[string]interface{}{"name": name}},
+		}
+	case strings.HasPrefix(path, "/api/v2/workspaces/") && strings.HasSuffix(path, "/runs"):
+		response = map[string]interface{}{"data": []interface{}{run}}
+	case path == "/api/v2/runs/run-mock-1":
+		response = map[string]interface{}{"data": run}
+	case path == "/api/v2/plans/plan-mock-1/json-output":
+		w.Header().Set("Content-Type", "application/json")
+		response = map[string]interface{}{
+			"format_version": "1.2",
+			"terraform_version": "1.9.5",
+			"resource_changes": []interface{}{
+				map[string]interface{}{
+					"address": "aws_instance.web",
+					"mode": "managed",
+					"type": "aws_instance",
+					"name": "web",
+					"change": map[string]interface{}{
+						"actions": []string{"update"},
+						"before": map[string]interface{}{"instance_type": "t3.small"},
+						"after": map[string]interface{}{"instance_type": "t3.medium"},
+					},
+				},
+			},
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		response = map[string]interface{}{
+			"errors": []interface{}{
+				map[string]interface{}{"status": "404", "title": "not found"},
+			},
+		}
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
+// mockOpenAPIHandler echoes requests for adapters generated from OpenAPI
+// documents, since their responses depend on the document
+func mockOpenAPIHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("Mock OpenAPI request: %s %s", r.Method, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	
+	var body interface{}
+	if r.Body != nil {
+		json.NewDecoder(r.Body).Decode(&body)
+	}
+	
+	status := http.StatusOK
+	if r.Method == http.MethodPost {
+		status = http.StatusCreated
+	}
+	w.WriteHeader(status)
+	
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"mock": true,
+		"method": r.Method,
+		"path": strings.TrimPrefix(r.URL.Path, "/mock-openapi"),
+		"query": r.URL.Query(),
+		"body": body,
+	})
+}
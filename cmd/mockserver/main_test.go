@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"testing"
 	"time"
+	"strconv"
 	"strings"
 	"io"
 	"bytes"
@@ -170,6 +171,727 @@ func TestGitHubMockHandler(t *testing.T) {
 	}
 }
 
+// TestGitLabMockHandler tests the GitLab mock API handler with table-driven tests
+func TestGitLabMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Health Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-gitlab/health",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"status", "timestamp"},
+		},
+		{
+			name:           "Version Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-gitlab/api/v4/version",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"version", "revision"},
+		},
+		{
+			name:           "Get Project",
+			method:         http.MethodGet,
+			path:           "/mock-gitlab/api/v4/projects/42",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"id", "path_with_namespace", "default_branch"},
+		},
+		{
+			name:           "Create Issue",
+			method:         http.MethodPost,
+			path:           "/mock-gitlab/api/v4/projects/42/issues",
+			requestBody:    `{"title":"Example Issue"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"state": "opened"},
+		},
+		{
+			name:           "Close Issue",
+			method:         http.MethodPut,
+			path:           "/mock-gitlab/api/v4/projects/42/issues/1",
+			requestBody:    `{"state_event":"close"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"state": "closed"},
+		},
+		{
+			name:           "Merge Merge Request",
+			method:         http.MethodPut,
+			path:           "/mock-gitlab/api/v4/projects/42/merge_requests/1/merge",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"state": "merged"},
+			expectedFields: []string{"merged_at"},
+		},
+		{
+			name:           "Unknown Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-gitlab/unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   map[string]interface{}{"message": "404 Not Found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reqBody io.Reader
+			if tc.requestBody != "" {
+				reqBody = strings.NewReader(tc.requestBody)
+			}
+
+			req, err := http.NewRequest(tc.method, tc.path, reqBody)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockGitLabHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+
+	t.Run("Job Trace", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/mock-gitlab/api/v4/projects/42/jobs/4001/trace", nil)
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(mockGitLabHandler).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/plain", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Body.String(), "Job succeeded")
+	})
+}
+
+// TestJenkinsMockHandler tests the Jenkins mock API handler with table-driven tests
+func TestJenkinsMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Health Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-jenkins/health",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"status", "timestamp"},
+		},
+		{
+			name:           "Crumb Issuer",
+			method:         http.MethodGet,
+			path:           "/mock-jenkins/crumbIssuer/api/json",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"crumbRequestField": "Jenkins-Crumb"},
+		},
+		{
+			name:           "Get Job",
+			method:         http.MethodGet,
+			path:           "/mock-jenkins/job/example-job/api/json",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"name": "example-job", "color": "blue"},
+			expectedFields: []string{"lastBuild", "builds"},
+		},
+		{
+			name:           "Get Build",
+			method:         http.MethodGet,
+			path:           "/mock-jenkins/job/example-job/1/api/json",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"result": "SUCCESS", "building": false},
+		},
+		{
+			name:           "Queue Item",
+			method:         http.MethodGet,
+			path:           "/mock-jenkins/queue/item/1/api/json",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"executable"},
+		},
+		{
+			name:           "Unknown Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-jenkins/unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   map[string]interface{}{"message": "Not Found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, tc.path, nil)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockJenkinsHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+
+	t.Run("Trigger Build", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/mock-jenkins/job/example-job/buildWithParameters", strings.NewReader("ENV=staging"))
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(mockJenkinsHandler).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Header().Get("Location"), "/queue/item/1/")
+	})
+
+	t.Run("Console Output", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/mock-jenkins/job/example-job/1/logText/progressiveText?start=0", nil)
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(mockJenkinsHandler).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, strconv.Itoa(rr.Body.Len()), rr.Header().Get("X-Text-Size"))
+		assert.Contains(t, rr.Body.String(), "Finished: SUCCESS")
+	})
+}
+
+// TestPagerDutyMockHandler tests the PagerDuty mock API handler with table-driven tests
+func TestPagerDutyMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Health Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-pagerduty/health",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"status", "timestamp"},
+		},
+		{
+			name:           "List Incidents",
+			method:         http.MethodGet,
+			path:           "/mock-pagerduty/incidents",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"incidents", "more"},
+		},
+		{
+			name:           "Get Incident",
+			method:         http.MethodGet,
+			path:           "/mock-pagerduty/incidents/PMOCK01",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"incident"},
+		},
+		{
+			name:           "Add Note",
+			method:         http.MethodPost,
+			path:           "/mock-pagerduty/incidents/PMOCK01/notes",
+			requestBody:    `{"note":{"content":"Investigating"}}`,
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"note"},
+		},
+		{
+			name:           "List On-Calls",
+			method:         http.MethodGet,
+			path:           "/mock-pagerduty/oncalls",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"oncalls"},
+		},
+		{
+			name:           "Send Event",
+			method:         http.MethodPost,
+			path:           "/mock-pagerduty/v2/enqueue",
+			requestBody:    `{"routing_key":"key","event_action":"trigger"}`,
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   map[string]interface{}{"status": "success"},
+		},
+		{
+			name:           "Unknown Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-pagerduty/unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedFields: []string{"error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reqBody io.Reader
+			if tc.requestBody != "" {
+				reqBody = strings.NewReader(tc.requestBody)
+			}
+
+			req, err := http.NewRequest(tc.method, tc.path, reqBody)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockPagerDutyHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+
+	t.Run("Acknowledge Incident", func(t *testing.T) {
+		body := `{"incidents":[{"id":"PMOCK01","type":"incident_reference","status":"acknowledged"}]}`
+		req := httptest.NewRequest(http.MethodPut, "/mock-pagerduty/incidents", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(mockPagerDutyHandler).ServeHTTP(rr, req)
+
+		var response struct {
+			Incidents []map[string]interface{} `json:"incidents"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Incidents, 1)
+		assert.Equal(t, "acknowledged", response.Incidents[0]["status"])
+	})
+}
+
+// TestJiraMockHandler tests the Jira mock API handler with table-driven tests
+func TestJiraMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Server Info",
+			method:         http.MethodGet,
+			path:           "/mock-jira/rest/api/2/serverInfo",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"baseUrl", "version"},
+		},
+		{
+			name:           "Search Issues",
+			method:         http.MethodPost,
+			path:           "/mock-jira/rest/api/2/search",
+			requestBody:    `{"jql":"project = MOCK"}`,
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"total", "issues"},
+		},
+		{
+			name:           "Get Issue",
+			method:         http.MethodGet,
+			path:           "/mock-jira/rest/api/2/issue/MOCK-1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"key": "MOCK-1"},
+		},
+		{
+			name:           "Create Issue",
+			method:         http.MethodPost,
+			path:           "/mock-jira/rest/api/2/issue",
+			requestBody:    `{"fields":{"project":{"key":"MOCK"},"summary":"New"}}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   map[string]interface{}{"key": "MOCK-2"},
+		},
+		{
+			name:           "Get Transitions",
+			method:         http.MethodGet,
+			path:           "/mock-jira/rest/api/2/issue/MOCK-1/transitions",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"transitions"},
+		},
+		{
+			name:           "Add Comment",
+			method:         http.MethodPost,
+			path:           "/mock-jira/rest/api/2/issue/MOCK-1/comment",
+			requestBody:    `{"body":"Looks good"}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   map[string]interface{}{"body": "Looks good"},
+		},
+		{
+			name:           "Unknown Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-jira/rest/api/2/unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedFields: []string{"errorMessages"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reqBody io.Reader
+			if tc.requestBody != "" {
+				reqBody = strings.NewReader(tc.requestBody)
+			}
+
+			req, err := http.NewRequest(tc.method, tc.path, reqBody)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockJiraHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+
+	t.Run("Transition Issue", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/mock-jira/rest/api/2/issue/MOCK-1/transitions", strings.NewReader(`{"transition":{"id":"21"}}`))
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(mockJiraHandler).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+}
+
+// TestSlackMockHandler tests the Slack mock API handler with table-driven tests
+func TestSlackMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Auth Test",
+			method:         http.MethodPost,
+			path:           "/mock-slack/auth.test",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"ok": true},
+		},
+		{
+			name:           "Post Message",
+			method:         http.MethodPost,
+			path:           "/mock-slack/chat.postMessage",
+			requestBody:    `{"channel":"C0OPS","text":"hello"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"ok": true, "channel": "C0OPS"},
+			expectedFields: []string{"ts", "message"},
+		},
+		{
+			name:           "Post Message Without Channel",
+			method:         http.MethodPost,
+			path:           "/mock-slack/chat.postMessage",
+			requestBody:    `{"text":"hello"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"ok": false, "error": "channel_not_found"},
+		},
+		{
+			name:           "Update Message",
+			method:         http.MethodPost,
+			path:           "/mock-slack/chat.update",
+			requestBody:    `{"channel":"C0OPS","ts":"1700000000.000100","text":"updated"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"ok": true, "ts": "1700000000.000100"},
+		},
+		{
+			name:           "Reserve Upload",
+			method:         http.MethodPost,
+			path:           "/mock-slack/files.getUploadURLExternal",
+			requestBody:    `filename=plan.txt&length=10`,
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"upload_url", "file_id"},
+		},
+		{
+			name:           "Unknown Method",
+			method:         http.MethodPost,
+			path:           "/mock-slack/admin.users.remove",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"ok": false, "error": "unknown_method"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reqBody io.Reader
+			if tc.requestBody != "" {
+				reqBody = strings.NewReader(tc.requestBody)
+			}
+
+			req, err := http.NewRequest(tc.method, tc.path, reqBody)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockSlackHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+}
+
+// TestArgoCDMockHandler tests the Argo CD mock API handler
+func TestArgoCDMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Version",
+			method:         http.MethodGet,
+			path:           "/mock-argocd/api/version",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"Version"},
+		},
+		{
+			name:           "List Applications",
+			method:         http.MethodGet,
+			path:           "/mock-argocd/api/v1/applications",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"items"},
+		},
+		{
+			name:           "Get Application",
+			method:         http.MethodGet,
+			path:           "/mock-argocd/api/v1/applications/guestbook",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"metadata", "spec", "status"},
+		},
+		{
+			name:           "Managed Resources",
+			method:         http.MethodGet,
+			path:           "/mock-argocd/api/v1/applications/guestbook/managed-resources",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"items"},
+		},
+		{
+			name:           "Sync Application",
+			method:         http.MethodPost,
+			path:           "/mock-argocd/api/v1/applications/guestbook/sync",
+			requestBody:    `{"prune":false}`,
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"status"},
+		},
+		{
+			name:           "Unknown Application",
+			method:         http.MethodGet,
+			path:           "/mock-argocd/api/v1/applications/missing",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   map[string]interface{}{"message": "application not found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reqBody io.Reader
+			if tc.requestBody != "" {
+				reqBody = strings.NewReader(tc.requestBody)
+			}
+
+			req, err := http.NewRequest(tc.method, tc.path, reqBody)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockArgoCDHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+}
+
+// TestPrometheusMockHandler tests the Prometheus and Alertmanager mock API handler
+func TestPrometheusMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Instant Query",
+			method:         http.MethodGet,
+			path:           "/mock-prometheus/api/v1/query?query=up",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"status": "success"},
+			expectedFields: []string{"data"},
+		},
+		{
+			name:           "Empty Query",
+			method:         http.MethodGet,
+			path:           "/mock-prometheus/api/v1/query",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   map[string]interface{}{"status": "error", "errorType": "bad_data"},
+		},
+		{
+			name:           "Range Query",
+			method:         http.MethodGet,
+			path:           "/mock-prometheus/api/v1/query_range?query=up",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"status": "success"},
+		},
+		{
+			name:           "Label Values",
+			method:         http.MethodGet,
+			path:           "/mock-prometheus/api/v1/label/job/values",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"data"},
+		},
+		{
+			name:           "Create Silence",
+			method:         http.MethodPost,
+			path:           "/mock-prometheus/api/v2/silences",
+			requestBody:    `{"matchers":[{"name":"alertname","value":"HighErrorRate","isRegex":false}],"comment":"test"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"silenceID": "mock-silence-1"},
+		},
+		{
+			name:           "Unknown Endpoint",
+			method:         http.MethodGet,
+			path:           "/mock-prometheus/api/v1/targets",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   map[string]interface{}{"status": "error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reqBody io.Reader
+			if tc.requestBody != "" {
+				reqBody = strings.NewReader(tc.requestBody)
+			}
+
+			req, err := http.NewRequest(tc.method, tc.path, reqBody)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockPrometheusHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+}
+
+func TestTerraformMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Account Details",
+			method:         http.MethodGet,
+			path:           "/mock-terraform/api/v2/account/details",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"data"},
+		},
+		{
+			name:           "Workspace Runs",
+			method:         http.MethodGet,
+			path:           "/mock-terraform/api/v2/workspaces/ws-mock-1/runs?page%5Bsize%5D=20",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"data"},
+		},
+		{
+			name:           "Plan JSON Output",
+			method:         http.MethodGet,
+			path:           "/mock-terraform/api/v2/plans/plan-mock-1/json-output",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"format_version": "1.2"},
+			expectedFields: []string{"resource_changes"},
+		},
+		{
+			name:           "Unknown Run",
+			method:         http.MethodGet,
+			path:           "/mock-terraform/api/v2/runs/run-missing",
+			expectedStatus: http.StatusNotFound,
+			expectedFields: []string{"errors"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, tc.path, nil)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockTerraformHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+}
+
+func TestOpenAPIMockHandler(t *testing.T) {
+	testCases := []MockHandlerTestCase{
+		{
+			name:           "Echo GET",
+			method:         http.MethodGet,
+			path:           "/mock-openapi/v1/invoices?status=open",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"method": "GET", "path": "/v1/invoices"},
+			expectedFields: []string{"query"},
+		},
+		{
+			name:           "Echo POST",
+			method:         http.MethodPost,
+			path:           "/mock-openapi/v1/invoices",
+			requestBody:    `{"amount":10}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   map[string]interface{}{"body": map[string]interface{}{"amount": float64(10)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reqBody io.Reader
+			if tc.requestBody != "" {
+				reqBody = strings.NewReader(tc.requestBody)
+			}
+
+			req, err := http.NewRequest(tc.method, tc.path, reqBody)
+			require.NoError(t, err, "Failed to create request")
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(mockOpenAPIHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code, "HTTP status code mismatch")
+
+			var response map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &response)
+			require.NoError(t, err, "Response is not valid JSON")
+
+			for key, value := range tc.expectedBody {
+				assert.Equal(t, value, response[key], "Response field mismatch: "+key)
+			}
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, response, field, "Response missing expected field: "+field)
+			}
+		})
+	}
+}
+
 // TestMockHandlers tests all mock API handlers with a shared test framework
 func TestMockHandlers(t *testing.T) {
 	// Define the mock handlers mapping
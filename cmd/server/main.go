@@ -114,6 +114,12 @@ func main() {
 	}
 	defer cacheClient.Close()
 
+	// The Redis event bus connects with the cache settings
+	cfg.Engine.EventBus.Redis = cacheConfig
+
+	// Adapters are configured in the adapters section
+	cfg.Engine.Adapters = adapterConfigs(cfg)
+
 	// Initialize engine
 	var engine *core.Engine
 	engine, err = core.NewEngine(ctx, cfg.Engine, db, cacheClient, metricsClient)
@@ -229,10 +235,36 @@ func validateConfiguration(cfg *config.Config) error {
 	
 	// Check webhook secrets if webhooks are enabled
 	if cfg.API.Webhooks.GitHub.Enabled && cfg.API.Webhooks.GitHub.Secret == "" {
-		log.Println("Warning: GitHub webhooks enabled without a secret - consider adding a secret for security")
+		log.Println("Warning: GitHub webhooks enabled without a secret - GitHub deliveries will be rejected")
 	}
 	
 	return nil
 }
 
+// adapterConfigs returns the adapters section with the GitHub webhook secret
+// of the webhook settings, unless the github adapter sets its own
+func adapterConfigs(cfg *config.Config) map[string]interface{} {
+	configs := make(map[string]interface{}, len(cfg.Adapters)+1)
+	for adapterType, adapterConfig := range cfg.Adapters {
+		configs[adapterType] = adapterConfig
+	}
+	
+	github := cfg.API.Webhooks.GitHub
+	if !github.Enabled || github.Secret == "" {
+		return configs
+	}
+	
+	githubConfig := map[string]interface{}{}
+	if existing, ok := configs["github"].(map[string]interface{}); ok {
+		for key, value := range existing {
+			githubConfig[key] = value
+		}
+	}
+	if secret, _ := githubConfig["webhook_secret"].(string); secret == "" {
+		githubConfig["webhook_secret"] = github.Secret
+	}
+	configs["github"] = githubConfig
+	return configs
+}
+
 // S3 functionality has been removed in this version
@@ -0,0 +1,169 @@
+// Command webhookctl records webhook deliveries as fixtures and replays them
+// against a running server.
+//
+// Record deliveries by pointing a service, or a tunnel, at the recorder:
+//
+//	webhookctl record -listen :9090 -out internal/adapters/gitlab/testdata/webhooks -forward http://localhost:8080
+//
+// Replay fixture files or directories, re-signed with the webhook secret the
+// server is configured with:
+//
+//	webhookctl replay -url http://localhost:8080 -secret $GITLAB_WEBHOOK_SECRET internal/adapters/gitlab/testdata/webhooks
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+const usage = `Usage:
+  webhookctl record [-listen addr] [-out dir] [-adapter name] [-forward url]
+  webhookctl replay [-url url] [-secret secret] fixture-or-dir...
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs a subcommand and returns the exit code
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	var err error
+	switch args[0] {
+	case "record":
+		err = record(ctx, args[1:], stderr)
+	case "replay":
+		err = replay(ctx, args[1:], stdout, stderr)
+	default:
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	if errors.Is(err, flag.ErrHelp) {
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "webhookctl: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// record serves the recorder until ctx is cancelled
+func record(ctx context.Context, args []string, stderr io.Writer) error {
+	flags := flag.NewFlagSet("record", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	listen := flags.String("listen", ":9090", "address to receive webhooks on")
+	out := flags.String("out", "testdata/webhooks", "directory to write fixtures to")
+	adapter := flags.String("adapter", "", "adapter of the recorded deliveries (default: last segment of the request path)")
+	forward := flags.String("forward", "", "base URL of a server to forward deliveries to, e.g. http://localhost:8080")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	logger := log.New(stderr, "", log.LstdFlags)
+	server := &http.Server{
+		Addr: *listen,
+		Handler: &fixture.Recorder{
+			Dir:     *out,
+			Adapter: *adapter,
+			Forward: *forward,
+			Client:  &http.Client{Timeout: 30 * time.Second},
+			Logger:  logger,
+		},
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Printf("Recording webhooks on %s to %s", *listen, *out)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// replay delivers fixtures to a server and prints the response status of
+// each. It fails if a fixture can't be delivered or isn't accepted.
+func replay(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	serverURL := flags.String("url", "http://localhost:8080", "base URL of the server")
+	secret := flags.String("secret", os.Getenv("WEBHOOK_SECRET"), "webhook secret to sign deliveries with (default: $WEBHOOK_SECRET)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return errors.New("no fixtures to replay")
+	}
+
+	var fixtures []*fixture.Fixture
+	for _, path := range flags.Args() {
+		loaded, err := fixture.LoadPath(path)
+		if err != nil {
+			return err
+		}
+		fixtures = append(fixtures, loaded...)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	failed := 0
+	for _, f := range fixtures {
+		status, err := deliver(ctx, client, *serverURL, f.Sign(*secret, time.Now()))
+		if err != nil {
+			fmt.Fprintf(stdout, "%-40s %s\n", f.Name, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(stdout, "%-40s %s\n", f.Name, status)
+		if !strings.HasPrefix(status, "2") {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d deliveries failed", failed, len(fixtures))
+	}
+	return nil
+}
+
+// deliver sends a fixture to the server and returns the response status
+func deliver(ctx context.Context, client *http.Client, serverURL string, f *fixture.Fixture) (string, error) {
+	req, err := f.NewRequest(ctx, serverURL)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	return resp.Status, nil
+}
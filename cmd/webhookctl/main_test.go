@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	var paths []string
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		paths = append(paths, r.URL.Path)
+		tokens = append(tokens, r.Header.Get("X-Gitlab-Token"))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	fixtures := filepath.Join("..", "..", "internal", "adapters", "gitlab", "testdata", "webhooks")
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"replay", "-url", server.URL, "-secret", "s3cret", fixtures}, &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+
+	assert.Equal(t, []string{"/webhooks/gitlab", "/webhooks/gitlab", "/webhooks/gitlab"}, paths)
+	assert.Equal(t, []string{"s3cret", "s3cret", "s3cret"}, tokens)
+	assert.Contains(t, stdout.String(), "merge-request-hook.json")
+	assert.Contains(t, stdout.String(), "202 Accepted")
+}
+
+func TestReplayFailsOnRejectedDeliveries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	fixture := filepath.Join("..", "..", "internal", "adapters", "jira", "testdata", "webhooks", "issue-updated.json")
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"replay", "-url", server.URL, fixture}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), "401 Unauthorized")
+	assert.Contains(t, stderr.String(), "1 of 1 deliveries failed")
+}
+
+func TestUsage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 2, run(context.Background(), nil, &stdout, &stderr))
+	assert.Equal(t, 2, run(context.Background(), []string{"unknown"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "webhookctl replay")
+
+	stderr.Reset()
+	assert.Equal(t, 1, run(context.Background(), []string{"replay"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "no fixtures to replay")
+}
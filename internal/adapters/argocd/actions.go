@@ -0,0 +1,127 @@
+package argocd
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// applicationParams are the params identifying an application
+var applicationParams = map[string]interface{}{
+	"name": map[string]interface{}{
+		"type":        "string",
+		"description": "Application name",
+		"minLength":   1,
+	},
+	"app_namespace": map[string]interface{}{
+		"type":        "string",
+		"description": "Namespace of the application. Defaults to the configured namespace",
+	},
+}
+
+// deploymentParams are the params of syncs and rollbacks. Applications
+// labelled as production require an approval reference.
+var deploymentParams = map[string]interface{}{
+	"prune": map[string]interface{}{
+		"type":        "boolean",
+		"description": "Delete resources that are no longer in Git. Defaults to false",
+	},
+	"dry_run": map[string]interface{}{
+		"type":        "boolean",
+		"description": "Preview the operation without applying it. Defaults to false",
+	},
+	"approval_ref": map[string]interface{}{
+		"type":        "string",
+		"description": "Reference to the approval of the change, required for production applications",
+	},
+}
+
+// Actions returns the descriptors of the supported Argo CD actions
+func (a *ArgoCDAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{
+		{
+			Name:        "list_applications",
+			Description: "List applications, optionally filtered by label selector and project",
+			Params: paramsSchema(nil, map[string]interface{}{
+				"selector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector, such as team=payments",
+				},
+				"project": map[string]interface{}{
+					"type":        "string",
+					"description": "Project to list the applications of",
+				},
+				"projects": map[string]interface{}{
+					"type":        []interface{}{"string", "array"},
+					"description": "Projects to list the applications of",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+				"app_namespace": applicationParams["app_namespace"],
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "get_application",
+			Description: "Get an application with the status of its resources and its deployment history",
+			Params: paramsSchema([]string{"name"}, applicationParams, map[string]interface{}{
+				"refresh": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Refresh the application from Git first. Defaults to false",
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "get_resource_diff",
+			Description: "Get the differences between the desired and live state of the out of sync resources of an application",
+			Params:      paramsSchema([]string{"name"}, applicationParams),
+			Safety:      core.SafetyRead,
+		},
+		{
+			Name:        "sync_application",
+			Description: "Sync an application to its target revision or to the given revision",
+			Params: paramsSchema([]string{"name"}, applicationParams, deploymentParams, map[string]interface{}{
+				"revision": map[string]interface{}{
+					"type":        "string",
+					"description": "Git revision to sync to. Defaults to the target revision",
+				},
+				"sync_options": map[string]interface{}{
+					"type":        []interface{}{"string", "array"},
+					"description": "Sync options, such as CreateNamespace=true",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+			}),
+			Safety: core.SafetyDestructive,
+		},
+		{
+			Name:        "rollback_application",
+			Description: "Roll an application back to a deployment in its history. Automated sync must be disabled",
+			Params: paramsSchema([]string{"name", "history_id"}, applicationParams, deploymentParams, map[string]interface{}{
+				"history_id": map[string]interface{}{
+					"type":        "integer",
+					"description": "ID of the deployment to roll back to, from the application history",
+					"minimum":     0,
+				},
+			}),
+			Safety: core.SafetyDestructive,
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
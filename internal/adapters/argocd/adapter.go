@@ -0,0 +1,584 @@
+package argocd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/jobs"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/safety"
+)
+
+// adapterType is the unique identifier for the Argo CD adapter
+const adapterType = "argocd"
+
+// ArgoCDAdapter provides an adapter for Argo CD applications: status, diffs,
+// syncs and rollbacks
+type ArgoCDAdapter struct {
+	config        *Config
+	baseURL       string
+	client        *http.Client
+	checker       safety.Checker
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+}
+
+// New creates a new Argo CD adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*ArgoCDAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("argocd_adapter")
+	}
+
+	baseURL := config.ServerURL
+	if config.MockResponses {
+		baseURL = config.MockURL
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("argo CD server URL is required")
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if config.InsecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &ArgoCDAdapter{
+		config:        config,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		client:        &http.Client{Timeout: config.RequestTimeout, Transport: transport},
+		checker:       safety.NewArgoCDChecker(config.ProductionLabels...),
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+	}, nil
+}
+
+// Type returns the adapter type
+func (a *ArgoCDAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *ArgoCDAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status
+func (a *ArgoCDAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := a.doRequest(ctx, "health", http.MethodGet, "/api/version", nil, nil, nil); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+
+	if a.config.MockResponses {
+		return "healthy (mock)"
+	}
+	return "healthy"
+}
+
+// ExecuteAction executes an Argo CD action
+func (a *ArgoCDAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing Argo CD action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	// Applications
+	case "list_applications":
+		result, err = a.listApplications(ctx, params)
+	case "get_application":
+		result, err = a.getApplication(ctx, params)
+	case "get_resource_diff":
+		result, err = a.getResourceDiff(ctx, params)
+
+	// Deployments
+	case "sync_application":
+		result, err = a.syncApplication(ctx, contextID, params)
+	case "rollback_application":
+		result, err = a.rollbackApplication(ctx, params)
+
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported Argo CD action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// listApplications lists applications, optionally filtered by label selector and project
+func (a *ArgoCDAdapter) listApplications(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	query := url.Values{}
+	if selector := core.StringParam(params, "selector"); selector != "" {
+		query.Set("selector", selector)
+	}
+	for _, project := range core.StringSliceParam(params, "projects") {
+		query.Add("projects", project)
+	}
+	if project := core.StringParam(params, "project"); project != "" {
+		query.Add("projects", project)
+	}
+	if namespace := a.appNamespace(params); namespace != "" {
+		query.Set("appNamespace", namespace)
+	}
+
+	var response struct {
+		Items []application `json:"items"`
+	}
+	if err := a.doRequest(ctx, "list_applications", http.MethodGet, "/api/v1/applications", query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	applications := make([]Application, 0, len(response.Items))
+	for _, app := range response.Items {
+		applications = append(applications, toApplication(app, false))
+	}
+
+	return applications, nil
+}
+
+// getApplication gets an application with the status of its resources and
+// its deployment history
+func (a *ArgoCDAdapter) getApplication(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	name, err := a.applicationName("get_application", params)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := a.fetchApplication(ctx, "get_application", name, params, core.BoolParam(params, "refresh", false))
+	if err != nil {
+		return nil, err
+	}
+
+	return toApplication(*app, true), nil
+}
+
+// getResourceDiff returns the differences between the desired and live state
+// of the out of sync resources of an application
+func (a *ArgoCDAdapter) getResourceDiff(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	name, err := a.applicationName("get_resource_diff", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Items []managedResource `json:"items"`
+	}
+	path := "/api/v1/applications/" + url.PathEscape(name) + "/managed-resources"
+	if err := a.doRequest(ctx, "get_resource_diff", http.MethodGet, path, a.namespaceQuery(params), nil, &response); err != nil {
+		return nil, err
+	}
+
+	diffs := []ResourceDiff{}
+	for _, resource := range response.Items {
+		if !resource.Modified || resource.Hook {
+			continue
+		}
+		diff, err := diffResource(resource, a.config.MaxDiffFields)
+		if err != nil {
+			return nil, adapterErrors.NewUnknownError(adapterType, "get_resource_diff", err, nil)
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+// syncApplication syncs an application to its target revision or to the
+// given revision. Pruning is off unless requested.
+func (a *ArgoCDAdapter) syncApplication(ctx context.Context, contextID string, params map[string]interface{}) (interface{}, error) {
+	name, err := a.applicationName("sync_application", params)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := a.fetchApplication(ctx, "sync_application", name, params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.checkSafety("sync_application", app, params); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"prune":  core.BoolParam(params, "prune", false),
+		"dryRun": core.BoolParam(params, "dry_run", false),
+	}
+	if namespace := a.appNamespace(params); namespace != "" {
+		body["appNamespace"] = namespace
+	}
+	if revision := core.StringParam(params, "revision"); revision != "" {
+		body["revision"] = revision
+	}
+	if options := core.StringSliceParam(params, "sync_options"); len(options) > 0 {
+		body["syncOptions"] = map[string]interface{}{"items": options}
+	}
+
+	// Recorded on the sync operation so it can be traced from the Argo CD UI
+	infos := []map[string]string{}
+	if approval := core.StringParam(params, "approval_ref"); approval != "" {
+		infos = append(infos, map[string]string{"name": "approval_ref", "value": approval})
+	}
+	if contextID != "" {
+		infos = append(infos, map[string]string{"name": "mcp_context_id", "value": contextID})
+	}
+	if len(infos) > 0 {
+		body["infos"] = infos
+	}
+
+	jobs.ReportProgress(ctx, 50, fmt.Sprintf("Syncing %s", name))
+
+	var response application
+	path := "/api/v1/applications/" + url.PathEscape(name) + "/sync"
+	if err := a.doRequest(ctx, "sync_application", http.MethodPost, path, nil, body, &response); err != nil {
+		return nil, err
+	}
+
+	return toApplication(response, false), nil
+}
+
+// rollbackApplication rolls an application back to a deployment in its
+// history. Argo CD only rolls back applications without automated sync.
+func (a *ArgoCDAdapter) rollbackApplication(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	name, err := a.applicationName("rollback_application", params)
+	if err != nil {
+		return nil, err
+	}
+
+	historyID := core.IntParam(params, "history_id", -1)
+	if historyID < 0 {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "rollback_application",
+			fmt.Errorf("missing required parameter: history_id"), nil)
+	}
+
+	app, err := a.fetchApplication(ctx, "rollback_application", name, params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.checkSafety("rollback_application", app, params); err != nil {
+		return nil, err
+	}
+
+	summary := toApplication(*app, true)
+	if summary.AutoSync {
+		return nil, adapterErrors.NewInvalidRequestError(adapterType, "rollback_application",
+			fmt.Errorf("application %s has automated sync enabled, which must be disabled before rolling back", name), nil)
+	}
+
+	found := false
+	for _, entry := range summary.History {
+		if entry.ID == historyID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "rollback_application",
+			fmt.Errorf("history ID %d not found for application %s", historyID, name), nil)
+	}
+
+	body := map[string]interface{}{
+		"id":     historyID,
+		"prune":  core.BoolParam(params, "prune", false),
+		"dryRun": core.BoolParam(params, "dry_run", false),
+	}
+	if namespace := a.appNamespace(params); namespace != "" {
+		body["appNamespace"] = namespace
+	}
+
+	jobs.ReportProgress(ctx, 50, fmt.Sprintf("Rolling %s back to deployment %d", name, historyID))
+
+	var response application
+	path := "/api/v1/applications/" + url.PathEscape(name) + "/rollback"
+	if err := a.doRequest(ctx, "rollback_application", http.MethodPost, path, nil, body, &response); err != nil {
+		return nil, err
+	}
+
+	return toApplication(response, false), nil
+}
+
+// checkSafety runs the safety checker against a sync or rollback with the
+// labels of the target application
+func (a *ArgoCDAdapter) checkSafety(operation string, app *application, params map[string]interface{}) error {
+	checked := make(map[string]interface{}, len(params)+1)
+	for k, v := range params {
+		checked[k] = v
+	}
+	checked["app_labels"] = app.Metadata.Labels
+
+	if safe, err := a.checker.IsSafeOperation(operation, checked); !safe {
+		if err == nil {
+			err = safety.ErrOperationNotAllowed
+		}
+		return adapterErrors.NewForbiddenError(adapterType, operation, err, nil)
+	}
+	return nil
+}
+
+// HandleWebhook handles an Argo CD notifications webhook. The payload is
+// defined by the notification template and is published as received.
+func (a *ArgoCDAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	var body map[string]interface{}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook",
+			fmt.Errorf("failed to parse Argo CD notification payload: %w", err), nil)
+	}
+
+	if eventType == "" {
+		eventType, _ = body["trigger"].(string)
+	}
+	app, _ := body["app"].(string)
+
+	a.logger.Info("Received Argo CD notification", map[string]interface{}{
+		"eventType": eventType,
+		"app":       app,
+	})
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, body).
+			WithMetadata("eventType", eventType).
+			WithMetadata("app", app)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// Close closes the adapter
+func (a *ArgoCDAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// fetchApplication gets an application, optionally refreshing it from Git first
+func (a *ArgoCDAdapter) fetchApplication(ctx context.Context, operation, name string, params map[string]interface{}, refresh bool) (*application, error) {
+	query := a.namespaceQuery(params)
+	if refresh {
+		query.Set("refresh", "normal")
+	}
+
+	var app application
+	if err := a.doRequest(ctx, operation, http.MethodGet, "/api/v1/applications/"+url.PathEscape(name), query, nil, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// applicationName returns the required name parameter
+func (a *ArgoCDAdapter) applicationName(operation string, params map[string]interface{}) (string, error) {
+	name, err := core.RequiredStringParam(params, "name")
+	if err != nil {
+		return "", adapterErrors.NewInvalidParameterError(adapterType, operation, err, nil)
+	}
+	return name, nil
+}
+
+// appNamespace returns the application namespace parameter or the configured default
+func (a *ArgoCDAdapter) appNamespace(params map[string]interface{}) string {
+	if namespace := core.StringParam(params, "app_namespace"); namespace != "" {
+		return namespace
+	}
+	return a.config.AppNamespace
+}
+
+// namespaceQuery returns the appNamespace query parameter, if any
+func (a *ArgoCDAdapter) namespaceQuery(params map[string]interface{}) url.Values {
+	query := url.Values{}
+	if namespace := a.appNamespace(params); namespace != "" {
+		query.Set("appNamespace", namespace)
+	}
+	return query
+}
+
+// doRequest performs an API request and decodes the JSON response into out
+func (a *ArgoCDAdapter) doRequest(ctx context.Context, operation, method, path string, query url.Values, body interface{}, out interface{}) error {
+	requestURL := a.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if a.config.AuthToken != "" {
+		req.Header.Set("Authorization", "Bearer "+a.config.AuthToken)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("argo CD API returned %d: %s", resp.StatusCode, errorMessage(respBody)), nil)
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Argo CD response: %w", err), nil)
+	}
+
+	return nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *ArgoCDAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// errorMessage extracts the message from an Argo CD error response
+func errorMessage(body []byte) string {
+	var response struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &response) == nil {
+		if response.Message != "" {
+			return response.Message
+		}
+		if response.Error != "" {
+			return response.Error
+		}
+	}
+	return strings.TrimSpace(string(body))
+}
+
+// toApplication summarizes an application. Resources and history are
+// included for detailed views only.
+func toApplication(app application, detailed bool) Application {
+	result := Application{
+		Name:                 app.Metadata.Name,
+		Namespace:            app.Metadata.Namespace,
+		Project:              app.Spec.Project,
+		Labels:               app.Metadata.Labels,
+		DestinationServer:    app.Spec.Destination.Server,
+		DestinationNamespace: app.Spec.Destination.Namespace,
+		SyncStatus:           app.Status.Sync.Status,
+		SyncRevision:         app.Status.Sync.Revision,
+		HealthStatus:         app.Status.Health.Status,
+		HealthMessage:        app.Status.Health.Message,
+	}
+	if result.DestinationServer == "" {
+		result.DestinationServer = app.Spec.Destination.Name
+	}
+	if source := app.Spec.Source; source != nil {
+		result.RepoURL = source.RepoURL
+		result.Path = source.Path
+		result.Chart = source.Chart
+		result.TargetRevision = source.TargetRevision
+	}
+	if policy := app.Spec.SyncPolicy; policy != nil && policy.Automated != nil {
+		result.AutoSync = true
+	}
+	if operation := app.Status.OperationState; operation != nil {
+		result.OperationPhase = operation.Phase
+		result.OperationMessage = operation.Message
+	}
+
+	if !detailed {
+		return result
+	}
+
+	for _, resource := range app.Status.Resources {
+		status := ResourceStatus{
+			Group:      resource.Group,
+			Kind:       resource.Kind,
+			Namespace:  resource.Namespace,
+			Name:       resource.Name,
+			SyncStatus: resource.Status,
+		}
+		if resource.Health != nil {
+			status.HealthStatus = resource.Health.Status
+			status.HealthMessage = resource.Health.Message
+		}
+		result.Resources = append(result.Resources, status)
+	}
+
+	// History is returned newest first
+	for i := len(app.Status.History) - 1; i >= 0; i-- {
+		entry := app.Status.History[i]
+		result.History = append(result.History, HistoryEntry{
+			ID:         entry.ID,
+			Revision:   entry.Revision,
+			DeployedAt: entry.DeployedAt,
+		})
+	}
+
+	return result
+}
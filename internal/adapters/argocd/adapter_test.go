@@ -0,0 +1,329 @@
+package argocd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/jobs"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/safety"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// recordedRequest captures the parts of a request the tests assert on
+type recordedRequest struct {
+	method  string
+	path    string
+	query   map[string][]string
+	body    map[string]interface{}
+	headers http.Header
+}
+
+const stagingAppJSON = `{"metadata":{"name":"checkout","namespace":"argocd","labels":{"environment":"staging"}},
+	"spec":{"project":"shop","source":{"repoURL":"https://git.example.com/shop.git","path":"deploy/checkout","targetRevision":"main"},
+	"destination":{"server":"https://kubernetes.default.svc","namespace":"checkout"}},
+	"status":{"sync":{"status":"OutOfSync","revision":"a1b2c3"},"health":{"status":"Degraded","message":"1 pod crash looping"},
+	"resources":[{"kind":"Deployment","namespace":"checkout","name":"checkout","status":"OutOfSync","health":{"status":"Degraded"}}],
+	"history":[{"id":3,"revision":"9f8e7d","deployedAt":"2026-10-17T09:00:00Z"},{"id":4,"revision":"a1b2c3","deployedAt":"2026-10-18T09:00:00Z"}]}}`
+
+const productionAppJSON = `{"metadata":{"name":"payments","labels":{"environment":"Production"}},
+	"spec":{"project":"shop","destination":{"server":"https://prod.example.com","namespace":"payments"}},
+	"status":{"sync":{"status":"OutOfSync"},"health":{"status":"Healthy"},"history":[{"id":11,"revision":"c0ffee"}]}}`
+
+func newTestAdapter(t *testing.T, responses map[string]string) (*ArgoCDAdapter, *[]recordedRequest) {
+	requests := []recordedRequest{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded := recordedRequest{
+			method:  r.Method,
+			path:    r.URL.Path,
+			query:   r.URL.Query(),
+			headers: r.Header,
+		}
+		json.NewDecoder(r.Body).Decode(&recorded.body)
+		requests = append(requests, recorded)
+
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"applications.argoproj.io \"missing\" not found","code":5,"message":"applications.argoproj.io \"missing\" not found"}`))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.ServerURL = server.URL
+	config.AuthToken = "argocd-token"
+
+	logger := observability.NewLogger("argocd_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+
+	return adapter, &requests
+}
+
+func TestListApplications(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v1/applications": `{"items":[` + stagingAppJSON + `,` + productionAppJSON + `]}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_applications", map[string]interface{}{
+		"selector": "team=shop",
+		"project":  "shop",
+	})
+	require.NoError(t, err)
+
+	applications := result.([]Application)
+	require.Len(t, applications, 2)
+	assert.Equal(t, "checkout", applications[0].Name)
+	assert.Equal(t, "OutOfSync", applications[0].SyncStatus)
+	assert.Equal(t, "Degraded", applications[0].HealthStatus)
+	assert.Equal(t, "deploy/checkout", applications[0].Path)
+	assert.Nil(t, applications[0].History, "history is only returned for a single application")
+
+	require.Len(t, *requests, 1)
+	request := (*requests)[0]
+	assert.Equal(t, "Bearer argocd-token", request.headers.Get("Authorization"))
+	assert.Equal(t, []string{"team=shop"}, request.query["selector"])
+	assert.Equal(t, []string{"shop"}, request.query["projects"])
+}
+
+func TestGetApplication(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v1/applications/checkout": stagingAppJSON,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_application", map[string]interface{}{
+		"name":    "checkout",
+		"refresh": true,
+	})
+	require.NoError(t, err)
+
+	app := result.(Application)
+	assert.Equal(t, "1 pod crash looping", app.HealthMessage)
+	require.Len(t, app.Resources, 1)
+	assert.Equal(t, "Degraded", app.Resources[0].HealthStatus)
+	require.Len(t, app.History, 2)
+	assert.Equal(t, 4, app.History[0].ID, "history is newest first")
+
+	assert.Equal(t, []string{"normal"}, (*requests)[0].query["refresh"])
+}
+
+func TestGetResourceDiff(t *testing.T) {
+	target := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"checkout"},"spec":{"replicas":3,"template":{"spec":{"containers":[{"name":"app","image":"shop/checkout:1.5"}]}}}}`
+	live := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"checkout","uid":"1234"},"spec":{"replicas":2,"template":{"spec":{"containers":[{"name":"app","image":"shop/checkout:1.4"}]}}},"status":{"replicas":2}}`
+
+	resources := []map[string]interface{}{
+		{"kind": "Deployment", "name": "checkout", "targetState": target, "normalizedLiveState": live, "modified": true},
+		{"kind": "Service", "name": "checkout", "targetState": `{"kind":"Service"}`, "liveState": `{"kind":"Service"}`, "modified": false},
+		{"kind": "ConfigMap", "name": "flags", "targetState": `{"kind":"ConfigMap"}`, "liveState": "null", "modified": true},
+	}
+	body, err := json.Marshal(map[string]interface{}{"items": resources})
+	require.NoError(t, err)
+
+	adapter, _ := newTestAdapter(t, map[string]string{
+		"GET /api/v1/applications/checkout/managed-resources": string(body),
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_resource_diff", map[string]interface{}{"name": "checkout"})
+	require.NoError(t, err)
+
+	diffs := result.([]ResourceDiff)
+	require.Len(t, diffs, 2, "unmodified resources are skipped")
+	assert.Equal(t, "update", diffs[0].Action)
+	assert.Equal(t, []FieldDiff{
+		{Path: "spec.replicas", Live: float64(2), Target: float64(3)},
+		{Path: "spec.template.spec.containers[0].image", Live: "shop/checkout:1.4", Target: "shop/checkout:1.5"},
+	}, diffs[0].Changes)
+	assert.Equal(t, "create", diffs[1].Action)
+}
+
+func TestSyncApplication(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v1/applications/checkout":       stagingAppJSON,
+		"POST /api/v1/applications/checkout/sync": stagingAppJSON,
+	})
+
+	var progress []string
+	ctx := jobs.WithProgressReporter(context.Background(), func(percent int, message string) {
+		progress = append(progress, message)
+	})
+
+	result, err := adapter.ExecuteAction(ctx, "ctx-1", "sync_application", map[string]interface{}{
+		"name":         "checkout",
+		"revision":     "a1b2c3",
+		"sync_options": []interface{}{"ApplyOutOfSyncOnly=true"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "checkout", result.(Application).Name)
+
+	require.Len(t, *requests, 2)
+	sync := (*requests)[1]
+	assert.Equal(t, "/api/v1/applications/checkout/sync", sync.path)
+	assert.Equal(t, false, sync.body["prune"], "prune is off by default")
+	assert.Equal(t, "a1b2c3", sync.body["revision"])
+	assert.Equal(t, map[string]interface{}{"items": []interface{}{"ApplyOutOfSyncOnly=true"}}, sync.body["syncOptions"])
+	assert.Equal(t, []string{"Syncing checkout"}, progress)
+}
+
+func TestProductionApplicationsRequireApproval(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v1/applications/payments":           productionAppJSON,
+		"POST /api/v1/applications/payments/sync":     productionAppJSON,
+		"POST /api/v1/applications/payments/rollback": productionAppJSON,
+	})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "sync_application", map[string]interface{}{"name": "payments"})
+	require.Error(t, err)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeForbidden))
+	assert.ErrorIs(t, err, safety.ErrRestrictedOperation)
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "rollback_application", map[string]interface{}{"name": "payments", "history_id": 11})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeForbidden))
+
+	for _, request := range *requests {
+		assert.Equal(t, http.MethodGet, request.method, "blocked operations are not sent to Argo CD")
+	}
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "sync_application", map[string]interface{}{
+		"name":         "payments",
+		"approval_ref": "CHG-7",
+	})
+	require.NoError(t, err)
+
+	sync := (*requests)[len(*requests)-1]
+	assert.Equal(t, "/api/v1/applications/payments/sync", sync.path)
+	assert.Contains(t, sync.body["infos"], map[string]interface{}{"name": "approval_ref", "value": "CHG-7"})
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "rollback_application", map[string]interface{}{
+		"name":         "payments",
+		"history_id":   11,
+		"approval_ref": "CHG-7",
+	})
+	require.NoError(t, err)
+
+	rollback := (*requests)[len(*requests)-1]
+	assert.Equal(t, "/api/v1/applications/payments/rollback", rollback.path)
+	assert.Equal(t, float64(11), rollback.body["id"])
+	assert.Equal(t, false, rollback.body["prune"])
+}
+
+func TestRollbackApplicationValidation(t *testing.T) {
+	autoSyncApp := `{"metadata":{"name":"search"},"spec":{"syncPolicy":{"automated":{"prune":true}}},"status":{"history":[{"id":1}]}}`
+	adapter, _ := newTestAdapter(t, map[string]string{
+		"GET /api/v1/applications/checkout": stagingAppJSON,
+		"GET /api/v1/applications/search":   autoSyncApp,
+	})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "rollback_application", map[string]interface{}{"name": "checkout"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidParameter))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "rollback_application", map[string]interface{}{"name": "checkout", "history_id": 99})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidParameter))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "rollback_application", map[string]interface{}{"name": "search", "history_id": 1})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidRequest))
+	assert.Contains(t, err.Error(), "automated sync")
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 5)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	sync, _ := core.FindAction(actions, "sync_application")
+	assert.Equal(t, core.SafetyDestructive, sync.Safety)
+	assert.NoError(t, sync.ValidateParams(map[string]interface{}{"name": "payments", "revision": "v1.2.0", "prune": true, "approval_ref": "CHG-1"}))
+	assert.Error(t, sync.ValidateParams(map[string]interface{}{"name": "payments", "force": true}))
+
+	rollback, _ := core.FindAction(actions, "rollback_application")
+	assert.NoError(t, rollback.ValidateParams(map[string]interface{}{"name": "payments", "history_id": float64(3)}))
+	assert.Error(t, rollback.ValidateParams(map[string]interface{}{"name": "payments"}))
+
+	getDiff, _ := core.FindAction(actions, "get_resource_diff")
+	assert.Equal(t, core.SafetyRead, getDiff.Safety)
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_application", map[string]interface{}{"name": "missing"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+	assert.Contains(t, err.Error(), "not found")
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "sync_application", nil)
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "delete_application", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+
+	_, err = New(&Config{}, nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestReceiveWebhook(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+	delivery, err := fixture.Load("testdata/webhooks/on-sync-succeeded.json")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		configured string
+		signedWith string
+		expectCode string
+	}{
+		{"valid token", fixture.TestSecret, fixture.TestSecret, ""},
+		{"invalid token", fixture.TestSecret, "wrong", adapterErrors.ErrCodeUnauthorized},
+		{"unsigned delivery", fixture.TestSecret, "", adapterErrors.ErrCodeUnauthorized},
+		{"no token configured", "", fixture.TestSecret, adapterErrors.ErrCodeUnauthorized},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adapter.config.WebhookToken = test.configured
+			signed := delivery.Sign(test.signedWith, time.Now())
+
+			payload, err := adapter.ReceiveWebhook(signed.Header, signed.Query, signed.Payload())
+			if test.expectCode == "" {
+				require.NoError(t, err)
+				assert.Equal(t, delivery.Payload(), payload)
+				return
+			}
+			assert.True(t, adapterErrors.IsSpecificErrorCode(err, test.expectCode), "%v", err)
+		})
+	}
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.WebhookToken = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("argocd_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"on-health-degraded.json": "app.health_degraded",
+		"on-sync-succeeded.json":  "on-sync-succeeded",
+	})
+}
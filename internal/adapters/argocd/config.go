@@ -0,0 +1,47 @@
+package argocd
+
+import (
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/safety"
+)
+
+// Config holds configuration for the Argo CD adapter
+type Config struct {
+	// ServerURL is the Argo CD API server, e.g. https://argocd.example.com
+	ServerURL string `mapstructure:"server_url"`
+	// AuthToken is a bearer token for a local account or project role
+	AuthToken             string        `mapstructure:"auth_token"`
+	InsecureSkipTLSVerify bool          `mapstructure:"insecure_skip_tls_verify"`
+	RequestTimeout        time.Duration `mapstructure:"request_timeout"`
+
+	// AppNamespace is the namespace of applications when Argo CD manages
+	// applications in any namespace; empty uses the control plane namespace
+	AppNamespace string `mapstructure:"app_namespace"`
+
+	// Bearer token expected in the Authorization header of notification
+	// webhooks, set in the headers of the notifications webhook service
+	WebhookToken string `mapstructure:"webhook_token"`
+
+	// Safety settings. Applications carrying one of the production labels,
+	// given as key=value, can only be synced or rolled back with an approval
+	// reference.
+	ProductionLabels []string `mapstructure:"production_labels"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+
+	// Diff settings
+	MaxDiffFields int `mapstructure:"max_diff_fields"`
+}
+
+// DefaultConfig returns a default configuration for the Argo CD adapter
+func DefaultConfig() *Config {
+	return &Config{
+		RequestTimeout:   30 * time.Second,
+		ProductionLabels: append([]string(nil), safety.DefaultProductionLabels...),
+		MockURL:          "http://localhost:8081/mock-argocd",
+		MaxDiffFields:    200,
+	}
+}
@@ -0,0 +1,118 @@
+package argocd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ignoredDiffPaths are fields managed by the API server rather than by Git
+var ignoredDiffPaths = map[string]bool{
+	"metadata.managedFields":     true,
+	"metadata.resourceVersion":   true,
+	"metadata.generation":        true,
+	"metadata.uid":               true,
+	"metadata.creationTimestamp": true,
+	"status":                     true,
+	"metadata.annotations.kubectl.kubernetes.io/last-applied-configuration": true,
+}
+
+// diffResource compares the desired state of a managed resource with its live
+// state. Only fields set in the desired state are compared, since the live
+// state also holds fields defaulted by the API server.
+func diffResource(resource managedResource, maxFields int) (ResourceDiff, error) {
+	diff := ResourceDiff{
+		Group:     resource.Group,
+		Kind:      resource.Kind,
+		Namespace: resource.Namespace,
+		Name:      resource.Name,
+		Changes:   []FieldDiff{},
+	}
+
+	// The predicted state accounts for server-side defaulting when available
+	liveState := resource.PredictedLiveState
+	if isEmptyState(liveState) {
+		liveState = resource.NormalizedLiveState
+	}
+	if isEmptyState(liveState) {
+		liveState = resource.LiveState
+	}
+
+	switch {
+	case isEmptyState(resource.TargetState):
+		diff.Action = "delete"
+		return diff, nil
+	case isEmptyState(liveState):
+		diff.Action = "create"
+		return diff, nil
+	}
+	diff.Action = "update"
+
+	var target, live interface{}
+	if err := json.Unmarshal([]byte(resource.TargetState), &target); err != nil {
+		return diff, fmt.Errorf("failed to parse target state of %s/%s: %w", resource.Kind, resource.Name, err)
+	}
+	if err := json.Unmarshal([]byte(liveState), &live); err != nil {
+		return diff, fmt.Errorf("failed to parse live state of %s/%s: %w", resource.Kind, resource.Name, err)
+	}
+
+	compare("", target, live, &diff.Changes)
+
+	if maxFields > 0 && len(diff.Changes) > maxFields {
+		diff.Changes = diff.Changes[:maxFields]
+		diff.Truncated = true
+	}
+
+	return diff, nil
+}
+
+// compare appends the fields of target that differ from live
+func compare(path string, target, live interface{}, changes *[]FieldDiff) {
+	if ignoredDiffPaths[path] {
+		return
+	}
+
+	switch t := target.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			*changes = append(*changes, FieldDiff{Path: path, Live: live, Target: target})
+			return
+		}
+		keys := make([]string, 0, len(t))
+		for key := range t {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			compare(joinPath(path, key), t[key], l[key], changes)
+		}
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(t) {
+			*changes = append(*changes, FieldDiff{Path: path, Live: live, Target: target})
+			return
+		}
+		for i := range t {
+			compare(fmt.Sprintf("%s[%d]", path, i), t[i], l[i], changes)
+		}
+	default:
+		if !reflect.DeepEqual(target, live) {
+			*changes = append(*changes, FieldDiff{Path: path, Live: live, Target: target})
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func isEmptyState(state string) bool {
+	state = strings.TrimSpace(state)
+	return state == "" || state == "null"
+}
@@ -0,0 +1,147 @@
+package argocd
+
+import (
+	"time"
+)
+
+// The types below are the subset of the Argo CD API objects used by the adapter
+
+type application struct {
+	Metadata struct {
+		Name      string            `json:"name"`
+		Namespace string            `json:"namespace"`
+		Labels    map[string]string `json:"labels"`
+	} `json:"metadata"`
+	Spec struct {
+		Project     string             `json:"project"`
+		Source      *applicationSource `json:"source"`
+		Destination struct {
+			Server    string `json:"server"`
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"destination"`
+		SyncPolicy *struct {
+			Automated *struct {
+				Prune    bool `json:"prune"`
+				SelfHeal bool `json:"selfHeal"`
+			} `json:"automated"`
+		} `json:"syncPolicy"`
+	} `json:"spec"`
+	Status struct {
+		Sync struct {
+			Status   string `json:"status"`
+			Revision string `json:"revision"`
+		} `json:"sync"`
+		Health struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		} `json:"health"`
+		OperationState *struct {
+			Phase      string     `json:"phase"`
+			Message    string     `json:"message"`
+			StartedAt  time.Time  `json:"startedAt"`
+			FinishedAt *time.Time `json:"finishedAt"`
+		} `json:"operationState"`
+		Resources []struct {
+			Group     string `json:"group"`
+			Kind      string `json:"kind"`
+			Namespace string `json:"namespace"`
+			Name      string `json:"name"`
+			Status    string `json:"status"`
+			Health    *struct {
+				Status  string `json:"status"`
+				Message string `json:"message"`
+			} `json:"health"`
+		} `json:"resources"`
+		History []struct {
+			ID         int                `json:"id"`
+			Revision   string             `json:"revision"`
+			DeployedAt time.Time          `json:"deployedAt"`
+			Source     *applicationSource `json:"source"`
+		} `json:"history"`
+	} `json:"status"`
+}
+
+type applicationSource struct {
+	RepoURL        string `json:"repoURL"`
+	Path           string `json:"path"`
+	Chart          string `json:"chart"`
+	TargetRevision string `json:"targetRevision"`
+}
+
+type managedResource struct {
+	Group               string `json:"group"`
+	Kind                string `json:"kind"`
+	Namespace           string `json:"namespace"`
+	Name                string `json:"name"`
+	TargetState         string `json:"targetState"`
+	LiveState           string `json:"liveState"`
+	NormalizedLiveState string `json:"normalizedLiveState"`
+	PredictedLiveState  string `json:"predictedLiveState"`
+	Modified            bool   `json:"modified"`
+	Hook                bool   `json:"hook"`
+}
+
+// Application is a summary of an Argo CD application
+type Application struct {
+	Name                 string            `json:"name"`
+	Namespace            string            `json:"namespace,omitempty"`
+	Project              string            `json:"project"`
+	Labels               map[string]string `json:"labels,omitempty"`
+	RepoURL              string            `json:"repo_url,omitempty"`
+	Path                 string            `json:"path,omitempty"`
+	Chart                string            `json:"chart,omitempty"`
+	TargetRevision       string            `json:"target_revision,omitempty"`
+	DestinationServer    string            `json:"destination_server,omitempty"`
+	DestinationNamespace string            `json:"destination_namespace,omitempty"`
+	AutoSync             bool              `json:"auto_sync"`
+	SyncStatus           string            `json:"sync_status"`
+	SyncRevision         string            `json:"sync_revision,omitempty"`
+	HealthStatus         string            `json:"health_status"`
+	HealthMessage        string            `json:"health_message,omitempty"`
+	OperationPhase       string            `json:"operation_phase,omitempty"`
+	OperationMessage     string            `json:"operation_message,omitempty"`
+	// Resources and History are only set for a single application
+	Resources []ResourceStatus `json:"resources,omitempty"`
+	History   []HistoryEntry   `json:"history,omitempty"`
+}
+
+// ResourceStatus is the sync and health status of a managed resource
+type ResourceStatus struct {
+	Group         string `json:"group,omitempty"`
+	Kind          string `json:"kind"`
+	Namespace     string `json:"namespace,omitempty"`
+	Name          string `json:"name"`
+	SyncStatus    string `json:"sync_status"`
+	HealthStatus  string `json:"health_status,omitempty"`
+	HealthMessage string `json:"health_message,omitempty"`
+}
+
+// HistoryEntry is a past deployment that can be rolled back to
+type HistoryEntry struct {
+	ID         int       `json:"id"`
+	Revision   string    `json:"revision"`
+	DeployedAt time.Time `json:"deployed_at"`
+}
+
+// ResourceDiff is the difference between the live and desired state of a
+// managed resource
+type ResourceDiff struct {
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Action is create, delete or update; changes are only listed for updates
+	Action  string      `json:"action"`
+	Changes []FieldDiff `json:"changes"`
+	// Truncated is set when the changes were limited to MaxDiffFields
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// FieldDiff is a field of the desired state that differs from the live state.
+// A nil Live value means the field is not set on the live resource.
+type FieldDiff struct {
+	Path   string      `json:"path"`
+	Live   interface{} `json:"live"`
+	Target interface{} `json:"target"`
+}
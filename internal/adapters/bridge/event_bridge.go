@@ -7,6 +7,7 @@ import (
 
 	"github.com/S-Corkum/mcp-server/internal/adapters/core"
 	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
 	"github.com/S-Corkum/mcp-server/internal/events/system"
 	"github.com/S-Corkum/mcp-server/internal/observability"
 )
@@ -196,18 +197,9 @@ func (b *EventBridge) mapToSystemEvent(adapterEvent *events.AdapterEvent) system
 	}
 }
 
-// getSystemEventType maps adapter event types to system event types
+// getSystemEventType maps adapter event types to system event types, which
+// are also the types of the CloudEvents converted from adapter events
 func getSystemEventType(eventType events.EventType) system.EventType {
-	switch eventType {
-	case events.EventTypeOperationSuccess:
-		return system.EventTypeAdapterOperationSuccess
-	case events.EventTypeOperationFailure:
-		return system.EventTypeAdapterOperationFailure
-	case events.EventTypeWebhookReceived:
-		return system.EventTypeWebhookReceived
-	case events.EventTypeAdapterHealthChanged:
-		return system.EventTypeAdapterHealthChanged
-	default:
-		return system.EventTypeAdapterGeneric
-	}
+	return system.EventType(cloudevents.AdapterEventType(eventType))
 }
+
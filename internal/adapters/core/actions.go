@@ -0,0 +1,338 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SafetyClass classifies the effect of an action
+type SafetyClass string
+
+const (
+	// SafetyRead actions only read data
+	SafetyRead SafetyClass = "read"
+	// SafetyWrite actions create or change data
+	SafetyWrite SafetyClass = "write"
+	// SafetyDestructive actions delete data or cannot be undone
+	SafetyDestructive SafetyClass = "destructive"
+)
+
+// ActionDescriptor describes an action of an adapter. Params and Result are
+// JSON Schemas; Params describes the params map passed to ExecuteAction.
+type ActionDescriptor struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Params      map[string]interface{} `json:"params"`
+	Result      map[string]interface{} `json:"result,omitempty"`
+	Safety      SafetyClass            `json:"safety"`
+	Scopes      []string               `json:"scopes,omitempty"`
+}
+
+// ActionDescriber is implemented by adapters that describe their actions.
+// Tool listings are generated from the descriptors, and params are validated
+// against their schema before the action is executed.
+type ActionDescriber interface {
+	// Actions returns the descriptors of the actions the adapter supports.
+	// Adapters wrapping other adapters return nil when the wrapped adapter
+	// does not describe its actions.
+	Actions() []ActionDescriptor
+}
+
+// DescribeActions returns the action descriptors of an adapter, sorted by
+// name, and whether the adapter describes its actions
+func DescribeActions(adapter Adapter) ([]ActionDescriptor, bool) {
+	describer, ok := adapter.(ActionDescriber)
+	if !ok {
+		return nil, false
+	}
+
+	described := describer.Actions()
+	if described == nil {
+		return nil, false
+	}
+
+	actions := append([]ActionDescriptor{}, described...)
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Name < actions[j].Name
+	})
+	return actions, true
+}
+
+// FindAction returns the descriptor of an action
+func FindAction(actions []ActionDescriptor, name string) (ActionDescriptor, bool) {
+	for _, action := range actions {
+		if action.Name == name {
+			return action, true
+		}
+	}
+	return ActionDescriptor{}, false
+}
+
+// ValidateParams validates action params against the params schema of the
+// action. Validation covers the JSON Schema keywords used by descriptors:
+// type, properties, required, additionalProperties, items, enum, minimum,
+// maximum, minLength, maxLength, minItems, maxItems and pattern.
+func (d ActionDescriptor) ValidateParams(params map[string]interface{}) error {
+	if d.Params == nil {
+		return nil
+	}
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	return ValidateSchema(d.Params, params)
+}
+
+// SchemaError is a value that does not match its schema
+type SchemaError struct {
+	// Path locates the value, such as params.labels[1]
+	Path    string
+	Message string
+}
+
+// Error returns the error message
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("%s %s", e.Path, e.Message)
+}
+
+// ValidateSchema validates a decoded JSON value against a JSON Schema
+func ValidateSchema(schema map[string]interface{}, value interface{}) error {
+	return validateValue("params", schema, value)
+}
+
+// validateValue validates a value at a path
+func validateValue(path string, schema map[string]interface{}, value interface{}) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	if types := schemaTypes(schema["type"]); len(types) > 0 {
+		matched := ""
+		for _, schemaType := range types {
+			if hasType(value, schemaType) {
+				matched = schemaType
+				break
+			}
+		}
+		if matched == "" {
+			return &SchemaError{Path: path, Message: "must be of type " + strings.Join(types, " or ")}
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		if !inEnum(enum, value) {
+			allowed := make([]string, len(enum))
+			for i, v := range enum {
+				allowed[i] = fmt.Sprint(v)
+			}
+			return &SchemaError{Path: path, Message: "must be one of " + strings.Join(allowed, ", ")}
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return validateObject(path, schema, v)
+	case []interface{}:
+		return validateArray(path, schema, v)
+	case []string:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return validateArray(path, schema, items)
+	case string:
+		return validateString(path, schema, v)
+	}
+
+	if number, ok := toNumber(value); ok {
+		if minimum, ok := toNumber(schema["minimum"]); ok && number < minimum {
+			return &SchemaError{Path: path, Message: fmt.Sprintf("must be at least %v", minimum)}
+		}
+		if maximum, ok := toNumber(schema["maximum"]); ok && number > maximum {
+			return &SchemaError{Path: path, Message: fmt.Sprintf("must be at most %v", maximum)}
+		}
+	}
+
+	return nil
+}
+
+// validateObject validates the properties of an object
+func validateObject(path string, schema map[string]interface{}, object map[string]interface{}) error {
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	for _, name := range schemaStrings(schema["required"]) {
+		if value, ok := object[name]; !ok || value == nil {
+			return &SchemaError{Path: path + "." + name, Message: "is required"}
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := object[name]
+		propertyPath := path + "." + name
+
+		propertySchema, known := properties[name].(map[string]interface{})
+		if !known {
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					return &SchemaError{Path: propertyPath, Message: "is not a known parameter"}
+				}
+				continue
+			case map[string]interface{}:
+				propertySchema = additional
+			default:
+				continue
+			}
+		}
+
+		// Optional parameters may be passed as null
+		if value == nil {
+			continue
+		}
+		if err := validateValue(propertyPath, propertySchema, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateArray validates the items of an array
+func validateArray(path string, schema map[string]interface{}, items []interface{}) error {
+	if minItems, ok := toNumber(schema["minItems"]); ok && float64(len(items)) < minItems {
+		return &SchemaError{Path: path, Message: fmt.Sprintf("must have at least %v items", minItems)}
+	}
+	if maxItems, ok := toNumber(schema["maxItems"]); ok && float64(len(items)) > maxItems {
+		return &SchemaError{Path: path, Message: fmt.Sprintf("must have at most %v items", maxItems)}
+	}
+
+	itemSchema, _ := schema["items"].(map[string]interface{})
+	for i, item := range items {
+		if err := validateValue(fmt.Sprintf("%s[%d]", path, i), itemSchema, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateString validates the length and pattern of a string
+func validateString(path string, schema map[string]interface{}, value string) error {
+	length := float64(len([]rune(value)))
+	if minLength, ok := toNumber(schema["minLength"]); ok && length < minLength {
+		return &SchemaError{Path: path, Message: fmt.Sprintf("must be at least %v characters", minLength)}
+	}
+	if maxLength, ok := toNumber(schema["maxLength"]); ok && length > maxLength {
+		return &SchemaError{Path: path, Message: fmt.Sprintf("must be at most %v characters", maxLength)}
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok && pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return &SchemaError{Path: path, Message: fmt.Sprintf("has an invalid pattern in its schema: %v", err)}
+		}
+		if !re.MatchString(value) {
+			return &SchemaError{Path: path, Message: "must match " + pattern}
+		}
+	}
+	return nil
+}
+
+// hasType reports whether a value is of a JSON Schema type
+func hasType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "integer":
+		number, ok := toNumber(value)
+		return ok && number == math.Trunc(number)
+	case "number":
+		_, ok := toNumber(value)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		switch value.(type) {
+		case []interface{}, []string:
+			return true
+		}
+		return false
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+// inEnum reports whether a value is one of the enum values
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, allowed := range enum {
+		if reflect.DeepEqual(allowed, value) {
+			return true
+		}
+		a, aok := toNumber(allowed)
+		v, vok := toNumber(value)
+		if aok && vok && a == v {
+			return true
+		}
+	}
+	return false
+}
+
+// toNumber converts a numeric value to a float64
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// schemaTypes returns the types allowed by a type keyword
+func schemaTypes(value interface{}) []string {
+	if schemaType, ok := value.(string); ok {
+		return []string{schemaType}
+	}
+	return schemaStrings(value)
+}
+
+// schemaStrings returns the strings of a schema list keyword
+func schemaStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
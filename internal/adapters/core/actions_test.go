@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParams(t *testing.T) {
+	action := ActionDescriptor{
+		Name: "createIssue",
+		Params: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"title":    map[string]interface{}{"type": "string", "minLength": 1},
+				"state":    map[string]interface{}{"type": "string", "enum": []interface{}{"open", "closed"}},
+				"labels":   map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}, "maxItems": 2},
+				"priority": map[string]interface{}{"type": "integer", "minimum": 1, "maximum": 5},
+				"path":     map[string]interface{}{"type": []interface{}{"string", "array"}},
+				"key":      map[string]interface{}{"type": "string", "pattern": "^[A-Z]+-[0-9]+$"},
+			},
+			"required":             []string{"title"},
+			"additionalProperties": false,
+		},
+	}
+
+	valid := []map[string]interface{}{
+		{"title": "Bug"},
+		{"title": "Bug", "state": "open", "labels": []interface{}{"bug"}, "priority": float64(2)},
+		{"title": "Bug", "labels": []string{"a", "b"}, "priority": 3},
+		{"title": "Bug", "path": "a/b"},
+		{"title": "Bug", "path": []interface{}{"a/b"}},
+		{"title": "Bug", "key": "OPS-12", "state": nil},
+	}
+	for _, params := range valid {
+		assert.NoError(t, action.ValidateParams(params), "%v", params)
+	}
+
+	invalid := map[string]map[string]interface{}{
+		"params.title is required":                    {},
+		"params.title must be at least 1 characters":  {"title": ""},
+		"params.state must be one of open, closed":    {"title": "Bug", "state": "new"},
+		"params.labels[1] must be of type string":     {"title": "Bug", "labels": []interface{}{"bug", 1.0}},
+		"params.labels must have at most 2 items":     {"title": "Bug", "labels": []string{"a", "b", "c"}},
+		"params.priority must be of type integer":     {"title": "Bug", "priority": 1.5},
+		"params.priority must be at most 5":           {"title": "Bug", "priority": float64(9)},
+		"params.path must be of type string or array": {"title": "Bug", "path": 1.0},
+		"params.key must match ^[A-Z]+-[0-9]+$":       {"title": "Bug", "key": "ops-12"},
+		"params.color is not a known parameter":       {"title": "Bug", "color": "red"},
+	}
+	for message, params := range invalid {
+		err := action.ValidateParams(params)
+		require.Error(t, err, message)
+		assert.Equal(t, message, err.Error())
+
+		var schemaErr *SchemaError
+		assert.True(t, errors.As(err, &schemaErr))
+	}
+
+	assert.Error(t, action.ValidateParams(nil), "missing required params are reported for nil params")
+	assert.NoError(t, ActionDescriptor{Name: "free"}.ValidateParams(map[string]interface{}{"any": 1}))
+}
+
+// describedAdapter is an adapter describing its actions
+type describedAdapter struct {
+	actions []ActionDescriptor
+}
+
+func (a *describedAdapter) Type() string    { return "described" }
+func (a *describedAdapter) Version() string { return "1.0.0" }
+func (a *describedAdapter) Health() string  { return "healthy" }
+func (a *describedAdapter) Close() error    { return nil }
+func (a *describedAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	return nil
+}
+func (a *describedAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	return nil, nil
+}
+func (a *describedAdapter) Actions() []ActionDescriptor { return a.actions }
+
+func TestDescribeActions(t *testing.T) {
+	adapter := &describedAdapter{actions: []ActionDescriptor{
+		{Name: "list", Safety: SafetyRead},
+		{Name: "delete", Safety: SafetyDestructive},
+	}}
+
+	actions, ok := DescribeActions(adapter)
+	require.True(t, ok)
+	assert.Equal(t, "delete", actions[0].Name, "actions are sorted by name")
+	assert.Equal(t, "list", adapter.actions[0].Name, "the adapter's descriptors are not reordered")
+
+	action, ok := FindAction(actions, "list")
+	assert.True(t, ok)
+	assert.Equal(t, SafetyRead, action.Safety)
+	_, ok = FindAction(actions, "create")
+	assert.False(t, ok)
+
+	_, ok = DescribeActions(&describedAdapter{})
+	assert.False(t, ok, "wrappers return nil when the wrapped adapter does not describe its actions")
+
+	actions, ok = DescribeActions(&describedAdapter{actions: []ActionDescriptor{}})
+	assert.True(t, ok)
+	assert.Empty(t, actions)
+}
@@ -0,0 +1,33 @@
+package core
+
+import (
+	"context"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// ContextRecorder records webhook events in MCP contexts. It is implemented
+// by the core engine.
+type ContextRecorder interface {
+	RecordWebhookInContext(ctx context.Context, agentID string, adapterType string, eventType string, payload interface{}) (string, error)
+}
+
+// ContextRecorderSetter is implemented by adapters that record the webhooks
+// they receive in contexts. The engine sets itself as their recorder when
+// they are registered.
+type ContextRecorderSetter interface {
+	SetContextRecorder(recorder ContextRecorder)
+}
+
+// ContextUpdater updates MCP contexts, appending the content of updates
+// unless the options replace it. It is implemented by the context manager.
+type ContextUpdater interface {
+	UpdateContext(ctx context.Context, contextID string, context *mcp.Context, options *mcp.ContextUpdateOptions) (*mcp.Context, error)
+}
+
+// ContextUpdaterSetter is implemented by adapters that record the outcome of
+// their actions in the contexts that requested them. The engine sets its
+// context manager as their updater when they are registered.
+type ContextUpdaterSetter interface {
+	SetContextUpdater(updater ContextUpdater)
+}
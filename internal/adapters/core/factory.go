@@ -2,12 +2,16 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	
 	"github.com/S-Corkum/mcp-server/internal/observability"
 )
 
+// ErrAdapterNotFound indicates no adapter of a type can be created
+var ErrAdapterNotFound = errors.New("adapter not found")
+
 // AdapterCreator is a function that creates an adapter
 type AdapterCreator func(ctx context.Context, config interface{}) (Adapter, error)
 
@@ -57,7 +61,7 @@ func (f *DefaultAdapterFactory) CreateAdapter(ctx context.Context, adapterType s
 	f.mu.RUnlock()
 	
 	if !exists {
-		return nil, fmt.Errorf("no creator registered for adapter type: %s", adapterType)
+		return nil, fmt.Errorf("%w: no creator registered for adapter type: %s", ErrAdapterNotFound, adapterType)
 	}
 	
 	return creator(ctx, config)
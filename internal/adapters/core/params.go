@@ -0,0 +1,83 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// StringParam returns a string parameter, or an empty string if it is missing or not a string
+func StringParam(params map[string]interface{}, key string) string {
+	if value, ok := params[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+// RequiredStringParam returns a string parameter, or an error if it is missing or empty
+func RequiredStringParam(params map[string]interface{}, key string) (string, error) {
+	value := StringParam(params, key)
+	if value == "" {
+		return "", fmt.Errorf("missing required parameter: %s", key)
+	}
+	return value, nil
+}
+
+// IntParam returns an integer parameter, or the default value if it is missing or invalid.
+// JSON numbers are decoded as float64 and numeric strings are accepted as well.
+func IntParam(params map[string]interface{}, key string, defaultValue int) int {
+	switch value := params[key].(type) {
+	case int:
+		return value
+	case int64:
+		return int(value)
+	case float64:
+		return int(value)
+	case string:
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+// BoolParam returns a boolean parameter, or the default value if it is missing or invalid
+func BoolParam(params map[string]interface{}, key string, defaultValue bool) bool {
+	switch value := params[key].(type) {
+	case bool:
+		return value
+	case string:
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+// StringSliceParam returns a string slice parameter. A single string is treated as a one-element slice.
+func StringSliceParam(params map[string]interface{}, key string) []string {
+	switch value := params[key].(type) {
+	case []string:
+		return value
+	case []interface{}:
+		result := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	case string:
+		if value != "" {
+			return []string{value}
+		}
+	}
+	return nil
+}
+
+// MapParam returns a map parameter, or nil if it is missing or not a map
+func MapParam(params map[string]interface{}, key string) map[string]interface{} {
+	if value, ok := params[key].(map[string]interface{}); ok {
+		return value
+	}
+	return nil
+}
@@ -23,6 +23,8 @@ type AdapterRegistry struct {
 	factory        AdapterFactory
 	healthStatuses map[string]HealthStatus
 	callbacks      map[string][]func(Adapter, HealthStatus)
+	listeners      []func(adapterType string, oldStatus, newStatus HealthStatus)
+	registered     []func(adapterType string, adapter Adapter)
 	mu             sync.RWMutex
 	logger         *observability.Logger
 }
@@ -43,8 +45,17 @@ func NewAdapterRegistry(factory AdapterFactory, logger *observability.Logger) *A
 	return registry
 }
 
-// RegisterAdapter registers an adapter
+// RegisterAdapter passes an adapter to the listeners registered with
+// OnRegister, then registers it
 func (r *AdapterRegistry) RegisterAdapter(adapterType string, adapter Adapter) {
+	r.mu.RLock()
+	listeners := append([]func(string, Adapter){}, r.registered...)
+	r.mu.RUnlock()
+	
+	for _, listener := range listeners {
+		listener(adapterType, adapter)
+	}
+	
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	
@@ -61,6 +72,16 @@ func (r *AdapterRegistry) RegisterAdapter(adapterType string, adapter Adapter) {
 	})
 }
 
+// OnRegister registers a listener called with every adapter registered
+// afterwards, including adapters created on first use and recreated after
+// failed health checks. Listeners run before the adapter is available from
+// the registry.
+func (r *AdapterRegistry) OnRegister(listener func(adapterType string, adapter Adapter)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.registered = append(r.registered, listener)
+}
+
 // DeregisterAdapter removes an adapter from the registry
 func (r *AdapterRegistry) DeregisterAdapter(adapterType string) error {
 	r.mu.Lock()
@@ -153,6 +174,15 @@ func (r *AdapterRegistry) RegisterHealthCallback(adapterType string, callback fu
 	r.callbacks[adapterType] = append(callbacks, callback)
 }
 
+// OnHealthChange registers a callback for health status changes of any
+// adapter
+func (r *AdapterRegistry) OnHealthChange(listener func(adapterType string, oldStatus, newStatus HealthStatus)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	
+	r.listeners = append(r.listeners, listener)
+}
+
 // healthCheckLoop periodically checks adapter health
 func (r *AdapterRegistry) healthCheckLoop() {
 	ticker := time.NewTicker(30 * time.Second)
@@ -199,6 +229,9 @@ func (r *AdapterRegistry) checkAllAdaptersHealth() {
 			for _, callback := range callbacks {
 				go callback(adapter, newStatus)
 			}
+			for _, listener := range r.listeners {
+				go listener(adapterType, oldStatus, newStatus)
+			}
 		}
 		
 		r.mu.Unlock()
@@ -0,0 +1,17 @@
+package core
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// WebhookReceiver is implemented by adapters that authenticate webhook
+// deliveries. Deliveries are verified when they are received, before they are
+// persisted, so the payload handed to HandleWebhook later has already been
+// authenticated.
+type WebhookReceiver interface {
+	// ReceiveWebhook verifies a delivery and returns the payload to pass to
+	// HandleWebhook. It returns an unauthorized adapter error if the delivery
+	// fails verification and an invalid request error if it can't be decoded.
+	ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error)
+}
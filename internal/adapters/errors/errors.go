@@ -60,6 +60,7 @@ const (
 	ErrCodeInvalidRequest      = "INVALID_REQUEST"
 	ErrCodeInvalidParameter    = "INVALID_PARAMETER"
 	ErrCodeResourceNotFound    = "RESOURCE_NOT_FOUND"
+	ErrCodeUnsupportedOperation = "UNSUPPORTED_OPERATION"
 	
 	// Configuration errors
 	ErrCodeInvalidConfiguration = "INVALID_CONFIGURATION"
@@ -191,6 +192,11 @@ func NewResourceNotFoundError(adapterType string, operation string, originalErro
 	return New(adapterType, operation, originalError, ErrCodeResourceNotFound, ErrorTypeValidation, false, context)
 }
 
+// NewUnsupportedOperationError creates a new unsupported operation error
+func NewUnsupportedOperationError(adapterType string, operation string, originalError error, context map[string]interface{}) *AdapterError {
+	return New(adapterType, operation, originalError, ErrCodeUnsupportedOperation, ErrorTypeValidation, false, context)
+}
+
 // NewInvalidConfigurationError creates a new invalid configuration error
 func NewInvalidConfigurationError(adapterType string, operation string, originalError error, context map[string]interface{}) *AdapterError {
 	return New(adapterType, operation, originalError, ErrCodeInvalidConfiguration, ErrorTypeConfiguration, false, context)
@@ -305,3 +311,34 @@ func IsConfigurationError(err error) bool {
 func IsTimeoutError(err error) bool {
 	return IsSpecificErrorType(err, ErrorTypeTimeout)
 }
+
+// FromHTTPStatus creates an adapter error matching an HTTP status code returned by an external service
+func FromHTTPStatus(adapterType string, operation string, statusCode int, originalError error, context map[string]interface{}) *AdapterError {
+	if context == nil {
+		context = make(map[string]interface{})
+	}
+	context["status_code"] = statusCode
+	
+	switch {
+	case statusCode == 400 || statusCode == 422:
+		return NewInvalidRequestError(adapterType, operation, originalError, context)
+	case statusCode == 401:
+		return NewUnauthorizedError(adapterType, operation, originalError, context)
+	case statusCode == 403:
+		return NewForbiddenError(adapterType, operation, originalError, context)
+	case statusCode == 404:
+		return NewResourceNotFoundError(adapterType, operation, originalError, context)
+	case statusCode == 408 || statusCode == 504:
+		return NewTimeoutError(adapterType, operation, originalError, context)
+	case statusCode == 429:
+		return NewTooManyRequestsError(adapterType, operation, originalError, context)
+	case statusCode == 502:
+		return NewBadGatewayError(adapterType, operation, originalError, context)
+	case statusCode == 503:
+		return NewServiceUnavailableError(adapterType, operation, originalError, context)
+	case statusCode >= 500:
+		return NewInternalServerError(adapterType, operation, originalError, context)
+	default:
+		return NewUnknownError(adapterType, operation, originalError, context)
+	}
+}
@@ -0,0 +1,117 @@
+package github
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// repositoryParams are the params identifying a repository
+var repositoryParams = map[string]interface{}{
+	"owner": map[string]interface{}{
+		"type":        "string",
+		"description": "Repository owner (organization or user)",
+		"minLength":   1,
+	},
+	"repo": map[string]interface{}{
+		"type":        "string",
+		"description": "Repository name",
+		"minLength":   1,
+	},
+}
+
+// issueSchema is the schema of an issue result
+var issueSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"id":      map[string]interface{}{"type": "integer"},
+		"title":   map[string]interface{}{"type": "string"},
+		"body":    map[string]interface{}{"type": "string"},
+		"state":   map[string]interface{}{"type": "string", "enum": []interface{}{"open", "closed"}},
+		"url":     map[string]interface{}{"type": "string"},
+		"created": map[string]interface{}{"type": "string", "format": "date-time"},
+	},
+}
+
+// Actions returns the descriptors of the supported GitHub actions
+func (a *GitHubAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{
+		{
+			Name:        "getRepository",
+			Description: "Gets a GitHub repository",
+			Params:      repositorySchema([]string{"owner", "repo"}, nil),
+			Result: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"id":    map[string]interface{}{"type": "integer"},
+					"name":  map[string]interface{}{"type": "string"},
+					"owner": map[string]interface{}{"type": "string"},
+					"url":   map[string]interface{}{"type": "string"},
+				},
+			},
+			Safety: core.SafetyRead,
+			Scopes: []string{"metadata:read"},
+		},
+		{
+			Name:        "listIssues",
+			Description: "Lists the issues of a GitHub repository",
+			Params: repositorySchema([]string{"owner", "repo"}, map[string]interface{}{
+				"state": map[string]interface{}{
+					"type":        "string",
+					"description": "Issue state to list",
+					"enum":        []interface{}{"open", "closed", "all"},
+				},
+			}),
+			Result: map[string]interface{}{
+				"type":  "array",
+				"items": issueSchema,
+			},
+			Safety: core.SafetyRead,
+			Scopes: []string{"issues:read"},
+		},
+		{
+			Name:        "createIssue",
+			Description: "Creates a new issue in a GitHub repository",
+			Params: repositorySchema([]string{"owner", "repo", "title"}, map[string]interface{}{
+				"title": map[string]interface{}{
+					"type":        "string",
+					"description": "Issue title",
+					"minLength":   1,
+				},
+				"body": map[string]interface{}{
+					"type":        "string",
+					"description": "Issue description",
+				},
+				"labels": map[string]interface{}{
+					"type":        "array",
+					"description": "Label names",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+				"assignees": map[string]interface{}{
+					"type":        "array",
+					"description": "Usernames to assign",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+			}),
+			Result: issueSchema,
+			Safety: core.SafetyWrite,
+			Scopes: []string{"issues:write"},
+		},
+	}
+}
+
+// repositorySchema returns the params schema of an action on a repository
+func repositorySchema(required []string, properties map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(repositoryParams)+len(properties))
+	for name, schema := range repositoryParams {
+		merged[name] = schema
+	}
+	for name, schema := range properties {
+		merged[name] = schema
+	}
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
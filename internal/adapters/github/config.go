@@ -12,6 +12,9 @@ type Config struct {
 	AppPrivateKey  string `mapstructure:"app_private_key"`
 	UseApp         bool   `mapstructure:"use_app"`
 	
+	// WebhookSecret is the secret webhook deliveries are signed with
+	WebhookSecret  string `mapstructure:"webhook_secret"`
+	
 	// Connection settings
 	BaseURL            string        `mapstructure:"base_url"`
 	UploadURL          string        `mapstructure:"upload_url"`
@@ -0,0 +1,39 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// WebhookSignatureHeader is the header carrying the signature of GitHub webhooks
+const WebhookSignatureHeader = "X-Hub-Signature-256"
+
+// VerifyWebhookSignature checks the X-Hub-Signature-256 header,
+// sha256=<hex>, against the HMAC-SHA256 of the payload. If no secret is
+// configured every payload is rejected.
+func (a *GitHubAdapter) VerifyWebhookSignature(payload []byte, signature string) bool {
+	if a.config.WebhookSecret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(a.config.WebhookSecret))
+	mac.Write(payload)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// ReceiveWebhook implements core.WebhookReceiver by checking the signature
+// of the body
+func (a *GitHubAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifyWebhookSignature(body, header.Get(WebhookSignatureHeader)) {
+		return nil, adapterErrors.NewUnauthorizedError(a.Type(), "webhook", errors.New("invalid webhook signature"), nil)
+	}
+	return body, nil
+}
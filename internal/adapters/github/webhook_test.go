@@ -0,0 +1,61 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// sign signs a payload the way GitHub does
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestReceiveWebhook(t *testing.T) {
+	config := DefaultConfig()
+	config.WebhookSecret = "s3cret"
+	adapter, err := New(config, observability.NewLogger("github_test"), nil, nil)
+	require.NoError(t, err)
+
+	payload := []byte(`{"action":"opened","repository":{"full_name":"org/repo"}}`)
+	tests := []struct {
+		name      string
+		signature string
+		expectErr bool
+	}{
+		{"valid signature", sign("s3cret", payload), false},
+		{"invalid signature", sign("wrong", payload), true},
+		{"missing signature", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			if test.signature != "" {
+				header.Set(WebhookSignatureHeader, test.signature)
+			}
+
+			received, err := adapter.ReceiveWebhook(header, nil, payload)
+			if test.expectErr {
+				assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, payload, received)
+		})
+	}
+
+	adapter.config.WebhookSecret = ""
+	_, err = adapter.ReceiveWebhook(http.Header{WebhookSignatureHeader: {sign("", payload)}}, nil, payload)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized), "deliveries are rejected when no secret is configured")
+}
@@ -0,0 +1,421 @@
+package gitlab
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// projectParams are the params identifying a project. The configured default
+// project is used when none is given.
+var projectParams = map[string]interface{}{
+	"project": map[string]interface{}{
+		"type":        "string",
+		"description": "Project ID or full path, such as group/subgroup/project",
+	},
+	"project_id": map[string]interface{}{
+		"type":        "integer",
+		"description": "Numeric project ID",
+		"minimum":     1,
+	},
+	"owner": map[string]interface{}{
+		"type":        "string",
+		"description": "Group or user owning the project, with repo",
+	},
+	"repo": map[string]interface{}{
+		"type":        "string",
+		"description": "Project name, with owner",
+	},
+}
+
+// pageParams are the pagination params of list actions
+var pageParams = map[string]interface{}{
+	"per_page": map[string]interface{}{
+		"type":        "integer",
+		"description": "Number of items per page",
+		"minimum":     1,
+		"maximum":     100,
+	},
+	"page": map[string]interface{}{
+		"type":        "integer",
+		"description": "Page to return, starting at 1",
+		"minimum":     1,
+	},
+}
+
+// issueParams identify an issue by its project and IID
+var issueParams = map[string]interface{}{
+	"issue_number": map[string]interface{}{
+		"type":        "integer",
+		"description": "IID of the issue in its project",
+		"minimum":     1,
+	},
+	"iid": map[string]interface{}{
+		"type":        "integer",
+		"description": "Alias of issue_number",
+		"minimum":     1,
+	},
+}
+
+// mergeRequestParams identify a merge request by its project and IID
+var mergeRequestParams = map[string]interface{}{
+	"pull_number": map[string]interface{}{
+		"type":        "integer",
+		"description": "IID of the merge request in its project",
+		"minimum":     1,
+	},
+	"merge_request_iid": map[string]interface{}{
+		"type":        "integer",
+		"description": "Alias of pull_number",
+		"minimum":     1,
+	},
+	"iid": map[string]interface{}{
+		"type":        "integer",
+		"description": "Alias of pull_number",
+		"minimum":     1,
+	},
+}
+
+// pipelineParams identify a pipeline by its project and ID
+var pipelineParams = map[string]interface{}{
+	"pipeline_id": map[string]interface{}{
+		"type":        "integer",
+		"description": "Pipeline ID",
+		"minimum":     1,
+	},
+}
+
+// jobParams identify a job by its project and ID
+var jobParams = map[string]interface{}{
+	"job_id": map[string]interface{}{
+		"type":        "integer",
+		"description": "Job ID",
+		"minimum":     1,
+	},
+}
+
+// labelsParam is the schema of labels, given as a list or a single label
+var labelsParam = map[string]interface{}{
+	"type":        []interface{}{"string", "array"},
+	"description": "Labels",
+	"items":       map[string]interface{}{"type": "string"},
+}
+
+// stateParam is the schema of the state filter of issues and merge requests
+var stateParam = map[string]interface{}{
+	"type":        "string",
+	"description": "State to list: open, closed, merged or all",
+}
+
+// issueSchema is the schema of an issue result
+var issueSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"number": map[string]interface{}{"type": "integer"},
+		"title":  map[string]interface{}{"type": "string"},
+		"state":  map[string]interface{}{"type": "string"},
+		"url":    map[string]interface{}{"type": "string"},
+	},
+}
+
+// pullRequestSchema is the schema of a merge request result
+var pullRequestSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"number": map[string]interface{}{"type": "integer"},
+		"title":  map[string]interface{}{"type": "string"},
+		"state":  map[string]interface{}{"type": "string"},
+		"head":   map[string]interface{}{"type": "string"},
+		"base":   map[string]interface{}{"type": "string"},
+		"url":    map[string]interface{}{"type": "string"},
+	},
+}
+
+// Actions returns the descriptors of the supported GitLab actions
+func (a *GitLabAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{
+		{
+			Name:        "get_repository",
+			Description: "Gets a GitLab project",
+			Params:      paramsSchema(nil, projectParams),
+			Safety:      core.SafetyRead,
+			Scopes:      []string{"read_api"},
+		},
+		{
+			Name:        "list_repositories",
+			Description: "Lists the projects the token is a member of, most recently active first",
+			Params: paramsSchema(nil, pageParams, map[string]interface{}{
+				"search": map[string]interface{}{
+					"type":        "string",
+					"description": "Text the project name or path contains",
+				},
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"read_api"},
+		},
+		{
+			Name:        "list_issues",
+			Description: "Lists the issues of a project",
+			Params: paramsSchema(nil, projectParams, pageParams, map[string]interface{}{
+				"state":  stateParam,
+				"labels": labelsParam,
+				"assignee": map[string]interface{}{
+					"type":        "string",
+					"description": "Username of the assignee",
+				},
+			}),
+			Result: map[string]interface{}{"type": "array", "items": issueSchema},
+			Safety: core.SafetyRead,
+			Scopes: []string{"read_api"},
+		},
+		{
+			Name:        "get_issue",
+			Description: "Gets an issue",
+			Params:      paramsSchema(nil, projectParams, issueParams),
+			Result:      issueSchema,
+			Safety:      core.SafetyRead,
+			Scopes:      []string{"read_api"},
+		},
+		{
+			Name:        "create_issue",
+			Description: "Creates an issue in a project",
+			Params: paramsSchema([]string{"title"}, projectParams, map[string]interface{}{
+				"title": map[string]interface{}{
+					"type":        "string",
+					"description": "Issue title",
+					"minLength":   1,
+				},
+				"body": map[string]interface{}{
+					"type":        "string",
+					"description": "Issue description in Markdown",
+				},
+				"labels": labelsParam,
+				"assignee_ids": map[string]interface{}{
+					"type":        "array",
+					"description": "IDs of the users to assign",
+					"items":       map[string]interface{}{"type": "integer"},
+				},
+			}),
+			Result: issueSchema,
+			Safety: core.SafetyWrite,
+			Scopes: []string{"api"},
+		},
+		{
+			Name:        "close_issue",
+			Description: "Closes an issue",
+			Params:      paramsSchema(nil, projectParams, issueParams),
+			Result:      issueSchema,
+			Safety:      core.SafetyWrite,
+			Scopes:      []string{"api"},
+		},
+		{
+			Name:        "list_pull_requests",
+			Description: "Lists the merge requests of a project",
+			Params: paramsSchema(nil, projectParams, pageParams, map[string]interface{}{
+				"state": stateParam,
+				"head": map[string]interface{}{
+					"type":        "string",
+					"description": "Source branch",
+				},
+				"base": map[string]interface{}{
+					"type":        "string",
+					"description": "Target branch",
+				},
+			}),
+			Result: map[string]interface{}{"type": "array", "items": pullRequestSchema},
+			Safety: core.SafetyRead,
+			Scopes: []string{"read_api"},
+		},
+		{
+			Name:        "get_pull_request",
+			Description: "Gets a merge request",
+			Params:      paramsSchema(nil, projectParams, mergeRequestParams),
+			Result:      pullRequestSchema,
+			Safety:      core.SafetyRead,
+			Scopes:      []string{"read_api"},
+		},
+		{
+			Name:        "create_pull_request",
+			Description: "Opens a merge request from a source branch to a target branch",
+			Params: paramsSchema([]string{"title", "head", "base"}, projectParams, map[string]interface{}{
+				"title": map[string]interface{}{
+					"type":        "string",
+					"description": "Merge request title",
+					"minLength":   1,
+				},
+				"head": map[string]interface{}{
+					"type":        "string",
+					"description": "Source branch",
+					"minLength":   1,
+				},
+				"base": map[string]interface{}{
+					"type":        "string",
+					"description": "Target branch",
+					"minLength":   1,
+				},
+				"body": map[string]interface{}{
+					"type":        "string",
+					"description": "Merge request description in Markdown",
+				},
+				"labels": labelsParam,
+				"draft": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Open the merge request as a draft",
+				},
+			}),
+			Result: pullRequestSchema,
+			Safety: core.SafetyWrite,
+			Scopes: []string{"api"},
+		},
+		{
+			Name:        "merge_pull_request",
+			Description: "Merges a merge request",
+			Params: paramsSchema(nil, projectParams, mergeRequestParams, map[string]interface{}{
+				"commit_message": map[string]interface{}{
+					"type":        "string",
+					"description": "Message of the merge commit",
+				},
+				"sha": map[string]interface{}{
+					"type":        "string",
+					"description": "Head commit the merge request must still have",
+				},
+				"squash": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Squash the commits",
+				},
+				"merge_method": map[string]interface{}{
+					"type":        "string",
+					"description": "squash to squash the commits",
+				},
+				"delete_branch": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Delete the source branch after merging",
+				},
+			}),
+			Result: pullRequestSchema,
+			Safety: core.SafetyWrite,
+			Scopes: []string{"api"},
+		},
+		{
+			Name:        "add_comment",
+			Description: "Adds a note to an issue, or to a merge request when pull_number is given",
+			Params: paramsSchema([]string{"body"}, projectParams, issueParams, mergeRequestParams, map[string]interface{}{
+				"body": map[string]interface{}{
+					"type":        "string",
+					"description": "Note text in Markdown",
+					"minLength":   1,
+				},
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"api"},
+		},
+		{
+			Name:        "list_comments",
+			Description: "Lists the notes of an issue, or of a merge request when pull_number is given",
+			Params: paramsSchema(nil, projectParams, issueParams, mergeRequestParams, pageParams, map[string]interface{}{
+				"include_system": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Include notes generated by GitLab",
+				},
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"read_api"},
+		},
+		{
+			Name:        "list_pipelines",
+			Description: "Lists the pipelines of a project",
+			Params: paramsSchema(nil, projectParams, pageParams, map[string]interface{}{
+				"ref": map[string]interface{}{
+					"type":        "string",
+					"description": "Branch or tag",
+				},
+				"status": map[string]interface{}{
+					"type":        "string",
+					"description": "Pipeline status, such as running or failed",
+				},
+				"sha": map[string]interface{}{
+					"type":        "string",
+					"description": "Commit SHA",
+				},
+				"source": map[string]interface{}{
+					"type":        "string",
+					"description": "What triggered the pipelines, such as push or merge_request_event",
+				},
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"read_api"},
+		},
+		{
+			Name:        "get_pipeline",
+			Description: "Gets a pipeline",
+			Params:      paramsSchema([]string{"pipeline_id"}, projectParams, pipelineParams),
+			Safety:      core.SafetyRead,
+			Scopes:      []string{"read_api"},
+		},
+		{
+			Name:        "retry_pipeline",
+			Description: "Retries the failed jobs of a pipeline",
+			Params:      paramsSchema([]string{"pipeline_id"}, projectParams, pipelineParams),
+			Safety:      core.SafetyWrite,
+			Scopes:      []string{"api"},
+		},
+		{
+			Name:        "cancel_pipeline",
+			Description: "Cancels the running jobs of a pipeline",
+			Params:      paramsSchema([]string{"pipeline_id"}, projectParams, pipelineParams),
+			Safety:      core.SafetyWrite,
+			Scopes:      []string{"api"},
+		},
+		{
+			Name:        "list_jobs",
+			Description: "Lists the jobs of a pipeline",
+			Params: paramsSchema([]string{"pipeline_id"}, projectParams, pipelineParams, pageParams, map[string]interface{}{
+				"scope": map[string]interface{}{
+					"type":        []interface{}{"string", "array"},
+					"description": "Job statuses to list, such as failed",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"read_api"},
+		},
+		{
+			Name:        "get_job",
+			Description: "Gets a job",
+			Params:      paramsSchema([]string{"job_id"}, projectParams, jobParams),
+			Safety:      core.SafetyRead,
+			Scopes:      []string{"read_api"},
+		},
+		{
+			Name:        "get_job_log",
+			Description: "Returns the last lines of the log of a job",
+			Params: paramsSchema([]string{"job_id"}, projectParams, jobParams, map[string]interface{}{
+				"tail_lines": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of lines to return from the end of the log",
+					"minimum":     1,
+				},
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"read_api"},
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
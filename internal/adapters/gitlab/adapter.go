@@ -0,0 +1,938 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the GitLab adapter
+const adapterType = "gitlab"
+
+// apiPath is the path of the REST API relative to the instance URL
+const apiPath = "/api/v4"
+
+// GitLabAdapter provides an adapter for GitLab operations. Actions cover the
+// operations of the GitHub adapter, but their names and parameters are
+// snake_case like the other adapters, e.g. get_repository and list_issues
+// rather than GitHub's getRepository and listIssues. Projects can still be
+// given as owner and repo, as for GitHub.
+type GitLabAdapter struct {
+	config        *Config
+	baseURL       string
+	client        *http.Client
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+}
+
+// New creates a new GitLab adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*GitLabAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("gitlab_adapter")
+	}
+
+	baseURL := config.BaseURL
+	if config.MockResponses {
+		baseURL = config.MockURL
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("gitlab base URL is required")
+	}
+
+	return &GitLabAdapter{
+		config:        config,
+		baseURL:       strings.TrimRight(baseURL, "/") + apiPath,
+		client:        &http.Client{Timeout: config.RequestTimeout},
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+	}, nil
+}
+
+// Type returns the adapter type
+func (a *GitLabAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *GitLabAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status
+func (a *GitLabAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := a.doRequest(ctx, "health", http.MethodGet, "/version", nil, nil, nil); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+
+	if a.config.MockResponses {
+		return "healthy (mock)"
+	}
+	return "healthy"
+}
+
+// ExecuteAction executes a GitLab action
+func (a *GitLabAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing GitLab action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	// Projects
+	case "get_repository":
+		result, err = a.getRepository(ctx, params)
+	case "list_repositories":
+		result, err = a.listRepositories(ctx, params)
+
+	// Issues
+	case "list_issues":
+		result, err = a.listIssues(ctx, params)
+	case "get_issue":
+		result, err = a.getIssue(ctx, params)
+	case "create_issue":
+		result, err = a.createIssue(ctx, params)
+	case "close_issue":
+		result, err = a.closeIssue(ctx, params)
+
+	// Merge requests
+	case "list_pull_requests":
+		result, err = a.listPullRequests(ctx, params)
+	case "get_pull_request":
+		result, err = a.getPullRequest(ctx, params)
+	case "create_pull_request":
+		result, err = a.createPullRequest(ctx, params)
+	case "merge_pull_request":
+		result, err = a.mergePullRequest(ctx, params)
+
+	// Notes
+	case "add_comment":
+		result, err = a.addComment(ctx, params)
+	case "list_comments":
+		result, err = a.listComments(ctx, params)
+
+	// Pipelines and jobs
+	case "list_pipelines":
+		result, err = a.listPipelines(ctx, params)
+	case "get_pipeline":
+		result, err = a.getPipeline(ctx, params)
+	case "retry_pipeline":
+		result, err = a.pipelineAction(ctx, "retry_pipeline", "retry", params)
+	case "cancel_pipeline":
+		result, err = a.pipelineAction(ctx, "cancel_pipeline", "cancel", params)
+	case "list_jobs":
+		result, err = a.listJobs(ctx, params)
+	case "get_job":
+		result, err = a.getJob(ctx, params)
+	case "get_job_log":
+		result, err = a.getJobLog(ctx, params)
+
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported GitLab action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// getRepository gets a GitLab project
+func (a *GitLabAdapter) getRepository(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	projectPath, err := a.projectPath("get_repository", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var p project
+	if err := a.doRequest(ctx, "get_repository", http.MethodGet, projectPath, nil, nil, &p); err != nil {
+		return nil, err
+	}
+
+	return toRepository(p), nil
+}
+
+// listRepositories lists the projects the token is a member of
+func (a *GitLabAdapter) listRepositories(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	query := a.pageQuery(params)
+	query.Set("membership", "true")
+	query.Set("order_by", "last_activity_at")
+	if search := core.StringParam(params, "search"); search != "" {
+		query.Set("search", search)
+	}
+
+	var projects []project
+	if err := a.doRequest(ctx, "list_repositories", http.MethodGet, "/projects", query, nil, &projects); err != nil {
+		return nil, err
+	}
+
+	repositories := make([]Repository, 0, len(projects))
+	for _, p := range projects {
+		repositories = append(repositories, toRepository(p))
+	}
+	return repositories, nil
+}
+
+// listIssues lists the issues of a project
+func (a *GitLabAdapter) listIssues(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	projectPath, err := a.projectPath("list_issues", params)
+	if err != nil {
+		return nil, err
+	}
+
+	query := a.pageQuery(params)
+	if state := gitlabState(core.StringParam(params, "state")); state != "" {
+		query.Set("state", state)
+	}
+	if labels := core.StringSliceParam(params, "labels"); len(labels) > 0 {
+		query.Set("labels", strings.Join(labels, ","))
+	}
+	if assignee := core.StringParam(params, "assignee"); assignee != "" {
+		query.Set("assignee_username", assignee)
+	}
+
+	var issues []issue
+	if err := a.doRequest(ctx, "list_issues", http.MethodGet, projectPath+"/issues", query, nil, &issues); err != nil {
+		return nil, err
+	}
+
+	result := make([]Issue, 0, len(issues))
+	for _, i := range issues {
+		result = append(result, toIssue(i))
+	}
+	return result, nil
+}
+
+// getIssue gets a single issue
+func (a *GitLabAdapter) getIssue(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	issuePath, err := a.issuePath("get_issue", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var i issue
+	if err := a.doRequest(ctx, "get_issue", http.MethodGet, issuePath, nil, nil, &i); err != nil {
+		return nil, err
+	}
+	return toIssue(i), nil
+}
+
+// createIssue creates a new issue in a project
+func (a *GitLabAdapter) createIssue(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	projectPath, err := a.projectPath("create_issue", params)
+	if err != nil {
+		return nil, err
+	}
+
+	title, err := core.RequiredStringParam(params, "title")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_issue", err, nil)
+	}
+
+	body := map[string]interface{}{
+		"title":       title,
+		"description": core.StringParam(params, "body"),
+	}
+	if labels := core.StringSliceParam(params, "labels"); len(labels) > 0 {
+		body["labels"] = strings.Join(labels, ",")
+	}
+	if assigneeIDs := intSliceParam(params, "assignee_ids"); len(assigneeIDs) > 0 {
+		body["assignee_ids"] = assigneeIDs
+	}
+
+	var i issue
+	if err := a.doRequest(ctx, "create_issue", http.MethodPost, projectPath+"/issues", nil, body, &i); err != nil {
+		return nil, err
+	}
+	return toIssue(i), nil
+}
+
+// closeIssue closes an issue
+func (a *GitLabAdapter) closeIssue(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	issuePath, err := a.issuePath("close_issue", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var i issue
+	body := map[string]string{"state_event": "close"}
+	if err := a.doRequest(ctx, "close_issue", http.MethodPut, issuePath, nil, body, &i); err != nil {
+		return nil, err
+	}
+	return toIssue(i), nil
+}
+
+// listPullRequests lists the merge requests of a project
+func (a *GitLabAdapter) listPullRequests(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	projectPath, err := a.projectPath("list_pull_requests", params)
+	if err != nil {
+		return nil, err
+	}
+
+	query := a.pageQuery(params)
+	if state := gitlabState(core.StringParam(params, "state")); state != "" {
+		query.Set("state", state)
+	}
+	if head := core.StringParam(params, "head"); head != "" {
+		query.Set("source_branch", head)
+	}
+	if base := core.StringParam(params, "base"); base != "" {
+		query.Set("target_branch", base)
+	}
+
+	var mergeRequests []mergeRequest
+	if err := a.doRequest(ctx, "list_pull_requests", http.MethodGet, projectPath+"/merge_requests", query, nil, &mergeRequests); err != nil {
+		return nil, err
+	}
+
+	result := make([]PullRequest, 0, len(mergeRequests))
+	for _, mr := range mergeRequests {
+		result = append(result, toPullRequest(mr))
+	}
+	return result, nil
+}
+
+// getPullRequest gets a single merge request
+func (a *GitLabAdapter) getPullRequest(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	mrPath, err := a.mergeRequestPath("get_pull_request", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var mr mergeRequest
+	if err := a.doRequest(ctx, "get_pull_request", http.MethodGet, mrPath, nil, nil, &mr); err != nil {
+		return nil, err
+	}
+	return toPullRequest(mr), nil
+}
+
+// createPullRequest opens a merge request from head into base
+func (a *GitLabAdapter) createPullRequest(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	projectPath, err := a.projectPath("create_pull_request", params)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{"description": core.StringParam(params, "body")}
+	for _, field := range [][2]string{{"title", "title"}, {"head", "source_branch"}, {"base", "target_branch"}} {
+		value, err := core.RequiredStringParam(params, field[0])
+		if err != nil {
+			return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_pull_request", err, nil)
+		}
+		body[field[1]] = value
+	}
+	if labels := core.StringSliceParam(params, "labels"); len(labels) > 0 {
+		body["labels"] = strings.Join(labels, ",")
+	}
+	if core.BoolParam(params, "draft", false) {
+		body["title"] = "Draft: " + body["title"].(string)
+	}
+
+	var mr mergeRequest
+	if err := a.doRequest(ctx, "create_pull_request", http.MethodPost, projectPath+"/merge_requests", nil, body, &mr); err != nil {
+		return nil, err
+	}
+	return toPullRequest(mr), nil
+}
+
+// mergePullRequest merges a merge request
+func (a *GitLabAdapter) mergePullRequest(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	mrPath, err := a.mergeRequestPath("merge_pull_request", params)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{}
+	if message := core.StringParam(params, "commit_message"); message != "" {
+		body["merge_commit_message"] = message
+	}
+	if sha := core.StringParam(params, "sha"); sha != "" {
+		body["sha"] = sha
+	}
+	// GitHub's merge_method=squash maps to GitLab's squash flag
+	if core.BoolParam(params, "squash", false) || core.StringParam(params, "merge_method") == "squash" {
+		body["squash"] = true
+	}
+	if core.BoolParam(params, "delete_branch", false) {
+		body["should_remove_source_branch"] = true
+	}
+
+	var mr mergeRequest
+	if err := a.doRequest(ctx, "merge_pull_request", http.MethodPut, mrPath+"/merge", nil, body, &mr); err != nil {
+		return nil, err
+	}
+	return toPullRequest(mr), nil
+}
+
+// addComment adds a note to an issue or merge request
+func (a *GitLabAdapter) addComment(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	notesPath, err := a.notesPath("add_comment", params)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := core.RequiredStringParam(params, "body")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "add_comment", err, nil)
+	}
+
+	var n note
+	if err := a.doRequest(ctx, "add_comment", http.MethodPost, notesPath, nil, map[string]string{"body": text}, &n); err != nil {
+		return nil, err
+	}
+	return toComment(n), nil
+}
+
+// listComments lists the notes on an issue or merge request, oldest first
+func (a *GitLabAdapter) listComments(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	notesPath, err := a.notesPath("list_comments", params)
+	if err != nil {
+		return nil, err
+	}
+
+	query := a.pageQuery(params)
+	query.Set("sort", "asc")
+	query.Set("order_by", "created_at")
+
+	var notes []note
+	if err := a.doRequest(ctx, "list_comments", http.MethodGet, notesPath, query, nil, &notes); err != nil {
+		return nil, err
+	}
+
+	includeSystem := core.BoolParam(params, "include_system", false)
+	comments := make([]Comment, 0, len(notes))
+	for _, n := range notes {
+		if n.System && !includeSystem {
+			continue
+		}
+		comments = append(comments, toComment(n))
+	}
+	return comments, nil
+}
+
+// listPipelines lists the pipelines of a project, most recent first
+func (a *GitLabAdapter) listPipelines(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	projectPath, err := a.projectPath("list_pipelines", params)
+	if err != nil {
+		return nil, err
+	}
+
+	query := a.pageQuery(params)
+	for _, key := range []string{"ref", "status", "sha", "source"} {
+		if value := core.StringParam(params, key); value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	var pipelines []Pipeline
+	if err := a.doRequest(ctx, "list_pipelines", http.MethodGet, projectPath+"/pipelines", query, nil, &pipelines); err != nil {
+		return nil, err
+	}
+	return pipelines, nil
+}
+
+// getPipeline gets a single pipeline
+func (a *GitLabAdapter) getPipeline(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	pipelinePath, err := a.pipelinePath("get_pipeline", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var p Pipeline
+	if err := a.doRequest(ctx, "get_pipeline", http.MethodGet, pipelinePath, nil, nil, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// pipelineAction retries or cancels a pipeline
+func (a *GitLabAdapter) pipelineAction(ctx context.Context, operation, verb string, params map[string]interface{}) (interface{}, error) {
+	pipelinePath, err := a.pipelinePath(operation, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var p Pipeline
+	if err := a.doRequest(ctx, operation, http.MethodPost, pipelinePath+"/"+verb, nil, nil, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// listJobs lists the jobs of a pipeline, optionally filtered by scope (e.g. failed)
+func (a *GitLabAdapter) listJobs(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	pipelinePath, err := a.pipelinePath("list_jobs", params)
+	if err != nil {
+		return nil, err
+	}
+
+	query := a.pageQuery(params)
+	for _, scope := range core.StringSliceParam(params, "scope") {
+		query.Add("scope[]", scope)
+	}
+
+	var jobs []Job
+	if err := a.doRequest(ctx, "list_jobs", http.MethodGet, pipelinePath+"/jobs", query, nil, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// getJob gets a single job
+func (a *GitLabAdapter) getJob(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jobPath, err := a.jobPath("get_job", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var j Job
+	if err := a.doRequest(ctx, "get_job", http.MethodGet, jobPath, nil, nil, &j); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// getJobLog returns the last lines of a job's log, capped at MaxLogLines
+func (a *GitLabAdapter) getJobLog(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jobPath, err := a.jobPath("get_job_log", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var trace bytes.Buffer
+	if err := a.doRequest(ctx, "get_job_log", http.MethodGet, jobPath+"/trace", nil, nil, &trace); err != nil {
+		return nil, err
+	}
+
+	maxLines := core.IntParam(params, "tail_lines", a.config.MaxLogLines)
+	if maxLines <= 0 || maxLines > a.config.MaxLogLines {
+		maxLines = a.config.MaxLogLines
+	}
+
+	lines := strings.Split(strings.TrimRight(trace.String(), "\n"), "\n")
+	truncated := false
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+		truncated = true
+	}
+
+	return map[string]interface{}{
+		"job_id":    core.IntParam(params, "job_id", 0),
+		"lines":     lines,
+		"count":     len(lines),
+		"truncated": truncated,
+	}, nil
+}
+
+// HandleWebhook handles a GitLab webhook. The X-Gitlab-Token header must be
+// verified with VerifyWebhookToken before the payload is passed in.
+func (a *GitLabAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	event, err := ParseWebhook(payload)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook", err, nil)
+	}
+
+	if eventType == "" {
+		eventType = event.ObjectKind
+	}
+
+	a.logger.Info("Received GitLab webhook", map[string]interface{}{
+		"eventType": eventType,
+		"project":   event.Project,
+		"action":    event.Action,
+	})
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, event).
+			WithMetadata("eventType", eventType).
+			WithMetadata("normalizedEventType", event.EventType).
+			WithMetadata("project", event.Project).
+			WithMetadata("action", event.Action)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// Close closes the adapter
+func (a *GitLabAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// projectPath returns the API path of the project given by project, or owner and repo,
+// or the configured default project
+func (a *GitLabAdapter) projectPath(operation string, params map[string]interface{}) (string, error) {
+	projectID := core.StringParam(params, "project")
+	if projectID == "" {
+		if id := core.IntParam(params, "project_id", 0); id > 0 {
+			projectID = strconv.Itoa(id)
+		}
+	}
+	if projectID == "" {
+		owner, repo := core.StringParam(params, "owner"), core.StringParam(params, "repo")
+		if owner != "" && repo != "" {
+			projectID = owner + "/" + repo
+		}
+	}
+	if projectID == "" {
+		projectID = a.config.DefaultProject
+	}
+	if projectID == "" {
+		return "", adapterErrors.NewInvalidParameterError(adapterType, operation,
+			fmt.Errorf("missing required parameter: project (or owner and repo)"), nil)
+	}
+
+	// Full paths such as group/subgroup/project must be URL encoded as a single segment
+	return "/projects/" + url.PathEscape(projectID), nil
+}
+
+// issuePath returns the API path of the issue given by issue_number
+func (a *GitLabAdapter) issuePath(operation string, params map[string]interface{}) (string, error) {
+	projectPath, err := a.projectPath(operation, params)
+	if err != nil {
+		return "", err
+	}
+	iid, err := requiredIntParam(operation, params, "issue_number", "iid")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/issues/%d", projectPath, iid), nil
+}
+
+// mergeRequestPath returns the API path of the merge request given by pull_number
+func (a *GitLabAdapter) mergeRequestPath(operation string, params map[string]interface{}) (string, error) {
+	projectPath, err := a.projectPath(operation, params)
+	if err != nil {
+		return "", err
+	}
+	iid, err := requiredIntParam(operation, params, "pull_number", "merge_request_iid", "iid")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/merge_requests/%d", projectPath, iid), nil
+}
+
+// notesPath returns the API path of the notes of an issue or merge request
+func (a *GitLabAdapter) notesPath(operation string, params map[string]interface{}) (string, error) {
+	if core.IntParam(params, "pull_number", 0) > 0 || core.IntParam(params, "merge_request_iid", 0) > 0 {
+		mrPath, err := a.mergeRequestPath(operation, params)
+		if err != nil {
+			return "", err
+		}
+		return mrPath + "/notes", nil
+	}
+
+	issuePath, err := a.issuePath(operation, params)
+	if err != nil {
+		return "", err
+	}
+	return issuePath + "/notes", nil
+}
+
+// pipelinePath returns the API path of the pipeline given by pipeline_id
+func (a *GitLabAdapter) pipelinePath(operation string, params map[string]interface{}) (string, error) {
+	projectPath, err := a.projectPath(operation, params)
+	if err != nil {
+		return "", err
+	}
+	id, err := requiredIntParam(operation, params, "pipeline_id")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/pipelines/%d", projectPath, id), nil
+}
+
+// jobPath returns the API path of the job given by job_id
+func (a *GitLabAdapter) jobPath(operation string, params map[string]interface{}) (string, error) {
+	projectPath, err := a.projectPath(operation, params)
+	if err != nil {
+		return "", err
+	}
+	id, err := requiredIntParam(operation, params, "job_id")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/jobs/%d", projectPath, id), nil
+}
+
+// pageQuery builds the pagination query parameters shared by list actions
+func (a *GitLabAdapter) pageQuery(params map[string]interface{}) url.Values {
+	query := url.Values{}
+	query.Set("per_page", strconv.Itoa(core.IntParam(params, "per_page", a.config.DefaultPerPage)))
+	if page := core.IntParam(params, "page", 0); page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	return query
+}
+
+// doRequest performs a request against the GitLab REST API. The response is
+// decoded as JSON into out, or copied verbatim when out is a *bytes.Buffer.
+func (a *GitLabAdapter) doRequest(ctx context.Context, operation, method, path string, query url.Values, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	requestURL := a.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if a.config.Token != "" {
+		if a.config.TokenType == TokenTypeOAuth {
+			req.Header.Set("Authorization", "Bearer "+a.config.Token)
+		} else {
+			req.Header.Set("PRIVATE-TOKEN", a.config.Token)
+		}
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("gitlab API returned %d: %s", resp.StatusCode, errorMessage(respBody)), nil)
+	}
+
+	if buffer, ok := out.(*bytes.Buffer); ok {
+		buffer.Write(respBody)
+		return nil
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode GitLab response: %w", err), nil)
+	}
+
+	return nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *GitLabAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// errorMessage extracts the message from a GitLab error response
+func errorMessage(body []byte) string {
+	var response struct {
+		Message interface{} `json:"message"`
+		Error   string      `json:"error"`
+	}
+	if json.Unmarshal(body, &response) == nil {
+		if response.Message != nil {
+			if message, ok := response.Message.(string); ok {
+				return message
+			}
+			// Validation errors are returned as a map of field to messages
+			if encoded, err := json.Marshal(response.Message); err == nil {
+				return string(encoded)
+			}
+		}
+		if response.Error != "" {
+			return response.Error
+		}
+	}
+	return strings.TrimSpace(string(body))
+}
+
+// gitlabState converts a GitHub style state filter into the GitLab equivalent
+func gitlabState(state string) string {
+	switch state {
+	case "open":
+		return "opened"
+	case "all", "":
+		return ""
+	default:
+		return state
+	}
+}
+
+// normalizedState converts a GitLab state into the GitHub style state
+func normalizedState(state string) string {
+	if state == "opened" {
+		return "open"
+	}
+	return state
+}
+
+// requiredIntParam returns the first positive integer parameter among keys
+func requiredIntParam(operation string, params map[string]interface{}, keys ...string) (int, error) {
+	for _, key := range keys {
+		if value := core.IntParam(params, key, 0); value > 0 {
+			return value, nil
+		}
+	}
+	return 0, adapterErrors.NewInvalidParameterError(adapterType, operation,
+		fmt.Errorf("missing required parameter: %s", keys[0]), nil)
+}
+
+// intSliceParam returns a list of integers from a JSON array parameter
+func intSliceParam(params map[string]interface{}, key string) []int {
+	values, ok := params[key].([]interface{})
+	if !ok {
+		if ints, ok := params[key].([]int); ok {
+			return ints
+		}
+		return nil
+	}
+
+	result := make([]int, 0, len(values))
+	for _, value := range values {
+		if n := core.IntParam(map[string]interface{}{key: value}, key, 0); n > 0 {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// usernames returns the usernames of a list of users
+func usernames(users []user) []string {
+	if len(users) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		names = append(names, u.Username)
+	}
+	return names
+}
+
+func toRepository(p project) Repository {
+	return Repository{
+		ID:            p.ID,
+		Name:          p.Name,
+		FullName:      p.PathWithNamespace,
+		Description:   p.Description,
+		DefaultBranch: p.DefaultBranch,
+		Visibility:    p.Visibility,
+		Archived:      p.Archived,
+		Stars:         p.StarCount,
+		Forks:         p.ForksCount,
+		URL:           p.WebURL,
+		UpdatedAt:     p.LastActivityAt,
+	}
+}
+
+func toIssue(i issue) Issue {
+	return Issue{
+		ID:        i.ID,
+		Number:    i.IID,
+		Title:     i.Title,
+		Body:      i.Description,
+		State:     normalizedState(i.State),
+		Labels:    i.Labels,
+		Author:    i.Author.Username,
+		Assignees: usernames(i.Assignees),
+		URL:       i.WebURL,
+		CreatedAt: i.CreatedAt,
+		UpdatedAt: i.UpdatedAt,
+		ClosedAt:  i.ClosedAt,
+	}
+}
+
+func toPullRequest(mr mergeRequest) PullRequest {
+	return PullRequest{
+		ID:        mr.ID,
+		Number:    mr.IID,
+		Title:     mr.Title,
+		Body:      mr.Description,
+		State:     normalizedState(mr.State),
+		Draft:     mr.Draft,
+		Merged:    mr.State == "merged",
+		Head:      mr.SourceBranch,
+		Base:      mr.TargetBranch,
+		SHA:       mr.SHA,
+		Mergeable: mr.MergeStatus,
+		Labels:    mr.Labels,
+		Author:    mr.Author.Username,
+		Assignees: usernames(mr.Assignees),
+		URL:       mr.WebURL,
+		CreatedAt: mr.CreatedAt,
+		UpdatedAt: mr.UpdatedAt,
+		MergedAt:  mr.MergedAt,
+	}
+}
+
+func toComment(n note) Comment {
+	return Comment{
+		ID:        n.ID,
+		Body:      n.Body,
+		Author:    n.Author.Username,
+		System:    n.System,
+		CreatedAt: n.CreatedAt,
+	}
+}
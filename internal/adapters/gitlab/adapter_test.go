@@ -0,0 +1,238 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// recordedRequest captures the parts of a request the tests assert on
+type recordedRequest struct {
+	method  string
+	path    string
+	query   map[string][]string
+	body    map[string]interface{}
+	headers http.Header
+}
+
+func newTestAdapter(t *testing.T, responses map[string]string) (*GitLabAdapter, *[]recordedRequest) {
+	requests := []recordedRequest{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded := recordedRequest{
+			method:  r.Method,
+			path:    r.URL.EscapedPath(),
+			query:   r.URL.Query(),
+			headers: r.Header,
+		}
+		json.NewDecoder(r.Body).Decode(&recorded.body)
+		requests = append(requests, recorded)
+
+		response, ok := responses[r.Method+" "+recorded.path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"404 Project Not Found"}`))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.BaseURL = server.URL
+	config.Token = "test-token"
+	config.DefaultProject = "group/repo"
+	config.MaxLogLines = 2
+
+	logger := observability.NewLogger("gitlab_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+
+	return adapter, &requests
+}
+
+const issueJSON = `{"id":1001,"iid":7,"title":"Login broken","description":"Safari only","state":"opened","labels":["bug"],"author":{"username":"alice"},"assignees":[{"username":"bob"}],"web_url":"https://gitlab.example.com/group/repo/-/issues/7"}`
+
+const mergeRequestJSON = `{"id":2001,"iid":3,"title":"Fix login","state":"merged","source_branch":"fix-login","target_branch":"main","merge_status":"can_be_merged","author":{"username":"alice"}}`
+
+func TestIssues(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v4/projects/group%2Frepo/issues":          "[" + issueJSON + "]",
+		"POST /api/v4/projects/group%2Frepo/issues":         issueJSON,
+		"PUT /api/v4/projects/other%2Frepo/issues/7":        strings.Replace(issueJSON, `"opened"`, `"closed"`, 1),
+		"POST /api/v4/projects/group%2Frepo/issues/7/notes": `{"id":9,"body":"On it","author":{"username":"bob"}}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_issues", map[string]interface{}{
+		"state":  "open",
+		"labels": []interface{}{"bug", "p1"},
+	})
+	require.NoError(t, err)
+
+	issues := result.([]Issue)
+	require.Len(t, issues, 1)
+	assert.Equal(t, 7, issues[0].Number)
+	assert.Equal(t, "open", issues[0].State)
+	assert.Equal(t, []string{"bob"}, issues[0].Assignees)
+	assert.Equal(t, "test-token", (*requests)[0].headers.Get("PRIVATE-TOKEN"))
+	assert.Equal(t, []string{"opened"}, (*requests)[0].query["state"])
+	assert.Equal(t, []string{"bug,p1"}, (*requests)[0].query["labels"])
+
+	// create_issue uses the same parameters as the GitHub adapter
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "create_issue", map[string]interface{}{
+		"title":  "Login broken",
+		"body":   "Safari only",
+		"labels": []interface{}{"bug"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Login broken", result.(Issue).Title)
+	assert.Equal(t, "Safari only", (*requests)[1].body["description"])
+	assert.Equal(t, "bug", (*requests)[1].body["labels"])
+
+	// owner and repo resolve to the project path
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "close_issue", map[string]interface{}{
+		"owner":        "other",
+		"repo":         "repo",
+		"issue_number": float64(7),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "closed", result.(Issue).State)
+	assert.Equal(t, "close", (*requests)[2].body["state_event"])
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "add_comment", map[string]interface{}{
+		"issue_number": 7,
+		"body":         "On it",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bob", result.(Comment).Author)
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "create_issue", map[string]interface{}{})
+	assert.True(t, adapterErrors.IsValidationError(err))
+}
+
+func TestMergeRequests(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v4/projects/group%2Frepo/merge_requests":         "[" + mergeRequestJSON + "]",
+		"POST /api/v4/projects/group%2Frepo/merge_requests":        mergeRequestJSON,
+		"PUT /api/v4/projects/group%2Frepo/merge_requests/3/merge": mergeRequestJSON,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_pull_requests", map[string]interface{}{
+		"state": "merged",
+		"base":  "main",
+	})
+	require.NoError(t, err)
+
+	pullRequests := result.([]PullRequest)
+	require.Len(t, pullRequests, 1)
+	assert.Equal(t, 3, pullRequests[0].Number)
+	assert.Equal(t, "fix-login", pullRequests[0].Head)
+	assert.True(t, pullRequests[0].Merged)
+	assert.Equal(t, []string{"main"}, (*requests)[0].query["target_branch"])
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "create_pull_request", map[string]interface{}{
+		"title": "Fix login",
+		"head":  "fix-login",
+		"base":  "main",
+		"draft": true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Draft: Fix login", (*requests)[1].body["title"])
+	assert.Equal(t, "fix-login", (*requests)[1].body["source_branch"])
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "merge_pull_request", map[string]interface{}{
+		"pull_number":  3,
+		"merge_method": "squash",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, (*requests)[2].body["squash"])
+}
+
+func TestPipelinesAndJobs(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v4/projects/group%2Frepo/pipelines":         `[{"id":55,"status":"failed","ref":"main"}]`,
+		"GET /api/v4/projects/group%2Frepo/pipelines/55/jobs": `[{"id":77,"name":"test","status":"failed","failure_reason":"script_failure"}]`,
+		"GET /api/v4/projects/group%2Frepo/jobs/77/trace":     "step 1\nstep 2\nerror: tests failed\n",
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_pipelines", map[string]interface{}{"ref": "main"})
+	require.NoError(t, err)
+	assert.Equal(t, "failed", result.([]Pipeline)[0].Status)
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "list_jobs", map[string]interface{}{
+		"pipeline_id": 55,
+		"scope":       "failed",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "script_failure", result.([]Job)[0].FailureReason)
+	assert.Equal(t, []string{"failed"}, (*requests)[1].query["scope[]"])
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_job_log", map[string]interface{}{"job_id": 77})
+	require.NoError(t, err)
+
+	log := result.(map[string]interface{})
+	assert.Equal(t, []string{"step 2", "error: tests failed"}, log["lines"])
+	assert.True(t, log["truncated"].(bool))
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_repository", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+	assert.Contains(t, err.Error(), "404 Project Not Found")
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_issue", nil)
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "delete_project", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 19)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	createIssue, _ := core.FindAction(actions, "create_issue")
+	assert.Equal(t, core.SafetyWrite, createIssue.Safety)
+	assert.NoError(t, createIssue.ValidateParams(map[string]interface{}{"owner": "group", "repo": "repo", "title": "Flaky test", "labels": "bug"}))
+	assert.Error(t, createIssue.ValidateParams(map[string]interface{}{"body": "no title"}))
+
+	listJobs, _ := core.FindAction(actions, "list_jobs")
+	assert.Equal(t, core.SafetyRead, listJobs.Safety)
+	assert.NoError(t, listJobs.ValidateParams(map[string]interface{}{"pipeline_id": float64(42), "scope": []interface{}{"failed"}}))
+	assert.Error(t, listJobs.ValidateParams(map[string]interface{}{"pipeline_id": float64(42), "status": "failed"}))
+}
+
+func TestOAuthToken(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v4/projects/group%2Frepo": `{"id":42,"path_with_namespace":"group/repo"}`,
+	})
+	adapter.config.TokenType = TokenTypeOAuth
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_repository", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "group/repo", result.(Repository).FullName)
+	assert.Equal(t, "Bearer test-token", (*requests)[0].headers.Get("Authorization"))
+	assert.Empty(t, (*requests)[0].headers.Get("PRIVATE-TOKEN"))
+}
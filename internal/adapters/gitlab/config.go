@@ -0,0 +1,50 @@
+package gitlab
+
+import (
+	"time"
+)
+
+// Token types supported by the GitLab API
+const (
+	// TokenTypePrivate sends the token in the PRIVATE-TOKEN header (personal, project and group access tokens)
+	TokenTypePrivate = "private"
+	// TokenTypeOAuth sends the token as an OAuth2 bearer token
+	TokenTypeOAuth = "oauth"
+)
+
+// Config holds configuration for the GitLab adapter
+type Config struct {
+	// Authentication settings
+	Token     string `mapstructure:"token"`
+	TokenType string `mapstructure:"token_type"`
+
+	// Connection settings. BaseURL is the root of the GitLab instance, e.g. https://gitlab.example.com
+	BaseURL        string        `mapstructure:"base_url"`
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// Default project (numeric ID or full path) used when an action does not specify one
+	DefaultProject string `mapstructure:"default_project"`
+
+	// Secret expected in the X-Gitlab-Token header of incoming webhooks
+	WebhookSecret string `mapstructure:"webhook_secret"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+
+	// Query settings
+	DefaultPerPage int `mapstructure:"default_per_page"`
+	MaxLogLines    int `mapstructure:"max_log_lines"`
+}
+
+// DefaultConfig returns a default configuration for the GitLab adapter
+func DefaultConfig() *Config {
+	return &Config{
+		TokenType:      TokenTypePrivate,
+		BaseURL:        "https://gitlab.com",
+		RequestTimeout: 30 * time.Second,
+		MockURL:        "http://localhost:8081/mock-gitlab",
+		DefaultPerPage: 20,
+		MaxLogLines:    500,
+	}
+}
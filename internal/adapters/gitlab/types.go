@@ -0,0 +1,170 @@
+package gitlab
+
+import (
+	"time"
+)
+
+// The types below are the subset of the GitLab REST API objects used by the adapter
+
+type user struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+type project struct {
+	ID                int        `json:"id"`
+	Name              string     `json:"name"`
+	PathWithNamespace string     `json:"path_with_namespace"`
+	Description       string     `json:"description"`
+	DefaultBranch     string     `json:"default_branch"`
+	Visibility        string     `json:"visibility"`
+	WebURL            string     `json:"web_url"`
+	Archived          bool       `json:"archived"`
+	StarCount         int        `json:"star_count"`
+	ForksCount        int        `json:"forks_count"`
+	LastActivityAt    *time.Time `json:"last_activity_at"`
+}
+
+type issue struct {
+	ID          int        `json:"id"`
+	IID         int        `json:"iid"`
+	ProjectID   int        `json:"project_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	State       string     `json:"state"`
+	Labels      []string   `json:"labels"`
+	Author      user       `json:"author"`
+	Assignees   []user     `json:"assignees"`
+	WebURL      string     `json:"web_url"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+}
+
+type mergeRequest struct {
+	ID           int        `json:"id"`
+	IID          int        `json:"iid"`
+	ProjectID    int        `json:"project_id"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	Draft        bool       `json:"draft"`
+	SourceBranch string     `json:"source_branch"`
+	TargetBranch string     `json:"target_branch"`
+	SHA          string     `json:"sha"`
+	MergeStatus  string     `json:"merge_status"`
+	Labels       []string   `json:"labels"`
+	Author       user       `json:"author"`
+	Assignees    []user     `json:"assignees"`
+	WebURL       string     `json:"web_url"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	MergedAt     *time.Time `json:"merged_at"`
+}
+
+type note struct {
+	ID        int       `json:"id"`
+	Body      string    `json:"body"`
+	Author    user      `json:"author"`
+	System    bool      `json:"system"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Pipeline is a GitLab CI pipeline
+type Pipeline struct {
+	ID         int        `json:"id"`
+	IID        int        `json:"iid"`
+	ProjectID  int        `json:"project_id"`
+	Status     string     `json:"status"`
+	Source     string     `json:"source"`
+	Ref        string     `json:"ref"`
+	SHA        string     `json:"sha"`
+	WebURL     string     `json:"web_url"`
+	Duration   float64    `json:"duration"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	StartedAt  *time.Time `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+// Job is a job of a GitLab CI pipeline
+type Job struct {
+	ID            int        `json:"id"`
+	Name          string     `json:"name"`
+	Stage         string     `json:"stage"`
+	Status        string     `json:"status"`
+	Ref           string     `json:"ref"`
+	AllowFailure  bool       `json:"allow_failure"`
+	FailureReason string     `json:"failure_reason"`
+	Duration      float64    `json:"duration"`
+	WebURL        string     `json:"web_url"`
+	CreatedAt     time.Time  `json:"created_at"`
+	StartedAt     *time.Time `json:"started_at"`
+	FinishedAt    *time.Time `json:"finished_at"`
+	Pipeline      struct {
+		ID int `json:"id"`
+	} `json:"pipeline"`
+}
+
+// Repository is the normalized view of a GitLab project, shared with the GitHub adapter's repository shape
+type Repository struct {
+	ID            int        `json:"id"`
+	Name          string     `json:"name"`
+	FullName      string     `json:"full_name"`
+	Description   string     `json:"description,omitempty"`
+	DefaultBranch string     `json:"default_branch,omitempty"`
+	Visibility    string     `json:"visibility,omitempty"`
+	Archived      bool       `json:"archived"`
+	Stars         int        `json:"stars"`
+	Forks         int        `json:"forks"`
+	URL           string     `json:"url"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+// Issue is the normalized view of a GitLab issue, shared with the GitHub adapter's issue shape
+type Issue struct {
+	ID        int        `json:"id"`
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body,omitempty"`
+	State     string     `json:"state"`
+	Labels    []string   `json:"labels,omitempty"`
+	Author    string     `json:"author,omitempty"`
+	Assignees []string   `json:"assignees,omitempty"`
+	URL       string     `json:"url"`
+	CreatedAt time.Time  `json:"created"`
+	UpdatedAt time.Time  `json:"updated"`
+	ClosedAt  *time.Time `json:"closed,omitempty"`
+}
+
+// PullRequest is the normalized view of a GitLab merge request, shared with the GitHub adapter's pull request shape
+type PullRequest struct {
+	ID        int        `json:"id"`
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body,omitempty"`
+	State     string     `json:"state"`
+	Draft     bool       `json:"draft"`
+	Merged    bool       `json:"merged"`
+	Head      string     `json:"head"`
+	Base      string     `json:"base"`
+	SHA       string     `json:"sha,omitempty"`
+	Mergeable string     `json:"mergeable,omitempty"`
+	Labels    []string   `json:"labels,omitempty"`
+	Author    string     `json:"author,omitempty"`
+	Assignees []string   `json:"assignees,omitempty"`
+	URL       string     `json:"url"`
+	CreatedAt time.Time  `json:"created"`
+	UpdatedAt time.Time  `json:"updated"`
+	MergedAt  *time.Time `json:"merged_at,omitempty"`
+}
+
+// Comment is the normalized view of a note on an issue or merge request
+type Comment struct {
+	ID        int       `json:"id"`
+	Body      string    `json:"body"`
+	Author    string    `json:"author"`
+	System    bool      `json:"system"`
+	CreatedAt time.Time `json:"created"`
+}
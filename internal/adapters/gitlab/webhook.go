@@ -0,0 +1,142 @@
+package gitlab
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// WebhookTokenHeader is the header GitLab uses to send the webhook secret
+const WebhookTokenHeader = "X-Gitlab-Token"
+
+// webhookEventTypes maps GitLab object kinds to the event names used by the GitHub adapter
+var webhookEventTypes = map[string]string{
+	"issue":         "issues",
+	"merge_request": "pull_request",
+	"note":          "issue_comment",
+	"pipeline":      "workflow_run",
+	"build":         "workflow_job",
+	"push":          "push",
+	"tag_push":      "create",
+	"release":       "release",
+}
+
+// WebhookEvent is the normalized summary of a GitLab webhook
+type WebhookEvent struct {
+	// ObjectKind is the GitLab object kind, e.g. merge_request
+	ObjectKind string `json:"object_kind"`
+	// EventType is the equivalent GitHub event name, e.g. pull_request
+	EventType string `json:"event_type"`
+	Action    string `json:"action,omitempty"`
+	Project   string `json:"project,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+	Number    int    `json:"number,omitempty"`
+	Title     string `json:"title,omitempty"`
+	State     string `json:"state,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Ref       string `json:"ref,omitempty"`
+	URL       string `json:"url,omitempty"`
+	User      string `json:"user,omitempty"`
+	// Payload is the full decoded webhook body
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// webhookPayload is the subset of the GitLab webhook body used for normalization
+type webhookPayload struct {
+	ObjectKind string `json:"object_kind"`
+	Ref        string `json:"ref"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	UserUsername string `json:"user_username"`
+	Project      struct {
+		ID                int    `json:"id"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ProjectID        int `json:"project_id"`
+	ObjectAttributes struct {
+		ID     int    `json:"id"`
+		IID    int    `json:"iid"`
+		Title  string `json:"title"`
+		State  string `json:"state"`
+		Status string `json:"status"`
+		Action string `json:"action"`
+		Ref    string `json:"ref"`
+		URL    string `json:"url"`
+	} `json:"object_attributes"`
+	BuildStatus string `json:"build_status"`
+}
+
+// VerifyWebhookToken checks the X-Gitlab-Token header value against the
+// configured webhook secret. If no secret is configured every token is rejected.
+func (a *GitLabAdapter) VerifyWebhookToken(token string) bool {
+	if a.config.WebhookSecret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(a.config.WebhookSecret)) == 1
+}
+
+// ReceiveWebhook implements core.WebhookReceiver by checking the
+// X-Gitlab-Token header. GitLab payloads are passed on unchanged.
+func (a *GitLabAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifyWebhookToken(header.Get(WebhookTokenHeader)) {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid webhook token"), nil)
+	}
+	return body, nil
+}
+
+// ParseWebhook decodes a GitLab webhook payload into a normalized event
+func ParseWebhook(payload []byte) (*WebhookEvent, error) {
+	var body webhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse GitLab webhook payload: %w", err)
+	}
+	if body.ObjectKind == "" {
+		return nil, fmt.Errorf("GitLab webhook payload is missing object_kind")
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse GitLab webhook payload: %w", err)
+	}
+
+	event := &WebhookEvent{
+		ObjectKind: body.ObjectKind,
+		EventType:  webhookEventTypes[body.ObjectKind],
+		Action:     body.ObjectAttributes.Action,
+		Project:    body.Project.PathWithNamespace,
+		ProjectID:  body.Project.ID,
+		Number:     body.ObjectAttributes.IID,
+		Title:      body.ObjectAttributes.Title,
+		State:      normalizedState(body.ObjectAttributes.State),
+		Status:     body.ObjectAttributes.Status,
+		Ref:        body.Ref,
+		URL:        body.ObjectAttributes.URL,
+		User:       body.User.Username,
+		Payload:    raw,
+	}
+
+	if event.EventType == "" {
+		event.EventType = body.ObjectKind
+	}
+	if event.ProjectID == 0 {
+		event.ProjectID = body.ProjectID
+	}
+	if event.Ref == "" {
+		event.Ref = body.ObjectAttributes.Ref
+	}
+	if event.User == "" {
+		event.User = body.UserUsername
+	}
+	// Job events carry their status at the top level
+	if body.ObjectKind == "build" {
+		event.Status = body.BuildStatus
+	}
+
+	return event, nil
+}
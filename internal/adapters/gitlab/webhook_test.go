@@ -0,0 +1,132 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// eventRecorder records adapter events emitted during a test
+type eventRecorder struct {
+	events []*events.AdapterEvent
+}
+
+func (r *eventRecorder) Handle(ctx context.Context, event *events.AdapterEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+const mergeRequestHook = `{
+	"object_kind": "merge_request",
+	"user": {"username": "alice"},
+	"project": {"id": 42, "path_with_namespace": "group/repo"},
+	"object_attributes": {"iid": 3, "title": "Fix login", "state": "opened", "action": "open", "url": "https://gitlab.example.com/group/repo/-/merge_requests/3"}
+}`
+
+func TestParseWebhook(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   string
+		eventType string
+		status    string
+		expectErr bool
+	}{
+		{"merge request", mergeRequestHook, "pull_request", "", false},
+		{"pipeline", `{"object_kind":"pipeline","project":{"id":42},"object_attributes":{"status":"failed","ref":"main"}}`, "workflow_run", "failed", false},
+		{"job", `{"object_kind":"build","project_id":42,"build_status":"success"}`, "workflow_job", "success", false},
+		{"missing object kind", `{"project":{"id":42}}`, "", "", true},
+		{"invalid json", `not json`, "", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event, err := ParseWebhook([]byte(test.payload))
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.eventType, event.EventType)
+			assert.Equal(t, test.status, event.Status)
+			assert.Equal(t, 42, event.ProjectID)
+		})
+	}
+}
+
+func TestReceiveWebhook(t *testing.T) {
+	logger := observability.NewLogger("gitlab_test")
+	eventBus := events.NewEventBus(logger)
+	recorder := &eventRecorder{}
+	eventBus.SubscribeAll(recorder)
+
+	config := DefaultConfig()
+	config.WebhookSecret = "s3cret"
+	adapter, err := New(config, logger, nil, eventBus)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		token     string
+		expectErr bool
+	}{
+		{"valid token", "s3cret", false},
+		{"invalid token", "wrong", true},
+		{"missing token", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			if test.token != "" {
+				header.Set(WebhookTokenHeader, test.token)
+			}
+
+			payload, err := adapter.ReceiveWebhook(header, nil, []byte(mergeRequestHook))
+			if test.expectErr {
+				assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized))
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, adapter.HandleWebhook(context.Background(), "", payload))
+		})
+	}
+
+	require.Len(t, recorder.events, 1, "only the verified delivery should be emitted")
+	event := recorder.events[0]
+	assert.Equal(t, events.EventTypeWebhookReceived, event.EventType)
+	assert.Equal(t, "merge_request", event.Metadata["eventType"])
+	assert.Equal(t, "pull_request", event.Metadata["normalizedEventType"])
+	assert.Equal(t, "group/repo", event.Metadata["project"])
+
+	webhookEvent := event.Payload.(*WebhookEvent)
+	assert.Equal(t, 3, webhookEvent.Number)
+	assert.Equal(t, "open", webhookEvent.State)
+
+	adapter.config.WebhookSecret = ""
+	_, err = adapter.ReceiveWebhook(http.Header{WebhookTokenHeader: {""}}, nil, []byte(mergeRequestHook))
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized), "deliveries are rejected when no secret is configured")
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.WebhookSecret = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("gitlab_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"merge-request-hook.json": "Merge Request Hook",
+		"pipeline-hook.json":      "Pipeline Hook",
+		"push-hook.json":          "Push Hook",
+	})
+}
@@ -0,0 +1,145 @@
+package jenkins
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// jobParams are the params identifying a job
+var jobParams = map[string]interface{}{
+	"job": map[string]interface{}{
+		"type":        "string",
+		"description": "Full job name. Jobs in folders are given as folder/job",
+		"minLength":   1,
+	},
+}
+
+// buildParams are the params identifying a build of a job, by number or by
+// permalink. The last build is used when neither is given.
+var buildParams = map[string]interface{}{
+	"build_number": map[string]interface{}{
+		"type":        "integer",
+		"description": "Build number",
+		"minimum":     1,
+	},
+	"build": map[string]interface{}{
+		"type":        "string",
+		"description": "Build permalink, used when no build_number is given. Defaults to lastBuild",
+		"enum": []interface{}{
+			"lastBuild", "lastCompletedBuild", "lastSuccessfulBuild", "lastFailedBuild",
+			"lastStableBuild", "lastUnstableBuild", "lastUnsuccessfulBuild",
+		},
+	},
+}
+
+// Actions returns the descriptors of the supported Jenkins actions
+func (a *JenkinsAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{
+		{
+			Name:        "get_job",
+			Description: "Get a job with its status and parameters",
+			Params:      paramsSchema([]string{"job"}, jobParams),
+			Safety:      core.SafetyRead,
+		},
+		{
+			Name:        "list_builds",
+			Description: "List the most recent builds of a job",
+			Params: paramsSchema([]string{"job"}, jobParams, map[string]interface{}{
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum number of builds to return",
+					"minimum":     1,
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "trigger_build",
+			Description: "Queue a build, and by default wait for it to start",
+			Params: paramsSchema([]string{"job"}, jobParams, map[string]interface{}{
+				"parameters": map[string]interface{}{
+					"type":        "object",
+					"description": "Build parameters, keyed by name",
+				},
+				"wait_for_start": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Wait for the queue item to become a build. Defaults to true",
+				},
+				"wait_for_completion": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Wait for the build to finish. Defaults to false",
+				},
+			}),
+			Safety: core.SafetyWrite,
+		},
+		{
+			Name:        "get_queue_item",
+			Description: "Get a queue item, with its build once it has started",
+			Params: paramsSchema([]string{"queue_id"}, map[string]interface{}{
+				"queue_id": map[string]interface{}{
+					"type":        "integer",
+					"description": "Queue item ID returned by trigger_build",
+					"minimum":     1,
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "get_build",
+			Description: "Get a build",
+			Params:      paramsSchema([]string{"job"}, jobParams, buildParams),
+			Safety:      core.SafetyRead,
+		},
+		{
+			Name:        "wait_for_build",
+			Description: "Wait for a running build to finish",
+			Params: paramsSchema([]string{"job", "build_number"}, jobParams, map[string]interface{}{
+				"build_number": buildParams["build_number"],
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "stop_build",
+			Description: "Abort a running build",
+			Params:      paramsSchema([]string{"job"}, jobParams, buildParams),
+			Safety:      core.SafetyDestructive,
+		},
+		{
+			Name:        "get_console_output",
+			Description: "Read the console output of a build from an offset",
+			Params: paramsSchema([]string{"job"}, jobParams, buildParams, map[string]interface{}{
+				"start": map[string]interface{}{
+					"type":        "integer",
+					"description": "Offset to read from, such as the next_start of the previous call",
+					"minimum":     0,
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "get_test_report",
+			Description: "Summarize the test report of a build, with its failed tests",
+			Params:      paramsSchema([]string{"job"}, jobParams, buildParams),
+			Safety:      core.SafetyRead,
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,883 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/jobs"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Jenkins adapter
+const adapterType = "jenkins"
+
+// buildOperation is the operation name used for build progress events
+const buildOperation = "build"
+
+// Build progress phases reported in the phase metadata of build events
+const (
+	PhaseQueued    = "queued"
+	PhaseStarted   = "started"
+	PhaseCompleted = "completed"
+)
+
+// queueItemPattern extracts the queue item ID from the Location header of a build trigger
+var queueItemPattern = regexp.MustCompile(`/queue/item/(\d+)/?$`)
+
+// buildPermalinks are the build references accepted in place of a build number
+var buildPermalinks = map[string]bool{
+	"lastBuild":             true,
+	"lastCompletedBuild":    true,
+	"lastSuccessfulBuild":   true,
+	"lastFailedBuild":       true,
+	"lastStableBuild":       true,
+	"lastUnstableBuild":     true,
+	"lastUnsuccessfulBuild": true,
+}
+
+// JenkinsAdapter provides an adapter for triggering and inspecting Jenkins builds
+type JenkinsAdapter struct {
+	config        *Config
+	baseURL       string
+	client        *http.Client
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+
+	// crumb is the cached CSRF crumb. A crumb with an empty field means the
+	// instance does not issue crumbs.
+	crumbMutex sync.Mutex
+	crumb      *crumb
+}
+
+// New creates a new Jenkins adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*JenkinsAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("jenkins_adapter")
+	}
+
+	baseURL := config.BaseURL
+	if config.MockResponses {
+		baseURL = config.MockURL
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("jenkins base URL is required")
+	}
+
+	// Crumbs are bound to the web session, so the client keeps cookies
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
+	}
+
+	return &JenkinsAdapter{
+		config:        config,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		client:        &http.Client{Timeout: config.RequestTimeout, Jar: jar},
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+	}, nil
+}
+
+// Type returns the adapter type
+func (a *JenkinsAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *JenkinsAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status
+func (a *JenkinsAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	query := url.Values{"tree": {"mode"}}
+	if _, err := a.doRequest(ctx, "health", http.MethodGet, "/api/json", query, nil, nil); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+
+	if a.config.MockResponses {
+		return "healthy (mock)"
+	}
+	return "healthy"
+}
+
+// ExecuteAction executes a Jenkins action
+func (a *JenkinsAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing Jenkins action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	// Jobs
+	case "get_job":
+		result, err = a.getJob(ctx, params)
+	case "list_builds":
+		result, err = a.listBuilds(ctx, params)
+
+	// Builds
+	case "trigger_build":
+		result, err = a.triggerBuild(ctx, contextID, params)
+	case "get_queue_item":
+		result, err = a.getQueueItem(ctx, params)
+	case "get_build":
+		result, err = a.getBuild(ctx, params)
+	case "wait_for_build":
+		result, err = a.waitForBuildAction(ctx, contextID, params)
+	case "stop_build":
+		result, err = a.stopBuild(ctx, params)
+
+	// Build output
+	case "get_console_output":
+		result, err = a.getConsoleOutput(ctx, params)
+	case "get_test_report":
+		result, err = a.getTestReport(ctx, params)
+
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported Jenkins action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// getJob gets a job and the parameters it accepts
+func (a *JenkinsAdapter) getJob(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jobName, jobPath, err := a.jobPath("get_job", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var j job
+	if _, err := a.doRequest(ctx, "get_job", http.MethodGet, jobPath+"/api/json", nil, nil, &j); err != nil {
+		return nil, err
+	}
+
+	result := Job{
+		Name:        j.Name,
+		FullName:    j.FullName,
+		Description: j.Description,
+		URL:         j.URL,
+		Buildable:   j.Buildable,
+		InQueue:     j.InQueue,
+		Status:      jobStatus(j.Color),
+	}
+	if result.FullName == "" {
+		result.FullName = jobName
+	}
+	if j.LastBuild != nil {
+		result.LastBuild = j.LastBuild.Number
+	}
+	if j.LastSuccessful != nil {
+		result.LastSuccessfulBuild = j.LastSuccessful.Number
+	}
+	if j.LastFailedBuild != nil {
+		result.LastFailedBuild = j.LastFailedBuild.Number
+	}
+	for _, property := range j.Property {
+		for _, definition := range property.ParameterDefinitions {
+			parameter := JobParameter{
+				Name:        definition.Name,
+				Type:        definition.Type,
+				Description: definition.Description,
+				Choices:     definition.Choices,
+			}
+			if definition.DefaultParameterValue != nil {
+				parameter.Default = definition.DefaultParameterValue.Value
+			}
+			result.Parameters = append(result.Parameters, parameter)
+		}
+	}
+
+	return result, nil
+}
+
+// listBuilds lists the most recent builds of a job
+func (a *JenkinsAdapter) listBuilds(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jobName, jobPath, err := a.jobPath("list_builds", params)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := core.IntParam(params, "limit", a.config.DefaultBuildLimit)
+	if limit <= 0 {
+		limit = a.config.DefaultBuildLimit
+	}
+	query := url.Values{
+		"tree": {fmt.Sprintf("builds[number,result,building,timestamp,duration,estimatedDuration,queueId,url]{0,%d}", limit)},
+	}
+
+	var j job
+	if _, err := a.doRequest(ctx, "list_builds", http.MethodGet, jobPath+"/api/json", query, nil, &j); err != nil {
+		return nil, err
+	}
+
+	builds := make([]Build, 0, len(j.Builds))
+	for _, b := range j.Builds {
+		builds = append(builds, toBuild(jobName, b))
+	}
+
+	return builds, nil
+}
+
+// triggerBuild queues a build, optionally with parameters, and by default waits
+// for the queue item to become a build. With wait_for_completion the build is
+// polled until it finishes.
+func (a *JenkinsAdapter) triggerBuild(ctx context.Context, contextID string, params map[string]interface{}) (interface{}, error) {
+	jobName, jobPath, err := a.jobPath("trigger_build", params)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := jobPath + "/build"
+	var form url.Values
+	if buildParams := core.MapParam(params, "parameters"); len(buildParams) > 0 {
+		endpoint = jobPath + "/buildWithParameters"
+		form = url.Values{}
+		for name, value := range buildParams {
+			form.Set(name, fmt.Sprint(value))
+		}
+	}
+
+	header, err := a.doRequest(ctx, "trigger_build", http.MethodPost, endpoint, nil, form, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := queueItemPattern.FindStringSubmatch(header.Get("Location"))
+	if matches == nil {
+		return nil, adapterErrors.NewUnknownError(adapterType, "trigger_build",
+			fmt.Errorf("jenkins did not return a queue item location for %s", jobName), nil)
+	}
+	queueID, _ := strconv.Atoi(matches[1])
+
+	result := &TriggerResult{Job: jobName, QueueID: queueID}
+	a.emitBuildEvent(ctx, contextID, events.EventTypeOperationStarting, PhaseQueued, jobName, *result,
+		map[string]interface{}{"queueId": queueID})
+	jobs.ReportProgress(ctx, 0, fmt.Sprintf("Build of %s queued", jobName))
+
+	waitForCompletion := core.BoolParam(params, "wait_for_completion", false)
+	if !core.BoolParam(params, "wait_for_start", true) && !waitForCompletion {
+		return result, nil
+	}
+
+	item, err := a.waitForQueueItem(ctx, jobName, queueID)
+	if err != nil {
+		return nil, err
+	}
+	result.BuildNumber = item.BuildNumber
+	result.BuildURL = item.BuildURL
+	a.emitBuildEvent(ctx, contextID, events.EventTypeOperationStarting, PhaseStarted, jobName, *result,
+		map[string]interface{}{"queueId": queueID, "buildNumber": item.BuildNumber})
+	jobs.ReportProgress(ctx, 0, fmt.Sprintf("Build %s #%d started", jobName, item.BuildNumber))
+
+	if !waitForCompletion {
+		return result, nil
+	}
+
+	completed, err := a.waitForBuild(ctx, contextID, jobName, jobPath, item.BuildNumber)
+	if err != nil {
+		return nil, err
+	}
+	result.Build = completed
+
+	return result, nil
+}
+
+// getQueueItem gets a queue item
+func (a *JenkinsAdapter) getQueueItem(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	queueID := core.IntParam(params, "queue_id", 0)
+	if queueID <= 0 {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "get_queue_item",
+			fmt.Errorf("missing required parameter: queue_id"), nil)
+	}
+
+	return a.fetchQueueItem(ctx, "get_queue_item", queueID)
+}
+
+// getBuild gets a build by number or permalink
+func (a *JenkinsAdapter) getBuild(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jobName, buildPath, err := a.buildPath("get_build", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.fetchBuild(ctx, "get_build", jobName, buildPath)
+}
+
+// waitForBuildAction waits for a running build to complete
+func (a *JenkinsAdapter) waitForBuildAction(ctx context.Context, contextID string, params map[string]interface{}) (interface{}, error) {
+	jobName, jobPath, err := a.jobPath("wait_for_build", params)
+	if err != nil {
+		return nil, err
+	}
+
+	number := core.IntParam(params, "build_number", 0)
+	if number <= 0 {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "wait_for_build",
+			fmt.Errorf("missing required parameter: build_number"), nil)
+	}
+
+	return a.waitForBuild(ctx, contextID, jobName, jobPath, number)
+}
+
+// stopBuild aborts a running build
+func (a *JenkinsAdapter) stopBuild(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jobName, buildPath, err := a.buildPath("stop_build", params)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := a.doRequest(ctx, "stop_build", http.MethodPost, buildPath+"/stop", nil, nil, nil); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"job":     jobName,
+		"build":   path.Base(buildPath),
+		"stopped": true,
+	}, nil
+}
+
+// getConsoleOutput reads console output from the start offset. The response is
+// capped at MaxConsoleBytes; the returned next_start continues where it stopped.
+func (a *JenkinsAdapter) getConsoleOutput(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jobName, buildPath, err := a.buildPath("get_console_output", params)
+	if err != nil {
+		return nil, err
+	}
+
+	start := int64(core.IntParam(params, "start", 0))
+	if start < 0 {
+		start = 0
+	}
+
+	var text strings.Builder
+	query := url.Values{"start": {strconv.FormatInt(start, 10)}}
+	header, err := a.doRequest(ctx, "get_console_output", http.MethodGet, buildPath+"/logText/progressiveText", query, nil, &text)
+	if err != nil {
+		return nil, err
+	}
+
+	output := ConsoleOutput{
+		Job:       jobName,
+		Number:    core.IntParam(params, "build_number", 0),
+		Text:      text.String(),
+		Start:     start,
+		NextStart: start + int64(text.Len()),
+		MoreData:  header.Get("X-More-Data") == "true",
+	}
+	if size, err := strconv.ParseInt(header.Get("X-Text-Size"), 10, 64); err == nil {
+		output.NextStart = size
+	}
+
+	if a.config.MaxConsoleBytes > 0 && len(output.Text) > a.config.MaxConsoleBytes {
+		output.Text = output.Text[:a.config.MaxConsoleBytes]
+		output.NextStart = start + int64(a.config.MaxConsoleBytes)
+		output.MoreData = true
+	}
+
+	return output, nil
+}
+
+// getTestReport summarizes the test report of a build
+func (a *JenkinsAdapter) getTestReport(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jobName, buildPath, err := a.buildPath("get_test_report", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var report testReport
+	if _, err := a.doRequest(ctx, "get_test_report", http.MethodGet, buildPath+"/testReport/api/json", nil, nil, &report); err != nil {
+		return nil, err
+	}
+
+	result := TestReport{
+		Job:      jobName,
+		Number:   core.IntParam(params, "build_number", 0),
+		Passed:   report.PassCount,
+		Failed:   report.FailCount,
+		Skipped:  report.SkipCount,
+		Duration: report.Duration,
+		Failures: []TestCase{},
+	}
+	result.Total = result.Passed + result.Failed + result.Skipped
+	if report.TotalCount > result.Total {
+		result.Total = report.TotalCount
+		result.Passed = result.Total - result.Failed - result.Skipped
+	}
+
+	for _, suite := range report.Suites {
+		for _, testCase := range suite.Cases {
+			if testCase.Status != "FAILED" && testCase.Status != "REGRESSION" {
+				continue
+			}
+			if len(result.Failures) >= a.config.MaxTestFailures {
+				result.Truncated = true
+				break
+			}
+			result.Failures = append(result.Failures, TestCase{
+				Suite:        suite.Name,
+				ClassName:    testCase.ClassName,
+				Name:         testCase.Name,
+				Status:       testCase.Status,
+				Duration:     testCase.Duration,
+				ErrorDetails: testCase.ErrorDetails,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// waitForQueueItem polls a queue item until it has become a build
+func (a *JenkinsAdapter) waitForQueueItem(ctx context.Context, jobName string, queueID int) (*QueueItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.config.QueueTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(a.config.QueuePollInterval)
+	defer ticker.Stop()
+
+	for {
+		item, err := a.fetchQueueItem(ctx, "trigger_build", queueID)
+		if err != nil {
+			return nil, err
+		}
+		if item.Cancelled {
+			return nil, adapterErrors.NewUnknownError(adapterType, "trigger_build",
+				fmt.Errorf("queue item %d for %s was cancelled", queueID, jobName), nil)
+		}
+		if item.BuildNumber > 0 {
+			return item, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, adapterErrors.NewTimeoutError(adapterType, "trigger_build",
+				fmt.Errorf("queue item %d for %s did not start a build: %s", queueID, jobName, item.Why), nil)
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForBuild polls a build until it is no longer running and emits a
+// success or failure build event with the build result
+func (a *JenkinsAdapter) waitForBuild(ctx context.Context, contextID, jobName, jobPath string, number int) (*Build, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.config.BuildTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(a.config.BuildPollInterval)
+	defer ticker.Stop()
+
+	buildPath := jobPath + "/" + strconv.Itoa(number)
+	for {
+		b, err := a.fetchBuild(ctx, "wait_for_build", jobName, buildPath)
+		if err != nil {
+			return nil, err
+		}
+		if !b.Building {
+			eventType := events.EventTypeOperationSuccess
+			if !b.Succeeded {
+				eventType = events.EventTypeOperationFailure
+			}
+			a.emitBuildEvent(ctx, contextID, eventType, PhaseCompleted, jobName, *b,
+				map[string]interface{}{"buildNumber": number, "result": b.Result})
+			return b, nil
+		}
+		jobs.ReportProgress(ctx, buildProgress(b, time.Now()), fmt.Sprintf("Build %s #%d running", jobName, number))
+
+		select {
+		case <-ctx.Done():
+			return nil, adapterErrors.NewTimeoutError(adapterType, "wait_for_build",
+				fmt.Errorf("build %s #%d did not complete in time", jobName, number), nil)
+		case <-ticker.C:
+		}
+	}
+}
+
+// buildProgress estimates the completion percentage of a running build from
+// its estimated duration, the average of the recent builds of its job. It
+// stays below 100 for builds running longer than estimated.
+func buildProgress(b *Build, now time.Time) int {
+	if b.Estimated <= 0 || b.StartedAt.IsZero() {
+		return 0
+	}
+	progress := int(now.Sub(b.StartedAt) * 100 / b.Estimated)
+	if progress < 0 {
+		return 0
+	}
+	if progress > 99 {
+		return 99
+	}
+	return progress
+}
+
+// fetchQueueItem gets a queue item by ID
+func (a *JenkinsAdapter) fetchQueueItem(ctx context.Context, operation string, queueID int) (*QueueItem, error) {
+	var item queueItem
+	if _, err := a.doRequest(ctx, operation, http.MethodGet, fmt.Sprintf("/queue/item/%d/api/json", queueID), nil, nil, &item); err != nil {
+		return nil, err
+	}
+
+	result := &QueueItem{
+		ID:        item.ID,
+		Job:       item.Task.Name,
+		Why:       item.Why,
+		Blocked:   item.Blocked,
+		Stuck:     item.Stuck,
+		Cancelled: item.Cancelled,
+	}
+	if item.Executable != nil {
+		result.BuildNumber = item.Executable.Number
+		result.BuildURL = item.Executable.URL
+	}
+
+	return result, nil
+}
+
+// fetchBuild gets a build by its API path
+func (a *JenkinsAdapter) fetchBuild(ctx context.Context, operation, jobName, buildPath string) (*Build, error) {
+	var b build
+	if _, err := a.doRequest(ctx, operation, http.MethodGet, buildPath+"/api/json", nil, nil, &b); err != nil {
+		return nil, err
+	}
+
+	result := toBuild(jobName, b)
+	return &result, nil
+}
+
+// HandleWebhook handles a Jenkins notification plugin webhook. The token query
+// parameter must be verified with VerifyWebhookToken before the payload is passed in.
+func (a *JenkinsAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	event, err := ParseWebhook(payload)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook", err, nil)
+	}
+
+	if eventType == "" {
+		eventType = event.EventType
+	}
+
+	a.logger.Info("Received Jenkins webhook", map[string]interface{}{
+		"eventType": eventType,
+		"job":       event.Job,
+		"build":     event.Number,
+	})
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, event).
+			WithMetadata("eventType", eventType).
+			WithMetadata("job", event.Job).
+			WithMetadata("buildNumber", event.Number).
+			WithMetadata("phase", event.Phase).
+			WithMetadata("status", event.Status)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// Close closes the adapter
+func (a *JenkinsAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// jobPath returns the full job name and its API path. Jobs in folders are
+// given as folder/job.
+func (a *JenkinsAdapter) jobPath(operation string, params map[string]interface{}) (string, string, error) {
+	jobName, err := core.RequiredStringParam(params, "job")
+	if err != nil {
+		return "", "", adapterErrors.NewInvalidParameterError(adapterType, operation, err, nil)
+	}
+
+	jobName = strings.Trim(jobName, "/")
+	var jobPath strings.Builder
+	for _, segment := range strings.Split(jobName, "/") {
+		jobPath.WriteString("/job/")
+		jobPath.WriteString(url.PathEscape(segment))
+	}
+
+	return jobName, jobPath.String(), nil
+}
+
+// buildPath returns the full job name and the API path of the build given by
+// build_number, or a permalink such as lastFailedBuild in build. The last build
+// is used when neither is set.
+func (a *JenkinsAdapter) buildPath(operation string, params map[string]interface{}) (string, string, error) {
+	jobName, jobPath, err := a.jobPath(operation, params)
+	if err != nil {
+		return "", "", err
+	}
+
+	if number := core.IntParam(params, "build_number", 0); number > 0 {
+		return jobName, jobPath + "/" + strconv.Itoa(number), nil
+	}
+
+	permalink := core.StringParam(params, "build")
+	if permalink == "" {
+		permalink = "lastBuild"
+	}
+	if !buildPermalinks[permalink] {
+		return "", "", adapterErrors.NewInvalidParameterError(adapterType, operation,
+			fmt.Errorf("invalid build reference: %s", permalink), nil)
+	}
+
+	return jobName, jobPath + "/" + permalink, nil
+}
+
+// doRequest performs a Jenkins API request and decodes the JSON response into
+// out. If out is a *strings.Builder the raw response body is written to it.
+// Form values are sent URL encoded. POST requests carry a CSRF crumb, which is
+// refreshed once if Jenkins rejects it.
+func (a *JenkinsAdapter) doRequest(ctx context.Context, operation, method, path string, query url.Values, form url.Values, out interface{}) (http.Header, error) {
+	header, status, body, err := a.send(ctx, operation, method, path, query, form)
+	if err == nil && status == http.StatusForbidden && method == http.MethodPost && !a.config.DisableCrumb {
+		a.crumbMutex.Lock()
+		a.crumb = nil
+		a.crumbMutex.Unlock()
+		header, status, body, err = a.send(ctx, operation, method, path, query, form)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if status >= http.StatusBadRequest {
+		return nil, adapterErrors.FromHTTPStatus(adapterType, operation, status,
+			fmt.Errorf("jenkins API returned %d: %s", status, errorMessage(body)), nil)
+	}
+
+	if builder, ok := out.(*strings.Builder); ok {
+		builder.Write(body)
+		return header, nil
+	}
+
+	if out == nil || len(body) == 0 {
+		return header, nil
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Jenkins response: %w", err), nil)
+	}
+
+	return header, nil
+}
+
+// send performs a single request and returns the response headers, status and body
+func (a *JenkinsAdapter) send(ctx context.Context, operation, method, path string, query url.Values, form url.Values) (http.Header, int, []byte, error) {
+	var reader io.Reader
+	if form != nil {
+		reader = strings.NewReader(form.Encode())
+	}
+
+	requestURL := a.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return nil, 0, nil, adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if a.config.Username != "" {
+		req.SetBasicAuth(a.config.Username, a.config.APIToken)
+	}
+
+	if method == http.MethodPost && !a.config.DisableCrumb {
+		c, err := a.getCrumb(ctx, operation)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		if c.CrumbRequestField != "" {
+			req.Header.Set(c.CrumbRequestField, c.Crumb)
+		}
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, 0, nil, adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return nil, 0, nil, adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, nil, adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	return resp.Header, resp.StatusCode, body, nil
+}
+
+// getCrumb returns the cached CSRF crumb, fetching it from the crumb issuer if needed
+func (a *JenkinsAdapter) getCrumb(ctx context.Context, operation string) (*crumb, error) {
+	a.crumbMutex.Lock()
+	defer a.crumbMutex.Unlock()
+
+	if a.crumb != nil {
+		return a.crumb, nil
+	}
+
+	_, status, body, err := a.send(ctx, operation, http.MethodGet, "/crumbIssuer/api/json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &crumb{}
+	switch {
+	case status == http.StatusNotFound:
+		// CSRF protection is disabled on this instance
+	case status >= http.StatusBadRequest:
+		return nil, adapterErrors.FromHTTPStatus(adapterType, operation, status,
+			fmt.Errorf("failed to get CSRF crumb: %s", errorMessage(body)), nil)
+	default:
+		if err := json.Unmarshal(body, c); err != nil {
+			return nil, adapterErrors.NewUnknownError(adapterType, operation,
+				fmt.Errorf("failed to decode CSRF crumb: %w", err), nil)
+		}
+	}
+
+	a.crumb = c
+	return c, nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *JenkinsAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// emitBuildEvent emits a build progress event for the given phase
+func (a *JenkinsAdapter) emitBuildEvent(ctx context.Context, contextID string, eventType events.EventType, phase, jobName string, payload interface{}, metadata map[string]interface{}) {
+	if a.eventBus == nil {
+		return
+	}
+
+	event := events.NewAdapterEvent(adapterType, eventType, payload).
+		WithMetadata("operation", buildOperation).
+		WithMetadata("contextId", contextID).
+		WithMetadata("job", jobName).
+		WithMetadata("phase", phase)
+	for key, value := range metadata {
+		event.WithMetadata(key, value)
+	}
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// errorMessage extracts a readable message from a Jenkins error response
+func errorMessage(body []byte) string {
+	message := strings.TrimSpace(string(body))
+	if len(message) > 200 {
+		message = message[:200] + "..."
+	}
+	return message
+}
+
+// jobStatus converts a Jenkins ball color into a status
+func jobStatus(color string) string {
+	status := strings.TrimSuffix(color, "_anime")
+	switch status {
+	case "blue":
+		status = "success"
+	case "red":
+		status = "failure"
+	case "yellow":
+		status = "unstable"
+	case "aborted":
+		status = "aborted"
+	case "notbuilt", "":
+		status = "not_built"
+	case "disabled", "grey":
+		status = "disabled"
+	}
+	if strings.HasSuffix(color, "_anime") {
+		status += " (building)"
+	}
+	return status
+}
+
+func toBuild(jobName string, b build) Build {
+	result := Build{
+		Job:       jobName,
+		Number:    b.Number,
+		Name:      b.FullDisplayName,
+		Result:    b.Result,
+		Building:  b.Building,
+		Duration:  time.Duration(b.Duration) * time.Millisecond,
+		Estimated: time.Duration(b.EstimatedDuration) * time.Millisecond,
+		QueueID:   b.QueueID,
+		URL:       b.URL,
+		Succeeded: b.Result == "SUCCESS",
+	}
+	if b.Timestamp > 0 {
+		result.StartedAt = time.UnixMilli(b.Timestamp).UTC()
+	}
+	for _, action := range b.Actions {
+		for _, parameter := range action.Parameters {
+			if result.Parameters == nil {
+				result.Parameters = map[string]interface{}{}
+			}
+			result.Parameters[parameter.Name] = parameter.Value
+		}
+		for _, cause := range action.Causes {
+			result.Causes = append(result.Causes, cause.ShortDescription)
+		}
+	}
+	return result
+}
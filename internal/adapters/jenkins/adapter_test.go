@@ -0,0 +1,453 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/jobs"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// eventRecorder records adapter events emitted during a test
+type eventRecorder struct {
+	mutex  sync.Mutex
+	events []*events.AdapterEvent
+}
+
+func (r *eventRecorder) Handle(ctx context.Context, event *events.AdapterEvent) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+// buildEvents returns the phases of the recorded build progress events
+func (r *eventRecorder) buildEvents() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var phases []string
+	for _, event := range r.events {
+		if event.Metadata["operation"] == buildOperation {
+			phases = append(phases, string(event.EventType)+":"+event.Metadata["phase"].(string))
+		}
+	}
+	return phases
+}
+
+// fakeJenkins is a minimal Jenkins server. The queue item becomes a build on
+// the second poll and the build completes on the second poll.
+type fakeJenkins struct {
+	mutex       sync.Mutex
+	crumb       string
+	queuePolls  int
+	buildPolls  int
+	buildResult string
+	form        map[string]string
+	posts       []string
+}
+
+func (f *fakeJenkins) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if user, token, ok := r.BasicAuth(); !ok || user != "ci-bot" || token != "api-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		if r.Header.Get("Jenkins-Crumb") != f.crumb {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("No valid crumb was included in the request"))
+			return
+		}
+		f.posts = append(f.posts, r.URL.Path)
+	}
+
+	switch r.URL.Path {
+	case "/crumbIssuer/api/json":
+		w.Write([]byte(`{"crumb":"` + f.crumb + `","crumbRequestField":"Jenkins-Crumb"}`))
+	case "/job/team/job/app/buildWithParameters":
+		r.ParseForm()
+		f.form = map[string]string{}
+		for key := range r.PostForm {
+			f.form[key] = r.PostForm.Get(key)
+		}
+		w.Header().Set("Location", "http://jenkins.example.com/queue/item/99/")
+		w.WriteHeader(http.StatusCreated)
+	case "/queue/item/99/api/json":
+		f.queuePolls++
+		if f.queuePolls < 2 {
+			w.Write([]byte(`{"id":99,"why":"Waiting for next available executor","task":{"name":"app"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":99,"task":{"name":"app"},"executable":{"number":12,"url":"http://jenkins.example.com/job/team/job/app/12/"}}`))
+	case "/job/team/job/app/12/api/json":
+		f.buildPolls++
+		if f.buildPolls < 2 {
+			w.Write([]byte(`{"number":12,"building":true,"result":null}`))
+			return
+		}
+		w.Write([]byte(`{"number":12,"building":false,"result":"` + f.buildResult + `","timestamp":1700000000000,"duration":65000,
+			"actions":[{"_class":"hudson.model.ParametersAction","parameters":[{"name":"ENV","value":"staging"}]},{"causes":[{"shortDescription":"Started by user ci-bot"}]}]}`))
+	case "/job/team/job/app/12/logText/progressiveText":
+		text := "Started by user ci-bot\nBuilding...\n"
+		start := r.URL.Query().Get("start")
+		if start == "23" {
+			text = "Building...\n"
+		}
+		w.Header().Set("X-Text-Size", "35")
+		w.Write([]byte(text))
+	case "/job/team/job/app/12/testReport/api/json":
+		w.Write([]byte(`{"duration":3.5,"passCount":8,"failCount":2,"skipCount":1,"suites":[{"name":"unit","cases":[
+			{"className":"app.LoginTest","name":"testSafari","status":"FAILED","errorDetails":"expected 200"},
+			{"className":"app.LoginTest","name":"testChrome","status":"PASSED"},
+			{"className":"app.LogoutTest","name":"testLogout","status":"REGRESSION","errorDetails":"timeout"}]}]}`))
+	case "/job/team/job/app/lastFailedBuild/stop":
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("Not Found"))
+	}
+}
+
+func newTestAdapter(t *testing.T, jenkins *fakeJenkins) (*JenkinsAdapter, *eventRecorder) {
+	server := httptest.NewServer(jenkins)
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.BaseURL = server.URL
+	config.Username = "ci-bot"
+	config.APIToken = "api-token"
+	config.QueuePollInterval = time.Millisecond
+	config.BuildPollInterval = time.Millisecond
+	config.MaxTestFailures = 1
+
+	logger := observability.NewLogger("jenkins_test")
+	eventBus := events.NewEventBus(logger)
+	recorder := &eventRecorder{}
+	eventBus.SubscribeAll(recorder)
+
+	adapter, err := New(config, logger, observability.NewMetricsClient(), eventBus)
+	require.NoError(t, err)
+
+	return adapter, recorder
+}
+
+func TestTriggerBuild(t *testing.T) {
+	tests := []struct {
+		name           string
+		buildResult    string
+		expectedEvents []string
+	}{
+		{
+			name:        "successful build",
+			buildResult: "SUCCESS",
+			expectedEvents: []string{
+				"operation.starting:queued",
+				"operation.starting:started",
+				"operation.success:completed",
+			},
+		},
+		{
+			name:        "failed build",
+			buildResult: "FAILURE",
+			expectedEvents: []string{
+				"operation.starting:queued",
+				"operation.starting:started",
+				"operation.failure:completed",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jenkins := &fakeJenkins{crumb: "abc123", buildResult: test.buildResult}
+			adapter, recorder := newTestAdapter(t, jenkins)
+
+			var progress []string
+			ctx := jobs.WithProgressReporter(context.Background(), func(percent int, message string) {
+				progress = append(progress, fmt.Sprintf("%d %s", percent, message))
+			})
+
+			result, err := adapter.ExecuteAction(ctx, "ctx-1", "trigger_build", map[string]interface{}{
+				"job":                 "team/app",
+				"parameters":          map[string]interface{}{"ENV": "staging", "DRY_RUN": true},
+				"wait_for_completion": true,
+			})
+			require.NoError(t, err)
+
+			triggered := result.(*TriggerResult)
+			assert.Equal(t, 99, triggered.QueueID)
+			assert.Equal(t, 12, triggered.BuildNumber)
+			require.NotNil(t, triggered.Build)
+			assert.Equal(t, test.buildResult, triggered.Build.Result)
+			assert.Equal(t, test.buildResult == "SUCCESS", triggered.Build.Succeeded)
+			assert.Equal(t, 65*time.Second, triggered.Build.Duration)
+			assert.Equal(t, "staging", triggered.Build.Parameters["ENV"])
+			assert.Equal(t, []string{"Started by user ci-bot"}, triggered.Build.Causes)
+
+			assert.Equal(t, map[string]string{"ENV": "staging", "DRY_RUN": "true"}, jenkins.form)
+			assert.Equal(t, 2, jenkins.queuePolls)
+			assert.Equal(t, test.expectedEvents, recorder.buildEvents())
+			assert.Equal(t, []string{
+				"0 Build of team/app queued",
+				"0 Build team/app #12 started",
+				"0 Build team/app #12 running",
+			}, progress)
+		})
+	}
+}
+
+func TestBuildProgress(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		build    Build
+		expected int
+	}{
+		{"no estimate", Build{StartedAt: now.Add(-time.Minute)}, 0},
+		{"not started", Build{Estimated: time.Minute}, 0},
+		{"half way", Build{StartedAt: now.Add(-30 * time.Second), Estimated: time.Minute}, 50},
+		{"over the estimate", Build{StartedAt: now.Add(-2 * time.Minute), Estimated: time.Minute}, 99},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildProgress(&tt.build, now))
+		})
+	}
+}
+
+func TestTriggerBuildWithoutWaiting(t *testing.T) {
+	jenkins := &fakeJenkins{crumb: "abc123"}
+	adapter, _ := newTestAdapter(t, jenkins)
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "trigger_build", map[string]interface{}{
+		"job":            "team/app",
+		"parameters":     map[string]interface{}{"ENV": "staging"},
+		"wait_for_start": false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 99, result.(*TriggerResult).QueueID)
+	assert.Zero(t, result.(*TriggerResult).BuildNumber)
+	assert.Zero(t, jenkins.queuePolls)
+}
+
+func TestCrumbRefresh(t *testing.T) {
+	jenkins := &fakeJenkins{crumb: "abc123"}
+	adapter, _ := newTestAdapter(t, jenkins)
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "stop_build", map[string]interface{}{
+		"job":   "team/app",
+		"build": "lastFailedBuild",
+	})
+	require.NoError(t, err)
+
+	// An expired crumb is refreshed once and the request retried
+	jenkins.crumb = "rotated"
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "stop_build", map[string]interface{}{
+		"job":   "team/app",
+		"build": "lastFailedBuild",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "lastFailedBuild", result.(map[string]interface{})["build"])
+	assert.Equal(t, "rotated", adapter.crumb.Crumb)
+	assert.Len(t, jenkins.posts, 2)
+}
+
+func TestConsoleOutput(t *testing.T) {
+	adapter, _ := newTestAdapter(t, &fakeJenkins{crumb: "abc123"})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_console_output", map[string]interface{}{
+		"job":          "team/app",
+		"build_number": 12,
+	})
+	require.NoError(t, err)
+
+	output := result.(ConsoleOutput)
+	assert.Equal(t, "Started by user ci-bot\nBuilding...\n", output.Text)
+	assert.Equal(t, int64(35), output.NextStart)
+	assert.False(t, output.MoreData)
+
+	// Output larger than MaxConsoleBytes continues from where it was cut
+	adapter.config.MaxConsoleBytes = 23
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_console_output", map[string]interface{}{
+		"job":          "team/app",
+		"build_number": 12,
+	})
+	require.NoError(t, err)
+	output = result.(ConsoleOutput)
+	assert.Equal(t, "Started by user ci-bot\n", output.Text)
+	assert.Equal(t, int64(23), output.NextStart)
+	assert.True(t, output.MoreData)
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_console_output", map[string]interface{}{
+		"job":          "team/app",
+		"build_number": 12,
+		"start":        output.NextStart,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Building...\n", result.(ConsoleOutput).Text)
+}
+
+func TestTestReport(t *testing.T) {
+	adapter, _ := newTestAdapter(t, &fakeJenkins{crumb: "abc123"})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_test_report", map[string]interface{}{
+		"job":          "team/app",
+		"build_number": 12,
+	})
+	require.NoError(t, err)
+
+	report := result.(TestReport)
+	assert.Equal(t, 11, report.Total)
+	assert.Equal(t, 2, report.Failed)
+	require.Len(t, report.Failures, 1)
+	assert.Equal(t, "testSafari", report.Failures[0].Name)
+	assert.Equal(t, "expected 200", report.Failures[0].ErrorDetails)
+	assert.True(t, report.Truncated)
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, &fakeJenkins{crumb: "crumb-1"})
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 9)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	trigger, _ := core.FindAction(actions, "trigger_build")
+	assert.Equal(t, core.SafetyWrite, trigger.Safety)
+	assert.NoError(t, trigger.ValidateParams(map[string]interface{}{"job": "team/app", "parameters": map[string]interface{}{"ENV": "staging"}, "wait_for_completion": true}))
+	assert.Error(t, trigger.ValidateParams(map[string]interface{}{"job": "team/app", "parameters": "ENV=staging"}))
+
+	stop, _ := core.FindAction(actions, "stop_build")
+	assert.Equal(t, core.SafetyDestructive, stop.Safety)
+	assert.NoError(t, stop.ValidateParams(map[string]interface{}{"job": "team/app", "build": "lastFailedBuild"}))
+	assert.Error(t, stop.ValidateParams(map[string]interface{}{"job": "team/app", "build": "nextBuild"}))
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, &fakeJenkins{crumb: "abc123"})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_build", map[string]interface{}{
+		"job":          "team/missing",
+		"build_number": 1,
+	})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_build", map[string]interface{}{
+		"job":   "team/app",
+		"build": "../../script",
+	})
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "trigger_build", nil)
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "delete_job", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+
+	adapter.config.APIToken = "wrong"
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_build", map[string]interface{}{
+		"job":          "team/app",
+		"build_number": 12,
+	})
+	assert.True(t, adapterErrors.IsAuthorizationError(err))
+}
+
+const completedHook = `{
+	"name": "app",
+	"display_name": "app",
+	"url": "job/team/job/app/",
+	"build": {
+		"full_url": "http://jenkins.example.com/job/team/job/app/12/",
+		"number": 12,
+		"queue_id": 99,
+		"phase": "COMPLETED",
+		"status": "FAILURE",
+		"url": "job/team/job/app/12/",
+		"scm": {"url": "https://github.com/example/app.git", "branch": "origin/main", "commit": "a1b2c3"},
+		"parameters": {"ENV": "staging"}
+	}
+}`
+
+func TestReceiveWebhook(t *testing.T) {
+	adapter, recorder := newTestAdapter(t, &fakeJenkins{crumb: "abc123"})
+	adapter.config.WebhookToken = "s3cret"
+
+	tests := []struct {
+		name       string
+		token      string
+		payload    string
+		expectCode string
+	}{
+		{"valid token", "s3cret", completedHook, ""},
+		{"invalid token", "wrong", completedHook, adapterErrors.ErrCodeUnauthorized},
+		{"missing phase", "s3cret", `{"name":"app"}`, adapterErrors.ErrCodeInvalidRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload, err := adapter.ReceiveWebhook(nil, url.Values{WebhookTokenParam: {test.token}}, []byte(test.payload))
+			if err == nil {
+				err = adapter.HandleWebhook(context.Background(), "", payload)
+			}
+			if test.expectCode == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, adapterErrors.IsSpecificErrorCode(err, test.expectCode), "%v", err)
+		})
+	}
+
+	require.Len(t, recorder.events, 1)
+	event := recorder.events[0]
+	assert.Equal(t, events.EventTypeWebhookReceived, event.EventType)
+	assert.Equal(t, "build.completed", event.Metadata["eventType"])
+	assert.Equal(t, "team/app", event.Metadata["job"])
+	assert.Equal(t, "FAILURE", event.Metadata["status"])
+
+	webhookEvent := event.Payload.(*WebhookEvent)
+	assert.Equal(t, 12, webhookEvent.Number)
+	assert.Equal(t, "a1b2c3", webhookEvent.SCM.Commit)
+	assert.Equal(t, "http://jenkins.example.com/job/team/job/app/12/", webhookEvent.URL)
+
+	adapter.config.WebhookToken = ""
+	_, err := adapter.ReceiveWebhook(nil, url.Values{WebhookTokenParam: {""}}, []byte(completedHook))
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized), "deliveries are rejected when no token is configured")
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.WebhookToken = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("jenkins_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"build-completed.json": "build.completed",
+		"build-started.json":   "build.started",
+	})
+}
@@ -0,0 +1,52 @@
+package jenkins
+
+import (
+	"time"
+)
+
+// Config holds configuration for the Jenkins adapter
+type Config struct {
+	// Connection settings. BaseURL is the root of the Jenkins instance, e.g. https://jenkins.example.com
+	BaseURL        string        `mapstructure:"base_url"`
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// Authentication settings. Requests use basic auth with the user's API token.
+	Username string `mapstructure:"username"`
+	APIToken string `mapstructure:"api_token"`
+
+	// DisableCrumb skips fetching a CSRF crumb before POST requests
+	DisableCrumb bool `mapstructure:"disable_crumb"`
+
+	// Polling settings for queue items and running builds
+	QueuePollInterval time.Duration `mapstructure:"queue_poll_interval"`
+	QueueTimeout      time.Duration `mapstructure:"queue_timeout"`
+	BuildPollInterval time.Duration `mapstructure:"build_poll_interval"`
+	BuildTimeout      time.Duration `mapstructure:"build_timeout"`
+
+	// Token expected in the token query parameter of notification plugin webhooks
+	WebhookToken string `mapstructure:"webhook_token"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+
+	// Query settings
+	DefaultBuildLimit int `mapstructure:"default_build_limit"`
+	MaxConsoleBytes   int `mapstructure:"max_console_bytes"`
+	MaxTestFailures   int `mapstructure:"max_test_failures"`
+}
+
+// DefaultConfig returns a default configuration for the Jenkins adapter
+func DefaultConfig() *Config {
+	return &Config{
+		RequestTimeout:    30 * time.Second,
+		QueuePollInterval: 2 * time.Second,
+		QueueTimeout:      5 * time.Minute,
+		BuildPollInterval: 10 * time.Second,
+		BuildTimeout:      60 * time.Minute,
+		MockURL:           "http://localhost:8081/mock-jenkins",
+		DefaultBuildLimit: 20,
+		MaxConsoleBytes:   256 * 1024,
+		MaxTestFailures:   50,
+	}
+}
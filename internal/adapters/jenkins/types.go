@@ -0,0 +1,194 @@
+package jenkins
+
+import (
+	"time"
+)
+
+// The types below are the subset of the Jenkins JSON API objects used by the adapter
+
+type crumb struct {
+	Crumb             string `json:"crumb"`
+	CrumbRequestField string `json:"crumbRequestField"`
+}
+
+type queueItem struct {
+	ID           int    `json:"id"`
+	Blocked      bool   `json:"blocked"`
+	Buildable    bool   `json:"buildable"`
+	Stuck        bool   `json:"stuck"`
+	Cancelled    bool   `json:"cancelled"`
+	Why          string `json:"why"`
+	InQueueSince int64  `json:"inQueueSince"`
+	Task         struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"task"`
+	Executable *struct {
+		Number int    `json:"number"`
+		URL    string `json:"url"`
+	} `json:"executable"`
+}
+
+type build struct {
+	Number            int    `json:"number"`
+	FullDisplayName   string `json:"fullDisplayName"`
+	Result            string `json:"result"`
+	Building          bool   `json:"building"`
+	Timestamp         int64  `json:"timestamp"`
+	Duration          int64  `json:"duration"`
+	EstimatedDuration int64  `json:"estimatedDuration"`
+	QueueID           int    `json:"queueId"`
+	URL               string `json:"url"`
+	Actions           []struct {
+		Class      string `json:"_class"`
+		Parameters []struct {
+			Name  string      `json:"name"`
+			Value interface{} `json:"value"`
+		} `json:"parameters"`
+		Causes []struct {
+			ShortDescription string `json:"shortDescription"`
+		} `json:"causes"`
+	} `json:"actions"`
+}
+
+type job struct {
+	Name            string  `json:"name"`
+	FullName        string  `json:"fullName"`
+	Description     string  `json:"description"`
+	URL             string  `json:"url"`
+	Buildable       bool    `json:"buildable"`
+	Color           string  `json:"color"`
+	InQueue         bool    `json:"inQueue"`
+	LastBuild       *build  `json:"lastBuild"`
+	LastSuccessful  *build  `json:"lastSuccessfulBuild"`
+	LastFailedBuild *build  `json:"lastFailedBuild"`
+	Builds          []build `json:"builds"`
+	Property        []struct {
+		ParameterDefinitions []struct {
+			Name                  string `json:"name"`
+			Type                  string `json:"type"`
+			Description           string `json:"description"`
+			DefaultParameterValue *struct {
+				Value interface{} `json:"value"`
+			} `json:"defaultParameterValue"`
+			Choices []string `json:"choices"`
+		} `json:"parameterDefinitions"`
+	} `json:"property"`
+}
+
+type testReport struct {
+	Duration  float64 `json:"duration"`
+	PassCount int     `json:"passCount"`
+	FailCount int     `json:"failCount"`
+	SkipCount int     `json:"skipCount"`
+	// Matrix and pipeline builds report totals instead of counts
+	TotalCount int `json:"totalCount"`
+	Suites     []struct {
+		Name  string `json:"name"`
+		Cases []struct {
+			ClassName       string  `json:"className"`
+			Name            string  `json:"name"`
+			Status          string  `json:"status"`
+			Duration        float64 `json:"duration"`
+			ErrorDetails    string  `json:"errorDetails"`
+			ErrorStackTrace string  `json:"errorStackTrace"`
+		} `json:"cases"`
+	} `json:"suites"`
+}
+
+// QueueItem is a summary of a Jenkins queue item
+type QueueItem struct {
+	ID          int    `json:"id"`
+	Job         string `json:"job"`
+	Why         string `json:"why,omitempty"`
+	Blocked     bool   `json:"blocked"`
+	Stuck       bool   `json:"stuck"`
+	Cancelled   bool   `json:"cancelled"`
+	BuildNumber int    `json:"build_number,omitempty"`
+	BuildURL    string `json:"build_url,omitempty"`
+}
+
+// Build is a summary of a Jenkins build
+type Build struct {
+	Job        string                 `json:"job"`
+	Number     int                    `json:"number"`
+	Name       string                 `json:"name,omitempty"`
+	Result     string                 `json:"result,omitempty"`
+	Building   bool                   `json:"building"`
+	StartedAt  time.Time              `json:"started_at"`
+	Duration   time.Duration          `json:"duration"`
+	Estimated  time.Duration          `json:"estimated_duration,omitempty"`
+	QueueID    int                    `json:"queue_id,omitempty"`
+	URL        string                 `json:"url"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Causes     []string               `json:"causes,omitempty"`
+	Succeeded  bool                   `json:"succeeded"`
+}
+
+// JobParameter describes a parameter accepted by a parameterized job
+type JobParameter struct {
+	Name        string      `json:"name"`
+	Type        string      `json:"type"`
+	Description string      `json:"description,omitempty"`
+	Default     interface{} `json:"default,omitempty"`
+	Choices     []string    `json:"choices,omitempty"`
+}
+
+// Job is a summary of a Jenkins job
+type Job struct {
+	Name                string         `json:"name"`
+	FullName            string         `json:"full_name"`
+	Description         string         `json:"description,omitempty"`
+	URL                 string         `json:"url"`
+	Buildable           bool           `json:"buildable"`
+	InQueue             bool           `json:"in_queue"`
+	Status              string         `json:"status"`
+	LastBuild           int            `json:"last_build,omitempty"`
+	LastSuccessfulBuild int            `json:"last_successful_build,omitempty"`
+	LastFailedBuild     int            `json:"last_failed_build,omitempty"`
+	Parameters          []JobParameter `json:"parameters,omitempty"`
+}
+
+// TestCase is a failed test case from a build test report
+type TestCase struct {
+	Suite        string  `json:"suite,omitempty"`
+	ClassName    string  `json:"class_name"`
+	Name         string  `json:"name"`
+	Status       string  `json:"status"`
+	Duration     float64 `json:"duration"`
+	ErrorDetails string  `json:"error_details,omitempty"`
+}
+
+// TestReport is a summary of a build test report
+type TestReport struct {
+	Job       string     `json:"job"`
+	Number    int        `json:"number"`
+	Total     int        `json:"total"`
+	Passed    int        `json:"passed"`
+	Failed    int        `json:"failed"`
+	Skipped   int        `json:"skipped"`
+	Duration  float64    `json:"duration"`
+	Failures  []TestCase `json:"failures"`
+	Truncated bool       `json:"truncated"`
+}
+
+// ConsoleOutput is a chunk of progressive console output. Pass NextStart as
+// the start parameter of the next request to continue reading.
+type ConsoleOutput struct {
+	Job       string `json:"job"`
+	Number    int    `json:"number"`
+	Text      string `json:"text"`
+	Start     int64  `json:"start"`
+	NextStart int64  `json:"next_start"`
+	MoreData  bool   `json:"more_data"`
+}
+
+// TriggerResult is the result of triggering a build. BuildNumber is set once
+// the queue item has left the queue, and Build once the build has completed.
+type TriggerResult struct {
+	Job         string `json:"job"`
+	QueueID     int    `json:"queue_id"`
+	BuildNumber int    `json:"build_number,omitempty"`
+	BuildURL    string `json:"build_url,omitempty"`
+	Build       *Build `json:"build,omitempty"`
+}
@@ -0,0 +1,126 @@
+package jenkins
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// WebhookTokenParam is the query parameter carrying the webhook token. The
+// notification plugin cannot sign requests, so the token is configured as part
+// of the endpoint URL, e.g. https://mcp.example.com/webhooks/jenkins?token=...
+const WebhookTokenParam = "token"
+
+// WebhookEvent is the normalized summary of a notification plugin webhook
+type WebhookEvent struct {
+	// EventType is build.<phase>, e.g. build.completed
+	EventType string `json:"event_type"`
+	// Job is the full job name, including folders
+	Job         string                 `json:"job"`
+	DisplayName string                 `json:"display_name,omitempty"`
+	Number      int                    `json:"number"`
+	QueueID     int                    `json:"queue_id,omitempty"`
+	Phase       string                 `json:"phase"`
+	Status      string                 `json:"status,omitempty"`
+	URL         string                 `json:"url,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+	SCM         *WebhookSCM            `json:"scm,omitempty"`
+	Log         string                 `json:"log,omitempty"`
+}
+
+// WebhookSCM is the source revision reported by the notification plugin
+type WebhookSCM struct {
+	URL    string `json:"url,omitempty"`
+	Branch string `json:"branch,omitempty"`
+	Commit string `json:"commit,omitempty"`
+}
+
+// webhookPayload is the notification plugin JSON format
+type webhookPayload struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	URL         string `json:"url"`
+	Build       *struct {
+		FullURL    string                 `json:"full_url"`
+		Number     int                    `json:"number"`
+		QueueID    int                    `json:"queue_id"`
+		Phase      string                 `json:"phase"`
+		Status     string                 `json:"status"`
+		URL        string                 `json:"url"`
+		SCM        *WebhookSCM            `json:"scm"`
+		Parameters map[string]interface{} `json:"parameters"`
+		Log        string                 `json:"log"`
+	} `json:"build"`
+}
+
+// VerifyWebhookToken checks the webhook token against the configured token.
+// If no token is configured every request is rejected.
+func (a *JenkinsAdapter) VerifyWebhookToken(token string) bool {
+	if a.config.WebhookToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(a.config.WebhookToken)) == 1
+}
+
+// ReceiveWebhook implements core.WebhookReceiver by checking the token query
+// parameter of the endpoint URL
+func (a *JenkinsAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifyWebhookToken(query.Get(WebhookTokenParam)) {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid webhook token"), nil)
+	}
+	return body, nil
+}
+
+// ParseWebhook decodes a notification plugin payload into a normalized event
+func ParseWebhook(payload []byte) (*WebhookEvent, error) {
+	var body webhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse Jenkins webhook payload: %w", err)
+	}
+	if body.Build == nil || body.Build.Phase == "" {
+		return nil, fmt.Errorf("Jenkins webhook payload is missing build phase")
+	}
+
+	phase := strings.ToLower(body.Build.Phase)
+	event := &WebhookEvent{
+		EventType:   "build." + phase,
+		Job:         jobNameFromURL(body.URL),
+		DisplayName: body.DisplayName,
+		Number:      body.Build.Number,
+		QueueID:     body.Build.QueueID,
+		Phase:       phase,
+		Status:      body.Build.Status,
+		URL:         body.Build.FullURL,
+		Parameters:  body.Build.Parameters,
+		SCM:         body.Build.SCM,
+		Log:         body.Build.Log,
+	}
+	if event.Job == "" {
+		event.Job = body.Name
+	}
+	if event.URL == "" {
+		event.URL = body.Build.URL
+	}
+
+	return event, nil
+}
+
+// jobNameFromURL converts a relative job URL such as job/folder/job/app/ into
+// the full job name folder/app
+func jobNameFromURL(jobURL string) string {
+	var segments []string
+	parts := strings.Split(strings.Trim(jobURL, "/"), "/")
+	for i := 0; i+1 < len(parts); i += 2 {
+		if parts[i] != "job" {
+			return ""
+		}
+		segments = append(segments, parts[i+1])
+	}
+	return strings.Join(segments, "/")
+}
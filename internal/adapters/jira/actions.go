@@ -0,0 +1,201 @@
+package jira
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// issueKeyParam is the schema of the key identifying an issue
+var issueKeyParam = map[string]interface{}{
+	"type":        "string",
+	"description": "Issue key, such as OPS-123",
+	"minLength":   1,
+}
+
+// fieldsParam is the schema of the custom fields to return. Fields may be
+// given by ID or by name.
+var fieldsParam = stringOrList("Custom fields to return, by ID or name")
+
+// fieldValuesParam is the schema of the field values to set. Fields may be
+// given by ID or by name.
+var fieldValuesParam = map[string]interface{}{
+	"type":        "object",
+	"description": "Field values to set, keyed by field ID or name",
+}
+
+// issueSchema is the schema of an issue result
+var issueSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"key":     map[string]interface{}{"type": "string"},
+		"summary": map[string]interface{}{"type": "string"},
+		"status":  map[string]interface{}{"type": "string"},
+		"url":     map[string]interface{}{"type": "string"},
+	},
+}
+
+// stringOrList is the schema of params taking a string or a list of strings
+func stringOrList(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        []interface{}{"string", "array"},
+		"description": description,
+		"items":       map[string]interface{}{"type": "string"},
+	}
+}
+
+// Actions returns the descriptors of the supported Jira actions
+func (a *JiraAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{
+		{
+			Name:        "search_issues",
+			Description: "Search issues with JQL",
+			Params: paramsSchema([]string{"jql"}, map[string]interface{}{
+				"jql": map[string]interface{}{
+					"type":        "string",
+					"description": "JQL query, such as project = OPS AND status = Open",
+					"minLength":   1,
+				},
+				"fields": fieldsParam,
+				"max_results": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum number of issues to return",
+					"minimum":     1,
+				},
+				"start_at": map[string]interface{}{
+					"type":        "integer",
+					"description": "Index of the first issue to return",
+					"minimum":     0,
+				},
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"read:jira-work"},
+		},
+		{
+			Name:        "get_issue",
+			Description: "Get an issue",
+			Params: paramsSchema([]string{"issue_key"}, map[string]interface{}{
+				"issue_key": issueKeyParam,
+				"fields":    fieldsParam,
+			}),
+			Result: issueSchema,
+			Safety: core.SafetyRead,
+			Scopes: []string{"read:jira-work"},
+		},
+		{
+			Name:        "create_issue",
+			Description: "Create an issue, linked back to the requesting context",
+			Params: paramsSchema([]string{"project", "summary"}, map[string]interface{}{
+				"project": map[string]interface{}{
+					"type":        "string",
+					"description": "Project key, such as OPS",
+					"minLength":   1,
+				},
+				"summary": map[string]interface{}{
+					"type":        "string",
+					"description": "Issue summary",
+					"minLength":   1,
+				},
+				"issue_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Issue type name. Defaults to the configured issue type",
+				},
+				"description": map[string]interface{}{
+					"type":        "string",
+					"description": "Issue description",
+				},
+				"labels":   stringOrList("Labels to set"),
+				"priority": map[string]interface{}{"type": "string", "description": "Priority name"},
+				"assignee": map[string]interface{}{"type": "string", "description": "Account ID of the assignee"},
+				"fields":   fieldValuesParam,
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"write:jira-work"},
+		},
+		{
+			Name:        "get_transitions",
+			Description: "List the workflow transitions available on an issue",
+			Params: paramsSchema([]string{"issue_key"}, map[string]interface{}{
+				"issue_key": issueKeyParam,
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"read:jira-work"},
+		},
+		{
+			Name:        "transition_issue",
+			Description: "Move an issue through its workflow",
+			Params: paramsSchema([]string{"issue_key", "transition"}, map[string]interface{}{
+				"issue_key": issueKeyParam,
+				"transition": map[string]interface{}{
+					"type":        "string",
+					"description": "Transition ID or name, or the name of the target status",
+					"minLength":   1,
+				},
+				"fields": fieldValuesParam,
+				"comment": map[string]interface{}{
+					"type":        "string",
+					"description": "Comment to add with the transition",
+				},
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"write:jira-work"},
+		},
+		{
+			Name:        "add_comment",
+			Description: "Comment on an issue",
+			Params: paramsSchema([]string{"issue_key", "body"}, map[string]interface{}{
+				"issue_key": issueKeyParam,
+				"body": map[string]interface{}{
+					"type":        "string",
+					"description": "Comment text",
+					"minLength":   1,
+				},
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"write:jira-work"},
+		},
+		{
+			Name:        "link_issues",
+			Description: "Link two issues",
+			Params: paramsSchema([]string{"inward_issue", "outward_issue"}, map[string]interface{}{
+				"inward_issue":  issueKeyParam,
+				"outward_issue": issueKeyParam,
+				"link_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Link type name. Defaults to Relates",
+				},
+				"comment": map[string]interface{}{
+					"type":        "string",
+					"description": "Comment to add with the link",
+				},
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"write:jira-work"},
+		},
+		{
+			Name:        "list_fields",
+			Description: "List the issue fields, including custom fields",
+			Params:      paramsSchema(nil),
+			Safety:      core.SafetyRead,
+			Scopes:      []string{"read:jira-work"},
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,836 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Jira adapter
+const adapterType = "jira"
+
+// apiPath is the REST API v2 prefix, which Jira Cloud and Data Center both serve
+const apiPath = "/rest/api/2"
+
+// systemFields are the fields requested for every issue
+var systemFields = []string{
+	"summary", "description", "status", "issuetype", "project", "priority",
+	"resolution", "assignee", "reporter", "labels", "created", "updated",
+}
+
+// JiraAdapter provides an adapter for Jira Cloud and Data Center issues
+type JiraAdapter struct {
+	config        *Config
+	baseURL       string
+	client        *http.Client
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+
+	// Field metadata used to resolve custom fields by name, loaded on first use
+	fieldMutex   sync.RWMutex
+	fieldsByID   map[string]field
+	fieldsByName map[string][]string
+
+	// issueContexts maps the keys of issues created by the adapter to the
+	// context they were created from
+	contextMutex  sync.RWMutex
+	issueContexts map[string]string
+}
+
+// New creates a new Jira adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*JiraAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("jira_adapter")
+	}
+
+	baseURL := config.BaseURL
+	if config.MockResponses {
+		baseURL = config.MockURL
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("jira base URL is required")
+	}
+
+	switch config.Deployment {
+	case "":
+		config.Deployment = DeploymentCloud
+	case DeploymentCloud, DeploymentDataCenter:
+	default:
+		return nil, fmt.Errorf("unsupported Jira deployment: %s", config.Deployment)
+	}
+
+	return &JiraAdapter{
+		config:        config,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		client:        &http.Client{Timeout: config.RequestTimeout},
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+		issueContexts: make(map[string]string),
+	}, nil
+}
+
+// Type returns the adapter type
+func (a *JiraAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *JiraAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status
+func (a *JiraAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := a.doRequest(ctx, "health", http.MethodGet, apiPath+"/serverInfo", nil, nil, nil); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+
+	if a.config.MockResponses {
+		return "healthy (mock)"
+	}
+	return "healthy"
+}
+
+// IssueContextID returns the ID of the context an issue was created from, for
+// issues created by this adapter
+func (a *JiraAdapter) IssueContextID(issueKey string) (string, bool) {
+	a.contextMutex.RLock()
+	defer a.contextMutex.RUnlock()
+	contextID, ok := a.issueContexts[issueKey]
+	return contextID, ok
+}
+
+// ExecuteAction executes a Jira action
+func (a *JiraAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing Jira action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	// Issues
+	case "search_issues":
+		result, err = a.searchIssues(ctx, params)
+	case "get_issue":
+		result, err = a.getIssue(ctx, params)
+	case "create_issue":
+		result, err = a.createIssue(ctx, contextID, params)
+
+	// Workflow
+	case "get_transitions":
+		result, err = a.getTransitions(ctx, params)
+	case "transition_issue":
+		result, err = a.transitionIssue(ctx, params)
+
+	// Comments and links
+	case "add_comment":
+		result, err = a.addComment(ctx, params)
+	case "link_issues":
+		result, err = a.linkIssues(ctx, params)
+
+	// Metadata
+	case "list_fields":
+		result, err = a.listFields(ctx)
+
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported Jira action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// searchIssues searches issues with JQL. Custom fields listed in fields may be
+// given by name and are returned keyed by name.
+func (a *JiraAdapter) searchIssues(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	jql, err := core.RequiredStringParam(params, "jql")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "search_issues", err, nil)
+	}
+
+	customFields, err := a.resolveFieldNames(ctx, "search_issues", core.StringSliceParam(params, "fields"))
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults := core.IntParam(params, "max_results", a.config.DefaultMaxResults)
+	if maxResults <= 0 || maxResults > 100 {
+		maxResults = a.config.DefaultMaxResults
+	}
+
+	body := map[string]interface{}{
+		"jql":        jql,
+		"startAt":    core.IntParam(params, "start_at", 0),
+		"maxResults": maxResults,
+		"fields":     append(append([]string{}, systemFields...), fieldIDs(customFields)...),
+		"properties": []string{a.config.ContextPropertyKey},
+	}
+
+	var response struct {
+		Total      int     `json:"total"`
+		StartAt    int     `json:"startAt"`
+		MaxResults int     `json:"maxResults"`
+		Issues     []issue `json:"issues"`
+	}
+	if err := a.doRequest(ctx, "search_issues", http.MethodPost, apiPath+"/search", nil, body, &response); err != nil {
+		return nil, err
+	}
+
+	result := SearchResult{
+		Total:      response.Total,
+		StartAt:    response.StartAt,
+		MaxResults: response.MaxResults,
+		Issues:     make([]Issue, 0, len(response.Issues)),
+	}
+	for _, i := range response.Issues {
+		result.Issues = append(result.Issues, a.toIssue(i, customFields))
+	}
+
+	return result, nil
+}
+
+// getIssue gets an issue
+func (a *JiraAdapter) getIssue(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	issueKey, err := a.issueKey("get_issue", params)
+	if err != nil {
+		return nil, err
+	}
+
+	customFields, err := a.resolveFieldNames(ctx, "get_issue", core.StringSliceParam(params, "fields"))
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"fields":     {strings.Join(append(append([]string{}, systemFields...), fieldIDs(customFields)...), ",")},
+		"properties": {a.config.ContextPropertyKey},
+	}
+
+	var response issue
+	if err := a.doRequest(ctx, "get_issue", http.MethodGet, apiPath+"/issue/"+url.PathEscape(issueKey), query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return a.toIssue(response, customFields), nil
+}
+
+// createIssue creates an issue. The ID of the context the issue is created
+// from is recorded as an issue property so the ticket can be traced back to
+// the agent session that opened it.
+func (a *JiraAdapter) createIssue(ctx context.Context, contextID string, params map[string]interface{}) (interface{}, error) {
+	project, err := core.RequiredStringParam(params, "project")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_issue", err, nil)
+	}
+
+	summary, err := core.RequiredStringParam(params, "summary")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_issue", err, nil)
+	}
+
+	issueType := core.StringParam(params, "issue_type")
+	if issueType == "" {
+		issueType = "Task"
+	}
+
+	fields, err := a.resolveFields(ctx, "create_issue", core.MapParam(params, "fields"))
+	if err != nil {
+		return nil, err
+	}
+	fields["project"] = map[string]interface{}{"key": project}
+	fields["issuetype"] = map[string]interface{}{"name": issueType}
+	fields["summary"] = summary
+	if description := core.StringParam(params, "description"); description != "" {
+		fields["description"] = description
+	}
+	if labels := core.StringSliceParam(params, "labels"); len(labels) > 0 {
+		fields["labels"] = labels
+	}
+	if priority := core.StringParam(params, "priority"); priority != "" {
+		fields["priority"] = map[string]interface{}{"name": priority}
+	}
+	if assignee := core.StringParam(params, "assignee"); assignee != "" {
+		fields["assignee"] = a.userReference(assignee)
+	}
+
+	body := map[string]interface{}{"fields": fields}
+	if contextID != "" {
+		body["properties"] = []map[string]interface{}{{
+			"key": a.config.ContextPropertyKey,
+			"value": map[string]interface{}{
+				"contextId": contextID,
+				"source":    "mcp-server",
+				"createdAt": time.Now().UTC().Format(time.RFC3339),
+			},
+		}}
+	}
+
+	var response struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	}
+	if err := a.doRequest(ctx, "create_issue", http.MethodPost, apiPath+"/issue", nil, body, &response); err != nil {
+		return nil, err
+	}
+
+	if contextID != "" {
+		a.contextMutex.Lock()
+		a.issueContexts[response.Key] = contextID
+		a.contextMutex.Unlock()
+	}
+
+	return CreatedIssue{
+		ID:        response.ID,
+		Key:       response.Key,
+		URL:       a.browseURL(response.Key),
+		ContextID: contextID,
+	}, nil
+}
+
+// getTransitions lists the workflow transitions available for an issue
+func (a *JiraAdapter) getTransitions(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	issueKey, err := a.issueKey("get_transitions", params)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := a.fetchTransitions(ctx, "get_transitions", issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Transition, 0, len(transitions))
+	for _, t := range transitions {
+		result = append(result, Transition{ID: t.ID, Name: t.Name, ToStatus: t.To.Name})
+	}
+
+	return result, nil
+}
+
+// transitionIssue moves an issue through its workflow. The transition may be
+// given by ID, by name or by the name of the target status.
+func (a *JiraAdapter) transitionIssue(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	issueKey, err := a.issueKey("transition_issue", params)
+	if err != nil {
+		return nil, err
+	}
+
+	requested, err := core.RequiredStringParam(params, "transition")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "transition_issue", err, nil)
+	}
+
+	transitions, err := a.fetchTransitions(ctx, "transition_issue", issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected *transition
+	for i, t := range transitions {
+		if t.ID == requested || strings.EqualFold(t.Name, requested) || strings.EqualFold(t.To.Name, requested) {
+			selected = &transitions[i]
+			break
+		}
+	}
+	if selected == nil {
+		available := make([]string, 0, len(transitions))
+		for _, t := range transitions {
+			available = append(available, t.Name)
+		}
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "transition_issue",
+			fmt.Errorf("transition %q is not available for %s, available transitions: %s",
+				requested, issueKey, strings.Join(available, ", ")), nil)
+	}
+
+	body := map[string]interface{}{
+		"transition": map[string]interface{}{"id": selected.ID},
+	}
+	if fieldParams := core.MapParam(params, "fields"); len(fieldParams) > 0 {
+		fields, err := a.resolveFields(ctx, "transition_issue", fieldParams)
+		if err != nil {
+			return nil, err
+		}
+		body["fields"] = fields
+	}
+	if comment := core.StringParam(params, "comment"); comment != "" {
+		body["update"] = map[string]interface{}{
+			"comment": []interface{}{
+				map[string]interface{}{"add": map[string]interface{}{"body": comment}},
+			},
+		}
+	}
+
+	path := apiPath + "/issue/" + url.PathEscape(issueKey) + "/transitions"
+	if err := a.doRequest(ctx, "transition_issue", http.MethodPost, path, nil, body, nil); err != nil {
+		return nil, err
+	}
+
+	return TransitionResult{
+		Key:        issueKey,
+		Transition: selected.Name,
+		Status:     selected.To.Name,
+	}, nil
+}
+
+// addComment adds a comment to an issue
+func (a *JiraAdapter) addComment(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	issueKey, err := a.issueKey("add_comment", params)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := core.RequiredStringParam(params, "body")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "add_comment", err, nil)
+	}
+
+	var response comment
+	path := apiPath + "/issue/" + url.PathEscape(issueKey) + "/comment"
+	if err := a.doRequest(ctx, "add_comment", http.MethodPost, path, nil, map[string]interface{}{"body": text}, &response); err != nil {
+		return nil, err
+	}
+
+	return toComment(response), nil
+}
+
+// linkIssues links two issues, by default with the Relates link type
+func (a *JiraAdapter) linkIssues(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	inward, err := core.RequiredStringParam(params, "inward_issue")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "link_issues", err, nil)
+	}
+
+	outward, err := core.RequiredStringParam(params, "outward_issue")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "link_issues", err, nil)
+	}
+
+	linkType := core.StringParam(params, "link_type")
+	if linkType == "" {
+		linkType = "Relates"
+	}
+
+	body := map[string]interface{}{
+		"type":         map[string]interface{}{"name": linkType},
+		"inwardIssue":  map[string]interface{}{"key": inward},
+		"outwardIssue": map[string]interface{}{"key": outward},
+	}
+	if comment := core.StringParam(params, "comment"); comment != "" {
+		body["comment"] = map[string]interface{}{"body": comment}
+	}
+
+	if err := a.doRequest(ctx, "link_issues", http.MethodPost, apiPath+"/issueLink", nil, body, nil); err != nil {
+		return nil, err
+	}
+
+	return IssueLink{Type: linkType, InwardIssue: inward, OutwardIssue: outward}, nil
+}
+
+// listFields lists the system and custom fields of the site
+func (a *JiraAdapter) listFields(ctx context.Context) (interface{}, error) {
+	if err := a.loadFields(ctx, "list_fields", false); err != nil {
+		return nil, err
+	}
+
+	a.fieldMutex.RLock()
+	defer a.fieldMutex.RUnlock()
+
+	result := make([]Field, 0, len(a.fieldsByID))
+	for _, f := range a.fieldsByID {
+		entry := Field{ID: f.ID, Name: f.Name, Custom: f.Custom}
+		if f.Schema != nil {
+			entry.Type = f.Schema.Type
+		}
+		result = append(result, entry)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result, nil
+}
+
+// HandleWebhook handles a Jira webhook. The delivery must be verified with
+// the webhook handler before the payload is passed in. Events for issues
+// created by this adapter carry the ID of the context they were created from.
+func (a *JiraAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	event, err := ParseWebhook(payload)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook", err, nil)
+	}
+
+	if eventType == "" {
+		eventType = event.EventType
+	}
+
+	a.logger.Info("Received Jira webhook", map[string]interface{}{
+		"eventType": eventType,
+		"issueKey":  event.IssueKey,
+	})
+
+	if a.eventBus != nil {
+		contextID, _ := a.IssueContextID(event.IssueKey)
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, event).
+			WithMetadata("eventType", eventType).
+			WithMetadata("issueKey", event.IssueKey).
+			WithMetadata("contextId", contextID)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// Close closes the adapter
+func (a *JiraAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// fetchTransitions gets the transitions available for an issue
+func (a *JiraAdapter) fetchTransitions(ctx context.Context, operation, issueKey string) ([]transition, error) {
+	var response struct {
+		Transitions []transition `json:"transitions"`
+	}
+	path := apiPath + "/issue/" + url.PathEscape(issueKey) + "/transitions"
+	if err := a.doRequest(ctx, operation, http.MethodGet, path, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Transitions, nil
+}
+
+// loadFields loads the field metadata used to resolve fields by name. Loaded
+// metadata is reused unless reload is set.
+func (a *JiraAdapter) loadFields(ctx context.Context, operation string, reload bool) error {
+	a.fieldMutex.RLock()
+	loaded := a.fieldsByID != nil
+	a.fieldMutex.RUnlock()
+	if loaded && !reload {
+		return nil
+	}
+
+	var fields []field
+	if err := a.doRequest(ctx, operation, http.MethodGet, apiPath+"/field", nil, nil, &fields); err != nil {
+		return err
+	}
+
+	byID := make(map[string]field, len(fields))
+	byName := make(map[string][]string, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+		name := strings.ToLower(f.Name)
+		byName[name] = append(byName[name], f.ID)
+	}
+
+	a.fieldMutex.Lock()
+	a.fieldsByID = byID
+	a.fieldsByName = byName
+	a.fieldMutex.Unlock()
+
+	return nil
+}
+
+// resolveField returns the field for a field ID or name. Names are matched
+// case-insensitively; the field metadata is reloaded once for unknown names
+// in case the field was added since it was loaded.
+func (a *JiraAdapter) resolveField(ctx context.Context, operation, nameOrID string) (field, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		if err := a.loadFields(ctx, operation, attempt > 0); err != nil {
+			return field{}, err
+		}
+
+		a.fieldMutex.RLock()
+		f, ok := a.fieldsByID[nameOrID]
+		ids := a.fieldsByName[strings.ToLower(nameOrID)]
+		if !ok && len(ids) == 1 {
+			f, ok = a.fieldsByID[ids[0]]
+		}
+		a.fieldMutex.RUnlock()
+
+		if ok {
+			return f, nil
+		}
+		if len(ids) > 1 {
+			return field{}, adapterErrors.NewInvalidParameterError(adapterType, operation,
+				fmt.Errorf("field name %q is ambiguous, use one of the field IDs: %s", nameOrID, strings.Join(ids, ", ")), nil)
+		}
+	}
+
+	return field{}, adapterErrors.NewInvalidParameterError(adapterType, operation,
+		fmt.Errorf("unknown Jira field: %s", nameOrID), nil)
+}
+
+// resolveFieldNames resolves the fields requested in addition to the system fields
+func (a *JiraAdapter) resolveFieldNames(ctx context.Context, operation string, names []string) ([]field, error) {
+	fields := make([]field, 0, len(names))
+	for _, name := range names {
+		f, err := a.resolveField(ctx, operation, name)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// resolveFields returns field values keyed by field ID. Values are passed
+// through unchanged and must use the format Jira expects for the field.
+func (a *JiraAdapter) resolveFields(ctx context.Context, operation string, values map[string]interface{}) (map[string]interface{}, error) {
+	resolved := make(map[string]interface{}, len(values)+8)
+	for nameOrID, value := range values {
+		f, err := a.resolveField(ctx, operation, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		resolved[f.ID] = value
+	}
+	return resolved, nil
+}
+
+// userReference references a user by account ID on Cloud and by name on Data Center
+func (a *JiraAdapter) userReference(user string) map[string]interface{} {
+	if a.config.Deployment == DeploymentDataCenter {
+		return map[string]interface{}{"name": user}
+	}
+	return map[string]interface{}{"accountId": user}
+}
+
+// issueKey returns the required issue_key parameter
+func (a *JiraAdapter) issueKey(operation string, params map[string]interface{}) (string, error) {
+	issueKey, err := core.RequiredStringParam(params, "issue_key")
+	if err != nil {
+		return "", adapterErrors.NewInvalidParameterError(adapterType, operation, err, nil)
+	}
+	return issueKey, nil
+}
+
+// browseURL returns the web URL of an issue
+func (a *JiraAdapter) browseURL(issueKey string) string {
+	return a.baseURL + "/browse/" + issueKey
+}
+
+// doRequest performs a REST API request and decodes the JSON response into out
+func (a *JiraAdapter) doRequest(ctx context.Context, operation, method, path string, query url.Values, body interface{}, out interface{}) error {
+	requestURL := a.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if a.config.PersonalAccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+a.config.PersonalAccessToken)
+	} else if a.config.APIToken != "" {
+		req.SetBasicAuth(a.config.Email, a.config.APIToken)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("jira API returned %d: %s", resp.StatusCode, errorMessage(respBody)), nil)
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Jira response: %w", err), nil)
+	}
+
+	return nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *JiraAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// errorMessage extracts the messages from a Jira error response
+func errorMessage(body []byte) string {
+	var response struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}
+	if json.Unmarshal(body, &response) == nil {
+		messages := append([]string{}, response.ErrorMessages...)
+		keys := make([]string, 0, len(response.Errors))
+		for key := range response.Errors {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			messages = append(messages, key+": "+response.Errors[key])
+		}
+		if len(messages) > 0 {
+			return strings.Join(messages, "; ")
+		}
+	}
+	return strings.TrimSpace(string(body))
+}
+
+// toIssue summarizes an issue. The requested custom fields are returned keyed
+// by name and the context property, if set, is returned as the context ID.
+func (a *JiraAdapter) toIssue(i issue, customFields []field) Issue {
+	var fields issueFields
+	var values map[string]json.RawMessage
+	if len(i.Fields) > 0 {
+		json.Unmarshal(i.Fields, &fields)
+		json.Unmarshal(i.Fields, &values)
+	}
+
+	result := Issue{
+		ID:          i.ID,
+		Key:         i.Key,
+		Summary:     fields.Summary,
+		Description: fields.Description,
+		Labels:      fields.Labels,
+		URL:         a.browseURL(i.Key),
+		Created:     fields.Created.Time,
+		Updated:     fields.Updated.Time,
+	}
+	if fields.Status != nil {
+		result.Status = fields.Status.Name
+	}
+	if fields.IssueType != nil {
+		result.IssueType = fields.IssueType.Name
+	}
+	if fields.Project != nil {
+		result.Project = fields.Project.Key
+	}
+	if fields.Priority != nil {
+		result.Priority = fields.Priority.Name
+	}
+	if fields.Resolution != nil {
+		result.Resolution = fields.Resolution.Name
+	}
+	if fields.Assignee != nil {
+		result.Assignee = fields.Assignee.DisplayName
+	}
+	if fields.Reporter != nil {
+		result.Reporter = fields.Reporter.DisplayName
+	}
+
+	for _, f := range customFields {
+		raw, ok := values[f.ID]
+		if !ok {
+			continue
+		}
+		var value interface{}
+		if json.Unmarshal(raw, &value) == nil {
+			if result.CustomFields == nil {
+				result.CustomFields = make(map[string]interface{}, len(customFields))
+			}
+			result.CustomFields[f.Name] = value
+		}
+	}
+
+	if raw, ok := i.Properties[a.config.ContextPropertyKey]; ok {
+		var property struct {
+			ContextID string `json:"contextId"`
+		}
+		if json.Unmarshal(raw, &property) == nil {
+			result.ContextID = property.ContextID
+		}
+	}
+
+	return result
+}
+
+func toComment(c comment) Comment {
+	result := Comment{
+		ID:      c.ID,
+		Body:    c.Body,
+		Created: c.Created.Time,
+	}
+	if c.Author != nil {
+		result.Author = c.Author.DisplayName
+	}
+	return result
+}
+
+// fieldIDs returns the IDs of fields
+func fieldIDs(fields []field) []string {
+	ids := make([]string, 0, len(fields))
+	for _, f := range fields {
+		ids = append(ids, f.ID)
+	}
+	return ids
+}
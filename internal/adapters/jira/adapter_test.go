@@ -0,0 +1,296 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// recordedRequest captures the parts of a request the tests assert on
+type recordedRequest struct {
+	method  string
+	path    string
+	query   map[string][]string
+	body    map[string]interface{}
+	headers http.Header
+}
+
+const fieldsJSON = `[{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string"}},
+	{"id":"customfield_10010","name":"Change Risk","custom":true,"schema":{"type":"option"}},
+	{"id":"customfield_10020","name":"Team","custom":true,"schema":{"type":"string"}},
+	{"id":"customfield_10021","name":"Team","custom":true,"schema":{"type":"array"}}]`
+
+const issueJSON = `{"id":"10001","key":"CHG-7","fields":{"summary":"Rotate database credentials","description":"Quarterly rotation",
+	"status":{"name":"In Review"},"issuetype":{"name":"Change"},"project":{"key":"CHG"},"priority":{"name":"High"},
+	"assignee":{"displayName":"Alice"},"labels":["security"],"created":"2026-10-19T10:00:00.000+0000",
+	"customfield_10010":{"value":"Low"}},"properties":{"mcp.context":{"contextId":"ctx-1","source":"mcp-server"}}}`
+
+func newTestAdapter(t *testing.T, responses map[string]string) (*JiraAdapter, *[]recordedRequest) {
+	requests := []recordedRequest{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded := recordedRequest{
+			method:  r.Method,
+			path:    r.URL.Path,
+			query:   r.URL.Query(),
+			headers: r.Header,
+		}
+		json.NewDecoder(r.Body).Decode(&recorded.body)
+		requests = append(requests, recorded)
+
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`))
+			return
+		}
+		if response == "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.BaseURL = server.URL
+	config.Email = "bot@example.com"
+	config.APIToken = "test-token"
+
+	logger := observability.NewLogger("jira_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+
+	return adapter, &requests
+}
+
+func TestSearchIssues(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /rest/api/2/field":   fieldsJSON,
+		"POST /rest/api/2/search": `{"total":1,"startAt":0,"maxResults":50,"issues":[` + issueJSON + `]}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "search_issues", map[string]interface{}{
+		"jql":    "project = CHG AND status = 'In Review'",
+		"fields": []interface{}{"change risk"},
+	})
+	require.NoError(t, err)
+
+	search := result.(SearchResult)
+	assert.Equal(t, 1, search.Total)
+	require.Len(t, search.Issues, 1)
+
+	issue := search.Issues[0]
+	assert.Equal(t, "CHG-7", issue.Key)
+	assert.Equal(t, "In Review", issue.Status)
+	assert.Equal(t, "Change", issue.IssueType)
+	assert.Equal(t, "Alice", issue.Assignee)
+	assert.Equal(t, 2026, issue.Created.Year())
+	assert.Equal(t, adapter.baseURL+"/browse/CHG-7", issue.URL)
+	assert.Equal(t, map[string]interface{}{"value": "Low"}, issue.CustomFields["Change Risk"])
+	assert.Equal(t, "ctx-1", issue.ContextID)
+
+	request := (*requests)[1]
+	user, password, ok := (&http.Request{Header: request.headers}).BasicAuth()
+	require.True(t, ok)
+	assert.Equal(t, "bot@example.com", user)
+	assert.Equal(t, "test-token", password)
+	assert.Contains(t, request.body["fields"], "customfield_10010")
+	assert.Equal(t, []interface{}{"mcp.context"}, request.body["properties"])
+}
+
+func TestCreateIssue(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /rest/api/2/field":  fieldsJSON,
+		"POST /rest/api/2/issue": `{"id":"10002","key":"CHG-8","self":"https://example.atlassian.net/rest/api/2/issue/10002"}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-42", "create_issue", map[string]interface{}{
+		"project":     "CHG",
+		"issue_type":  "Change",
+		"summary":     "Deploy payments v2",
+		"description": "Requested by the release agent",
+		"assignee":    "5b10ac8d82e05b22cc7d4ef5",
+		"fields":      map[string]interface{}{"Change Risk": map[string]interface{}{"value": "Medium"}},
+	})
+	require.NoError(t, err)
+
+	created := result.(CreatedIssue)
+	assert.Equal(t, "CHG-8", created.Key)
+	assert.Equal(t, "ctx-42", created.ContextID)
+
+	contextID, ok := adapter.IssueContextID("CHG-8")
+	assert.True(t, ok)
+	assert.Equal(t, "ctx-42", contextID)
+
+	body := (*requests)[1].body
+	fields := body["fields"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"key": "CHG"}, fields["project"])
+	assert.Equal(t, map[string]interface{}{"value": "Medium"}, fields["customfield_10010"])
+	assert.Equal(t, map[string]interface{}{"accountId": "5b10ac8d82e05b22cc7d4ef5"}, fields["assignee"])
+
+	// The originating context is recorded on the issue itself
+	properties := body["properties"].([]interface{})
+	require.Len(t, properties, 1)
+	property := properties[0].(map[string]interface{})
+	assert.Equal(t, "mcp.context", property["key"])
+	assert.Equal(t, "ctx-42", property["value"].(map[string]interface{})["contextId"])
+}
+
+func TestCustomFieldResolution(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /rest/api/2/field": fieldsJSON,
+	})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "create_issue", map[string]interface{}{
+		"project": "CHG",
+		"summary": "Ambiguous",
+		"fields":  map[string]interface{}{"Team": "Payments"},
+	})
+	require.Error(t, err)
+	assert.True(t, adapterErrors.IsValidationError(err))
+	assert.Contains(t, err.Error(), "customfield_10020")
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "create_issue", map[string]interface{}{
+		"project": "CHG",
+		"summary": "Unknown",
+		"fields":  map[string]interface{}{"Blast Radius": "small"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown Jira field")
+
+	// Field metadata is loaded once and reloaded once for the unknown name
+	fieldRequests := 0
+	for _, request := range *requests {
+		if request.path == "/rest/api/2/field" {
+			fieldRequests++
+		}
+	}
+	assert.Equal(t, 2, fieldRequests)
+}
+
+func TestTransitionIssue(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /rest/api/2/issue/CHG-7/transitions": `{"transitions":[{"id":"21","name":"Approve","to":{"name":"Approved"}},
+			{"id":"31","name":"Reject","to":{"name":"Rejected"}}]}`,
+		"POST /rest/api/2/issue/CHG-7/transitions": ``,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "transition_issue", map[string]interface{}{
+		"issue_key":  "CHG-7",
+		"transition": "approved",
+		"comment":    "Checks passed",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, TransitionResult{Key: "CHG-7", Transition: "Approve", Status: "Approved"}, result)
+
+	body := (*requests)[1].body
+	assert.Equal(t, "21", body["transition"].(map[string]interface{})["id"])
+	assert.NotNil(t, body["update"])
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "transition_issue", map[string]interface{}{
+		"issue_key":  "CHG-7",
+		"transition": "Close",
+	})
+	require.Error(t, err)
+	assert.True(t, adapterErrors.IsValidationError(err))
+	assert.Contains(t, err.Error(), "Approve, Reject")
+}
+
+func TestCommentsAndLinks(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"POST /rest/api/2/issue/CHG-7/comment": `{"id":"100","body":"Deployed","author":{"displayName":"MCP Bot"},"created":"2026-10-19T11:00:00.000+0000"}`,
+		"POST /rest/api/2/issueLink":           ``,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "add_comment", map[string]interface{}{
+		"issue_key": "CHG-7",
+		"body":      "Deployed",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "MCP Bot", result.(Comment).Author)
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "link_issues", map[string]interface{}{
+		"inward_issue":  "CHG-7",
+		"outward_issue": "INC-3",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Relates", result.(IssueLink).Type)
+
+	body := (*requests)[1].body
+	assert.Equal(t, map[string]interface{}{"name": "Relates"}, body["type"])
+	assert.Equal(t, map[string]interface{}{"key": "INC-3"}, body["outwardIssue"])
+}
+
+func TestDataCenterAuth(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /rest/api/2/field":  fieldsJSON,
+		"POST /rest/api/2/issue": `{"id":"10002","key":"CHG-8"}`,
+	})
+	adapter.config.Deployment = DeploymentDataCenter
+	adapter.config.PersonalAccessToken = "pat-token"
+
+	_, err := adapter.ExecuteAction(context.Background(), "", "create_issue", map[string]interface{}{
+		"project":  "CHG",
+		"summary":  "Deploy payments v2",
+		"assignee": "alice",
+	})
+	require.NoError(t, err)
+
+	request := (*requests)[0]
+	assert.Equal(t, "Bearer pat-token", request.headers.Get("Authorization"))
+	fields := request.body["fields"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"name": "alice"}, fields["assignee"])
+	assert.Nil(t, request.body["properties"], "no back-reference without a context")
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 8)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	search, _ := core.FindAction(actions, "search_issues")
+	assert.Equal(t, core.SafetyRead, search.Safety)
+	assert.NoError(t, search.ValidateParams(map[string]interface{}{"jql": "project = OPS", "fields": []interface{}{"Team"}, "max_results": float64(10)}))
+	assert.Error(t, search.ValidateParams(map[string]interface{}{"query": "project = OPS"}))
+
+	transition, _ := core.FindAction(actions, "transition_issue")
+	assert.Equal(t, core.SafetyWrite, transition.Safety)
+	assert.NoError(t, transition.ValidateParams(map[string]interface{}{"issue_key": "OPS-1", "transition": "Done", "fields": map[string]interface{}{"Resolution": "Fixed"}}))
+	assert.Error(t, transition.ValidateParams(map[string]interface{}{"issue_key": "OPS-1"}))
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_issue", map[string]interface{}{"issue_key": "CHG-404"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+	assert.Contains(t, err.Error(), "Issue does not exist")
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "search_issues", nil)
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "delete_issue", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+
+	_, err = New(&Config{BaseURL: "https://jira.example.com", Deployment: "server"}, nil, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"time"
+)
+
+// Deployment types
+const (
+	DeploymentCloud      = "cloud"
+	DeploymentDataCenter = "datacenter"
+)
+
+// Config holds configuration for the Jira adapter
+type Config struct {
+	// BaseURL is the site URL, e.g. https://example.atlassian.net
+	BaseURL string `mapstructure:"base_url"`
+	// Deployment is cloud or datacenter. It selects how users are referenced,
+	// by account ID on Cloud and by user name on Data Center.
+	Deployment     string        `mapstructure:"deployment"`
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// Jira Cloud authenticates with an account email and API token, Data
+	// Center with a personal access token. The personal access token takes
+	// precedence when both are set.
+	Email               string `mapstructure:"email"`
+	APIToken            string `mapstructure:"api_token"`
+	PersonalAccessToken string `mapstructure:"personal_access_token"`
+
+	// Secret used to verify the X-Hub-Signature header of Jira Cloud webhooks.
+	// Data Center webhooks cannot be signed and pass it as the token query
+	// parameter instead.
+	WebhookSecret string `mapstructure:"webhook_secret"`
+
+	// ContextPropertyKey is the issue property recording the context an issue
+	// was created from
+	ContextPropertyKey string `mapstructure:"context_property_key"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+
+	// Query settings
+	DefaultMaxResults int `mapstructure:"default_max_results"`
+}
+
+// DefaultConfig returns a default configuration for the Jira adapter
+func DefaultConfig() *Config {
+	return &Config{
+		Deployment:         DeploymentCloud,
+		RequestTimeout:     30 * time.Second,
+		ContextPropertyKey: "mcp.context",
+		MockURL:            "http://localhost:8081/mock-jira",
+		DefaultMaxResults:  50,
+	}
+}
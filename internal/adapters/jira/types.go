@@ -0,0 +1,169 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// The types below are the subset of the Jira REST API v2 objects used by the adapter
+
+type user struct {
+	AccountID    string `json:"accountId"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+type named struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type issue struct {
+	ID     string          `json:"id"`
+	Key    string          `json:"key"`
+	Self   string          `json:"self"`
+	Fields json.RawMessage `json:"fields"`
+	// Properties holds the issue properties requested with the issue
+	Properties map[string]json.RawMessage `json:"properties"`
+}
+
+// issueFields are the system fields summarized for every issue
+type issueFields struct {
+	Summary     string   `json:"summary"`
+	Description string   `json:"description"`
+	Status      *named   `json:"status"`
+	IssueType   *named   `json:"issuetype"`
+	Project     *named   `json:"project"`
+	Priority    *named   `json:"priority"`
+	Resolution  *named   `json:"resolution"`
+	Assignee    *user    `json:"assignee"`
+	Reporter    *user    `json:"reporter"`
+	Labels      []string `json:"labels"`
+	Created     jiraTime `json:"created"`
+	Updated     jiraTime `json:"updated"`
+}
+
+type field struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Custom bool   `json:"custom"`
+	Schema *struct {
+		Type  string `json:"type"`
+		Items string `json:"items"`
+	} `json:"schema"`
+}
+
+type transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   named  `json:"to"`
+}
+
+type comment struct {
+	ID      string   `json:"id"`
+	Body    string   `json:"body"`
+	Author  *user    `json:"author"`
+	Created jiraTime `json:"created"`
+}
+
+// jiraTime parses the timestamp format of the Jira REST API, e.g.
+// 2026-10-19T10:00:00.000+0000, which is not RFC 3339
+type jiraTime struct {
+	time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *jiraTime) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil || value == "" {
+		return nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05.000-0700", time.RFC3339Nano} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid Jira timestamp: %s", value)
+}
+
+// Issue is a summary of a Jira issue
+type Issue struct {
+	ID          string    `json:"id"`
+	Key         string    `json:"key"`
+	Summary     string    `json:"summary"`
+	Description string    `json:"description,omitempty"`
+	Status      string    `json:"status"`
+	IssueType   string    `json:"issue_type"`
+	Project     string    `json:"project"`
+	Priority    string    `json:"priority,omitempty"`
+	Resolution  string    `json:"resolution,omitempty"`
+	Assignee    string    `json:"assignee,omitempty"`
+	Reporter    string    `json:"reporter,omitempty"`
+	Labels      []string  `json:"labels,omitempty"`
+	URL         string    `json:"url"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+	// CustomFields holds the requested custom fields keyed by field name
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	// ContextID is the context the issue was created from, if it was created
+	// by the adapter
+	ContextID string `json:"context_id,omitempty"`
+}
+
+// SearchResult is a page of JQL search results
+type SearchResult struct {
+	Total      int     `json:"total"`
+	StartAt    int     `json:"start_at"`
+	MaxResults int     `json:"max_results"`
+	Issues     []Issue `json:"issues"`
+}
+
+// CreatedIssue is the result of creating an issue
+type CreatedIssue struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+	URL string `json:"url"`
+	// ContextID is the context recorded on the issue, if any
+	ContextID string `json:"context_id,omitempty"`
+}
+
+// Field is a system or custom issue field
+type Field struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Custom bool   `json:"custom"`
+	Type   string `json:"type,omitempty"`
+}
+
+// Transition is a workflow transition available for an issue
+type Transition struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	ToStatus string `json:"to_status"`
+}
+
+// TransitionResult is the result of transitioning an issue
+type TransitionResult struct {
+	Key        string `json:"key"`
+	Transition string `json:"transition"`
+	Status     string `json:"status"`
+}
+
+// Comment is a comment on an issue
+type Comment struct {
+	ID      string    `json:"id"`
+	Body    string    `json:"body"`
+	Author  string    `json:"author"`
+	Created time.Time `json:"created"`
+}
+
+// IssueLink is a link between two issues
+type IssueLink struct {
+	Type         string `json:"type"`
+	InwardIssue  string `json:"inward_issue"`
+	OutwardIssue string `json:"outward_issue"`
+}
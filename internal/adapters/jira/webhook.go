@@ -0,0 +1,170 @@
+package jira
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// WebhookSignatureHeader is the header carrying the signature of Jira Cloud webhooks
+const WebhookSignatureHeader = "X-Hub-Signature"
+
+// WebhookTokenParam is the query parameter carrying the webhook secret for
+// Jira Data Center, which cannot sign webhooks, e.g.
+// https://mcp.example.com/webhooks/jira?token=...
+const WebhookTokenParam = "token"
+
+// WebhookEvent is the normalized summary of a Jira webhook
+type WebhookEvent struct {
+	// EventType is the webhook event, e.g. jira:issue_updated or comment_created
+	EventType string `json:"event_type"`
+	// IssueEventType is the issue event of issue webhooks, e.g. issue_generic
+	IssueEventType string        `json:"issue_event_type,omitempty"`
+	Timestamp      time.Time     `json:"timestamp"`
+	User           string        `json:"user,omitempty"`
+	IssueID        string        `json:"issue_id,omitempty"`
+	IssueKey       string        `json:"issue_key,omitempty"`
+	Project        string        `json:"project,omitempty"`
+	IssueType      string        `json:"issue_type,omitempty"`
+	Summary        string        `json:"summary,omitempty"`
+	Status         string        `json:"status,omitempty"`
+	Changes        []FieldChange `json:"changes,omitempty"`
+	// Comment is the comment body of comment events
+	Comment string `json:"comment,omitempty"`
+}
+
+// FieldChange is a changelog entry of an issue update
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// webhookPayload is the Jira webhook format
+type webhookPayload struct {
+	Timestamp      int64  `json:"timestamp"`
+	WebhookEvent   string `json:"webhookEvent"`
+	IssueEventType string `json:"issue_event_type_name"`
+	User           *user  `json:"user"`
+	Issue          *struct {
+		ID     string `json:"id"`
+		Key    string `json:"key"`
+		Fields *struct {
+			Summary   string `json:"summary"`
+			Status    *named `json:"status"`
+			Project   *named `json:"project"`
+			IssueType *named `json:"issuetype"`
+		} `json:"fields"`
+	} `json:"issue"`
+	Changelog *struct {
+		Items []struct {
+			Field      string `json:"field"`
+			FromString string `json:"fromString"`
+			ToString   string `json:"toString"`
+		} `json:"items"`
+	} `json:"changelog"`
+	Comment *comment `json:"comment"`
+}
+
+// VerifyWebhookSignature checks the X-Hub-Signature header, sha256=<hex>,
+// against the HMAC-SHA256 of the payload. If no secret is configured every
+// payload is rejected.
+func (a *JiraAdapter) VerifyWebhookSignature(payload []byte, signature string) bool {
+	if a.config.WebhookSecret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(a.config.WebhookSecret))
+	mac.Write(payload)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// VerifyWebhookToken checks the webhook token against the configured secret.
+// If no secret is configured every request is rejected.
+func (a *JiraAdapter) VerifyWebhookToken(token string) bool {
+	if a.config.WebhookSecret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(a.config.WebhookSecret)) == 1
+}
+
+// ReceiveWebhook implements core.WebhookReceiver. Signed deliveries are
+// verified by signature, others by the token query parameter.
+func (a *JiraAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	var verified bool
+	if signature := header.Get(WebhookSignatureHeader); signature != "" {
+		verified = a.VerifyWebhookSignature(body, signature)
+	} else {
+		verified = a.VerifyWebhookToken(query.Get(WebhookTokenParam))
+	}
+	if !verified {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid webhook signature or token"), nil)
+	}
+	return body, nil
+}
+
+// ParseWebhook decodes a Jira webhook payload into a normalized event
+func ParseWebhook(payload []byte) (*WebhookEvent, error) {
+	var body webhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse Jira webhook payload: %w", err)
+	}
+	if body.WebhookEvent == "" {
+		return nil, fmt.Errorf("Jira webhook payload is missing webhookEvent")
+	}
+
+	event := &WebhookEvent{
+		EventType:      body.WebhookEvent,
+		IssueEventType: body.IssueEventType,
+	}
+	if body.Timestamp > 0 {
+		event.Timestamp = time.UnixMilli(body.Timestamp).UTC()
+	}
+	if body.User != nil {
+		event.User = body.User.DisplayName
+	}
+
+	if body.Issue != nil {
+		event.IssueID = body.Issue.ID
+		event.IssueKey = body.Issue.Key
+		if fields := body.Issue.Fields; fields != nil {
+			event.Summary = fields.Summary
+			if fields.Status != nil {
+				event.Status = fields.Status.Name
+			}
+			if fields.Project != nil {
+				event.Project = fields.Project.Key
+			}
+			if fields.IssueType != nil {
+				event.IssueType = fields.IssueType.Name
+			}
+		}
+	}
+
+	if body.Changelog != nil {
+		for _, item := range body.Changelog.Items {
+			event.Changes = append(event.Changes, FieldChange{
+				Field: item.Field,
+				From:  item.FromString,
+				To:    item.ToString,
+			})
+		}
+	}
+
+	if body.Comment != nil {
+		event.Comment = body.Comment.Body
+	}
+
+	return event, nil
+}
@@ -0,0 +1,135 @@
+package jira
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// eventRecorder records adapter events emitted during a test
+type eventRecorder struct {
+	events []*events.AdapterEvent
+}
+
+func (r *eventRecorder) Handle(ctx context.Context, event *events.AdapterEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+const issueUpdatedPayload = `{"timestamp":1792404000000,"webhookEvent":"jira:issue_updated","issue_event_type_name":"issue_generic",
+	"user":{"displayName":"Alice"},
+	"issue":{"id":"10002","key":"CHG-8","fields":{"summary":"Deploy payments v2","status":{"name":"Approved"},
+		"project":{"key":"CHG"},"issuetype":{"name":"Change"}}},
+	"changelog":{"items":[{"field":"status","fromString":"In Review","toString":"Approved"}]}}`
+
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseWebhook(t *testing.T) {
+	event, err := ParseWebhook([]byte(issueUpdatedPayload))
+	require.NoError(t, err)
+	assert.Equal(t, "jira:issue_updated", event.EventType)
+	assert.Equal(t, "CHG-8", event.IssueKey)
+	assert.Equal(t, "Approved", event.Status)
+	assert.Equal(t, "Alice", event.User)
+	assert.Equal(t, []FieldChange{{Field: "status", From: "In Review", To: "Approved"}}, event.Changes)
+	assert.False(t, event.Timestamp.IsZero())
+
+	event, err = ParseWebhook([]byte(`{"webhookEvent":"comment_created","issue":{"key":"CHG-8"},"comment":{"id":"1","body":"LGTM"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "LGTM", event.Comment)
+
+	_, err = ParseWebhook([]byte(`{"issue":{"key":"CHG-8"}}`))
+	assert.Error(t, err)
+}
+
+func TestReceiveWebhook(t *testing.T) {
+	logger := observability.NewLogger("jira_test")
+	eventBus := events.NewEventBus(logger)
+	recorder := &eventRecorder{}
+	eventBus.SubscribeAll(recorder)
+
+	config := DefaultConfig()
+	config.BaseURL = "https://example.atlassian.net"
+	config.WebhookSecret = "s3cret"
+	adapter, err := New(config, logger, nil, eventBus)
+	require.NoError(t, err)
+
+	// CHG-8 was created by the adapter from ctx-42
+	adapter.issueContexts["CHG-8"] = "ctx-42"
+
+	payload := []byte(issueUpdatedPayload)
+	tests := []struct {
+		name      string
+		token     string
+		signature string
+		expectErr bool
+	}{
+		{"signed", "", sign("s3cret", payload), false},
+		{"token", "s3cret", "", false},
+		{"bad signature", "s3cret", sign("wrong", payload), true},
+		{"bad token", "wrong", "", true},
+		{"unverified", "", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			if test.signature != "" {
+				header.Set(WebhookSignatureHeader, test.signature)
+			}
+			query := url.Values{}
+			if test.token != "" {
+				query.Set(WebhookTokenParam, test.token)
+			}
+
+			received, err := adapter.ReceiveWebhook(header, query, payload)
+			if test.expectErr {
+				assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized))
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, adapter.HandleWebhook(context.Background(), "", received))
+		})
+	}
+
+	require.Len(t, recorder.events, 2)
+	assert.Equal(t, events.EventTypeWebhookReceived, recorder.events[0].EventType)
+	assert.Equal(t, "jira:issue_updated", recorder.events[0].Metadata["eventType"])
+	assert.Equal(t, "CHG-8", recorder.events[0].Metadata["issueKey"])
+	assert.Equal(t, "ctx-42", recorder.events[0].Metadata["contextId"])
+
+	adapter.config.WebhookSecret = ""
+	_, err = adapter.ReceiveWebhook(http.Header{}, url.Values{WebhookTokenParam: {""}}, payload)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized), "deliveries are rejected when no secret is configured")
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.WebhookSecret = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("jira_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"comment-created-data-center.json": "comment_created",
+		"issue-updated.json":               "jira:issue_updated",
+	})
+}
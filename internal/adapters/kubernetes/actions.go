@@ -0,0 +1,180 @@
+package kubernetes
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// namespaceParam is the schema of the namespace of an object. The configured
+// default namespace is used when none is given.
+var namespaceParam = map[string]interface{}{
+	"type":        "string",
+	"description": "Namespace. Defaults to the configured namespace",
+}
+
+// listParams are the params of list actions
+var listParams = map[string]interface{}{
+	"namespace": namespaceParam,
+	"all_namespaces": map[string]interface{}{
+		"type":        "boolean",
+		"description": "List across all namespaces. Defaults to false",
+	},
+	"label_selector": map[string]interface{}{
+		"type":        "string",
+		"description": "Label selector, such as app=api",
+	},
+	"field_selector": map[string]interface{}{
+		"type":        "string",
+		"description": "Field selector, such as status.phase=Running",
+	},
+	"limit": map[string]interface{}{
+		"type":        "integer",
+		"description": "Maximum number of items to return",
+		"minimum":     1,
+	},
+	"continue": map[string]interface{}{
+		"type":        "string",
+		"description": "Continue token returned by the previous page",
+	},
+}
+
+// objectParams return the params identifying a namespaced object
+func objectParams(kind string) map[string]interface{} {
+	return map[string]interface{}{
+		"name": map[string]interface{}{
+			"type":        "string",
+			"description": kind + " name",
+			"minLength":   1,
+		},
+		"namespace": namespaceParam,
+	}
+}
+
+// Actions returns the descriptors of the supported Kubernetes actions
+func (a *KubernetesAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{
+		{
+			Name:        "list_pods",
+			Description: "List pods with their phase, readiness and restarts",
+			Params:      paramsSchema(nil, listParams),
+			Safety:      core.SafetyRead,
+		},
+		{
+			Name:        "describe_pod",
+			Description: "Get a pod with its conditions and recent events",
+			Params:      paramsSchema([]string{"name"}, objectParams("Pod")),
+			Safety:      core.SafetyRead,
+		},
+		{
+			Name:        "list_deployments",
+			Description: "List deployments with their replica counts",
+			Params:      paramsSchema(nil, listParams),
+			Safety:      core.SafetyRead,
+		},
+		{
+			Name:        "describe_deployment",
+			Description: "Get a deployment with its rollout status and recent events",
+			Params:      paramsSchema([]string{"name"}, objectParams("Deployment")),
+			Safety:      core.SafetyRead,
+		},
+		{
+			Name:        "list_events",
+			Description: "List events, optionally filtered by involved object and type",
+			Params: paramsSchema(nil, listParams, map[string]interface{}{
+				"kind": map[string]interface{}{
+					"type":        "string",
+					"description": "Kind of the involved object, such as Pod",
+				},
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the involved object",
+				},
+				"type": map[string]interface{}{
+					"type":        "string",
+					"description": "Event type",
+					"enum":        []interface{}{"Normal", "Warning"},
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "list_rollouts",
+			Description: "List the revision history of a deployment",
+			Params:      paramsSchema([]string{"name"}, objectParams("Deployment")),
+			Safety:      core.SafetyRead,
+		},
+		{
+			Name:        "get_rollout_status",
+			Description: "Get the progress of the current rollout of a deployment",
+			Params: paramsSchema([]string{"name"}, objectParams("Deployment"), map[string]interface{}{
+				"include_history": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Include the revision history. Defaults to false",
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "get_pod_logs",
+			Description: "Get the last lines of the logs of a pod's container",
+			Params: paramsSchema([]string{"name"}, objectParams("Pod"), map[string]interface{}{
+				"container": map[string]interface{}{
+					"type":        "string",
+					"description": "Container name, required for pods with several containers",
+				},
+				"tail_lines": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of lines to return, up to the configured maximum",
+					"minimum":     1,
+				},
+				"since_seconds": map[string]interface{}{
+					"type":        "integer",
+					"description": "Only return lines logged in the last seconds",
+					"minimum":     1,
+				},
+				"previous": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Return the logs of the previous terminated container. Defaults to false",
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "rollout_restart",
+			Description: "Restart the pods of a deployment with a rolling update, like kubectl rollout restart",
+			Params:      paramsSchema([]string{"name"}, objectParams("Deployment")),
+			Safety:      core.SafetyWrite,
+		},
+		{
+			Name:        "scale",
+			Description: "Change the number of replicas of a deployment",
+			Params: paramsSchema([]string{"name", "replicas"}, objectParams("Deployment"), map[string]interface{}{
+				"replicas": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of replicas",
+					"minimum":     0,
+				},
+			}),
+			Safety: core.SafetyWrite,
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
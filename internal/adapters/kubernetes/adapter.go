@@ -0,0 +1,939 @@
+package kubernetes
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/safety"
+)
+
+// adapterType is the unique identifier for the Kubernetes adapter
+const adapterType = "kubernetes"
+
+// Well-known annotations used for rollouts
+const (
+	revisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+// Patch content types accepted by the API server
+const (
+	mergePatchType          = "application/merge-patch+json"
+	strategicMergePatchType = "application/strategic-merge-patch+json"
+)
+
+// KubernetesAdapter provides read-mostly access to a Kubernetes cluster
+type KubernetesAdapter struct {
+	config        *Config
+	creds         *credentials
+	client        *http.Client
+	checker       safety.Checker
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+}
+
+// New creates a new Kubernetes adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*KubernetesAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("kubernetes_adapter")
+	}
+
+	creds, err := loadCredentials(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Kubernetes credentials: %w", err)
+	}
+
+	// Fall back to the namespace of the kubeconfig context or service account
+	if config.DefaultNamespace == "" {
+		config.DefaultNamespace = creds.namespace
+	}
+	if config.DefaultNamespace == "" {
+		config.DefaultNamespace = "default"
+	}
+
+	return &KubernetesAdapter{
+		config: config,
+		creds:  creds,
+		client: &http.Client{
+			Timeout:   config.RequestTimeout,
+			Transport: &http.Transport{TLSClientConfig: creds.tlsConfig, Proxy: http.ProxyFromEnvironment},
+		},
+		checker:       safety.NewKubernetesChecker(config.ProtectedNamespaces...),
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+	}, nil
+}
+
+// Type returns the adapter type
+func (a *KubernetesAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *KubernetesAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status
+func (a *KubernetesAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var version struct {
+		GitVersion string `json:"gitVersion"`
+	}
+	if err := a.getJSON(ctx, "health", "/version", nil, &version); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+
+	return "healthy"
+}
+
+// ExecuteAction executes a Kubernetes action
+func (a *KubernetesAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing Kubernetes action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	case "list_pods":
+		result, err = a.listPods(ctx, params)
+	case "describe_pod":
+		result, err = a.describePod(ctx, params)
+	case "list_deployments":
+		result, err = a.listDeployments(ctx, params)
+	case "describe_deployment":
+		result, err = a.describeDeployment(ctx, params)
+	case "list_events":
+		result, err = a.listEvents(ctx, params)
+	case "list_rollouts":
+		result, err = a.listRollouts(ctx, params)
+	case "get_rollout_status":
+		result, err = a.getRolloutStatus(ctx, params)
+	case "get_pod_logs":
+		result, err = a.getPodLogs(ctx, params)
+	case "rollout_restart":
+		result, err = a.rolloutRestart(ctx, params)
+	case "scale":
+		result, err = a.scale(ctx, params)
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported Kubernetes action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// listPods lists the pods in a namespace, or in all namespaces when all_namespaces is set
+func (a *KubernetesAdapter) listPods(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	var list podList
+	if err := a.getJSON(ctx, "list_pods", a.collectionPath("/api/v1", "pods", params), a.listQuery(params), &list); err != nil {
+		return nil, err
+	}
+
+	pods := make([]PodSummary, 0, len(list.Items))
+	for _, p := range list.Items {
+		pods = append(pods, summarizePod(p))
+	}
+
+	return map[string]interface{}{
+		"pods":     pods,
+		"total":    len(pods),
+		"continue": list.Metadata.Continue,
+	}, nil
+}
+
+// describePod returns a pod with its conditions and recent events
+func (a *KubernetesAdapter) describePod(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	namespace, name, err := a.namespacedName("describe_pod", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var p pod
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", url.PathEscape(namespace), url.PathEscape(name))
+	if err := a.getJSON(ctx, "describe_pod", path, nil, &p); err != nil {
+		return nil, err
+	}
+
+	podEvents, err := a.objectEvents(ctx, "describe_pod", namespace, "Pod", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"pod":        summarizePod(p),
+		"conditions": p.Status.Conditions,
+		"events":     podEvents,
+	}, nil
+}
+
+// listDeployments lists the deployments in a namespace
+func (a *KubernetesAdapter) listDeployments(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	var list deploymentList
+	if err := a.getJSON(ctx, "list_deployments", a.collectionPath("/apis/apps/v1", "deployments", params), a.listQuery(params), &list); err != nil {
+		return nil, err
+	}
+
+	deployments := make([]DeploymentSummary, 0, len(list.Items))
+	for _, d := range list.Items {
+		deployments = append(deployments, summarizeDeployment(d))
+	}
+
+	return map[string]interface{}{
+		"deployments": deployments,
+		"total":       len(deployments),
+		"continue":    list.Metadata.Continue,
+	}, nil
+}
+
+// describeDeployment returns a deployment with its rollout status and recent events
+func (a *KubernetesAdapter) describeDeployment(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	namespace, name, err := a.namespacedName("describe_deployment", params)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := a.getDeployment(ctx, "describe_deployment", namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentEvents, err := a.objectEvents(ctx, "describe_deployment", namespace, "Deployment", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"deployment": summarizeDeployment(*d),
+		"rollout":    rolloutStatus(*d),
+		"events":     deploymentEvents,
+	}, nil
+}
+
+// listEvents lists events in a namespace, optionally filtered by involved object and type
+func (a *KubernetesAdapter) listEvents(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	query := a.listQuery(params)
+
+	var fieldSelectors []string
+	if existing := query.Get("fieldSelector"); existing != "" {
+		fieldSelectors = append(fieldSelectors, existing)
+	}
+	if kind := core.StringParam(params, "kind"); kind != "" {
+		fieldSelectors = append(fieldSelectors, "involvedObject.kind="+kind)
+	}
+	if name := core.StringParam(params, "name"); name != "" {
+		fieldSelectors = append(fieldSelectors, "involvedObject.name="+name)
+	}
+	if eventType := core.StringParam(params, "type"); eventType != "" {
+		fieldSelectors = append(fieldSelectors, "type="+eventType)
+	}
+	if len(fieldSelectors) > 0 {
+		query.Set("fieldSelector", strings.Join(fieldSelectors, ","))
+	}
+
+	var list eventList
+	if err := a.getJSON(ctx, "list_events", a.collectionPath("/api/v1", "events", params), query, &list); err != nil {
+		return nil, err
+	}
+
+	summaries := summarizeEvents(list.Items)
+	return map[string]interface{}{
+		"events":   summaries,
+		"total":    len(summaries),
+		"continue": list.Metadata.Continue,
+	}, nil
+}
+
+// listRollouts returns the revision history of a deployment
+func (a *KubernetesAdapter) listRollouts(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	namespace, name, err := a.namespacedName("list_rollouts", params)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := a.getDeployment(ctx, "list_rollouts", namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := a.rolloutHistory(ctx, "list_rollouts", *d)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"deployment": name,
+		"namespace":  namespace,
+		"revisions":  history,
+	}, nil
+}
+
+// getRolloutStatus returns the progress of the current rollout of a deployment
+func (a *KubernetesAdapter) getRolloutStatus(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	namespace, name, err := a.namespacedName("get_rollout_status", params)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := a.getDeployment(ctx, "get_rollout_status", namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	status := rolloutStatus(*d)
+	if core.BoolParam(params, "include_history", false) {
+		if status.History, err = a.rolloutHistory(ctx, "get_rollout_status", *d); err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
+
+// getPodLogs returns the last lines of a container's logs, capped at MaxLogLines
+func (a *KubernetesAdapter) getPodLogs(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	namespace, name, err := a.namespacedName("get_pod_logs", params)
+	if err != nil {
+		return nil, err
+	}
+
+	tailLines := core.IntParam(params, "tail_lines", a.config.MaxLogLines)
+	capped := false
+	if tailLines <= 0 || tailLines > a.config.MaxLogLines {
+		tailLines = a.config.MaxLogLines
+		capped = true
+	}
+
+	query := url.Values{}
+	query.Set("tailLines", strconv.Itoa(tailLines))
+	container := core.StringParam(params, "container")
+	if container != "" {
+		query.Set("container", container)
+	}
+	if sinceSeconds := core.IntParam(params, "since_seconds", 0); sinceSeconds > 0 {
+		query.Set("sinceSeconds", strconv.Itoa(sinceSeconds))
+	}
+	if core.BoolParam(params, "previous", false) {
+		query.Set("previous", "true")
+	}
+
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log", url.PathEscape(namespace), url.PathEscape(name))
+	body, err := a.doRequest(ctx, "get_pod_logs", http.MethodGet, path, query, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API server honours tailLines, but cap again in case it is a proxy that does not
+	lines := make([]string, 0, tailLines)
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if len(lines) > tailLines {
+		lines = lines[len(lines)-tailLines:]
+		capped = true
+	}
+
+	return map[string]interface{}{
+		"pod":       name,
+		"namespace": namespace,
+		"container": container,
+		"lines":     lines,
+		"count":     len(lines),
+		"capped":    capped,
+	}, nil
+}
+
+// rolloutRestart triggers a rolling restart of a deployment, like kubectl rollout restart
+func (a *KubernetesAdapter) rolloutRestart(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	namespace, name, err := a.namespacedName("rollout_restart", params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.checkSafety("rollout_restart", namespace, params); err != nil {
+		return nil, err
+	}
+
+	restartedAt := time.Now().UTC().Format(time.RFC3339)
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{restartedAtAnnotation: restartedAt},
+				},
+			},
+		},
+	}
+
+	path := fmt.Sprintf("/apis/apps/v1/namespaces/%s/deployments/%s", url.PathEscape(namespace), url.PathEscape(name))
+	body, err := a.doRequest(ctx, "rollout_restart", http.MethodPatch, path, nil, strategicMergePatchType, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	var d deployment
+	if err := a.decode("rollout_restart", body, &d); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"deployment":   name,
+		"namespace":    namespace,
+		"restarted_at": restartedAt,
+		"rollout":      rolloutStatus(d),
+	}, nil
+}
+
+// scale changes the number of replicas of a deployment
+func (a *KubernetesAdapter) scale(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	namespace, name, err := a.namespacedName("scale", params)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := core.IntParam(params, "replicas", -1)
+	if replicas < 0 {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "scale",
+			fmt.Errorf("replicas must be a non-negative integer"), nil)
+	}
+
+	if err := a.checkSafety("scale", namespace, params); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/apis/apps/v1/namespaces/%s/deployments/%s/scale", url.PathEscape(namespace), url.PathEscape(name))
+
+	var current scaleSubresource
+	if err := a.getJSON(ctx, "scale", path, nil, &current); err != nil {
+		return nil, err
+	}
+
+	patch := map[string]interface{}{"spec": map[string]interface{}{"replicas": replicas}}
+	body, err := a.doRequest(ctx, "scale", http.MethodPatch, path, nil, mergePatchType, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated scaleSubresource
+	if err := a.decode("scale", body, &updated); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"deployment":        name,
+		"namespace":         namespace,
+		"previous_replicas": current.Spec.Replicas,
+		"replicas":          updated.Spec.Replicas,
+	}, nil
+}
+
+// checkSafety runs the safety checker against a mutation in the resolved namespace
+func (a *KubernetesAdapter) checkSafety(operation, namespace string, params map[string]interface{}) error {
+	checked := make(map[string]interface{}, len(params)+1)
+	for k, v := range params {
+		checked[k] = v
+	}
+	checked["namespace"] = namespace
+
+	if safe, err := a.checker.IsSafeOperation(operation, checked); !safe {
+		if err == nil {
+			err = safety.ErrOperationNotAllowed
+		}
+		return adapterErrors.NewForbiddenError(adapterType, operation, err, map[string]interface{}{
+			"namespace": namespace,
+		})
+	}
+
+	return nil
+}
+
+// getDeployment fetches a single deployment
+func (a *KubernetesAdapter) getDeployment(ctx context.Context, operation, namespace, name string) (*deployment, error) {
+	var d deployment
+	path := fmt.Sprintf("/apis/apps/v1/namespaces/%s/deployments/%s", url.PathEscape(namespace), url.PathEscape(name))
+	if err := a.getJSON(ctx, operation, path, nil, &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// objectEvents returns the events involving a single object, most recent first
+func (a *KubernetesAdapter) objectEvents(ctx context.Context, operation, namespace, kind, name string) ([]EventSummary, error) {
+	query := url.Values{}
+	query.Set("fieldSelector", fmt.Sprintf("involvedObject.kind=%s,involvedObject.name=%s", kind, name))
+
+	var list eventList
+	path := fmt.Sprintf("/api/v1/namespaces/%s/events", url.PathEscape(namespace))
+	if err := a.getJSON(ctx, operation, path, query, &list); err != nil {
+		return nil, err
+	}
+
+	return summarizeEvents(list.Items), nil
+}
+
+// rolloutHistory returns the revisions of a deployment from its owned replica sets, newest first
+func (a *KubernetesAdapter) rolloutHistory(ctx context.Context, operation string, d deployment) ([]RolloutRevision, error) {
+	query := url.Values{}
+	if selector := labelSelector(d.Spec.Selector.MatchLabels); selector != "" {
+		query.Set("labelSelector", selector)
+	}
+
+	var list replicaSetList
+	path := fmt.Sprintf("/apis/apps/v1/namespaces/%s/replicasets", url.PathEscape(d.Metadata.Namespace))
+	if err := a.getJSON(ctx, operation, path, query, &list); err != nil {
+		return nil, err
+	}
+
+	currentRevision := parseRevision(d.Metadata.Annotations)
+
+	history := []RolloutRevision{}
+	for _, rs := range list.Items {
+		if !ownedBy(rs.Metadata, "Deployment", d.Metadata.Name, d.Metadata.UID) {
+			continue
+		}
+
+		revision := parseRevision(rs.Metadata.Annotations)
+		var replicas int32
+		if rs.Spec.Replicas != nil {
+			replicas = *rs.Spec.Replicas
+		}
+
+		history = append(history, RolloutRevision{
+			Revision:    revision,
+			ReplicaSet:  rs.Metadata.Name,
+			Replicas:    replicas,
+			Ready:       rs.Status.ReadyReplicas,
+			Images:      containerImages(rs.Spec.Template.Spec.Containers),
+			ChangeCause: rs.Metadata.Annotations[changeCauseAnnotation],
+			Current:     revision == currentRevision,
+			CreatedAt:   rs.Metadata.CreationTimestamp,
+		})
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Revision > history[j].Revision
+	})
+
+	return history, nil
+}
+
+// HandleWebhook handles Kubernetes event notifications forwarded by an event exporter
+func (a *KubernetesAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	var e event
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook",
+			fmt.Errorf("failed to parse Kubernetes event payload: %w", err), nil)
+	}
+
+	if eventType == "" {
+		eventType = e.Type
+	}
+
+	summary := summarizeEvent(e)
+
+	a.logger.Info("Received Kubernetes event", map[string]interface{}{
+		"eventType": eventType,
+		"reason":    summary.Reason,
+		"object":    summary.Object,
+		"namespace": summary.Namespace,
+	})
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, summary).
+			WithMetadata("eventType", eventType).
+			WithMetadata("namespace", summary.Namespace).
+			WithMetadata("object", summary.Object)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// Close closes the adapter
+func (a *KubernetesAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// namespacedName resolves the namespace and required name parameters
+func (a *KubernetesAdapter) namespacedName(operation string, params map[string]interface{}) (string, string, error) {
+	name, err := core.RequiredStringParam(params, "name")
+	if err != nil {
+		return "", "", adapterErrors.NewInvalidParameterError(adapterType, operation, err, nil)
+	}
+	return a.namespace(params), name, nil
+}
+
+// namespace returns the namespace parameter or the configured default
+func (a *KubernetesAdapter) namespace(params map[string]interface{}) string {
+	if namespace := core.StringParam(params, "namespace"); namespace != "" {
+		return namespace
+	}
+	return a.config.DefaultNamespace
+}
+
+// collectionPath builds the path of a resource collection, cluster wide when all_namespaces is set
+func (a *KubernetesAdapter) collectionPath(groupVersion, resource string, params map[string]interface{}) string {
+	if core.BoolParam(params, "all_namespaces", false) {
+		return fmt.Sprintf("%s/%s", groupVersion, resource)
+	}
+	return fmt.Sprintf("%s/namespaces/%s/%s", groupVersion, url.PathEscape(a.namespace(params)), resource)
+}
+
+// listQuery builds the common query parameters of list requests
+func (a *KubernetesAdapter) listQuery(params map[string]interface{}) url.Values {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(core.IntParam(params, "limit", a.config.DefaultListLimit)))
+	if selector := core.StringParam(params, "label_selector"); selector != "" {
+		query.Set("labelSelector", selector)
+	}
+	if selector := core.StringParam(params, "field_selector"); selector != "" {
+		query.Set("fieldSelector", selector)
+	}
+	if token := core.StringParam(params, "continue"); token != "" {
+		query.Set("continue", token)
+	}
+	return query
+}
+
+// getJSON performs a GET request and decodes the JSON response into out
+func (a *KubernetesAdapter) getJSON(ctx context.Context, operation, path string, query url.Values, out interface{}) error {
+	body, err := a.doRequest(ctx, operation, http.MethodGet, path, query, "", nil)
+	if err != nil {
+		return err
+	}
+	return a.decode(operation, body, out)
+}
+
+// decode decodes a JSON response body
+func (a *KubernetesAdapter) decode(operation string, body []byte, out interface{}) error {
+	if err := json.Unmarshal(body, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Kubernetes response: %w", err), nil)
+	}
+	return nil
+}
+
+// doRequest performs a request against the Kubernetes API server and returns the response body
+func (a *KubernetesAdapter) doRequest(ctx context.Context, operation, method, path string, query url.Values, contentType string, body interface{}) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	requestURL := a.creds.host + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return nil, adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	if a.creds.token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.creds.token)
+	} else if a.creds.username != "" {
+		req.SetBasicAuth(a.creds.username, a.creds.password)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return nil, adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		message := strings.TrimSpace(string(respBody))
+		var statusBody apiStatus
+		if json.Unmarshal(respBody, &statusBody) == nil && statusBody.Message != "" {
+			message = statusBody.Message
+		}
+		return nil, adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("kubernetes API returned %d: %s", resp.StatusCode, message), nil)
+	}
+
+	return respBody, nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *KubernetesAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// summarizePod converts a pod into its normalized summary
+func summarizePod(p pod) PodSummary {
+	summary := PodSummary{
+		Name:      p.Metadata.Name,
+		Namespace: p.Metadata.Namespace,
+		Phase:     p.Status.Phase,
+		Node:      p.Spec.NodeName,
+		IP:        p.Status.PodIP,
+		Reason:    p.Status.Reason,
+		Labels:    p.Metadata.Labels,
+		CreatedAt: p.Metadata.CreationTimestamp,
+	}
+	if len(p.Metadata.OwnerReferences) > 0 {
+		owner := p.Metadata.OwnerReferences[0]
+		summary.Owner = owner.Kind + "/" + owner.Name
+	}
+
+	images := make(map[string]string, len(p.Spec.Containers))
+	for _, c := range p.Spec.Containers {
+		images[c.Name] = c.Image
+	}
+
+	ready := 0
+	for _, cs := range p.Status.ContainerStatuses {
+		container := ContainerSummary{
+			Name:     cs.Name,
+			Image:    images[cs.Name],
+			Ready:    cs.Ready,
+			Restarts: cs.RestartCount,
+		}
+		switch {
+		case cs.State.Waiting != nil:
+			container.State = "waiting"
+			container.Reason = cs.State.Waiting.Reason
+		case cs.State.Terminated != nil:
+			container.State = "terminated"
+			container.Reason = cs.State.Terminated.Reason
+		case cs.State.Running != nil:
+			container.State = "running"
+		}
+
+		// Surface the most useful reason, such as CrashLoopBackOff, at the pod level
+		if summary.Reason == "" && container.Reason != "" {
+			summary.Reason = container.Reason
+		}
+		if cs.Ready {
+			ready++
+		}
+		summary.Restarts += cs.RestartCount
+		summary.Containers = append(summary.Containers, container)
+	}
+	summary.Ready = fmt.Sprintf("%d/%d", ready, len(p.Spec.Containers))
+
+	return summary
+}
+
+// summarizeDeployment converts a deployment into its normalized summary
+func summarizeDeployment(d deployment) DeploymentSummary {
+	var replicas int32 = 1
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+
+	return DeploymentSummary{
+		Name:              d.Metadata.Name,
+		Namespace:         d.Metadata.Namespace,
+		Replicas:          replicas,
+		ReadyReplicas:     d.Status.ReadyReplicas,
+		UpdatedReplicas:   d.Status.UpdatedReplicas,
+		AvailableReplicas: d.Status.AvailableReplicas,
+		Strategy:          d.Spec.Strategy.Type,
+		Paused:            d.Spec.Paused,
+		Images:            containerImages(d.Spec.Template.Spec.Containers),
+		Labels:            d.Metadata.Labels,
+		Conditions:        d.Status.Conditions,
+		CreatedAt:         d.Metadata.CreationTimestamp,
+	}
+}
+
+// rolloutStatus computes the rollout status of a deployment the same way kubectl rollout status does
+func rolloutStatus(d deployment) RolloutStatus {
+	status := RolloutStatus{
+		Deployment: d.Metadata.Name,
+		Namespace:  d.Metadata.Namespace,
+		Revision:   parseRevision(d.Metadata.Annotations),
+	}
+
+	if d.Metadata.Generation > d.Status.ObservedGeneration {
+		status.Message = "waiting for deployment spec update to be observed"
+		return status
+	}
+
+	for _, c := range d.Status.Conditions {
+		if c.Type == "Progressing" && c.Reason == "ProgressDeadlineExceeded" {
+			status.Message = fmt.Sprintf("deployment %q exceeded its progress deadline", d.Metadata.Name)
+			return status
+		}
+	}
+
+	var desired int32 = 1
+	if d.Spec.Replicas != nil {
+		desired = *d.Spec.Replicas
+	}
+
+	switch {
+	case d.Status.UpdatedReplicas < desired:
+		status.Message = fmt.Sprintf("waiting for rollout to finish: %d out of %d new replicas have been updated",
+			d.Status.UpdatedReplicas, desired)
+	case d.Status.Replicas > d.Status.UpdatedReplicas:
+		status.Message = fmt.Sprintf("waiting for rollout to finish: %d old replicas are pending termination",
+			d.Status.Replicas-d.Status.UpdatedReplicas)
+	case d.Status.AvailableReplicas < d.Status.UpdatedReplicas:
+		status.Message = fmt.Sprintf("waiting for rollout to finish: %d of %d updated replicas are available",
+			d.Status.AvailableReplicas, d.Status.UpdatedReplicas)
+	default:
+		status.Complete = true
+		status.Message = fmt.Sprintf("deployment %q successfully rolled out", d.Metadata.Name)
+	}
+
+	return status
+}
+
+// summarizeEvents converts events into summaries ordered from most to least recent
+func summarizeEvents(items []event) []EventSummary {
+	summaries := make([]EventSummary, 0, len(items))
+	for _, e := range items {
+		summaries = append(summaries, summarizeEvent(e))
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].LastSeen.After(summaries[j].LastSeen)
+	})
+
+	return summaries
+}
+
+// summarizeEvent converts an event into its normalized summary
+func summarizeEvent(e event) EventSummary {
+	lastSeen := e.LastTimestamp
+	if lastSeen.IsZero() {
+		lastSeen = e.EventTime
+	}
+	if lastSeen.IsZero() {
+		lastSeen = e.FirstTimestamp
+	}
+
+	count := e.Count
+	if count == 0 {
+		count = 1
+	}
+
+	namespace := e.InvolvedObject.Namespace
+	if namespace == "" {
+		namespace = e.Metadata.Namespace
+	}
+
+	return EventSummary{
+		Type:      e.Type,
+		Reason:    e.Reason,
+		Message:   e.Message,
+		Object:    strings.ToLower(e.InvolvedObject.Kind) + "/" + e.InvolvedObject.Name,
+		Namespace: namespace,
+		Count:     count,
+		Source:    e.Source.Component,
+		LastSeen:  lastSeen,
+	}
+}
+
+// containerImages returns the images of a list of containers
+func containerImages(containers []container) []string {
+	images := make([]string, 0, len(containers))
+	for _, c := range containers {
+		images = append(images, c.Image)
+	}
+	return images
+}
+
+// labelSelector builds an equality based label selector from match labels
+func labelSelector(labels map[string]string) string {
+	selectors := make([]string, 0, len(labels))
+	for k, v := range labels {
+		selectors = append(selectors, k+"="+v)
+	}
+	sort.Strings(selectors)
+	return strings.Join(selectors, ",")
+}
+
+// ownedBy returns true if an object is owned by the given owner
+func ownedBy(meta objectMeta, kind, name, uid string) bool {
+	for _, owner := range meta.OwnerReferences {
+		if owner.Kind == kind && owner.Name == name && (uid == "" || owner.UID == uid) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRevision returns the deployment revision annotation, or 0 if it is missing
+func parseRevision(annotations map[string]string) int64 {
+	revision, err := strconv.ParseInt(annotations[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
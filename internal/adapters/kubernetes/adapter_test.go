@@ -0,0 +1,350 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/safety"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// fakeAPIServer is a minimal Kubernetes API server that serves canned objects
+type fakeAPIServer struct {
+	mu       sync.Mutex
+	requests []*http.Request
+	patches  map[string]string
+	replicas int32
+}
+
+func (s *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r)
+	s.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer test-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"kind":"Status","message":"Unauthorized","code":401}`))
+		return
+	}
+
+	if r.Method == http.MethodPatch {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		s.patches[r.URL.Path] = r.Header.Get("Content-Type") + " " + string(body)
+		s.mu.Unlock()
+	}
+
+	switch r.URL.Path {
+	case "/version":
+		w.Write([]byte(`{"gitVersion":"v1.29.0"}`))
+	case "/api/v1/namespaces/payments/pods":
+		w.Write([]byte(`{"metadata":{"continue":"next"},"items":[` + podJSON + `]}`))
+	case "/api/v1/namespaces/payments/pods/api-7d9f-abc":
+		w.Write([]byte(podJSON))
+	case "/api/v1/namespaces/payments/pods/api-7d9f-abc/log":
+		lines := make([]string, 0, 20)
+		for i := 1; i <= 20; i++ {
+			lines = append(lines, fmt.Sprintf("line %d", i))
+		}
+		w.Write([]byte(strings.Join(lines, "\n") + "\n"))
+	case "/api/v1/namespaces/payments/events":
+		w.Write([]byte(`{"items":[
+			{"involvedObject":{"kind":"Pod","name":"api-7d9f-abc","namespace":"payments"},"reason":"Pulled","type":"Normal","count":1,"lastTimestamp":"2024-05-01T10:00:00Z"},
+			{"involvedObject":{"kind":"Pod","name":"api-7d9f-abc","namespace":"payments"},"reason":"BackOff","type":"Warning","count":7,"lastTimestamp":"2024-05-01T10:05:00Z","message":"Back-off restarting failed container"}
+		]}`))
+	case "/apis/apps/v1/namespaces/payments/deployments":
+		w.Write([]byte(`{"items":[` + deploymentJSON + `]}`))
+	case "/apis/apps/v1/namespaces/payments/deployments/api":
+		w.Write([]byte(deploymentJSON))
+	case "/apis/apps/v1/namespaces/payments/deployments/api/scale":
+		s.mu.Lock()
+		if r.Method == http.MethodPatch {
+			s.replicas = 5
+		}
+		replicas := s.replicas
+		s.mu.Unlock()
+		fmt.Fprintf(w, `{"metadata":{"name":"api","namespace":"payments"},"spec":{"replicas":%d}}`, replicas)
+	case "/apis/apps/v1/namespaces/payments/replicasets":
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"api-6c8b","namespace":"payments","annotations":{"deployment.kubernetes.io/revision":"1"},"ownerReferences":[{"kind":"Deployment","name":"api","uid":"dep-uid"}]},"spec":{"replicas":0,"template":{"spec":{"containers":[{"name":"api","image":"api:1.0"}]}}}},
+			{"metadata":{"name":"api-7d9f","namespace":"payments","annotations":{"deployment.kubernetes.io/revision":"2","kubernetes.io/change-cause":"bump to 1.1"},"ownerReferences":[{"kind":"Deployment","name":"api","uid":"dep-uid"}]},"spec":{"replicas":3,"template":{"spec":{"containers":[{"name":"api","image":"api:1.1"}]}}},"status":{"readyReplicas":2}},
+			{"metadata":{"name":"worker-1a2b","namespace":"payments","annotations":{"deployment.kubernetes.io/revision":"9"},"ownerReferences":[{"kind":"Deployment","name":"worker","uid":"other-uid"}]}}
+		]}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"kind":"Status","message":"not found","code":404}`))
+	}
+}
+
+const podJSON = `{
+	"metadata": {"name": "api-7d9f-abc", "namespace": "payments", "ownerReferences": [{"kind": "ReplicaSet", "name": "api-7d9f"}]},
+	"spec": {"nodeName": "node-1", "containers": [{"name": "api", "image": "api:1.1"}, {"name": "proxy", "image": "envoy:1.28"}]},
+	"status": {
+		"phase": "Running",
+		"podIP": "10.0.0.12",
+		"containerStatuses": [
+			{"name": "api", "ready": false, "restartCount": 7, "state": {"waiting": {"reason": "CrashLoopBackOff"}}},
+			{"name": "proxy", "ready": true, "restartCount": 0, "state": {"running": {"startedAt": "2024-05-01T09:00:00Z"}}}
+		]
+	}
+}`
+
+const deploymentJSON = `{
+	"metadata": {"name": "api", "namespace": "payments", "uid": "dep-uid", "generation": 4, "annotations": {"deployment.kubernetes.io/revision": "2"}},
+	"spec": {"replicas": 3, "selector": {"matchLabels": {"app": "api"}}, "strategy": {"type": "RollingUpdate"}, "template": {"spec": {"containers": [{"name": "api", "image": "api:1.1"}]}}},
+	"status": {"observedGeneration": 4, "replicas": 3, "updatedReplicas": 3, "readyReplicas": 2, "availableReplicas": 2}
+}`
+
+func newTestAdapter(t *testing.T) (*KubernetesAdapter, *fakeAPIServer) {
+	fake := &fakeAPIServer{patches: make(map[string]string), replicas: 3}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.Host = server.URL
+	config.Token = "test-token"
+	config.DefaultNamespace = "payments"
+	config.MaxLogLines = 10
+
+	logger := observability.NewLogger("kubernetes_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+
+	return adapter, fake
+}
+
+func TestListAndDescribePods(t *testing.T) {
+	adapter, fake := newTestAdapter(t)
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_pods", map[string]interface{}{
+		"label_selector": "app=api",
+		"limit":          float64(20),
+	})
+	require.NoError(t, err)
+
+	query := fake.requests[0].URL.Query()
+	assert.Equal(t, "app=api", query.Get("labelSelector"))
+	assert.Equal(t, "20", query.Get("limit"))
+
+	list := result.(map[string]interface{})
+	assert.Equal(t, "next", list["continue"])
+	pods := list["pods"].([]PodSummary)
+	require.Len(t, pods, 1)
+	assert.Equal(t, "1/2", pods[0].Ready)
+	assert.Equal(t, int32(7), pods[0].Restarts)
+	assert.Equal(t, "CrashLoopBackOff", pods[0].Reason)
+	assert.Equal(t, "ReplicaSet/api-7d9f", pods[0].Owner)
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "describe_pod", map[string]interface{}{
+		"name": "api-7d9f-abc",
+	})
+	require.NoError(t, err)
+
+	described := result.(map[string]interface{})
+	podEvents := described["events"].([]EventSummary)
+	require.Len(t, podEvents, 2)
+	assert.Equal(t, "BackOff", podEvents[0].Reason, "events should be ordered most recent first")
+	assert.Equal(t, "pod/api-7d9f-abc", podEvents[0].Object)
+	assert.Contains(t, fake.requests[2].URL.Query().Get("fieldSelector"), "involvedObject.name=api-7d9f-abc")
+}
+
+func TestDeploymentsAndRollouts(t *testing.T) {
+	adapter, _ := newTestAdapter(t)
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_deployments", nil)
+	require.NoError(t, err)
+	deployments := result.(map[string]interface{})["deployments"].([]DeploymentSummary)
+	require.Len(t, deployments, 1)
+	assert.Equal(t, []string{"api:1.1"}, deployments[0].Images)
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_rollout_status", map[string]interface{}{
+		"name":            "api",
+		"include_history": true,
+	})
+	require.NoError(t, err)
+
+	status := result.(RolloutStatus)
+	assert.False(t, status.Complete)
+	assert.Equal(t, "waiting for rollout to finish: 2 of 3 updated replicas are available", status.Message)
+	assert.Equal(t, int64(2), status.Revision)
+
+	require.Len(t, status.History, 2, "replica sets owned by other deployments should be ignored")
+	assert.Equal(t, int64(2), status.History[0].Revision)
+	assert.True(t, status.History[0].Current)
+	assert.Equal(t, "bump to 1.1", status.History[0].ChangeCause)
+	assert.Equal(t, []string{"api:1.0"}, status.History[1].Images)
+}
+
+func TestGetPodLogsIsCapped(t *testing.T) {
+	adapter, fake := newTestAdapter(t)
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_pod_logs", map[string]interface{}{
+		"name":       "api-7d9f-abc",
+		"container":  "api",
+		"tail_lines": 1000,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "10", fake.requests[0].URL.Query().Get("tailLines"))
+	assert.Equal(t, "api", fake.requests[0].URL.Query().Get("container"))
+
+	logs := result.(map[string]interface{})
+	lines := logs["lines"].([]string)
+	require.Len(t, lines, 10)
+	assert.Equal(t, "line 20", lines[9])
+	assert.True(t, logs["capped"].(bool))
+}
+
+func TestRolloutRestartAndScale(t *testing.T) {
+	adapter, fake := newTestAdapter(t)
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "rollout_restart", map[string]interface{}{
+		"name": "api",
+	})
+	require.NoError(t, err)
+
+	patch := fake.patches["/apis/apps/v1/namespaces/payments/deployments/api"]
+	assert.True(t, strings.HasPrefix(patch, strategicMergePatchType))
+	assert.Contains(t, patch, restartedAtAnnotation)
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "scale", map[string]interface{}{
+		"name":     "api",
+		"replicas": float64(5),
+	})
+	require.NoError(t, err)
+
+	scaled := result.(map[string]interface{})
+	assert.Equal(t, int32(3), scaled["previous_replicas"])
+	assert.Equal(t, int32(5), scaled["replicas"])
+
+	var body map[string]interface{}
+	scalePatch := fake.patches["/apis/apps/v1/namespaces/payments/deployments/api/scale"]
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(scalePatch, mergePatchType+" ")), &body))
+	assert.Equal(t, float64(5), body["spec"].(map[string]interface{})["replicas"])
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "scale", map[string]interface{}{"name": "api"})
+	assert.True(t, adapterErrors.IsValidationError(err))
+}
+
+func TestMutationsBlockedInProtectedNamespaces(t *testing.T) {
+	adapter, fake := newTestAdapter(t)
+
+	for _, action := range []string{"rollout_restart", "scale"} {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action, map[string]interface{}{
+			"name":      "coredns",
+			"namespace": "kube-system",
+			"replicas":  0,
+		})
+		require.Error(t, err)
+		assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeForbidden))
+		assert.ErrorIs(t, err, safety.ErrRestrictedOperation)
+	}
+
+	assert.Empty(t, fake.requests, "blocked mutations must not reach the API server")
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 10)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	scale, _ := core.FindAction(actions, "scale")
+	assert.Equal(t, core.SafetyWrite, scale.Safety)
+	assert.NoError(t, scale.ValidateParams(map[string]interface{}{"name": "api", "namespace": "staging", "replicas": float64(0)}))
+	assert.Error(t, scale.ValidateParams(map[string]interface{}{"name": "api", "replicas": float64(-1)}))
+
+	logs, _ := core.FindAction(actions, "get_pod_logs")
+	assert.Equal(t, core.SafetyRead, logs.Safety)
+	assert.NoError(t, logs.ValidateParams(map[string]interface{}{"name": "api-0", "tail_lines": float64(100), "previous": true}))
+	assert.Error(t, logs.ValidateParams(map[string]interface{}{"name": "api-0", "follow": true}))
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t)
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "describe_pod", map[string]interface{}{"name": "missing"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "delete_namespace", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+
+	adapter.creds.token = "wrong-token"
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "list_pods", nil)
+	assert.True(t, adapterErrors.IsAuthorizationError(err))
+	assert.Contains(t, err.Error(), "Unauthorized")
+}
+
+func TestHealth(t *testing.T) {
+	adapter, _ := newTestAdapter(t)
+	assert.Equal(t, "healthy", adapter.Health())
+}
+
+func TestReceiveWebhook(t *testing.T) {
+	adapter, _ := newTestAdapter(t)
+	delivery, err := fixture.Load("testdata/webhooks/warning-event.json")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		configured string
+		signedWith string
+		expectCode string
+	}{
+		{"valid token", fixture.TestSecret, fixture.TestSecret, ""},
+		{"invalid token", fixture.TestSecret, "wrong", adapterErrors.ErrCodeUnauthorized},
+		{"unsigned delivery", fixture.TestSecret, "", adapterErrors.ErrCodeUnauthorized},
+		{"no token configured", "", fixture.TestSecret, adapterErrors.ErrCodeUnauthorized},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adapter.config.WebhookToken = test.configured
+			signed := delivery.Sign(test.signedWith, time.Now())
+
+			payload, err := adapter.ReceiveWebhook(signed.Header, signed.Query, signed.Payload())
+			if test.expectCode == "" {
+				require.NoError(t, err)
+				assert.Equal(t, delivery.Payload(), payload)
+				return
+			}
+			assert.True(t, adapterErrors.IsSpecificErrorCode(err, test.expectCode), "%v", err)
+		})
+	}
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.Host = "https://kubernetes.example.com"
+		config.Token = "test-token"
+		config.WebhookToken = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("kubernetes_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"warning-event.json": "Warning",
+	})
+}
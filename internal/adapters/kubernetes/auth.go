@@ -0,0 +1,259 @@
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// serviceAccountDir is where Kubernetes mounts the service account credentials in a pod
+var serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// credentials holds everything needed to talk to an API server
+type credentials struct {
+	host      string
+	token     string
+	username  string
+	password  string
+	namespace string
+	tlsConfig *tls.Config
+}
+
+// kubeconfig is the subset of the kubeconfig file format used by the adapter
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthority     string `yaml:"certificate-authority"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token                 string `yaml:"token"`
+			TokenFile             string `yaml:"tokenFile"`
+			Username              string `yaml:"username"`
+			Password              string `yaml:"password"`
+			ClientCertificate     string `yaml:"client-certificate"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKey             string `yaml:"client-key"`
+			ClientKeyData         string `yaml:"client-key-data"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster   string `yaml:"cluster"`
+			User      string `yaml:"user"`
+			Namespace string `yaml:"namespace"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// loadCredentials resolves API server credentials from the configuration
+func loadCredentials(config *Config) (*credentials, error) {
+	var creds *credentials
+	var err error
+
+	switch {
+	case config.InCluster:
+		creds, err = loadInClusterCredentials()
+	case config.Host != "":
+		creds = &credentials{host: config.Host, tlsConfig: &tls.Config{MinVersion: tls.VersionTLS12}}
+	default:
+		creds, err = loadKubeconfigCredentials(config.KubeconfigPath, config.Context)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Explicit settings override whatever was discovered
+	if config.Host != "" {
+		creds.host = config.Host
+	}
+	if config.Token != "" {
+		creds.token = config.Token
+	}
+	if config.CAFile != "" {
+		pool, err := loadCertPool(config.CAFile, "")
+		if err != nil {
+			return nil, err
+		}
+		creds.tlsConfig.RootCAs = pool
+	}
+	if config.InsecureSkipTLSVerify {
+		creds.tlsConfig.InsecureSkipVerify = true
+	}
+
+	creds.host = strings.TrimRight(creds.host, "/")
+	return creds, nil
+}
+
+// loadInClusterCredentials loads the service account credentials mounted into the pod
+func loadInClusterCredentials() (*credentials, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("in-cluster configuration requires KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT")
+	}
+
+	token, err := os.ReadFile(filepath.Join(serviceAccountDir, "token"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service account token: %w", err)
+	}
+
+	pool, err := loadCertPool(filepath.Join(serviceAccountDir, "ca.crt"), "")
+	if err != nil {
+		return nil, err
+	}
+
+	creds := &credentials{
+		host:      "https://" + net.JoinHostPort(host, port),
+		token:     strings.TrimSpace(string(token)),
+		tlsConfig: &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool},
+	}
+
+	if namespace, err := os.ReadFile(filepath.Join(serviceAccountDir, "namespace")); err == nil {
+		creds.namespace = strings.TrimSpace(string(namespace))
+	}
+
+	return creds, nil
+}
+
+// loadKubeconfigCredentials loads credentials for a context from a kubeconfig file.
+// The path defaults to $KUBECONFIG or ~/.kube/config and the context to current-context.
+func loadKubeconfigCredentials(path, contextName string) (*credentials, error) {
+	if path == "" {
+		path = os.Getenv("KUBECONFIG")
+		if index := strings.Index(path, string(os.PathListSeparator)); index >= 0 {
+			path = path[:index]
+		}
+	}
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to locate kubeconfig: %w", err)
+		}
+		path = filepath.Join(home, ".kube", "config")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubeconfig: %w", err)
+	}
+
+	var kc kubeconfig
+	if err := yaml.Unmarshal(data, &kc); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	if contextName == "" {
+		contextName = kc.CurrentContext
+	}
+
+	var clusterName, userName, namespace string
+	found := false
+	for _, c := range kc.Contexts {
+		if c.Name == contextName {
+			clusterName, userName, namespace = c.Context.Cluster, c.Context.User, c.Context.Namespace
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("context %q not found in kubeconfig", contextName)
+	}
+
+	creds := &credentials{
+		namespace: namespace,
+		tlsConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+
+	for _, c := range kc.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		creds.host = c.Cluster.Server
+		creds.tlsConfig.InsecureSkipVerify = c.Cluster.InsecureSkipTLSVerify
+		if c.Cluster.CertificateAuthority != "" || c.Cluster.CertificateAuthorityData != "" {
+			pool, err := loadCertPool(c.Cluster.CertificateAuthority, c.Cluster.CertificateAuthorityData)
+			if err != nil {
+				return nil, err
+			}
+			creds.tlsConfig.RootCAs = pool
+		}
+	}
+	if creds.host == "" {
+		return nil, fmt.Errorf("cluster %q not found in kubeconfig", clusterName)
+	}
+
+	for _, u := range kc.Users {
+		if u.Name != userName {
+			continue
+		}
+		creds.token = u.User.Token
+		creds.username = u.User.Username
+		creds.password = u.User.Password
+
+		if creds.token == "" && u.User.TokenFile != "" {
+			token, err := os.ReadFile(u.User.TokenFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read token file: %w", err)
+			}
+			creds.token = strings.TrimSpace(string(token))
+		}
+
+		certPEM, err := readFileOrData(u.User.ClientCertificate, u.User.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		keyPEM, err := readFileOrData(u.User.ClientKey, u.User.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client key: %w", err)
+		}
+		if len(certPEM) > 0 && len(keyPEM) > 0 {
+			cert, err := tls.X509KeyPair(certPEM, keyPEM)
+			if err != nil {
+				return nil, fmt.Errorf("invalid client certificate: %w", err)
+			}
+			creds.tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	return creds, nil
+}
+
+// loadCertPool builds a certificate pool from a PEM file or base64 encoded PEM data
+func loadCertPool(path, data string) (*x509.CertPool, error) {
+	pemData, err := readFileOrData(path, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate authority: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemData) {
+		return nil, fmt.Errorf("no valid certificates found in certificate authority")
+	}
+	return pool, nil
+}
+
+// readFileOrData returns base64 decoded data if present, otherwise the contents of the file at path
+func readFileOrData(path, data string) ([]byte, error) {
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	if path != "" {
+		return os.ReadFile(path)
+	}
+	return nil, nil
+}
@@ -0,0 +1,105 @@
+package kubernetes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://dev.example.com:6443/
+    insecure-skip-tls-verify: true
+- name: prod-cluster
+  cluster:
+    server: https://prod.example.com:6443
+users:
+- name: dev-user
+  user:
+    token: dev-token
+- name: prod-user
+  user:
+    username: admin
+    password: secret
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+    namespace: payments
+- name: prod
+  context:
+    cluster: prod-cluster
+    user: prod-user
+`
+
+func TestLoadKubeconfigCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(testKubeconfig), 0600))
+
+	creds, err := loadCredentials(&Config{KubeconfigPath: path})
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.example.com:6443", creds.host)
+	assert.Equal(t, "dev-token", creds.token)
+	assert.Equal(t, "payments", creds.namespace)
+	assert.True(t, creds.tlsConfig.InsecureSkipVerify)
+
+	creds, err = loadCredentials(&Config{KubeconfigPath: path, Context: "prod"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com:6443", creds.host)
+	assert.Equal(t, "admin", creds.username)
+	assert.Equal(t, "secret", creds.password)
+
+	creds, err = loadCredentials(&Config{KubeconfigPath: path, Context: "prod", Token: "override"})
+	require.NoError(t, err)
+	assert.Equal(t, "override", creds.token)
+
+	_, err = loadCredentials(&Config{KubeconfigPath: path, Context: "missing"})
+	assert.Error(t, err)
+}
+
+func TestLoadInClusterCredentials(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte("sa-token\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "namespace"), []byte("mcp"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.crt"), []byte(testCA), 0600))
+
+	original := serviceAccountDir
+	serviceAccountDir = dir
+	defer func() { serviceAccountDir = original }()
+
+	t.Setenv("KUBERNETES_SERVICE_HOST", "10.96.0.1")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "443")
+
+	creds, err := loadCredentials(&Config{InCluster: true})
+	require.NoError(t, err)
+	assert.Equal(t, "https://10.96.0.1:443", creds.host)
+	assert.Equal(t, "sa-token", creds.token)
+	assert.Equal(t, "mcp", creds.namespace)
+	assert.NotNil(t, creds.tlsConfig.RootCAs)
+
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	_, err = loadCredentials(&Config{InCluster: true})
+	assert.Error(t, err)
+}
+
+// testCA is a self-signed certificate used only to exercise CA loading
+const testCA = `-----BEGIN CERTIFICATE-----
+MIIBhTCCASugAwIBAgIQIRi6zePL6mKjOipn+dNuaTAKBggqhkjOPQQDAjASMRAw
+DgYDVQQKEwdBY21lIENvMB4XDTE3MTAyMDE5NDMwNloXDTE4MTAyMDE5NDMwNlow
+EjEQMA4GA1UEChMHQWNtZSBDbzBZMBMGByqGSM49AgEGCCqGSM49AwEHA0IABD0d
+7VNhbWvZLWPuj/RtHFjvtJBEwOkhbN/BnnE8rnZR8+sbwnc/KhCk3FhnpHZnQz7B
+5aETbbIgmuvewdjvSBSjYzBhMA4GA1UdDwEB/wQEAwICpDATBgNVHSUEDDAKBggr
+BgEFBQcDATAPBgNVHRMBAf8EBTADAQH/MCkGA1UdEQQiMCCCDmxvY2FsaG9zdDo1
+NDUzgg4xMjcuMC4wLjE6NTQ1MzAKBggqhkjOPQQDAgNIADBFAiEA2zpJEPQyz6/l
+Wf86aX6PepsntZv2GYlA5UpabfT2EZICICpJ5h/iI+i341gBmLiAFQOyTDT+/wQc
+6MF9+Yw1Yy0t
+-----END CERTIFICATE-----
+`
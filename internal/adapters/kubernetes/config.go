@@ -0,0 +1,48 @@
+package kubernetes
+
+import (
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/safety"
+)
+
+// Config holds configuration for the Kubernetes adapter
+type Config struct {
+	// Authentication settings. When InCluster is set the pod's service account
+	// is used, otherwise credentials are loaded from the kubeconfig file.
+	InCluster      bool   `mapstructure:"in_cluster"`
+	KubeconfigPath string `mapstructure:"kubeconfig"`
+	Context        string `mapstructure:"context"`
+
+	// Explicit connection settings, which take precedence over the kubeconfig
+	Host                  string `mapstructure:"host"`
+	Token                 string `mapstructure:"token"`
+	CAFile                string `mapstructure:"ca_file"`
+	InsecureSkipTLSVerify bool   `mapstructure:"insecure_skip_tls_verify"`
+
+	// Connection settings
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// Query settings
+	DefaultNamespace string `mapstructure:"default_namespace"`
+	DefaultListLimit int    `mapstructure:"default_list_limit"`
+	MaxLogLines      int    `mapstructure:"max_log_lines"`
+
+	// Bearer token expected in the Authorization header of event exporter
+	// webhooks
+	WebhookToken string `mapstructure:"webhook_token"`
+
+	// Safety settings
+	ProtectedNamespaces []string `mapstructure:"protected_namespaces"`
+}
+
+// DefaultConfig returns a default configuration for the Kubernetes adapter
+func DefaultConfig() *Config {
+	return &Config{
+		RequestTimeout:      30 * time.Second,
+		DefaultNamespace:    "default",
+		DefaultListLimit:    100,
+		MaxLogLines:         500,
+		ProtectedNamespaces: append([]string(nil), safety.DefaultProtectedNamespaces...),
+	}
+}
@@ -0,0 +1,252 @@
+package kubernetes
+
+import (
+	"time"
+)
+
+// The types below are the subset of the Kubernetes API objects used by the adapter
+
+type objectMeta struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace,omitempty"`
+	UID               string            `json:"uid,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	Annotations       map[string]string `json:"annotations,omitempty"`
+	CreationTimestamp time.Time         `json:"creationTimestamp"`
+	Generation        int64             `json:"generation,omitempty"`
+	OwnerReferences   []struct {
+		Kind string `json:"kind"`
+		Name string `json:"name"`
+		UID  string `json:"uid"`
+	} `json:"ownerReferences,omitempty"`
+}
+
+type listMeta struct {
+	Continue string `json:"continue,omitempty"`
+}
+
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type container struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+type containerState struct {
+	Waiting *struct {
+		Reason  string `json:"reason"`
+		Message string `json:"message"`
+	} `json:"waiting,omitempty"`
+	Running *struct {
+		StartedAt time.Time `json:"startedAt"`
+	} `json:"running,omitempty"`
+	Terminated *struct {
+		ExitCode int32  `json:"exitCode"`
+		Reason   string `json:"reason"`
+	} `json:"terminated,omitempty"`
+}
+
+type containerStatus struct {
+	Name         string         `json:"name"`
+	Image        string         `json:"image"`
+	Ready        bool           `json:"ready"`
+	RestartCount int32          `json:"restartCount"`
+	State        containerState `json:"state"`
+}
+
+type pod struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		NodeName   string      `json:"nodeName"`
+		Containers []container `json:"containers"`
+	} `json:"spec"`
+	Status struct {
+		Phase             string            `json:"phase"`
+		Reason            string            `json:"reason,omitempty"`
+		Message           string            `json:"message,omitempty"`
+		PodIP             string            `json:"podIP,omitempty"`
+		StartTime         *time.Time        `json:"startTime,omitempty"`
+		Conditions        []condition       `json:"conditions,omitempty"`
+		ContainerStatuses []containerStatus `json:"containerStatuses,omitempty"`
+	} `json:"status"`
+}
+
+type podList struct {
+	Metadata listMeta `json:"metadata"`
+	Items    []pod    `json:"items"`
+}
+
+type deployment struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		Replicas *int32 `json:"replicas"`
+		Paused   bool   `json:"paused,omitempty"`
+		Selector struct {
+			MatchLabels map[string]string `json:"matchLabels"`
+		} `json:"selector"`
+		Strategy struct {
+			Type string `json:"type"`
+		} `json:"strategy"`
+		Template struct {
+			Spec struct {
+				Containers []container `json:"containers"`
+			} `json:"spec"`
+		} `json:"template"`
+	} `json:"spec"`
+	Status struct {
+		ObservedGeneration  int64       `json:"observedGeneration"`
+		Replicas            int32       `json:"replicas"`
+		UpdatedReplicas     int32       `json:"updatedReplicas"`
+		ReadyReplicas       int32       `json:"readyReplicas"`
+		AvailableReplicas   int32       `json:"availableReplicas"`
+		UnavailableReplicas int32       `json:"unavailableReplicas"`
+		Conditions          []condition `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+type deploymentList struct {
+	Metadata listMeta     `json:"metadata"`
+	Items    []deployment `json:"items"`
+}
+
+type replicaSet struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		Replicas *int32 `json:"replicas"`
+		Template struct {
+			Spec struct {
+				Containers []container `json:"containers"`
+			} `json:"spec"`
+		} `json:"template"`
+	} `json:"spec"`
+	Status struct {
+		Replicas      int32 `json:"replicas"`
+		ReadyReplicas int32 `json:"readyReplicas"`
+	} `json:"status"`
+}
+
+type replicaSetList struct {
+	Items []replicaSet `json:"items"`
+}
+
+type event struct {
+	Metadata       objectMeta `json:"metadata"`
+	InvolvedObject struct {
+		Kind      string `json:"kind"`
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"involvedObject"`
+	Reason         string    `json:"reason"`
+	Message        string    `json:"message"`
+	Type           string    `json:"type"`
+	Count          int32     `json:"count"`
+	FirstTimestamp time.Time `json:"firstTimestamp"`
+	LastTimestamp  time.Time `json:"lastTimestamp"`
+	EventTime      time.Time `json:"eventTime"`
+	Source         struct {
+		Component string `json:"component"`
+	} `json:"source"`
+}
+
+type eventList struct {
+	Metadata listMeta `json:"metadata"`
+	Items    []event  `json:"items"`
+}
+
+type scaleSubresource struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		Replicas int32 `json:"replicas"`
+	} `json:"spec"`
+	Status struct {
+		Replicas int32 `json:"replicas"`
+	} `json:"status"`
+}
+
+// apiStatus is the error body returned by the API server
+type apiStatus struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+	Code    int    `json:"code"`
+}
+
+// PodSummary is the normalized view of a pod
+type PodSummary struct {
+	Name       string             `json:"name"`
+	Namespace  string             `json:"namespace"`
+	Phase      string             `json:"phase"`
+	Ready      string             `json:"ready"`
+	Restarts   int32              `json:"restarts"`
+	Node       string             `json:"node,omitempty"`
+	IP         string             `json:"ip,omitempty"`
+	Reason     string             `json:"reason,omitempty"`
+	Owner      string             `json:"owner,omitempty"`
+	Labels     map[string]string  `json:"labels,omitempty"`
+	Containers []ContainerSummary `json:"containers,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// ContainerSummary is the normalized view of a container in a pod
+type ContainerSummary struct {
+	Name     string `json:"name"`
+	Image    string `json:"image"`
+	Ready    bool   `json:"ready"`
+	Restarts int32  `json:"restarts"`
+	State    string `json:"state"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// DeploymentSummary is the normalized view of a deployment
+type DeploymentSummary struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace"`
+	Replicas          int32             `json:"replicas"`
+	ReadyReplicas     int32             `json:"ready_replicas"`
+	UpdatedReplicas   int32             `json:"updated_replicas"`
+	AvailableReplicas int32             `json:"available_replicas"`
+	Strategy          string            `json:"strategy,omitempty"`
+	Paused            bool              `json:"paused,omitempty"`
+	Images            []string          `json:"images,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	Conditions        []condition       `json:"conditions,omitempty"`
+	CreatedAt         time.Time         `json:"created_at"`
+}
+
+// EventSummary is the normalized view of a Kubernetes event
+type EventSummary struct {
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Object    string    `json:"object"`
+	Namespace string    `json:"namespace"`
+	Count     int32     `json:"count"`
+	Source    string    `json:"source,omitempty"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// RolloutRevision is a single revision in the rollout history of a deployment
+type RolloutRevision struct {
+	Revision    int64     `json:"revision"`
+	ReplicaSet  string    `json:"replica_set"`
+	Replicas    int32     `json:"replicas"`
+	Ready       int32     `json:"ready_replicas"`
+	Images      []string  `json:"images,omitempty"`
+	ChangeCause string    `json:"change_cause,omitempty"`
+	Current     bool      `json:"current"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// RolloutStatus describes the progress of a deployment rollout
+type RolloutStatus struct {
+	Deployment string            `json:"deployment"`
+	Namespace  string            `json:"namespace"`
+	Complete   bool              `json:"complete"`
+	Message    string            `json:"message"`
+	Revision   int64             `json:"revision"`
+	History    []RolloutRevision `json:"history,omitempty"`
+}
@@ -0,0 +1,35 @@
+package kubernetes
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// VerifyWebhookToken checks the bearer token of an event exporter webhook,
+// set in the headers of the exporter webhook receiver. If no token is
+// configured every delivery is rejected.
+func (a *KubernetesAdapter) VerifyWebhookToken(authorizationHeader string) bool {
+	if a.config.WebhookToken == "" {
+		return false
+	}
+
+	token, ok := strings.CutPrefix(authorizationHeader, "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(a.config.WebhookToken)) == 1
+}
+
+// ReceiveWebhook implements core.WebhookReceiver by checking the bearer token
+// in the Authorization header
+func (a *KubernetesAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifyWebhookToken(header.Get("Authorization")) {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid webhook token"), nil)
+	}
+	return body, nil
+}
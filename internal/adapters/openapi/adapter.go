@@ -0,0 +1,500 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sony/gobreaker"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/adapters/resilience"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// maxErrorMessageLength bounds the part of an error response included in errors
+const maxErrorMessageLength = 512
+
+// OpenAPIAdapter is an adapter generated from an OpenAPI document. Each
+// allow-listed operation is an action named by its operationId.
+type OpenAPIAdapter struct {
+	config        *Config
+	adapterType   string
+	baseURL       string
+	operations    map[string]*Operation
+	client        *http.Client
+	authenticate  authenticator
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+
+	// Resilience wrappers, nil when disabled
+	retry          *resilience.RetryConfig
+	timeout        *resilience.TimeoutConfig
+	circuitBreaker resilience.CircuitBreaker
+	rateLimiter    resilience.RateLimiter
+	bulkhead       resilience.Bulkhead
+}
+
+// New creates an adapter from the OpenAPI document of the configuration
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*OpenAPIAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("openapi_adapter")
+	}
+
+	if config.Name == "" {
+		return nil, fmt.Errorf("a name is required for an OpenAPI adapter")
+	}
+
+	data := []byte(config.Spec)
+	if config.Spec == "" {
+		if config.SpecPath == "" {
+			return nil, fmt.Errorf("an OpenAPI document or document path is required for adapter %s", config.Name)
+		}
+		var err error
+		if data, err = os.ReadFile(config.SpecPath); err != nil {
+			return nil, fmt.Errorf("failed to read OpenAPI document for adapter %s: %w", config.Name, err)
+		}
+	}
+
+	s, err := parseSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("adapter %s: %w", config.Name, err)
+	}
+
+	// Only allow-listed operations are exposed, and each must exist so that
+	// typos do not silently hide an action
+	if len(config.AllowedOperations) == 0 {
+		return nil, fmt.Errorf("adapter %s exposes no operations: allowed_operations is empty", config.Name)
+	}
+	operations := make(map[string]*Operation, len(config.AllowedOperations))
+	for _, id := range config.AllowedOperations {
+		if err, invalid := s.invalid[id]; invalid {
+			return nil, fmt.Errorf("adapter %s: operation %s cannot be exposed: %w", config.Name, id, err)
+		}
+		op, ok := s.operations[id]
+		if !ok {
+			return nil, fmt.Errorf("adapter %s: allowed operation %s is not in the OpenAPI document", config.Name, id)
+		}
+		operations[id] = op
+	}
+
+	baseURL := config.BaseURL
+	if config.MockResponses {
+		baseURL = config.MockURL
+	} else if baseURL == "" && len(s.servers) > 0 {
+		baseURL = s.servers[0]
+	}
+	if parsed, err := url.Parse(baseURL); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("adapter %s needs an absolute base URL, got %q", config.Name, baseURL)
+	}
+
+	authenticate, err := newAuthenticator(config.Security, s)
+	if err != nil {
+		return nil, fmt.Errorf("adapter %s: %w", config.Name, err)
+	}
+
+	client, err := newHTTPClient(config.Security, config.RequestTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("adapter %s: %w", config.Name, err)
+	}
+
+	adapter := &OpenAPIAdapter{
+		config:        config,
+		adapterType:   config.Name,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		operations:    operations,
+		client:        client,
+		authenticate:  authenticate,
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+	}
+	adapter.configureResilience()
+
+	return adapter, nil
+}
+
+// configureResilience creates the resilience wrappers enabled in the configuration
+func (a *OpenAPIAdapter) configureResilience() {
+	settings := a.config.Resilience
+
+	if settings.Retry.Enabled {
+		a.retry = &resilience.RetryConfig{
+			MaxRetries:      settings.Retry.MaxRetries,
+			InitialInterval: settings.Retry.InitialInterval,
+			MaxInterval:     settings.Retry.MaxInterval,
+			Multiplier:      settings.Retry.Multiplier,
+			MaxElapsedTime:  settings.Retry.MaxElapsedTime,
+			RetryIfFn:       adapterErrors.IsRetryable,
+		}
+	}
+
+	if settings.Timeout.Enabled {
+		a.timeout = &resilience.TimeoutConfig{
+			Timeout:     settings.Timeout.Timeout,
+			GracePeriod: settings.Timeout.GracePeriod,
+		}
+	}
+
+	if settings.CircuitBreaker.Enabled {
+		failureRatio := settings.CircuitBreaker.FailureRatio
+		a.circuitBreaker = resilience.NewCircuitBreaker(resilience.CircuitBreakerConfig{
+			Name:        a.adapterType,
+			MaxRequests: settings.CircuitBreaker.MaxRequests,
+			Interval:    settings.CircuitBreaker.Interval,
+			Timeout:     settings.CircuitBreaker.Timeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.Requests >= 5 && float64(counts.TotalFailures)/float64(counts.Requests) >= failureRatio
+			},
+			// Client errors such as invalid parameters say nothing about the health of the service
+			IsSuccessful: func(err error) bool {
+				return err == nil || !adapterErrors.IsRetryable(err)
+			},
+		})
+	}
+
+	if settings.RateLimiter.Enabled {
+		a.rateLimiter = resilience.NewRateLimiter(resilience.RateLimiterConfig{
+			Name:      a.adapterType,
+			Rate:      settings.RateLimiter.Rate,
+			Burst:     settings.RateLimiter.Burst,
+			WaitLimit: settings.RateLimiter.WaitLimit,
+		})
+	}
+
+	if settings.Bulkhead.Enabled {
+		a.bulkhead = resilience.NewBulkhead(resilience.BulkheadConfig{
+			Name:           a.adapterType,
+			MaxConcurrent:  settings.Bulkhead.MaxConcurrent,
+			MaxWaitingTime: settings.Bulkhead.MaxWaitingTime,
+		})
+	}
+}
+
+// Type returns the adapter type, which is the configured name
+func (a *OpenAPIAdapter) Type() string {
+	return a.adapterType
+}
+
+// Version returns the adapter version
+func (a *OpenAPIAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Operations returns the exposed operations, sorted by operationId
+func (a *OpenAPIAdapter) Operations() []Operation {
+	operations := make([]Operation, 0, len(a.operations))
+	for _, op := range a.operations {
+		operations = append(operations, *op)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].ID < operations[j].ID
+	})
+	return operations
+}
+
+// Operation returns an exposed operation by operationId
+func (a *OpenAPIAdapter) Operation(id string) (Operation, bool) {
+	op, ok := a.operations[id]
+	if !ok {
+		return Operation{}, false
+	}
+	return *op, true
+}
+
+// Actions returns a descriptor for each exposed operation. Results are
+// responses holding the status code and the decoded body.
+func (a *OpenAPIAdapter) Actions() []core.ActionDescriptor {
+	operations := a.Operations()
+	actions := make([]core.ActionDescriptor, 0, len(operations))
+	for _, op := range operations {
+		description := op.Summary
+		if description == "" {
+			description = op.Description
+		}
+		if description == "" {
+			description = fmt.Sprintf("%s %s", op.Method, op.Path)
+		}
+
+		body := op.Result
+		if body == nil {
+			body = map[string]interface{}{}
+		}
+
+		actions = append(actions, core.ActionDescriptor{
+			Name:        op.ID,
+			Description: description,
+			Params:      op.Parameters,
+			Result: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"status_code": map[string]interface{}{"type": "integer"},
+					"body":        body,
+				},
+			},
+			Safety: safetyClass(op.Method),
+			Scopes: op.Scopes,
+		})
+	}
+	return actions
+}
+
+// safetyClass classifies an operation by its method
+func safetyClass(method string) core.SafetyClass {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return core.SafetyRead
+	case http.MethodDelete:
+		return core.SafetyDestructive
+	}
+	return core.SafetyWrite
+}
+
+// Health returns the adapter health status. The health path is requested when
+// configured; otherwise the service is degraded while the circuit breaker is open.
+func (a *OpenAPIAdapter) Health() string {
+	if a.config.HealthPath != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		prepared := &preparedRequest{method: http.MethodGet, url: a.baseURL + a.config.HealthPath}
+		if _, err := a.doRequest(ctx, "health", prepared); err != nil {
+			return fmt.Sprintf("unhealthy: %v", err)
+		}
+	}
+
+	if a.circuitBreaker != nil && a.circuitBreaker.IsOpen() {
+		return "degraded: circuit breaker open"
+	}
+
+	return "healthy"
+}
+
+// Close releases idle connections
+func (a *OpenAPIAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// ExecuteAction calls the operation with the action name as operationId
+func (a *OpenAPIAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing OpenAPI action", map[string]interface{}{
+		"adapter":   a.adapterType,
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	op, ok := a.operations[action]
+	if !ok {
+		return nil, adapterErrors.NewUnsupportedOperationError(a.adapterType, action,
+			fmt.Errorf("operation %s is not exposed by adapter %s", action, a.adapterType), nil)
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	response, err := a.call(ctx, op, params)
+	if err == nil {
+		result = response
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(a.adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// call validates the parameters of an operation and sends its request
+// through the resilience wrappers
+func (a *OpenAPIAdapter) call(ctx context.Context, op *Operation, params map[string]interface{}) (*Response, error) {
+	if err := validateParams(op, params); err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(a.adapterType, op.ID, err, nil)
+	}
+
+	prepared, err := buildRequest(a.baseURL, op, params)
+	if err != nil {
+		return nil, adapterErrors.NewInvalidRequestError(a.adapterType, op.ID, err, nil)
+	}
+
+	if a.rateLimiter != nil {
+		if err := a.rateLimiter.Wait(ctx); err != nil {
+			return nil, adapterErrors.NewRateLimitExceededError(a.adapterType, op.ID,
+				fmt.Errorf("rate limit wait failed: %w", err), nil)
+		}
+	}
+
+	run := func() (interface{}, error) {
+		return a.attempts(ctx, op, prepared)
+	}
+
+	if a.circuitBreaker != nil {
+		protected := run
+		run = func() (interface{}, error) {
+			result, err := a.circuitBreaker.Execute(protected)
+			if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+				return nil, adapterErrors.NewServiceUnavailableError(a.adapterType, op.ID,
+					fmt.Errorf("circuit breaker is open: %w", err), nil)
+			}
+			return result, err
+		}
+	}
+
+	var result interface{}
+	if a.bulkhead != nil {
+		result, err = a.bulkhead.Execute(ctx, run)
+		var adapterErr *adapterErrors.AdapterError
+		if err != nil && !errors.As(err, &adapterErr) {
+			err = adapterErrors.NewTooManyRequestsError(a.adapterType, op.ID, err, nil)
+		}
+	} else {
+		result, err = run()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*Response), nil
+}
+
+// attempts sends a request, with retries for idempotent methods
+func (a *OpenAPIAdapter) attempts(ctx context.Context, op *Operation, prepared *preparedRequest) (*Response, error) {
+	attempt := func() (*Response, error) {
+		if a.timeout == nil {
+			return a.doRequest(ctx, op.ID, prepared)
+		}
+
+		response, err := resilience.ExecuteWithTimeout(ctx, *a.timeout, func(ctx context.Context) (*Response, error) {
+			return a.doRequest(ctx, op.ID, prepared)
+		})
+		var adapterErr *adapterErrors.AdapterError
+		if err != nil && !errors.As(err, &adapterErr) {
+			err = adapterErrors.NewTimeoutError(a.adapterType, op.ID, err, nil)
+		}
+		return response, err
+	}
+
+	if a.retry == nil || !isIdempotent(op.Method) {
+		return attempt()
+	}
+
+	return resilience.RetryWithResult(ctx, *a.retry, attempt)
+}
+
+// doRequest sends a request and decodes the response
+func (a *OpenAPIAdapter) doRequest(ctx context.Context, operation string, prepared *preparedRequest) (*Response, error) {
+	req, err := prepared.newHTTPRequest(ctx)
+	if err != nil {
+		return nil, adapterErrors.NewInvalidRequestError(a.adapterType, operation, err, nil)
+	}
+	if a.authenticate != nil {
+		a.authenticate(req)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, adapterErrors.NewTimeoutError(a.adapterType, operation, err, nil)
+		}
+		return nil, adapterErrors.NewConnectionFailedError(a.adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	var reader io.Reader = resp.Body
+	if a.config.MaxResponseSize > 0 {
+		reader = io.LimitReader(resp.Body, a.config.MaxResponseSize+1)
+	}
+	respBody, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, adapterErrors.NewConnectionFailedError(a.adapterType, operation, err, nil)
+	}
+	if a.config.MaxResponseSize > 0 && int64(len(respBody)) > a.config.MaxResponseSize {
+		return nil, adapterErrors.NewUnknownError(a.adapterType, operation,
+			fmt.Errorf("response exceeds %d bytes", a.config.MaxResponseSize), nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		message := strings.TrimSpace(string(respBody))
+		if len(message) > maxErrorMessageLength {
+			message = message[:maxErrorMessageLength] + "..."
+		}
+		return nil, adapterErrors.FromHTTPStatus(a.adapterType, operation, resp.StatusCode,
+			fmt.Errorf("API returned %d: %s", resp.StatusCode, message), nil)
+	}
+
+	response := &Response{StatusCode: resp.StatusCode}
+	if len(respBody) == 0 {
+		return response, nil
+	}
+	if !isJSON(resp.Header.Get("Content-Type")) {
+		response.Body = string(respBody)
+		return response, nil
+	}
+	if err := json.Unmarshal(respBody, &response.Body); err != nil {
+		return nil, adapterErrors.NewUnknownError(a.adapterType, operation,
+			fmt.Errorf("failed to decode response: %w", err), nil)
+	}
+
+	return response, nil
+}
+
+// HandleWebhook publishes a JSON webhook sent by the service
+func (a *OpenAPIAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	var body interface{}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return adapterErrors.NewInvalidRequestError(a.adapterType, "handle_webhook",
+			fmt.Errorf("failed to parse webhook payload: %w", err), nil)
+	}
+
+	a.logger.Info("Received OpenAPI adapter webhook", map[string]interface{}{
+		"adapter":   a.adapterType,
+		"eventType": eventType,
+	})
+
+	if a.eventBus != nil {
+		event := events.NewAdapterEvent(a.adapterType, events.EventTypeWebhookReceived, body).
+			WithMetadata("eventType", eventType)
+		a.eventBus.Emit(ctx, event)
+	}
+
+	return nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *OpenAPIAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(a.adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(a.adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
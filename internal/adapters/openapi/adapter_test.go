@@ -0,0 +1,461 @@
+package openapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+const petstoreSpec = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+servers:
+  - url: https://{region}.pets.example.com/v1
+    variables:
+      region:
+        default: eu
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List all pets
+      parameters:
+        - name: limit
+          in: query
+          description: How many pets to return
+          schema:
+            type: integer
+        - name: tags
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+        - name: status
+          in: query
+          schema:
+            type: string
+            enum: [available, sold]
+      responses:
+        200:
+          description: A list of pets
+    post:
+      operationId: createPet
+      requestBody:
+        required: true
+        description: The pet to create
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      security:
+        - petKey: []
+          petOAuth: [pets:write]
+      responses:
+        201:
+          description: Created
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+  /pets/{petId}:
+    parameters:
+      - $ref: '#/components/parameters/PetId'
+    get:
+      operationId: showPetById
+      parameters:
+        - name: X-Request-Id
+          in: header
+          schema:
+            type: string
+      responses:
+        200:
+          description: A pet
+    delete:
+      operationId: deletePet
+      responses:
+        204:
+          description: Deleted
+  /pets/{petId}/photo:
+    post:
+      operationId: uploadPhoto
+      requestBody:
+        content:
+          multipart/form-data:
+            schema:
+              type: object
+      responses:
+        200:
+          description: Uploaded
+components:
+  parameters:
+    PetId:
+      name: petId
+      in: path
+      required: true
+      schema:
+        type: string
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      example:
+        name: Rex
+      properties:
+        name:
+          type: string
+        parent:
+          $ref: '#/components/schemas/Pet'
+  securitySchemes:
+    petKey:
+      type: apiKey
+      in: header
+      name: X-Pet-Key
+    petOAuth:
+      type: oauth2
+      flows:
+        clientCredentials:
+          tokenUrl: https://auth.example.com/token
+          scopes:
+            pets:write: Manage pets
+`
+
+// recordedRequest captures the parts of a request the tests assert on
+type recordedRequest struct {
+	method  string
+	path    string
+	query   map[string][]string
+	body    string
+	headers http.Header
+}
+
+// testServer serves canned responses by "METHOD path". Responses starting
+// with a status code, such as "503 unavailable", are sent with that status.
+type testServer struct {
+	mu        sync.Mutex
+	requests  []recordedRequest
+	responses map[string][]string
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, recordedRequest{
+		method:  r.Method,
+		path:    r.URL.EscapedPath(),
+		query:   r.URL.Query(),
+		body:    string(body),
+		headers: r.Header,
+	})
+
+	// Each response is served once; the last one is repeated
+	key := r.Method + " " + r.URL.EscapedPath()
+	queue := s.responses[key]
+	if len(queue) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+		return
+	}
+	response := queue[0]
+	if len(queue) > 1 {
+		s.responses[key] = queue[1:]
+	}
+
+	status := http.StatusOK
+	if len(response) > 4 && response[3] == ' ' && response[0] >= '1' && response[0] <= '5' {
+		switch response[:3] {
+		case "201":
+			status = http.StatusCreated
+		case "204":
+			status = http.StatusNoContent
+		case "503":
+			status = http.StatusServiceUnavailable
+		}
+		response = response[4:]
+	}
+	if strings.HasPrefix(response, "{") || strings.HasPrefix(response, "[") {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(status)
+	w.Write([]byte(response))
+}
+
+func (s *testServer) recorded() []recordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]recordedRequest(nil), s.requests...)
+}
+
+func testConfig(baseURL string) *Config {
+	config := DefaultConfig()
+	config.Name = "petstore"
+	config.Spec = petstoreSpec
+	config.BaseURL = baseURL
+	config.AllowedOperations = []string{"listPets", "createPet", "showPetById"}
+	config.Security.Authentication.Type = AuthTypeAPIKey
+	config.Security.Authentication.Settings = map[string]interface{}{"key": "secret"}
+
+	config.Resilience.Retry.InitialInterval = time.Millisecond
+	config.Resilience.Retry.MaxInterval = 5 * time.Millisecond
+	config.Resilience.RateLimiter.Enabled = false
+	return config
+}
+
+func newTestAdapter(t *testing.T, responses map[string][]string, configure func(*Config)) (*OpenAPIAdapter, *testServer) {
+	server := &testServer{responses: responses}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	config := testConfig(httpServer.URL + "/v1")
+	if configure != nil {
+		configure(config)
+	}
+
+	logger := observability.NewLogger("openapi_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+
+	return adapter, server
+}
+
+func TestOperations(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil, nil)
+	assert.Equal(t, "petstore", adapter.Type())
+
+	operations := adapter.Operations()
+	require.Len(t, operations, 3, "only allow-listed operations are exposed")
+	assert.Equal(t, "createPet", operations[0].ID)
+	_, ok := adapter.Operation("deletePet")
+	assert.False(t, ok)
+
+	listPets, ok := adapter.Operation("listPets")
+	require.True(t, ok)
+	assert.Equal(t, "GET", listPets.Method)
+	assert.Equal(t, "List all pets", listPets.Summary)
+	properties := listPets.Parameters["properties"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "integer", "description": "How many pets to return"}, properties["limit"])
+	assert.NotContains(t, listPets.Parameters, "required")
+
+	showPet, _ := adapter.Operation("showPetById")
+	assert.Equal(t, []string{"petId"}, showPet.Parameters["required"], "path item parameters apply to the operation")
+
+	createPet, _ := adapter.Operation("createPet")
+	assert.Equal(t, []string{"body"}, createPet.Parameters["required"])
+	body := createPet.Parameters["properties"].(map[string]interface{})["body"].(map[string]interface{})
+	assert.Equal(t, "The pet to create", body["description"])
+	assert.NotContains(t, body, "example")
+	parent := body["properties"].(map[string]interface{})["parent"].(map[string]interface{})
+	assert.Equal(t, "Recursive reference to #/components/schemas/Pet", parent["description"])
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 3)
+
+	createPet, ok := core.FindAction(actions, "createPet")
+	require.True(t, ok)
+	assert.Equal(t, core.SafetyWrite, createPet.Safety)
+	assert.Equal(t, []string{"pets:write"}, createPet.Scopes)
+	assert.Equal(t, "POST /pets", createPet.Description)
+	body := createPet.Result["properties"].(map[string]interface{})["body"].(map[string]interface{})
+	assert.Equal(t, "Created", body["description"])
+	assert.Equal(t, "object", body["type"])
+
+	listPets, _ := core.FindAction(actions, "listPets")
+	assert.Equal(t, core.SafetyRead, listPets.Safety)
+	assert.Equal(t, "List all pets", listPets.Description)
+	assert.NoError(t, listPets.ValidateParams(map[string]interface{}{"limit": float64(5), "status": "sold"}))
+	assert.Error(t, listPets.ValidateParams(map[string]interface{}{"status": "lost"}))
+	assert.Error(t, listPets.ValidateParams(map[string]interface{}{"color": "brown"}))
+}
+
+func TestExecuteAction(t *testing.T) {
+	adapter, server := newTestAdapter(t, map[string][]string{
+		"GET /v1/pets":       {`[{"name":"Rex"}]`},
+		"GET /v1/pets/a%2Fb": {`{"name":"Rex"}`},
+		"POST /v1/pets":      {`201 {"id":"1","name":"Rex"}`},
+	}, nil)
+	ctx := context.Background()
+
+	result, err := adapter.ExecuteAction(ctx, "ctx-1", "listPets", map[string]interface{}{
+		"limit":  float64(10),
+		"tags":   []interface{}{"dog", "cat"},
+		"status": "available",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &Response{StatusCode: http.StatusOK, Body: []interface{}{map[string]interface{}{"name": "Rex"}}}, result)
+
+	result, err = adapter.ExecuteAction(ctx, "ctx-1", "showPetById", map[string]interface{}{
+		"petId":        "a/b",
+		"X-Request-Id": "req-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "Rex"}, result.(*Response).Body)
+
+	result, err = adapter.ExecuteAction(ctx, "ctx-1", "createPet", map[string]interface{}{
+		"body": map[string]interface{}{"name": "Rex"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, result.(*Response).StatusCode)
+
+	requests := server.recorded()
+	require.Len(t, requests, 3)
+	assert.Equal(t, []string{"dog", "cat"}, requests[0].query["tags"])
+	assert.Equal(t, []string{"10"}, requests[0].query["limit"])
+	assert.Equal(t, "secret", requests[0].headers.Get("X-Pet-Key"), "the API key goes where the security scheme says")
+	assert.Equal(t, "req-1", requests[1].headers.Get("X-Request-Id"))
+	assert.JSONEq(t, `{"name":"Rex"}`, requests[2].body)
+	assert.Equal(t, "application/json", requests[2].headers.Get("Content-Type"))
+}
+
+func TestResilience(t *testing.T) {
+	t.Run("idempotent operations are retried", func(t *testing.T) {
+		adapter, server := newTestAdapter(t, map[string][]string{
+			"GET /v1/pets":  {"503 unavailable", `[]`},
+			"POST /v1/pets": {"503 unavailable", `201 {}`},
+		}, nil)
+
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "listPets", nil)
+		require.NoError(t, err)
+		assert.Len(t, server.recorded(), 2)
+
+		_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "createPet", map[string]interface{}{
+			"body": map[string]interface{}{"name": "Rex"},
+		})
+		assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeServiceUnavailable))
+		assert.Len(t, server.recorded(), 3, "POST requests are not retried")
+	})
+
+	t.Run("circuit breaker opens on server errors", func(t *testing.T) {
+		adapter, server := newTestAdapter(t, map[string][]string{
+			"GET /v1/pets": {"503 unavailable"},
+		}, func(config *Config) {
+			config.Resilience.Retry.Enabled = false
+		})
+
+		for i := 0; i < 5; i++ {
+			_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "listPets", nil)
+			require.Error(t, err)
+		}
+		assert.Equal(t, "degraded: circuit breaker open", adapter.Health())
+
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "listPets", nil)
+		assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeServiceUnavailable))
+		assert.Len(t, server.recorded(), 5, "requests are not sent while the circuit is open")
+	})
+
+	t.Run("client errors do not open the circuit", func(t *testing.T) {
+		adapter, _ := newTestAdapter(t, map[string][]string{}, nil)
+
+		for i := 0; i < 6; i++ {
+			_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "showPetById", map[string]interface{}{"petId": "missing"})
+			assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+		}
+		assert.Equal(t, "healthy", adapter.Health())
+	})
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name      string
+		configure func(*Config)
+		expected  string
+	}{
+		{
+			name:      "operation not in document",
+			configure: func(c *Config) { c.AllowedOperations = []string{"listPets", "listOwners"} },
+			expected:  "allowed operation listOwners is not in the OpenAPI document",
+		},
+		{
+			name:      "operation without JSON body",
+			configure: func(c *Config) { c.AllowedOperations = []string{"uploadPhoto"} },
+			expected:  "request body has no JSON content",
+		},
+		{
+			name:      "no operations",
+			configure: func(c *Config) { c.AllowedOperations = nil },
+			expected:  "allowed_operations is empty",
+		},
+		{
+			name:      "missing API key",
+			configure: func(c *Config) { c.Security.Authentication.Settings = nil },
+			expected:  "api_key authentication requires the key setting",
+		},
+		{
+			name: "relative server",
+			configure: func(c *Config) {
+				c.BaseURL = ""
+				c.Spec = strings.Replace(petstoreSpec, "https://{region}.pets.example.com/v1", "/v1", 1)
+			},
+			expected: "needs an absolute base URL",
+		},
+		{
+			name:      "swagger 2",
+			configure: func(c *Config) { c.Spec = `{"swagger":"2.0","paths":{}}` },
+			expected:  "only OpenAPI 3 documents are supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := testConfig("http://localhost")
+			tc.configure(config)
+
+			_, err := New(config, nil, nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expected)
+		})
+	}
+
+	config := testConfig("")
+	adapter, err := New(config, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://eu.pets.example.com/v1", adapter.baseURL, "server variables take their defaults")
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, server := newTestAdapter(t, map[string][]string{}, nil)
+	ctx := context.Background()
+
+	_, err := adapter.ExecuteAction(ctx, "ctx-1", "deletePet", map[string]interface{}{"petId": "1"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+
+	for name, params := range map[string]map[string]interface{}{
+		"unknown parameter": {"limit": 1, "color": "brown"},
+		"wrong type":        {"limit": "ten"},
+		"fractional":        {"limit": 1.5},
+		"enum":              {"status": "lost"},
+		"array items":       {"tags": []interface{}{"dog", 1}},
+	} {
+		_, err = adapter.ExecuteAction(ctx, "ctx-1", "listPets", params)
+		assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidParameter), name)
+	}
+
+	_, err = adapter.ExecuteAction(ctx, "ctx-1", "createPet", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidParameter), "the body is required")
+
+	_, err = adapter.ExecuteAction(ctx, "ctx-1", "showPetById", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidParameter), "path parameters are required")
+
+	assert.Empty(t, server.recorded(), "invalid calls are not sent")
+}
@@ -0,0 +1,125 @@
+package openapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	adapterConfig "github.com/S-Corkum/mcp-server/internal/adapters/config"
+)
+
+// authenticator sets credentials on a request
+type authenticator func(req *http.Request)
+
+// newAuthenticator returns the authenticator for the authentication settings.
+// It returns nil when security is disabled or the type is none.
+func newAuthenticator(security adapterConfig.SecurityConfig, s *spec) (authenticator, error) {
+	if !security.Enabled {
+		return nil, nil
+	}
+
+	settings := security.Authentication.Settings
+	switch strings.ToLower(security.Authentication.Type) {
+	case "", AuthTypeNone:
+		return nil, nil
+
+	case AuthTypeAPIKey:
+		key := settingString(settings, "key")
+		if key == "" {
+			return nil, fmt.Errorf("api_key authentication requires the key setting")
+		}
+
+		name, in := settingString(settings, "name"), settingString(settings, "in")
+		if name == "" {
+			name, in = s.apiKeyName, s.apiKeyIn
+		}
+		if name == "" {
+			name = "X-API-Key"
+		}
+		if in == "" {
+			in = "header"
+		}
+
+		switch in {
+		case "header":
+			return func(req *http.Request) {
+				req.Header.Set(name, key)
+			}, nil
+		case "query":
+			return func(req *http.Request) {
+				query := req.URL.Query()
+				query.Set(name, key)
+				req.URL.RawQuery = query.Encode()
+			}, nil
+		default:
+			return nil, fmt.Errorf("unsupported api_key location %q: must be header or query", in)
+		}
+
+	case AuthTypeBearer:
+		token := settingString(settings, "token")
+		if token == "" {
+			return nil, fmt.Errorf("bearer authentication requires the token setting")
+		}
+		return func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}, nil
+
+	case AuthTypeBasic:
+		username, password := settingString(settings, "username"), settingString(settings, "password")
+		if username == "" {
+			return nil, fmt.Errorf("basic authentication requires the username setting")
+		}
+		return func(req *http.Request) {
+			req.SetBasicAuth(username, password)
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported authentication type %q", security.Authentication.Type)
+	}
+}
+
+// newHTTPClient returns an HTTP client using the TLS settings
+func newHTTPClient(security adapterConfig.SecurityConfig, timeout time.Duration) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if security.Enabled && security.TLS.Enabled {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: !security.TLS.VerifyCert,
+		}
+
+		if security.TLS.CAPath != "" {
+			caData, err := os.ReadFile(security.TLS.CAPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caData) {
+				return nil, fmt.Errorf("no certificates found in %s", security.TLS.CAPath)
+			}
+			tlsConfig.RootCAs = pool
+		}
+
+		if security.TLS.CertPath != "" || security.TLS.KeyPath != "" {
+			cert, err := tls.LoadX509KeyPair(security.TLS.CertPath, security.TLS.KeyPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load client certificate: %w", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &http.Client{Timeout: timeout, Transport: transport}, nil
+}
+
+// settingString returns a string authentication setting
+func settingString(settings map[string]interface{}, key string) string {
+	value, _ := settings[key].(string)
+	return value
+}
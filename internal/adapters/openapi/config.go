@@ -0,0 +1,72 @@
+package openapi
+
+import (
+	"time"
+
+	adapterConfig "github.com/S-Corkum/mcp-server/internal/adapters/config"
+)
+
+// Authentication types supported in Security.Authentication.Type. The
+// credentials are read from Security.Authentication.Settings:
+//   - api_key: key, plus name and in ("header" or "query"). When name is not
+//     set, the first apiKey security scheme of the document is used, and
+//     otherwise the X-API-Key header.
+//   - bearer: token
+//   - basic: username and password
+//   - none: no credentials are sent
+const (
+	AuthTypeAPIKey = "api_key"
+	AuthTypeBearer = "bearer"
+	AuthTypeBasic  = "basic"
+	AuthTypeNone   = "none"
+)
+
+// Config holds configuration for an adapter generated from an OpenAPI document
+type Config struct {
+	// Name is the adapter type the document is registered under
+	Name string `mapstructure:"name"`
+
+	// SpecPath is the path of the OpenAPI 3 document, in JSON or YAML. Spec
+	// holds the document itself instead.
+	SpecPath string `mapstructure:"spec_path"`
+	Spec     string `mapstructure:"spec"`
+
+	// BaseURL overrides the first server of the document
+	BaseURL string `mapstructure:"base_url"`
+
+	// AllowedOperations lists the operation IDs exposed as actions. Other
+	// operations of the document cannot be called.
+	AllowedOperations []string `mapstructure:"allowed_operations"`
+
+	// HealthPath is requested with GET to check the service health
+	HealthPath string `mapstructure:"health_path"`
+
+	// Request settings
+	RequestTimeout  time.Duration `mapstructure:"request_timeout"`
+	MaxResponseSize int64         `mapstructure:"max_response_size"`
+
+	// Common adapter configuration
+	Resilience adapterConfig.ResilienceConfig `mapstructure:"resilience"`
+	Security   adapterConfig.SecurityConfig   `mapstructure:"security"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+}
+
+// DefaultConfig returns a default configuration for an OpenAPI adapter
+func DefaultConfig() *Config {
+	defaultAdapter := adapterConfig.DefaultAdapterConfig()
+
+	// Services differ in how they authenticate, so none is assumed
+	defaultAdapter.Security.Authentication.Type = AuthTypeNone
+
+	return &Config{
+		Name:            "openapi",
+		RequestTimeout:  30 * time.Second,
+		MaxResponseSize: 10 * 1024 * 1024,
+		Resilience:      defaultAdapter.Resilience,
+		Security:        defaultAdapter.Security,
+		MockURL:         "http://localhost:8081/mock-openapi",
+	}
+}
@@ -0,0 +1,279 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Response is the response to an operation. JSON bodies are decoded, and
+// other bodies are returned as text.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+// preparedRequest is a request built from action parameters. It is turned
+// into an http.Request for each attempt, since request bodies can only be
+// read once.
+type preparedRequest struct {
+	method      string
+	url         string
+	header      http.Header
+	body        []byte
+	contentType string
+}
+
+// newHTTPRequest returns the HTTP request for an attempt
+func (p *preparedRequest) newHTTPRequest(ctx context.Context) (*http.Request, error) {
+	var body io.Reader
+	if p.body != nil {
+		body = bytes.NewReader(p.body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, p.method, p.url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range p.header {
+		req.Header[name] = append([]string(nil), values...)
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.body != nil {
+		req.Header.Set("Content-Type", p.contentType)
+	}
+
+	return req, nil
+}
+
+// validateParams checks action parameters against the operation: unknown
+// parameters are rejected, required parameters must be set, and values must
+// match the type and enum of their schema
+func validateParams(op *Operation, params map[string]interface{}) error {
+	properties, _ := op.Parameters["properties"].(map[string]interface{})
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := properties[name]; !ok {
+			return fmt.Errorf("unknown parameter %s for operation %s", name, op.ID)
+		}
+	}
+
+	for _, param := range op.params {
+		value, ok := params[param.Name]
+		if !ok || value == nil {
+			if param.Required {
+				return fmt.Errorf("parameter %s is required", param.Name)
+			}
+			continue
+		}
+		if err := checkValue(param.Name, param.Schema, value); err != nil {
+			return err
+		}
+	}
+
+	if op.bodyRequired && params[bodyParameter] == nil {
+		return fmt.Errorf("parameter %s is required", bodyParameter)
+	}
+
+	return nil
+}
+
+// checkValue checks a parameter value against the type and enum of its
+// schema. Nested object properties are left to the service to validate.
+func checkValue(name string, schema map[string]interface{}, value interface{}) error {
+	schemaType, _ := schema["type"].(string)
+
+	valid := true
+	switch schemaType {
+	case "string":
+		_, valid = value.(string)
+	case "integer":
+		valid = isInteger(value)
+	case "number":
+		_, valid = toFloat(value)
+	case "boolean":
+		_, valid = value.(bool)
+	case "object":
+		_, valid = value.(map[string]interface{})
+	case "array":
+		items, ok := toSlice(value)
+		if !ok {
+			valid = false
+			break
+		}
+		itemSchema, _ := schema["items"].(map[string]interface{})
+		for i, item := range items {
+			if err := checkValue(fmt.Sprintf("%s[%d]", name, i), itemSchema, item); err != nil {
+				return err
+			}
+		}
+	}
+	if !valid {
+		return fmt.Errorf("parameter %s must be of type %s", name, schemaType)
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		for _, allowed := range enum {
+			if formatValue(allowed) == formatValue(value) {
+				return nil
+			}
+		}
+		allowed := make([]string, len(enum))
+		for i, v := range enum {
+			allowed[i] = formatValue(v)
+		}
+		return fmt.Errorf("parameter %s must be one of %s", name, strings.Join(allowed, ", "))
+	}
+
+	return nil
+}
+
+// buildRequest builds the request for an operation from validated action parameters
+func buildRequest(baseURL string, op *Operation, params map[string]interface{}) (*preparedRequest, error) {
+	path := op.Path
+	query := url.Values{}
+	header := http.Header{}
+
+	for _, param := range op.params {
+		value, ok := params[param.Name]
+		if !ok || value == nil {
+			continue
+		}
+
+		switch param.In {
+		case "path":
+			path = strings.ReplaceAll(path, "{"+param.Name+"}", url.PathEscape(joinValue(value)))
+		case "query":
+			if items, ok := toSlice(value); ok && param.Explode {
+				for _, item := range items {
+					query.Add(param.Name, formatValue(item))
+				}
+			} else {
+				query.Set(param.Name, joinValue(value))
+			}
+		case "header":
+			header.Set(param.Name, joinValue(value))
+		}
+	}
+
+	if strings.Contains(path, "{") {
+		return nil, fmt.Errorf("path %s has parameters that are not defined", op.Path)
+	}
+
+	requestURL := strings.TrimRight(baseURL, "/") + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	prepared := &preparedRequest{
+		method: op.Method,
+		url:    requestURL,
+		header: header,
+	}
+
+	if body, ok := params[bodyParameter]; ok && body != nil && op.hasBody {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+		prepared.body = data
+		prepared.contentType = op.bodyMediaType
+	}
+
+	return prepared, nil
+}
+
+// joinValue formats a value, joining array items with commas as with the simple style
+func joinValue(value interface{}) string {
+	items, ok := toSlice(value)
+	if !ok {
+		return formatValue(value)
+	}
+
+	formatted := make([]string, len(items))
+	for i, item := range items {
+		formatted[i] = formatValue(item)
+	}
+	return strings.Join(formatted, ",")
+}
+
+// formatValue formats a scalar value for a path, query or header parameter
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case int, int32, int64, uint, uint32, uint64:
+		return fmt.Sprint(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
+
+// toSlice returns the items of an array value
+func toSlice(value interface{}) ([]interface{}, bool) {
+	switch v := value.(type) {
+	case []interface{}:
+		return v, true
+	case []string:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return items, true
+	}
+	return nil, false
+}
+
+// toFloat returns a numeric value as a float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// isInteger returns whether a value is an integer. JSON numbers decode to
+// float64, so integral floats are accepted.
+func isInteger(value interface{}) bool {
+	f, ok := toFloat(value)
+	return ok && f == float64(int64(f))
+}
@@ -0,0 +1,586 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// methods are the operation keys of a path item, in the order operations are listed
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// bodyParameter is the action parameter holding the request body
+const bodyParameter = "body"
+
+// maxSchemaDepth bounds the inlining of schema references
+const maxSchemaDepth = 32
+
+// Operation is an operation of an OpenAPI document exposed as an action
+type Operation struct {
+	ID          string `json:"operation_id"`
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Parameters is the JSON Schema of the action parameters. Path, query and
+	// header parameters are properties by name, and the request body is the
+	// body property.
+	Parameters map[string]interface{} `json:"parameters"`
+	// Result is the JSON Schema of the body of a successful response, when
+	// the response is JSON
+	Result map[string]interface{} `json:"result,omitempty"`
+	// Scopes are the scopes of the security requirements of the operation
+	Scopes []string `json:"scopes,omitempty"`
+
+	params        []parameter
+	hasBody       bool
+	bodyRequired  bool
+	bodyMediaType string
+}
+
+// parameter is a path, query or header parameter of an operation
+type parameter struct {
+	Name     string
+	In       string
+	Required bool
+	Explode  bool
+	Schema   map[string]interface{}
+}
+
+// spec is a parsed OpenAPI document
+type spec struct {
+	root       map[string]interface{}
+	servers    []string
+	operations map[string]*Operation
+	// invalid holds the errors of operations that cannot be exposed, such as
+	// operations with XML request bodies. They only matter when allow-listed.
+	invalid map[string]error
+	// apiKeyName and apiKeyIn are taken from the first apiKey security scheme
+	apiKeyName string
+	apiKeyIn   string
+}
+
+// parseSpec parses an OpenAPI 3 document in JSON or YAML
+func parseSpec(data []byte) (*spec, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+
+	root, ok := normalize(raw).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("OpenAPI document must be an object")
+	}
+	if version, _ := root["openapi"].(string); !strings.HasPrefix(version, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q: only OpenAPI 3 documents are supported", root["openapi"])
+	}
+
+	s := &spec{
+		root:       root,
+		operations: make(map[string]*Operation),
+		invalid:    make(map[string]error),
+	}
+
+	servers, _ := root["servers"].([]interface{})
+	for _, server := range servers {
+		if serverURL, ok := serverURL(server); ok {
+			s.servers = append(s.servers, serverURL)
+		}
+	}
+
+	paths, _ := root["paths"].(map[string]interface{})
+	pathNames := make([]string, 0, len(paths))
+	for path := range paths {
+		pathNames = append(pathNames, path)
+	}
+	sort.Strings(pathNames)
+
+	for _, path := range pathNames {
+		item, err := s.object(paths[path])
+		if err != nil {
+			return nil, fmt.Errorf("path %s: %w", path, err)
+		}
+
+		for _, method := range methods {
+			operation, ok := item[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, _ := operation["operationId"].(string)
+			if id == "" {
+				// Operations without an ID cannot be allow-listed
+				continue
+			}
+			if _, exists := s.operations[id]; exists {
+				return nil, fmt.Errorf("duplicate operationId %s", id)
+			}
+			if _, exists := s.invalid[id]; exists {
+				return nil, fmt.Errorf("duplicate operationId %s", id)
+			}
+
+			op, err := s.buildOperation(id, strings.ToUpper(method), path, item, operation)
+			if err != nil {
+				s.invalid[id] = err
+				continue
+			}
+			s.operations[id] = op
+		}
+	}
+
+	s.apiKeyName, s.apiKeyIn = s.apiKeyScheme()
+
+	return s, nil
+}
+
+// buildOperation builds an operation and the JSON Schema of its parameters
+func (s *spec) buildOperation(id, method, path string, item, operation map[string]interface{}) (*Operation, error) {
+	op := &Operation{
+		ID:     id,
+		Method: method,
+		Path:   path,
+	}
+	op.Summary, _ = operation["summary"].(string)
+	op.Description, _ = operation["description"].(string)
+
+	// Operation parameters override path item parameters with the same name and location
+	params := map[string]parameter{}
+	var order []string
+	for _, list := range []interface{}{item["parameters"], operation["parameters"]} {
+		entries, _ := list.([]interface{})
+		for _, entry := range entries {
+			param, err := s.buildParameter(entry)
+			if err != nil {
+				return nil, err
+			}
+			if param.In == "cookie" {
+				continue
+			}
+			key := param.In + ":" + param.Name
+			if _, exists := params[key]; !exists {
+				order = append(order, key)
+			}
+			params[key] = param
+		}
+	}
+
+	properties := map[string]interface{}{}
+	required := []string{}
+	for _, key := range order {
+		param := params[key]
+		if param.Name == bodyParameter {
+			return nil, fmt.Errorf("parameter name %s is reserved for the request body", bodyParameter)
+		}
+		if _, exists := properties[param.Name]; exists {
+			return nil, fmt.Errorf("parameter %s is defined in more than one location", param.Name)
+		}
+
+		op.params = append(op.params, param)
+		properties[param.Name] = param.Schema
+		if param.Required {
+			required = append(required, param.Name)
+		}
+	}
+
+	if operation["requestBody"] != nil {
+		body, err := s.object(operation["requestBody"])
+		if err != nil {
+			return nil, fmt.Errorf("request body: %w", err)
+		}
+
+		content, _ := body["content"].(map[string]interface{})
+		mediaType := jsonMediaType(content)
+		if mediaType == "" {
+			return nil, fmt.Errorf("request body has no JSON content")
+		}
+
+		schema := map[string]interface{}{}
+		media, _ := content[mediaType].(map[string]interface{})
+		if media["schema"] != nil {
+			inlined, err := s.inline(media["schema"], nil)
+			if err != nil {
+				return nil, fmt.Errorf("request body: %w", err)
+			}
+			if inlinedSchema, ok := inlined.(map[string]interface{}); ok {
+				schema = inlinedSchema
+			}
+		}
+		if description, ok := body["description"].(string); ok {
+			schema = withDescription(schema, description)
+		}
+
+		op.hasBody = true
+		op.bodyMediaType = mediaType
+		op.bodyRequired, _ = body["required"].(bool)
+		properties[bodyParameter] = schema
+		if op.bodyRequired {
+			required = append(required, bodyParameter)
+		}
+	}
+
+	op.Parameters = map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		op.Parameters["required"] = required
+	}
+
+	result, err := s.resultSchema(operation["responses"])
+	if err != nil {
+		return nil, fmt.Errorf("responses: %w", err)
+	}
+	op.Result = result
+
+	security, ok := operation["security"]
+	if !ok {
+		security = s.root["security"]
+	}
+	op.Scopes = securityScopes(security)
+
+	return op, nil
+}
+
+// resultSchema returns the JSON schema of the first successful response with
+// JSON content, or nil if there is none
+func (s *spec) resultSchema(value interface{}) (map[string]interface{}, error) {
+	responses, _ := value.(map[string]interface{})
+
+	codes := make([]string, 0, len(responses))
+	for code := range responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	// Explicit codes sort before ranges such as 2XX
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		response, err := s.object(responses[code])
+		if err != nil {
+			return nil, fmt.Errorf("response %s: %w", code, err)
+		}
+
+		content, _ := response["content"].(map[string]interface{})
+		media, _ := content[jsonMediaType(content)].(map[string]interface{})
+		if media["schema"] == nil {
+			continue
+		}
+
+		inlined, err := s.inline(media["schema"], nil)
+		if err != nil {
+			return nil, fmt.Errorf("response %s: %w", code, err)
+		}
+		schema, ok := inlined.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if description, ok := response["description"].(string); ok {
+			schema = withDescription(schema, description)
+		}
+		return schema, nil
+	}
+
+	return nil, nil
+}
+
+// securityScopes returns the scopes of security requirements, in order and
+// without duplicates
+func securityScopes(value interface{}) []string {
+	requirements, _ := value.([]interface{})
+
+	var scopes []string
+	seen := map[string]bool{}
+	for _, requirement := range requirements {
+		schemes, _ := requirement.(map[string]interface{})
+		names := make([]string, 0, len(schemes))
+		for name := range schemes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			list, _ := schemes[name].([]interface{})
+			for _, scope := range list {
+				if scope, ok := scope.(string); ok && !seen[scope] {
+					seen[scope] = true
+					scopes = append(scopes, scope)
+				}
+			}
+		}
+	}
+	return scopes
+}
+
+// buildParameter builds a parameter from a parameter object or reference
+func (s *spec) buildParameter(value interface{}) (parameter, error) {
+	object, err := s.object(value)
+	if err != nil {
+		return parameter{}, fmt.Errorf("parameter: %w", err)
+	}
+
+	param := parameter{}
+	param.Name, _ = object["name"].(string)
+	param.In, _ = object["in"].(string)
+	param.Required, _ = object["required"].(bool)
+	if param.Name == "" || param.In == "" {
+		return parameter{}, fmt.Errorf("parameter must have a name and a location")
+	}
+	if param.In == "path" {
+		// Path parameters are always required
+		param.Required = true
+	}
+
+	// Query parameters are exploded by default, as with the form style
+	param.Explode = param.In == "query"
+	if explode, ok := object["explode"].(bool); ok {
+		param.Explode = explode
+	}
+
+	schema := map[string]interface{}{}
+	if object["schema"] != nil {
+		inlined, err := s.inline(object["schema"], nil)
+		if err != nil {
+			return parameter{}, fmt.Errorf("parameter %s: %w", param.Name, err)
+		}
+		if inlinedSchema, ok := inlined.(map[string]interface{}); ok {
+			schema = inlinedSchema
+		}
+	}
+	if description, ok := object["description"].(string); ok {
+		schema = withDescription(schema, description)
+	}
+	param.Schema = schema
+
+	return param, nil
+}
+
+// object returns an object of the document, following a reference
+func (s *spec) object(value interface{}) (map[string]interface{}, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an object")
+	}
+
+	seen := map[string]bool{}
+	for {
+		ref, ok := object["$ref"].(string)
+		if !ok {
+			return object, nil
+		}
+		if seen[ref] {
+			return nil, fmt.Errorf("circular reference %s", ref)
+		}
+		seen[ref] = true
+
+		target, err := s.resolve(ref)
+		if err != nil {
+			return nil, err
+		}
+		if object, ok = target.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("reference %s is not an object", ref)
+		}
+	}
+}
+
+// inline copies a schema with its references replaced by the referenced
+// schemas, so the result is self-contained. Recursive references are
+// replaced by a plain object schema.
+func (s *spec) inline(value interface{}, stack []string) (interface{}, error) {
+	if len(stack) > maxSchemaDepth {
+		return map[string]interface{}{"type": "object"}, nil
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			for _, seen := range stack {
+				if seen == ref {
+					return map[string]interface{}{
+						"type":        "object",
+						"description": "Recursive reference to " + ref,
+					}, nil
+				}
+			}
+
+			target, err := s.resolve(ref)
+			if err != nil {
+				return nil, err
+			}
+			return s.inline(target, append(stack, ref))
+		}
+
+		copied := make(map[string]interface{}, len(v))
+		for key, nested := range v {
+			// Examples can be large and are of no use to validation
+			if key == "example" || key == "examples" {
+				continue
+			}
+			inlined, err := s.inline(nested, stack)
+			if err != nil {
+				return nil, err
+			}
+			copied[key] = inlined
+		}
+		return copied, nil
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, nested := range v {
+			inlined, err := s.inline(nested, stack)
+			if err != nil {
+				return nil, err
+			}
+			copied[i] = inlined
+		}
+		return copied, nil
+	default:
+		return v, nil
+	}
+}
+
+// resolve resolves a local reference such as #/components/schemas/Pet
+func (s *spec) resolve(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported reference %s: only references within the document are supported", ref)
+	}
+
+	var current interface{} = s.root
+	for _, token := range strings.Split(ref[2:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unresolvable reference %s", ref)
+		}
+		if current, ok = object[token]; !ok {
+			return nil, fmt.Errorf("unresolvable reference %s", ref)
+		}
+	}
+
+	return current, nil
+}
+
+// apiKeyScheme returns the parameter name and location of the first apiKey
+// security scheme, by scheme name
+func (s *spec) apiKeyScheme() (string, string) {
+	components, _ := s.root["components"].(map[string]interface{})
+	schemes, _ := components["securitySchemes"].(map[string]interface{})
+
+	names := make([]string, 0, len(schemes))
+	for name := range schemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		scheme, err := s.object(schemes[name])
+		if err != nil || scheme["type"] != "apiKey" {
+			continue
+		}
+		keyName, _ := scheme["name"].(string)
+		keyIn, _ := scheme["in"].(string)
+		if keyName != "" && (keyIn == "header" || keyIn == "query") {
+			return keyName, keyIn
+		}
+	}
+
+	return "", ""
+}
+
+// serverURL returns the URL of a server object with its variables set to their defaults
+func serverURL(value interface{}) (string, bool) {
+	server, ok := value.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	result, ok := server["url"].(string)
+	if !ok || result == "" {
+		return "", false
+	}
+
+	variables, _ := server["variables"].(map[string]interface{})
+	for name, variable := range variables {
+		v, _ := variable.(map[string]interface{})
+		if def, ok := v["default"]; ok {
+			result = strings.ReplaceAll(result, "{"+name+"}", fmt.Sprint(def))
+		}
+	}
+
+	return result, true
+}
+
+// normalize converts the maps decoded from YAML to maps with string keys, as
+// decoded from JSON. YAML allows keys such as response codes to be integers.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			v[key] = normalize(nested)
+		}
+		return v
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, nested := range v {
+			converted[fmt.Sprint(key)] = normalize(nested)
+		}
+		return converted
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = normalize(nested)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// withDescription returns a copy of a schema with a description, unless the
+// schema has its own
+func withDescription(schema map[string]interface{}, description string) map[string]interface{} {
+	copied := make(map[string]interface{}, len(schema)+1)
+	for key, value := range schema {
+		copied[key] = value
+	}
+	if _, ok := copied["description"]; !ok {
+		copied["description"] = description
+	}
+	return copied
+}
+
+// jsonMediaType returns the JSON media type of request body content,
+// preferring application/json
+func jsonMediaType(content map[string]interface{}) string {
+	if _, ok := content["application/json"]; ok {
+		return "application/json"
+	}
+
+	mediaTypes := make([]string, 0, len(content))
+	for mediaType := range content {
+		if isJSON(mediaType) {
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+	}
+	if len(mediaTypes) == 0 {
+		return ""
+	}
+	sort.Strings(mediaTypes)
+	return mediaTypes[0]
+}
+
+// isJSON returns whether a media type is JSON
+func isJSON(mediaType string) bool {
+	mediaType = strings.ToLower(strings.TrimSpace(strings.Split(mediaType, ";")[0]))
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// isIdempotent returns whether requests with a method can be retried safely
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace:
+		return true
+	}
+	return false
+}
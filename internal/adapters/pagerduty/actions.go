@@ -0,0 +1,212 @@
+package pagerduty
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// incidentIDParam is the schema of the ID identifying an incident
+var incidentIDParam = map[string]interface{}{
+	"type":        "string",
+	"description": "Incident ID, such as PT4KHLK",
+	"minLength":   1,
+}
+
+// fromParam is the schema of the email address write requests are made as
+var fromParam = map[string]interface{}{
+	"type":        "string",
+	"description": "Email address of the PagerDuty user making the change. Defaults to the configured address",
+}
+
+// limitParam is the schema of the page size of list actions
+var limitParam = map[string]interface{}{
+	"type":        "integer",
+	"description": "Maximum number of items to return",
+	"minimum":     1,
+	"maximum":     100,
+}
+
+// timeParam is the schema of an ISO 8601 time bound
+func timeParam(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"description": description,
+	}
+}
+
+// stringOrList is the schema of params taking a string or a list of strings
+func stringOrList(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        []interface{}{"string", "array"},
+		"description": description,
+		"items":       map[string]interface{}{"type": "string"},
+	}
+}
+
+// Actions returns the descriptors of the supported PagerDuty actions
+func (a *PagerDutyAdapter) Actions() []core.ActionDescriptor {
+	updateParams := map[string]interface{}{
+		"incident_id":  incidentIDParam,
+		"incident_ids": stringOrList("Incident IDs, to update several incidents at once"),
+		"from":         fromParam,
+	}
+	resolveParams := map[string]interface{}{
+		"resolution": map[string]interface{}{
+			"type":        "string",
+			"description": "Resolution note",
+		},
+	}
+
+	return []core.ActionDescriptor{
+		{
+			Name:        "list_incidents",
+			Description: "List incidents, by default those that are not resolved",
+			Params: paramsSchema(nil, map[string]interface{}{
+				"statuses":    stringOrList("Statuses to return: triggered, acknowledged or resolved"),
+				"urgencies":   stringOrList("Urgencies to return: high or low"),
+				"service_ids": stringOrList("IDs of the services to return incidents of"),
+				"team_ids":    stringOrList("IDs of the teams to return incidents of"),
+				"since":       timeParam("Start of the time range, in ISO 8601 format"),
+				"until":       timeParam("End of the time range, in ISO 8601 format"),
+				"limit":       limitParam,
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"incidents.read"},
+		},
+		{
+			Name:        "get_incident",
+			Description: "Get an incident",
+			Params: paramsSchema([]string{"incident_id"}, map[string]interface{}{
+				"incident_id": incidentIDParam,
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"incidents.read"},
+		},
+		{
+			Name:        "acknowledge_incident",
+			Description: "Acknowledge one or more incidents",
+			Params:      paramsSchema(nil, updateParams),
+			Safety:      core.SafetyWrite,
+			Scopes:      []string{"incidents.write"},
+		},
+		{
+			Name:        "resolve_incident",
+			Description: "Resolve one or more incidents",
+			Params:      paramsSchema(nil, updateParams, resolveParams),
+			Safety:      core.SafetyWrite,
+			Scopes:      []string{"incidents.write"},
+		},
+		{
+			Name:        "list_notes",
+			Description: "List the notes on an incident",
+			Params: paramsSchema([]string{"incident_id"}, map[string]interface{}{
+				"incident_id": incidentIDParam,
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"incidents.read"},
+		},
+		{
+			Name:        "add_note",
+			Description: "Add a note to an incident",
+			Params: paramsSchema([]string{"incident_id", "content"}, map[string]interface{}{
+				"incident_id": incidentIDParam,
+				"content": map[string]interface{}{
+					"type":        "string",
+					"description": "Note text",
+					"minLength":   1,
+				},
+				"from": fromParam,
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"incidents.write"},
+		},
+		{
+			Name:        "list_oncalls",
+			Description: "List who is on call",
+			Params: paramsSchema(nil, map[string]interface{}{
+				"schedule_ids":          stringOrList("IDs of the schedules to return on-calls of"),
+				"escalation_policy_ids": stringOrList("IDs of the escalation policies to return on-calls of"),
+				"since":                 timeParam("Start of the time range, in ISO 8601 format"),
+				"until":                 timeParam("End of the time range, in ISO 8601 format"),
+				"earliest": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Return only the earliest on-call of each user and level. Defaults to true",
+				},
+				"limit": limitParam,
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"oncalls.read"},
+		},
+		{
+			Name:        "get_schedule",
+			Description: "Get a schedule and its final rendered entries",
+			Params: paramsSchema([]string{"schedule_id"}, map[string]interface{}{
+				"schedule_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Schedule ID",
+					"minLength":   1,
+				},
+				"since": timeParam("Start of the entries, in ISO 8601 format"),
+				"until": timeParam("End of the entries, in ISO 8601 format"),
+			}),
+			Safety: core.SafetyRead,
+			Scopes: []string{"schedules.read"},
+		},
+		{
+			Name:        "send_event",
+			Description: "Trigger, acknowledge or resolve an alert with the Events API v2",
+			Params: paramsSchema(nil, map[string]interface{}{
+				"event_action": map[string]interface{}{
+					"type":        "string",
+					"description": "Event action. Defaults to trigger",
+					"enum":        []interface{}{"trigger", "acknowledge", "resolve"},
+				},
+				"routing_key": map[string]interface{}{
+					"type":        "string",
+					"description": "Integration key of the service. Defaults to the configured key",
+				},
+				"dedup_key": map[string]interface{}{
+					"type":        "string",
+					"description": "Key identifying the alert, required to acknowledge or resolve it",
+				},
+				"summary": map[string]interface{}{
+					"type":        "string",
+					"description": "Alert summary, required to trigger an alert",
+				},
+				"source": map[string]interface{}{
+					"type":        "string",
+					"description": "Affected system. Defaults to mcp-server",
+				},
+				"severity": map[string]interface{}{
+					"type":        "string",
+					"description": "Alert severity. Defaults to error",
+					"enum":        []interface{}{"critical", "error", "warning", "info"},
+				},
+				"component":      map[string]interface{}{"type": "string", "description": "Affected component"},
+				"group":          map[string]interface{}{"type": "string", "description": "Logical group of components"},
+				"class":          map[string]interface{}{"type": "string", "description": "Class of the event"},
+				"custom_details": map[string]interface{}{"type": "object", "description": "Additional details of the alert"},
+			}),
+			Safety: core.SafetyWrite,
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,774 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/safety"
+)
+
+// adapterType is the unique identifier for the PagerDuty adapter
+const adapterType = "pagerduty"
+
+// restAcceptHeader selects version 2 of the REST API
+const restAcceptHeader = "application/vnd.pagerduty+json;version=2"
+
+// ContextRecorder records webhook events in MCP contexts. It is implemented
+// by the core engine, which sets itself as the recorder when the adapter is
+// registered.
+type ContextRecorder = core.ContextRecorder
+
+// PagerDutyAdapter provides an adapter for PagerDuty incidents, notes and
+// on-call schedules
+type PagerDutyAdapter struct {
+	config        *Config
+	baseURL       string
+	eventsURL     string
+	client        *http.Client
+	checker       safety.Checker
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+
+	// incidentContexts maps incident IDs to the context their webhooks are recorded in
+	contextMutex     sync.RWMutex
+	contextRecorder  ContextRecorder
+	incidentContexts map[string]string
+}
+
+// New creates a new PagerDuty adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*PagerDutyAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("pagerduty_adapter")
+	}
+
+	baseURL, eventsURL := config.BaseURL, config.EventsURL
+	if config.MockResponses {
+		baseURL, eventsURL = config.MockURL, config.MockURL
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("pagerduty base URL is required")
+	}
+
+	return &PagerDutyAdapter{
+		config:           config,
+		baseURL:          strings.TrimRight(baseURL, "/"),
+		eventsURL:        strings.TrimRight(eventsURL, "/"),
+		client:           &http.Client{Timeout: config.RequestTimeout},
+		checker:          safety.NewPagerDutyChecker(config.MaxBulkIncidents),
+		metricsClient:    metricsClient,
+		logger:           logger,
+		eventBus:         eventBus,
+		incidentContexts: make(map[string]string),
+	}, nil
+}
+
+// SetContextRecorder sets the recorder used to open or attach a context for
+// each incident received by webhook
+func (a *PagerDutyAdapter) SetContextRecorder(recorder ContextRecorder) {
+	a.contextMutex.Lock()
+	defer a.contextMutex.Unlock()
+	a.contextRecorder = recorder
+}
+
+// IncidentContextID returns the ID of the context webhooks for an incident are recorded in
+func (a *PagerDutyAdapter) IncidentContextID(incidentID string) (string, bool) {
+	a.contextMutex.RLock()
+	defer a.contextMutex.RUnlock()
+	contextID, ok := a.incidentContexts[incidentID]
+	return contextID, ok
+}
+
+// Type returns the adapter type
+func (a *PagerDutyAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *PagerDutyAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status
+func (a *PagerDutyAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	query := url.Values{"limit": {"1"}}
+	if err := a.doRequest(ctx, "health", http.MethodGet, "/abilities", query, nil, "", nil); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+
+	if a.config.MockResponses {
+		return "healthy (mock)"
+	}
+	return "healthy"
+}
+
+// ExecuteAction executes a PagerDuty action
+func (a *PagerDutyAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing PagerDuty action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	// Incidents
+	case "list_incidents":
+		result, err = a.listIncidents(ctx, params)
+	case "get_incident":
+		result, err = a.getIncident(ctx, params)
+	case "acknowledge_incident":
+		result, err = a.updateIncidents(ctx, "acknowledge_incident", "acknowledged", params)
+	case "resolve_incident":
+		result, err = a.updateIncidents(ctx, "resolve_incident", "resolved", params)
+
+	// Notes
+	case "list_notes":
+		result, err = a.listNotes(ctx, params)
+	case "add_note":
+		result, err = a.addNote(ctx, params)
+
+	// On-call
+	case "list_oncalls":
+		result, err = a.listOnCalls(ctx, params)
+	case "get_schedule":
+		result, err = a.getSchedule(ctx, params)
+
+	// Events API v2
+	case "send_event":
+		result, err = a.sendEvent(ctx, params)
+
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported PagerDuty action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// listIncidents lists incidents, by default those that are not resolved
+func (a *PagerDutyAdapter) listIncidents(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	query := a.limitQuery(params)
+	statuses := core.StringSliceParam(params, "statuses")
+	if len(statuses) == 0 {
+		statuses = []string{"triggered", "acknowledged"}
+	}
+	for _, status := range statuses {
+		query.Add("statuses[]", status)
+	}
+	for _, urgency := range core.StringSliceParam(params, "urgencies") {
+		query.Add("urgencies[]", urgency)
+	}
+	for _, serviceID := range core.StringSliceParam(params, "service_ids") {
+		query.Add("service_ids[]", serviceID)
+	}
+	for _, teamID := range core.StringSliceParam(params, "team_ids") {
+		query.Add("team_ids[]", teamID)
+	}
+	if since := core.StringParam(params, "since"); since != "" {
+		query.Set("since", since)
+	}
+	if until := core.StringParam(params, "until"); until != "" {
+		query.Set("until", until)
+	}
+	query.Set("sort_by", "created_at:desc")
+
+	var response struct {
+		Incidents []incident `json:"incidents"`
+	}
+	if err := a.doRequest(ctx, "list_incidents", http.MethodGet, "/incidents", query, nil, "", &response); err != nil {
+		return nil, err
+	}
+
+	incidents := make([]Incident, 0, len(response.Incidents))
+	for _, i := range response.Incidents {
+		incidents = append(incidents, toIncident(i))
+	}
+
+	return incidents, nil
+}
+
+// getIncident gets an incident
+func (a *PagerDutyAdapter) getIncident(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	incidentID, err := a.incidentID("get_incident", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Incident incident `json:"incident"`
+	}
+	if err := a.doRequest(ctx, "get_incident", http.MethodGet, "/incidents/"+url.PathEscape(incidentID), nil, nil, "", &response); err != nil {
+		return nil, err
+	}
+
+	return toIncident(response.Incident), nil
+}
+
+// updateIncidents sets the status of one or more incidents after the update
+// has passed the safety checker
+func (a *PagerDutyAdapter) updateIncidents(ctx context.Context, operation, status string, params map[string]interface{}) (interface{}, error) {
+	if safe, err := a.checker.IsSafeOperation(operation, params); !safe {
+		if err == nil {
+			err = safety.ErrOperationNotAllowed
+		}
+		return nil, adapterErrors.NewForbiddenError(adapterType, operation, err, nil)
+	}
+
+	from, err := a.from(operation, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := core.StringSliceParam(params, "incident_ids")
+	if id := core.StringParam(params, "incident_id"); id != "" {
+		ids = append([]string{id}, ids...)
+	}
+
+	resolution := core.StringParam(params, "resolution")
+	references := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		reference := map[string]interface{}{
+			"id":     id,
+			"type":   "incident_reference",
+			"status": status,
+		}
+		if status == "resolved" && resolution != "" {
+			reference["resolution"] = resolution
+		}
+		references = append(references, reference)
+	}
+
+	var response struct {
+		Incidents []incident `json:"incidents"`
+	}
+	body := map[string]interface{}{"incidents": references}
+	if err := a.doRequest(ctx, operation, http.MethodPut, "/incidents", nil, body, from, &response); err != nil {
+		return nil, err
+	}
+
+	incidents := make([]Incident, 0, len(response.Incidents))
+	for _, i := range response.Incidents {
+		incidents = append(incidents, toIncident(i))
+	}
+
+	return incidents, nil
+}
+
+// listNotes lists the notes on an incident
+func (a *PagerDutyAdapter) listNotes(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	incidentID, err := a.incidentID("list_notes", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Notes []note `json:"notes"`
+	}
+	path := "/incidents/" + url.PathEscape(incidentID) + "/notes"
+	if err := a.doRequest(ctx, "list_notes", http.MethodGet, path, nil, nil, "", &response); err != nil {
+		return nil, err
+	}
+
+	notes := make([]Note, 0, len(response.Notes))
+	for _, n := range response.Notes {
+		notes = append(notes, toNote(n))
+	}
+
+	return notes, nil
+}
+
+// addNote adds a note to an incident
+func (a *PagerDutyAdapter) addNote(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	incidentID, err := a.incidentID("add_note", params)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := core.RequiredStringParam(params, "content")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "add_note", err, nil)
+	}
+
+	from, err := a.from("add_note", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Note note `json:"note"`
+	}
+	body := map[string]interface{}{"note": map[string]interface{}{"content": content}}
+	path := "/incidents/" + url.PathEscape(incidentID) + "/notes"
+	if err := a.doRequest(ctx, "add_note", http.MethodPost, path, nil, body, from, &response); err != nil {
+		return nil, err
+	}
+
+	return toNote(response.Note), nil
+}
+
+// listOnCalls lists who is on call, optionally filtered by schedule or escalation policy
+func (a *PagerDutyAdapter) listOnCalls(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	query := a.limitQuery(params)
+	for _, scheduleID := range core.StringSliceParam(params, "schedule_ids") {
+		query.Add("schedule_ids[]", scheduleID)
+	}
+	for _, policyID := range core.StringSliceParam(params, "escalation_policy_ids") {
+		query.Add("escalation_policy_ids[]", policyID)
+	}
+	if since := core.StringParam(params, "since"); since != "" {
+		query.Set("since", since)
+	}
+	if until := core.StringParam(params, "until"); until != "" {
+		query.Set("until", until)
+	}
+	if core.BoolParam(params, "earliest", true) {
+		query.Set("earliest", "true")
+	}
+
+	var response struct {
+		OnCalls []oncall `json:"oncalls"`
+	}
+	if err := a.doRequest(ctx, "list_oncalls", http.MethodGet, "/oncalls", query, nil, "", &response); err != nil {
+		return nil, err
+	}
+
+	onCalls := make([]OnCall, 0, len(response.OnCalls))
+	for _, o := range response.OnCalls {
+		entry := OnCall{
+			User:             o.User.Summary,
+			UserID:           o.User.ID,
+			Email:            o.User.Email,
+			EscalationPolicy: o.EscalationPolicy.Summary,
+			EscalationLevel:  o.EscalationLevel,
+			Start:            o.Start,
+			End:              o.End,
+		}
+		if o.Schedule != nil {
+			entry.Schedule = o.Schedule.Summary
+			entry.ScheduleID = o.Schedule.ID
+		}
+		onCalls = append(onCalls, entry)
+	}
+
+	return onCalls, nil
+}
+
+// getSchedule gets a schedule with its rendered shifts, by default for the next week
+func (a *PagerDutyAdapter) getSchedule(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	scheduleID, err := core.RequiredStringParam(params, "schedule_id")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "get_schedule", err, nil)
+	}
+
+	since := core.StringParam(params, "since")
+	until := core.StringParam(params, "until")
+	if since == "" {
+		since = time.Now().UTC().Format(time.RFC3339)
+	}
+	if until == "" {
+		until = time.Now().UTC().Add(7 * 24 * time.Hour).Format(time.RFC3339)
+	}
+	query := url.Values{"since": {since}, "until": {until}}
+
+	var response struct {
+		Schedule schedule `json:"schedule"`
+	}
+	if err := a.doRequest(ctx, "get_schedule", http.MethodGet, "/schedules/"+url.PathEscape(scheduleID), query, nil, "", &response); err != nil {
+		return nil, err
+	}
+
+	result := Schedule{
+		ID:       response.Schedule.ID,
+		Name:     response.Schedule.Name,
+		TimeZone: response.Schedule.TimeZone,
+		URL:      response.Schedule.HTMLURL,
+		Entries:  []ScheduleEntry{},
+	}
+	for _, entry := range response.Schedule.FinalSchedule.RenderedScheduleEntries {
+		result.Entries = append(result.Entries, ScheduleEntry{
+			User:   entry.User.Summary,
+			UserID: entry.User.ID,
+			Start:  entry.Start,
+			End:    entry.End,
+		})
+	}
+
+	return result, nil
+}
+
+// sendEvent sends an alert event to the Events API v2. Acknowledge and
+// resolve events must pass the safety checker.
+func (a *PagerDutyAdapter) sendEvent(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	eventAction := core.StringParam(params, "event_action")
+	if eventAction == "" {
+		eventAction = "trigger"
+	}
+
+	checked := make(map[string]interface{}, len(params)+1)
+	for k, v := range params {
+		checked[k] = v
+	}
+	checked["event_action"] = eventAction
+	if safe, err := a.checker.IsSafeOperation("send_event", checked); !safe {
+		if err == nil {
+			err = safety.ErrOperationNotAllowed
+		}
+		return nil, adapterErrors.NewForbiddenError(adapterType, "send_event", err, nil)
+	}
+
+	routingKey := core.StringParam(params, "routing_key")
+	if routingKey == "" {
+		routingKey = a.config.RoutingKey
+	}
+	if routingKey == "" {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "send_event",
+			fmt.Errorf("missing required parameter: routing_key"), nil)
+	}
+
+	body := map[string]interface{}{
+		"routing_key":  routingKey,
+		"event_action": eventAction,
+	}
+	if dedupKey := core.StringParam(params, "dedup_key"); dedupKey != "" {
+		body["dedup_key"] = dedupKey
+	}
+
+	if eventAction == "trigger" {
+		summary, err := core.RequiredStringParam(params, "summary")
+		if err != nil {
+			return nil, adapterErrors.NewInvalidParameterError(adapterType, "send_event", err, nil)
+		}
+		payload := map[string]interface{}{
+			"summary":  summary,
+			"source":   core.StringParam(params, "source"),
+			"severity": core.StringParam(params, "severity"),
+		}
+		if payload["source"] == "" {
+			payload["source"] = "mcp-server"
+		}
+		if payload["severity"] == "" {
+			payload["severity"] = "error"
+		}
+		for _, key := range []string{"component", "group", "class"} {
+			if value := core.StringParam(params, key); value != "" {
+				payload[key] = value
+			}
+		}
+		if details := core.MapParam(params, "custom_details"); details != nil {
+			payload["custom_details"] = details
+		}
+		body["payload"] = payload
+	}
+
+	var result EventResult
+	if err := a.send(ctx, "send_event", http.MethodPost, a.eventsURL+"/v2/enqueue", body, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// HandleWebhook handles a PagerDuty v3 webhook. The X-PagerDuty-Signature
+// header must be verified with VerifyWebhookSignature before the payload is
+// passed in. Incident events are recorded in a context per incident when a
+// context recorder is set.
+func (a *PagerDutyAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	event, err := ParseWebhook(payload)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook", err, nil)
+	}
+
+	if eventType == "" {
+		eventType = event.EventType
+	}
+
+	a.logger.Info("Received PagerDuty webhook", map[string]interface{}{
+		"eventType":  eventType,
+		"incidentId": event.IncidentID,
+	})
+
+	contextID, err := a.recordInContext(ctx, eventType, event)
+	if err != nil {
+		// The event is still published so that other subscribers see it
+		a.logger.Warn("Failed to record PagerDuty webhook in context", map[string]interface{}{
+			"eventType":  eventType,
+			"incidentId": event.IncidentID,
+			"error":      err.Error(),
+		})
+	}
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, event).
+			WithMetadata("eventType", eventType).
+			WithMetadata("incidentId", event.IncidentID).
+			WithMetadata("contextId", contextID)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// recordInContext records an incident webhook in the incident's context. The
+// context ID from earlier events of the same incident is passed in the payload
+// so the event is attached to it; the first event opens a new context.
+func (a *PagerDutyAdapter) recordInContext(ctx context.Context, eventType string, event *WebhookEvent) (string, error) {
+	if event.IncidentID == "" {
+		return "", nil
+	}
+
+	a.contextMutex.RLock()
+	recorder := a.contextRecorder
+	contextID := a.incidentContexts[event.IncidentID]
+	a.contextMutex.RUnlock()
+
+	if recorder == nil {
+		return "", nil
+	}
+
+	record := &IncidentContextEvent{
+		ContextID:    contextID,
+		ContextKey:   IncidentContextKey(event.IncidentID),
+		WebhookEvent: event,
+	}
+	recordedID, err := recorder.RecordWebhookInContext(ctx, a.config.AgentID, adapterType, eventType, record)
+	if err != nil {
+		return contextID, err
+	}
+
+	if recordedID != "" {
+		a.contextMutex.Lock()
+		a.incidentContexts[event.IncidentID] = recordedID
+		a.contextMutex.Unlock()
+		contextID = recordedID
+	}
+
+	return contextID, nil
+}
+
+// Close closes the adapter
+func (a *PagerDutyAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// incidentID returns the required incident_id parameter
+func (a *PagerDutyAdapter) incidentID(operation string, params map[string]interface{}) (string, error) {
+	incidentID, err := core.RequiredStringParam(params, "incident_id")
+	if err != nil {
+		return "", adapterErrors.NewInvalidParameterError(adapterType, operation, err, nil)
+	}
+	return incidentID, nil
+}
+
+// from returns the email address sent in the From header of write requests
+func (a *PagerDutyAdapter) from(operation string, params map[string]interface{}) (string, error) {
+	from := core.StringParam(params, "from")
+	if from == "" {
+		from = a.config.FromEmail
+	}
+	if from == "" {
+		return "", adapterErrors.NewMissingConfigurationError(adapterType, operation,
+			fmt.Errorf("a from email address is required for incident updates"), nil)
+	}
+	return from, nil
+}
+
+// limitQuery returns the limit query parameter
+func (a *PagerDutyAdapter) limitQuery(params map[string]interface{}) url.Values {
+	limit := core.IntParam(params, "limit", a.config.DefaultLimit)
+	if limit <= 0 || limit > 100 {
+		limit = a.config.DefaultLimit
+	}
+	return url.Values{"limit": {fmt.Sprintf("%d", limit)}}
+}
+
+// doRequest performs a REST API request and decodes the JSON response into out
+func (a *PagerDutyAdapter) doRequest(ctx context.Context, operation, method, path string, query url.Values, body interface{}, from string, out interface{}) error {
+	requestURL := a.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	header := http.Header{}
+	header.Set("Accept", restAcceptHeader)
+	if a.config.APIToken != "" {
+		header.Set("Authorization", "Token token="+a.config.APIToken)
+	}
+	if from != "" {
+		header.Set("From", from)
+	}
+
+	return a.send(ctx, operation, method, requestURL, body, header, out)
+}
+
+// send performs a request against the REST or Events API
+func (a *PagerDutyAdapter) send(ctx context.Context, operation, method, requestURL string, body interface{}, header http.Header, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("pagerduty API returned %d: %s", resp.StatusCode, errorMessage(respBody)), nil)
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode PagerDuty response: %w", err), nil)
+	}
+
+	return nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *PagerDutyAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// errorMessage extracts the message from a PagerDuty error response
+func errorMessage(body []byte) string {
+	var response struct {
+		Error struct {
+			Message string   `json:"message"`
+			Errors  []string `json:"errors"`
+		} `json:"error"`
+		// The Events API reports errors at the top level
+		Message string   `json:"message"`
+		Errors  []string `json:"errors"`
+	}
+	if json.Unmarshal(body, &response) == nil {
+		message, details := response.Error.Message, response.Error.Errors
+		if message == "" {
+			message, details = response.Message, response.Errors
+		}
+		if message != "" {
+			if len(details) > 0 {
+				message += ": " + strings.Join(details, "; ")
+			}
+			return message
+		}
+	}
+	return strings.TrimSpace(string(body))
+}
+
+func toIncident(i incident) Incident {
+	result := Incident{
+		ID:               i.ID,
+		Number:           i.IncidentNumber,
+		Title:            i.Title,
+		Status:           i.Status,
+		Urgency:          i.Urgency,
+		IncidentKey:      i.IncidentKey,
+		Service:          i.Service.Summary,
+		ServiceID:        i.Service.ID,
+		EscalationPolicy: i.EscalationPolicy.Summary,
+		URL:              i.HTMLURL,
+		CreatedAt:        i.CreatedAt,
+	}
+	if result.Title == "" {
+		result.Title = i.Summary
+	}
+	if i.Priority != nil {
+		result.Priority = i.Priority.Summary
+	}
+	if !i.LastStatusChangeAt.IsZero() {
+		changed := i.LastStatusChangeAt
+		result.LastStatusChange = &changed
+	}
+	for _, assignment := range i.Assignments {
+		result.Assignees = append(result.Assignees, assignment.Assignee.Summary)
+	}
+	for _, acknowledgement := range i.Acknowledgements {
+		result.AcknowledgedBy = append(result.AcknowledgedBy, acknowledgement.Acknowledger.Summary)
+	}
+	return result
+}
+
+func toNote(n note) Note {
+	return Note{
+		ID:        n.ID,
+		Content:   n.Content,
+		Author:    n.User.Summary,
+		CreatedAt: n.CreatedAt,
+	}
+}
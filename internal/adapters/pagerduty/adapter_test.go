@@ -0,0 +1,252 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/safety"
+)
+
+// recordedRequest captures the parts of a request the tests assert on
+type recordedRequest struct {
+	method  string
+	path    string
+	query   map[string][]string
+	body    map[string]interface{}
+	headers http.Header
+}
+
+func newTestAdapter(t *testing.T, responses map[string]string) (*PagerDutyAdapter, *[]recordedRequest) {
+	requests := []recordedRequest{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded := recordedRequest{
+			method:  r.Method,
+			path:    r.URL.Path,
+			query:   r.URL.Query(),
+			headers: r.Header,
+		}
+		json.NewDecoder(r.Body).Decode(&recorded.body)
+		requests = append(requests, recorded)
+
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"Not Found","code":2100}}`))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.BaseURL = server.URL
+	config.EventsURL = server.URL
+	config.APIToken = "test-token"
+	config.FromEmail = "oncall@example.com"
+	config.RoutingKey = "routing-key"
+	config.MaxBulkIncidents = 2
+
+	logger := observability.NewLogger("pagerduty_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+
+	return adapter, &requests
+}
+
+const incidentJSON = `{"id":"PT4KHLK","incident_number":1234,"title":"Checkout latency above SLO","status":"triggered","urgency":"high",
+	"html_url":"https://example.pagerduty.com/incidents/PT4KHLK","created_at":"2026-10-01T10:00:00Z",
+	"service":{"id":"PSVC1","summary":"checkout-api"},"escalation_policy":{"summary":"Payments"},
+	"priority":{"summary":"P1"},"assignments":[{"assignee":{"summary":"Alice"}}]}`
+
+func TestIncidents(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /incidents":         `{"incidents":[` + incidentJSON + `]}`,
+		"GET /incidents/PT4KHLK": `{"incident":` + incidentJSON + `}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_incidents", map[string]interface{}{
+		"urgencies": []interface{}{"high"},
+	})
+	require.NoError(t, err)
+
+	incidents := result.([]Incident)
+	require.Len(t, incidents, 1)
+	assert.Equal(t, 1234, incidents[0].Number)
+	assert.Equal(t, "checkout-api", incidents[0].Service)
+	assert.Equal(t, "P1", incidents[0].Priority)
+	assert.Equal(t, []string{"Alice"}, incidents[0].Assignees)
+
+	request := (*requests)[0]
+	assert.Equal(t, "Token token=test-token", request.headers.Get("Authorization"))
+	assert.Equal(t, restAcceptHeader, request.headers.Get("Accept"))
+	assert.Equal(t, []string{"triggered", "acknowledged"}, request.query["statuses[]"])
+	assert.Equal(t, []string{"high"}, request.query["urgencies[]"])
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_incident", map[string]interface{}{"incident_id": "PT4KHLK"})
+	require.NoError(t, err)
+	assert.Equal(t, "Checkout latency above SLO", result.(Incident).Title)
+}
+
+func TestIncidentUpdates(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"PUT /incidents":                `{"incidents":[` + incidentJSON + `]}`,
+		"POST /incidents/PT4KHLK/notes": `{"note":{"id":"N1","content":"Rolling back","user":{"summary":"MCP"}}}`,
+	})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "acknowledge_incident", map[string]interface{}{
+		"incident_id": "PT4KHLK",
+	})
+	require.NoError(t, err)
+
+	request := (*requests)[0]
+	assert.Equal(t, "oncall@example.com", request.headers.Get("From"))
+	update := request.body["incidents"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "acknowledged", update["status"])
+	assert.Equal(t, "incident_reference", update["type"])
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "resolve_incident", map[string]interface{}{
+		"incident_id": "PT4KHLK",
+		"resolution":  "Rolled back deploy 42",
+	})
+	require.NoError(t, err)
+	update = (*requests)[1].body["incidents"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "resolved", update["status"])
+	assert.Equal(t, "Rolled back deploy 42", update["resolution"])
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "add_note", map[string]interface{}{
+		"incident_id": "PT4KHLK",
+		"content":     "Rolling back",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "MCP", result.(Note).Author)
+	assert.Equal(t, "Rolling back", (*requests)[2].body["note"].(map[string]interface{})["content"])
+}
+
+func TestIncidentUpdatesSafety(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{})
+
+	tests := []struct {
+		name   string
+		action string
+		params map[string]interface{}
+	}{
+		{"resolve without resolution", "resolve_incident", map[string]interface{}{"incident_id": "P1"}},
+		{"too many incidents", "acknowledge_incident", map[string]interface{}{"incident_ids": []interface{}{"P1", "P2", "P3"}}},
+		{"no incident", "acknowledge_incident", map[string]interface{}{}},
+		{"resolve event without dedup key", "send_event", map[string]interface{}{"event_action": "resolve"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := adapter.ExecuteAction(context.Background(), "ctx-1", test.action, test.params)
+			require.Error(t, err)
+			assert.True(t, adapterErrors.IsAuthorizationError(err))
+			assert.ErrorIs(t, err, safety.ErrRestrictedOperation)
+		})
+	}
+
+	assert.Empty(t, *requests, "rejected updates must not reach PagerDuty")
+}
+
+func TestOnCall(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /oncalls": `{"oncalls":[{"escalation_level":1,"user":{"id":"PU1","summary":"Alice","email":"alice@example.com"},
+			"schedule":{"id":"PS1","summary":"Payments Primary"},"escalation_policy":{"summary":"Payments"}}]}`,
+		"GET /schedules/PS1": `{"schedule":{"id":"PS1","name":"Payments Primary","time_zone":"UTC",
+			"final_schedule":{"rendered_schedule_entries":[{"start":"2026-10-19T00:00:00Z","end":"2026-10-20T00:00:00Z","user":{"id":"PU1","summary":"Alice"}}]}}}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_oncalls", map[string]interface{}{
+		"schedule_ids": []interface{}{"PS1"},
+	})
+	require.NoError(t, err)
+
+	onCalls := result.([]OnCall)
+	require.Len(t, onCalls, 1)
+	assert.Equal(t, "Alice", onCalls[0].User)
+	assert.Equal(t, "Payments Primary", onCalls[0].Schedule)
+	assert.Equal(t, []string{"PS1"}, (*requests)[0].query["schedule_ids[]"])
+	assert.Equal(t, []string{"true"}, (*requests)[0].query["earliest"])
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_schedule", map[string]interface{}{"schedule_id": "PS1"})
+	require.NoError(t, err)
+	require.Len(t, result.(Schedule).Entries, 1)
+	assert.Equal(t, "Alice", result.(Schedule).Entries[0].User)
+	assert.NotEmpty(t, (*requests)[1].query["since"])
+}
+
+func TestSendEvent(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"POST /v2/enqueue": `{"status":"success","message":"Event processed","dedup_key":"disk-full-db1"}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "send_event", map[string]interface{}{
+		"summary":   "Disk full on db1",
+		"severity":  "critical",
+		"dedup_key": "disk-full-db1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "disk-full-db1", result.(EventResult).DedupKey)
+
+	request := (*requests)[0]
+	assert.Empty(t, request.headers.Get("Authorization"), "the Events API is authenticated by routing key")
+	assert.Equal(t, "routing-key", request.body["routing_key"])
+	assert.Equal(t, "trigger", request.body["event_action"])
+	assert.Equal(t, "critical", request.body["payload"].(map[string]interface{})["severity"])
+	assert.Equal(t, "mcp-server", request.body["payload"].(map[string]interface{})["source"])
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 9)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	resolve, _ := core.FindAction(actions, "resolve_incident")
+	assert.Equal(t, core.SafetyWrite, resolve.Safety)
+	assert.NoError(t, resolve.ValidateParams(map[string]interface{}{"incident_ids": []interface{}{"P1", "P2"}, "resolution": "Rolled back"}))
+	assert.Error(t, resolve.ValidateParams(map[string]interface{}{"incident_id": "P1", "note": "Rolled back"}))
+
+	sendEvent, _ := core.FindAction(actions, "send_event")
+	assert.NoError(t, sendEvent.ValidateParams(map[string]interface{}{"summary": "Disk full", "severity": "critical"}))
+	assert.Error(t, sendEvent.ValidateParams(map[string]interface{}{"summary": "Disk full", "severity": "fatal"}))
+
+	listOnCalls, _ := core.FindAction(actions, "list_oncalls")
+	assert.Equal(t, core.SafetyRead, listOnCalls.Safety)
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_incident", map[string]interface{}{"incident_id": "PMISSING"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+	assert.Contains(t, err.Error(), "Not Found")
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "add_note", map[string]interface{}{"incident_id": "P1"})
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	adapter.config.FromEmail = ""
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "acknowledge_incident", map[string]interface{}{"incident_id": "P1"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeMissingConfiguration))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "merge_incidents", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+}
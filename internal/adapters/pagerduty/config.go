@@ -0,0 +1,50 @@
+package pagerduty
+
+import (
+	"time"
+)
+
+// Config holds configuration for the PagerDuty adapter
+type Config struct {
+	// REST API settings. FromEmail is sent in the From header, which PagerDuty
+	// requires for incident updates and notes.
+	APIToken  string `mapstructure:"api_token"`
+	BaseURL   string `mapstructure:"base_url"`
+	FromEmail string `mapstructure:"from_email"`
+
+	// Events API v2 settings. RoutingKey is the integration key used when an
+	// event does not specify one.
+	EventsURL  string `mapstructure:"events_url"`
+	RoutingKey string `mapstructure:"routing_key"`
+
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// Secret used to verify the X-PagerDuty-Signature header of v3 webhooks
+	WebhookSecret string `mapstructure:"webhook_secret"`
+
+	// AgentID owns the contexts opened for incidents received by webhook
+	AgentID string `mapstructure:"agent_id"`
+
+	// Safety settings
+	MaxBulkIncidents int `mapstructure:"max_bulk_incidents"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+
+	// Query settings
+	DefaultLimit int `mapstructure:"default_limit"`
+}
+
+// DefaultConfig returns a default configuration for the PagerDuty adapter
+func DefaultConfig() *Config {
+	return &Config{
+		BaseURL:          "https://api.pagerduty.com",
+		EventsURL:        "https://events.pagerduty.com",
+		RequestTimeout:   30 * time.Second,
+		AgentID:          "incident-response",
+		MaxBulkIncidents: 5,
+		MockURL:          "http://localhost:8081/mock-pagerduty",
+		DefaultLimit:     25,
+	}
+}
@@ -0,0 +1,134 @@
+package pagerduty
+
+import (
+	"time"
+)
+
+// The types below are the subset of the PagerDuty REST API objects used by the adapter
+
+type reference struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Summary string `json:"summary"`
+	HTMLURL string `json:"html_url"`
+	Email   string `json:"email"`
+}
+
+type incident struct {
+	ID                 string    `json:"id"`
+	IncidentNumber     int       `json:"incident_number"`
+	Title              string    `json:"title"`
+	Summary            string    `json:"summary"`
+	Status             string    `json:"status"`
+	Urgency            string    `json:"urgency"`
+	IncidentKey        string    `json:"incident_key"`
+	HTMLURL            string    `json:"html_url"`
+	CreatedAt          time.Time `json:"created_at"`
+	LastStatusChangeAt time.Time `json:"last_status_change_at"`
+	Service            reference `json:"service"`
+	EscalationPolicy   reference `json:"escalation_policy"`
+	Priority           *struct {
+		Summary string `json:"summary"`
+	} `json:"priority"`
+	Assignments []struct {
+		Assignee reference `json:"assignee"`
+	} `json:"assignments"`
+	Acknowledgements []struct {
+		Acknowledger reference `json:"acknowledger"`
+		At           time.Time `json:"at"`
+	} `json:"acknowledgements"`
+}
+
+type note struct {
+	ID        string    `json:"id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	User      reference `json:"user"`
+}
+
+type oncall struct {
+	EscalationLevel  int        `json:"escalation_level"`
+	Start            *time.Time `json:"start"`
+	End              *time.Time `json:"end"`
+	User             reference  `json:"user"`
+	Schedule         *reference `json:"schedule"`
+	EscalationPolicy reference  `json:"escalation_policy"`
+}
+
+type schedule struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	TimeZone      string `json:"time_zone"`
+	HTMLURL       string `json:"html_url"`
+	FinalSchedule struct {
+		RenderedScheduleEntries []struct {
+			Start time.Time `json:"start"`
+			End   time.Time `json:"end"`
+			User  reference `json:"user"`
+		} `json:"rendered_schedule_entries"`
+	} `json:"final_schedule"`
+}
+
+// Incident is a summary of a PagerDuty incident
+type Incident struct {
+	ID               string     `json:"id"`
+	Number           int        `json:"number"`
+	Title            string     `json:"title"`
+	Status           string     `json:"status"`
+	Urgency          string     `json:"urgency"`
+	Priority         string     `json:"priority,omitempty"`
+	IncidentKey      string     `json:"incident_key,omitempty"`
+	Service          string     `json:"service"`
+	ServiceID        string     `json:"service_id"`
+	EscalationPolicy string     `json:"escalation_policy,omitempty"`
+	Assignees        []string   `json:"assignees,omitempty"`
+	AcknowledgedBy   []string   `json:"acknowledged_by,omitempty"`
+	URL              string     `json:"url"`
+	CreatedAt        time.Time  `json:"created_at"`
+	LastStatusChange *time.Time `json:"last_status_change,omitempty"`
+}
+
+// Note is a note on an incident timeline
+type Note struct {
+	ID        string    `json:"id"`
+	Content   string    `json:"content"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OnCall is an on-call entry for an escalation policy level
+type OnCall struct {
+	User             string     `json:"user"`
+	UserID           string     `json:"user_id"`
+	Email            string     `json:"email,omitempty"`
+	Schedule         string     `json:"schedule,omitempty"`
+	ScheduleID       string     `json:"schedule_id,omitempty"`
+	EscalationPolicy string     `json:"escalation_policy"`
+	EscalationLevel  int        `json:"escalation_level"`
+	Start            *time.Time `json:"start,omitempty"`
+	End              *time.Time `json:"end,omitempty"`
+}
+
+// ScheduleEntry is a shift in the final layer of a schedule
+type ScheduleEntry struct {
+	User   string    `json:"user"`
+	UserID string    `json:"user_id"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+}
+
+// Schedule is an on-call schedule with its rendered shifts
+type Schedule struct {
+	ID       string          `json:"id"`
+	Name     string          `json:"name"`
+	TimeZone string          `json:"time_zone"`
+	URL      string          `json:"url"`
+	Entries  []ScheduleEntry `json:"entries"`
+}
+
+// EventResult is the response of the Events API v2
+type EventResult struct {
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+	DedupKey string `json:"dedup_key"`
+}
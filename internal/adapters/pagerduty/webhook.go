@@ -0,0 +1,170 @@
+package pagerduty
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// WebhookSignatureHeader is the header carrying the v3 webhook signatures
+const WebhookSignatureHeader = "X-PagerDuty-Signature"
+
+// WebhookEvent is the normalized summary of a v3 webhook event
+type WebhookEvent struct {
+	ID string `json:"id"`
+	// EventType is the v3 event type, e.g. incident.triggered
+	EventType    string    `json:"event_type"`
+	ResourceType string    `json:"resource_type"`
+	OccurredAt   time.Time `json:"occurred_at"`
+	Agent        string    `json:"agent,omitempty"`
+	IncidentID   string    `json:"incident_id,omitempty"`
+	Number       int       `json:"number,omitempty"`
+	Title        string    `json:"title,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	Urgency      string    `json:"urgency,omitempty"`
+	Service      string    `json:"service,omitempty"`
+	URL          string    `json:"url,omitempty"`
+	// Note is the note content of incident.annotated events
+	Note string `json:"note,omitempty"`
+	// Data is the full event data
+	Data map[string]interface{} `json:"data"`
+}
+
+// IncidentContextEvent is the payload recorded in an incident's context.
+// ContextID is empty for the first event of an incident, in which case a new
+// context is opened; ContextKey identifies the incident across events.
+type IncidentContextEvent struct {
+	ContextID  string `json:"context_id,omitempty"`
+	ContextKey string `json:"context_key"`
+	*WebhookEvent
+}
+
+// IncidentContextKey returns the key identifying the context of an incident
+func IncidentContextKey(incidentID string) string {
+	return "pagerduty:incident:" + incidentID
+}
+
+// webhookPayload is the v3 webhook envelope
+type webhookPayload struct {
+	Event *struct {
+		ID           string    `json:"id"`
+		EventType    string    `json:"event_type"`
+		ResourceType string    `json:"resource_type"`
+		OccurredAt   time.Time `json:"occurred_at"`
+		Agent        *struct {
+			Summary string `json:"summary"`
+		} `json:"agent"`
+		Data json.RawMessage `json:"data"`
+	} `json:"event"`
+}
+
+// webhookData is the subset of incident and incident note data used for normalization
+type webhookData struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	Status  string `json:"status"`
+	Urgency string `json:"urgency"`
+	HTMLURL string `json:"html_url"`
+	Content string `json:"content"`
+	Service *struct {
+		Summary string `json:"summary"`
+	} `json:"service"`
+	// Incident is set on events about resources that belong to an incident
+	Incident *struct {
+		ID      string `json:"id"`
+		HTMLURL string `json:"html_url"`
+	} `json:"incident"`
+}
+
+// VerifyWebhookSignature checks the X-PagerDuty-Signature header against the
+// HMAC-SHA256 of the payload. The header may contain several comma separated
+// signatures while secrets are rotated; any match is accepted. If no secret is
+// configured every payload is rejected.
+func (a *PagerDutyAdapter) VerifyWebhookSignature(payload []byte, signatureHeader string) bool {
+	if a.config.WebhookSecret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(a.config.WebhookSecret))
+	mac.Write(payload)
+	expected := []byte("v1=" + hex.EncodeToString(mac.Sum(nil)))
+
+	for _, signature := range strings.Split(signatureHeader, ",") {
+		if hmac.Equal([]byte(strings.TrimSpace(signature)), expected) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReceiveWebhook implements core.WebhookReceiver by checking the v3 webhook
+// signatures of the body
+func (a *PagerDutyAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifyWebhookSignature(body, header.Get(WebhookSignatureHeader)) {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid webhook signature"), nil)
+	}
+	return body, nil
+}
+
+// ParseWebhook decodes a v3 webhook payload into a normalized event
+func ParseWebhook(payload []byte) (*WebhookEvent, error) {
+	var body webhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse PagerDuty webhook payload: %w", err)
+	}
+	if body.Event == nil || body.Event.EventType == "" {
+		return nil, fmt.Errorf("PagerDuty webhook payload is missing event_type")
+	}
+
+	event := &WebhookEvent{
+		ID:           body.Event.ID,
+		EventType:    body.Event.EventType,
+		ResourceType: body.Event.ResourceType,
+		OccurredAt:   body.Event.OccurredAt,
+	}
+	if body.Event.Agent != nil {
+		event.Agent = body.Event.Agent.Summary
+	}
+
+	if len(body.Event.Data) == 0 {
+		return event, nil
+	}
+
+	var data webhookData
+	if err := json.Unmarshal(body.Event.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse PagerDuty webhook data: %w", err)
+	}
+	if err := json.Unmarshal(body.Event.Data, &event.Data); err != nil {
+		return nil, fmt.Errorf("failed to parse PagerDuty webhook data: %w", err)
+	}
+
+	switch {
+	case data.Type == "incident":
+		event.IncidentID = data.ID
+		event.Number = data.Number
+		event.Title = data.Title
+		event.Status = data.Status
+		event.Urgency = data.Urgency
+		event.URL = data.HTMLURL
+		if data.Service != nil {
+			event.Service = data.Service.Summary
+		}
+	case data.Incident != nil:
+		event.IncidentID = data.Incident.ID
+		event.URL = data.Incident.HTMLURL
+		event.Note = data.Content
+	}
+
+	return event, nil
+}
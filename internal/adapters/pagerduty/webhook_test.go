@@ -0,0 +1,184 @@
+package pagerduty
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// eventRecorder records adapter events emitted during a test
+type eventRecorder struct {
+	events []*events.AdapterEvent
+}
+
+func (r *eventRecorder) Handle(ctx context.Context, event *events.AdapterEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+// fakeContextRecorder opens a context for payloads without a context ID and
+// attaches to the given context otherwise
+type fakeContextRecorder struct {
+	opened   int
+	recorded []*IncidentContextEvent
+	err      error
+}
+
+func (r *fakeContextRecorder) RecordWebhookInContext(ctx context.Context, agentID, adapterType, eventType string, payload interface{}) (string, error) {
+	if r.err != nil {
+		return "", r.err
+	}
+	event := payload.(*IncidentContextEvent)
+	r.recorded = append(r.recorded, event)
+	if event.ContextID != "" {
+		return event.ContextID, nil
+	}
+	r.opened++
+	return fmt.Sprintf("context-%d", r.opened), nil
+}
+
+func testWebhookPayload(eventType, data string) []byte {
+	return []byte(`{"event":{"id":"01EV","event_type":"` + eventType + `","resource_type":"incident",
+		"occurred_at":"2026-10-19T10:00:00Z","agent":{"summary":"Alice"},"data":` + data + `}}`)
+}
+
+const incidentData = `{"id":"PT4KHLK","type":"incident","number":1234,"title":"Checkout latency above SLO",
+	"status":"triggered","urgency":"high","html_url":"https://example.pagerduty.com/incidents/PT4KHLK","service":{"summary":"checkout-api"}}`
+
+const noteData = `{"id":"N1","type":"incident_note","content":"Rolling back","incident":{"id":"PT4KHLK","html_url":"https://example.pagerduty.com/incidents/PT4KHLK"}}`
+
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseWebhook(t *testing.T) {
+	event, err := ParseWebhook(testWebhookPayload("incident.triggered", incidentData))
+	require.NoError(t, err)
+	assert.Equal(t, "PT4KHLK", event.IncidentID)
+	assert.Equal(t, "high", event.Urgency)
+	assert.Equal(t, "checkout-api", event.Service)
+	assert.Equal(t, "Alice", event.Agent)
+
+	event, err = ParseWebhook(testWebhookPayload("incident.annotated", noteData))
+	require.NoError(t, err)
+	assert.Equal(t, "PT4KHLK", event.IncidentID)
+	assert.Equal(t, "Rolling back", event.Note)
+
+	_, err = ParseWebhook([]byte(`{"event":{}}`))
+	assert.Error(t, err)
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	config := DefaultConfig()
+	config.WebhookSecret = "s3cret"
+	adapter, err := New(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	payload := testWebhookPayload("incident.triggered", incidentData)
+
+	assert.True(t, adapter.VerifyWebhookSignature(payload, sign("s3cret", payload)))
+	// Several signatures are sent while secrets are rotated
+	assert.True(t, adapter.VerifyWebhookSignature(payload, sign("old", payload)+","+sign("s3cret", payload)))
+	assert.False(t, adapter.VerifyWebhookSignature(payload, sign("wrong", payload)))
+	assert.False(t, adapter.VerifyWebhookSignature(payload, ""))
+
+	adapter.config.WebhookSecret = ""
+	assert.False(t, adapter.VerifyWebhookSignature(payload, sign("", payload)), "payloads are rejected when no secret is configured")
+}
+
+func TestWebhookContexts(t *testing.T) {
+	logger := observability.NewLogger("pagerduty_test")
+	eventBus := events.NewEventBus(logger)
+	recorder := &eventRecorder{}
+	eventBus.SubscribeAll(recorder)
+
+	config := DefaultConfig()
+	config.WebhookSecret = "s3cret"
+	adapter, err := New(config, logger, nil, eventBus)
+	require.NoError(t, err)
+
+	contexts := &fakeContextRecorder{}
+	adapter.SetContextRecorder(contexts)
+
+	deliveries := [][]byte{
+		testWebhookPayload("incident.triggered", incidentData),
+		testWebhookPayload("incident.annotated", noteData),
+		testWebhookPayload("incident.resolved", incidentData),
+	}
+	for _, payload := range deliveries {
+		header := http.Header{}
+		header.Set(WebhookSignatureHeader, sign("s3cret", payload))
+		received, err := adapter.ReceiveWebhook(header, nil, payload)
+		require.NoError(t, err)
+		require.NoError(t, adapter.HandleWebhook(context.Background(), "", received))
+	}
+
+	// The first event opens the incident context and later events attach to it
+	assert.Equal(t, 1, contexts.opened)
+	require.Len(t, contexts.recorded, 3)
+	assert.Empty(t, contexts.recorded[0].ContextID)
+	assert.Equal(t, "context-1", contexts.recorded[1].ContextID)
+	assert.Equal(t, IncidentContextKey("PT4KHLK"), contexts.recorded[2].ContextKey)
+
+	contextID, ok := adapter.IncidentContextID("PT4KHLK")
+	assert.True(t, ok)
+	assert.Equal(t, "context-1", contextID)
+
+	require.Len(t, recorder.events, 3)
+	assert.Equal(t, "incident.annotated", recorder.events[1].Metadata["eventType"])
+	assert.Equal(t, "context-1", recorder.events[1].Metadata["contextId"])
+
+	// A bad signature is rejected before anything is recorded
+	header := http.Header{}
+	header.Set(WebhookSignatureHeader, sign("wrong", deliveries[0]))
+	_, err = adapter.ReceiveWebhook(header, nil, deliveries[0])
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized))
+	assert.Len(t, contexts.recorded, 3)
+}
+
+func TestWebhookContextFailure(t *testing.T) {
+	logger := observability.NewLogger("pagerduty_test")
+	eventBus := events.NewEventBus(logger)
+	recorder := &eventRecorder{}
+	eventBus.SubscribeAll(recorder)
+
+	adapter, err := New(DefaultConfig(), logger, nil, eventBus)
+	require.NoError(t, err)
+	adapter.SetContextRecorder(&fakeContextRecorder{err: fmt.Errorf("context store unavailable")})
+
+	// The webhook is still published when the context cannot be recorded
+	err = adapter.HandleWebhook(context.Background(), "", testWebhookPayload("incident.triggered", incidentData))
+	require.NoError(t, err)
+	require.Len(t, recorder.events, 1)
+	_, ok := adapter.IncidentContextID("PT4KHLK")
+	assert.False(t, ok)
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.WebhookSecret = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("pagerduty_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"incident-annotated.json": "incident.annotated",
+		"incident-triggered.json": "incident.triggered",
+	})
+}
@@ -0,0 +1,525 @@
+package plugin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// Plugin states
+const (
+	StateRunning    = "running"
+	StateRestarting = "restarting"
+	StateFailed     = "failed"
+	StateClosed     = "closed"
+)
+
+// stopTimeout bounds the time for a launched plugin to exit after it is closed
+const stopTimeout = 5 * time.Second
+
+// errClosed is returned when the adapter is closed while a plugin starts
+var errClosed = errors.New("plugin adapter is closed")
+
+// PluginAdapter is a core.Adapter backed by a plugin. It checks the health of
+// the plugin, and relaunches launched plugins that crash or stop answering.
+type PluginAdapter struct {
+	config Config
+	logger *observability.Logger
+	token  string
+
+	mu          sync.RWMutex
+	client      *client
+	process     *process
+	adapterType string
+	version     string
+	actions     []core.ActionDescriptor
+	state       string
+	lastError   string
+	// restarts counts restarts since the last successful health check
+	restarts int
+
+	closeOnce sync.Once
+	closed    chan struct{}
+	// supervised is closed when supervision stops
+	supervised chan struct{}
+}
+
+// Start launches or connects to a plugin and returns it as an adapter
+func Start(ctx context.Context, config Config, logger *observability.Logger) (*PluginAdapter, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("plugin_adapter")
+	}
+
+	token := config.Token
+	if config.Command != "" {
+		var err error
+		token, err = newToken()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	a := &PluginAdapter{
+		config:     config,
+		logger:     logger,
+		token:      token,
+		closed:     make(chan struct{}),
+		supervised: make(chan struct{}),
+	}
+
+	if err := a.start(ctx); err != nil {
+		return nil, err
+	}
+
+	go a.supervise()
+
+	return a, nil
+}
+
+// newToken returns a random token for a launched plugin
+func newToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate plugin token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// start launches or connects to the plugin and reads its type and version
+func (a *PluginAdapter) start(ctx context.Context) error {
+	var proc *process
+	address := a.config.Address
+	if a.config.Command != "" {
+		var err error
+		proc, err = startProcess(a.config, a.token, a.logger)
+		if err != nil {
+			return err
+		}
+		address = proc.address
+	}
+
+	c, err := a.handshake(ctx, address, proc)
+	if err != nil {
+		if proc != nil {
+			proc.stop(stopTimeout)
+		}
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	select {
+	case <-a.closed:
+		c.close()
+		if proc != nil {
+			proc.stop(stopTimeout)
+		}
+		return errClosed
+	default:
+	}
+
+	a.client = c
+	a.process = proc
+	a.state = StateRunning
+	a.lastError = ""
+
+	return nil
+}
+
+// handshake connects to a started plugin and reads its type and version
+func (a *PluginAdapter) handshake(ctx context.Context, address string, proc *process) (*client, error) {
+	c, err := dial(address, a.token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.config.StartTimeout)
+	defer cancel()
+
+	// Wait for plugins that are not listening yet
+	var typeResp TypeResponse
+	if err := c.call(ctx, "Type", &Empty{}, &typeResp, grpc.WaitForReady(true)); err != nil {
+		c.close()
+		return nil, fmt.Errorf("plugin at %s did not answer: %w", address, err)
+	}
+	if typeResp.Type == "" {
+		c.close()
+		return nil, fmt.Errorf("plugin at %s reported no adapter type", address)
+	}
+
+	var versionResp VersionResponse
+	if err := c.call(ctx, "Version", &Empty{}, &versionResp); err != nil {
+		c.close()
+		return nil, fmt.Errorf("plugin at %s did not report its version: %w", address, err)
+	}
+
+	// Plugins that do not implement the optional Actions call do not
+	// describe their actions
+	var actionsResp ActionsResponse
+	if err := c.call(ctx, "Actions", &Empty{}, &actionsResp); err != nil && status.Code(err) != codes.Unimplemented {
+		c.close()
+		return nil, fmt.Errorf("plugin at %s did not describe its actions: %w", address, err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.adapterType != "" && a.adapterType != typeResp.Type {
+		c.close()
+		return nil, fmt.Errorf("plugin at %s reports adapter type %s, expected %s", address, typeResp.Type, a.adapterType)
+	}
+	a.adapterType = typeResp.Type
+	a.version = versionResp.Version
+	a.actions = actionsResp.Actions
+
+	return c, nil
+}
+
+// supervise checks the health of the plugin and restarts it when it exits or
+// fails consecutive health checks, until the adapter is closed
+func (a *PluginAdapter) supervise() {
+	defer close(a.supervised)
+
+	ticker := time.NewTicker(a.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		a.mu.RLock()
+		proc := a.process
+		a.mu.RUnlock()
+
+		// Connected plugins are only supervised through health checks
+		var exited <-chan struct{}
+		if proc != nil {
+			exited = proc.exited
+		}
+
+		select {
+		case <-a.closed:
+			return
+		case <-exited:
+			a.logger.Warn("Adapter plugin exited", map[string]interface{}{
+				"plugin": a.Type(),
+				"error":  fmt.Sprint(proc.err),
+			})
+			if !a.restart(fmt.Sprintf("plugin exited: %v", proc.err)) {
+				return
+			}
+			failures = 0
+		case <-ticker.C:
+			if err := a.ping(); err != nil {
+				failures++
+				a.logger.Warn("Adapter plugin health check failed", map[string]interface{}{
+					"plugin":   a.Type(),
+					"failures": failures,
+					"error":    err.Error(),
+				})
+				if failures < a.config.FailureThreshold {
+					continue
+				}
+				if !a.restart(fmt.Sprintf("health check failed: %v", err)) {
+					return
+				}
+				failures = 0
+				continue
+			}
+
+			failures = 0
+			a.mu.Lock()
+			a.restarts = 0
+			a.mu.Unlock()
+		}
+	}
+}
+
+// ping checks that the plugin answers calls
+func (a *PluginAdapter) ping() error {
+	a.mu.RLock()
+	c := a.client
+	a.mu.RUnlock()
+
+	if c == nil {
+		return errors.New("plugin is not connected")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.CallTimeout)
+	defer cancel()
+
+	var resp HealthResponse
+	return c.call(ctx, "Health", &Empty{}, &resp)
+}
+
+// restart stops the plugin and starts it again with exponential backoff. It
+// returns false when the adapter is closed or the restarts are exhausted.
+func (a *PluginAdapter) restart(reason string) bool {
+	a.mu.Lock()
+	c, proc := a.client, a.process
+	a.client, a.process = nil, nil
+	a.state = StateRestarting
+	a.lastError = reason
+	a.mu.Unlock()
+
+	if c != nil {
+		c.close()
+	}
+	if proc != nil {
+		proc.stop(stopTimeout)
+	}
+
+	for {
+		a.mu.Lock()
+		a.restarts++
+		attempt := a.restarts
+		if a.config.MaxRestarts > 0 && attempt > a.config.MaxRestarts {
+			a.state = StateFailed
+			a.mu.Unlock()
+			a.logger.Error("Adapter plugin failed", map[string]interface{}{
+				"plugin":   a.Type(),
+				"restarts": a.config.MaxRestarts,
+				"error":    reason,
+			})
+			return false
+		}
+		a.mu.Unlock()
+
+		timer := time.NewTimer(a.backoff(attempt))
+		select {
+		case <-a.closed:
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+
+		err := a.start(context.Background())
+		if err == nil {
+			a.logger.Info("Adapter plugin restarted", map[string]interface{}{
+				"plugin":  a.Type(),
+				"attempt": attempt,
+			})
+			return true
+		}
+		if errors.Is(err, errClosed) {
+			return false
+		}
+
+		reason = err.Error()
+		a.mu.Lock()
+		a.lastError = reason
+		a.mu.Unlock()
+		a.logger.Warn("Failed to restart adapter plugin", map[string]interface{}{
+			"plugin":  a.Type(),
+			"attempt": attempt,
+			"error":   reason,
+		})
+	}
+}
+
+// backoff returns the delay before a restart attempt
+func (a *PluginAdapter) backoff(attempt int) time.Duration {
+	delay := a.config.RestartBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if a.config.MaxRestartBackoff > 0 && delay >= a.config.MaxRestartBackoff {
+			return a.config.MaxRestartBackoff
+		}
+	}
+	return delay
+}
+
+// connected returns the client of a running plugin
+func (a *PluginAdapter) connected(operation string) (*client, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.client == nil {
+		return nil, adapterErrors.NewServiceUnavailableError(a.typeLocked(), operation,
+			fmt.Errorf("plugin is %s: %s", a.state, a.lastError), nil)
+	}
+	return a.client, nil
+}
+
+// callError converts a failed call to an adapter error
+func (a *PluginAdapter) callError(operation string, err error) error {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		return adapterErrors.NewTimeoutError(a.Type(), operation, err, nil)
+	case codes.Unavailable, codes.Canceled:
+		return adapterErrors.NewServiceUnavailableError(a.Type(), operation, err, nil)
+	case codes.Unauthenticated:
+		return adapterErrors.NewUnauthorizedError(a.Type(), operation, err, nil)
+	case codes.Unimplemented:
+		return adapterErrors.NewUnsupportedOperationError(a.Type(), operation, err, nil)
+	default:
+		return adapterErrors.NewUnknownError(a.Type(), operation, err, nil)
+	}
+}
+
+// withCallTimeout applies the call timeout to contexts without a deadline
+func (a *PluginAdapter) withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, a.config.CallTimeout)
+}
+
+// Type returns the adapter type
+func (a *PluginAdapter) Type() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.typeLocked()
+}
+
+// typeLocked returns the adapter type with the lock held
+func (a *PluginAdapter) typeLocked() string {
+	if a.config.Name != "" {
+		return a.config.Name
+	}
+	return a.adapterType
+}
+
+// Version returns the version reported by the plugin
+func (a *PluginAdapter) Version() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.version
+}
+
+// Actions returns the action descriptors reported by the plugin, or nil if
+// the plugin does not describe its actions
+func (a *PluginAdapter) Actions() []core.ActionDescriptor {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.actions
+}
+
+// State returns the state of the plugin
+func (a *PluginAdapter) State() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.state
+}
+
+// Health returns the health reported by the plugin
+func (a *PluginAdapter) Health() string {
+	a.mu.RLock()
+	c, state, lastError := a.client, a.state, a.lastError
+	a.mu.RUnlock()
+
+	if c == nil {
+		return fmt.Sprintf("unhealthy: plugin %s: %s", state, lastError)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.CallTimeout)
+	defer cancel()
+
+	var resp HealthResponse
+	if err := c.call(ctx, "Health", &Empty{}, &resp); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+	return resp.Status
+}
+
+// ExecuteAction executes an action of the plugin
+func (a *PluginAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	c, err := a.connected(action)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.withCallTimeout(ctx)
+	defer cancel()
+
+	var resp ExecuteActionResponse
+	req := &ExecuteActionRequest{ContextID: contextID, Action: action, Params: params}
+	if err := c.call(ctx, "ExecuteAction", req, &resp); err != nil {
+		return nil, a.callError(action, err)
+	}
+	if resp.Error != nil {
+		return nil, resp.Error.adapterError(a.Type(), action)
+	}
+
+	if len(resp.Result) == 0 {
+		return nil, nil
+	}
+
+	var result interface{}
+	if err := json.Unmarshal(resp.Result, &result); err != nil {
+		return nil, adapterErrors.NewUnknownError(a.Type(), action, err, nil)
+	}
+	return result, nil
+}
+
+// HandleWebhook passes a webhook to the plugin
+func (a *PluginAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	operation := "webhook:" + eventType
+
+	c, err := a.connected(operation)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.withCallTimeout(ctx)
+	defer cancel()
+
+	var resp ErrorResponse
+	req := &HandleWebhookRequest{EventType: eventType, Payload: payload}
+	if err := c.call(ctx, "HandleWebhook", req, &resp); err != nil {
+		return a.callError(operation, err)
+	}
+	return resp.Error.adapterError(a.Type(), operation)
+}
+
+// Close stops supervision and closes the plugin. Launched plugins are asked
+// to close and then stopped; connected plugins are left running.
+func (a *PluginAdapter) Close() error {
+	var closeErr error
+
+	a.closeOnce.Do(func() {
+		close(a.closed)
+
+		a.mu.Lock()
+		c, proc := a.client, a.process
+		a.client, a.process = nil, nil
+		a.state = StateClosed
+		a.lastError = "adapter closed"
+		a.mu.Unlock()
+
+		if proc != nil && c != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), a.config.CallTimeout)
+			var resp ErrorResponse
+			if err := c.call(ctx, "Close", &Empty{}, &resp); err == nil {
+				closeErr = resp.Error.adapterError(a.Type(), "close")
+			}
+			cancel()
+		}
+		if c != nil {
+			c.close()
+		}
+		if proc != nil {
+			proc.stop(stopTimeout)
+		}
+
+		<-a.supervised
+	})
+
+	return closeErr
+}
@@ -0,0 +1,199 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// maxLineLength bounds the plugin output buffered while waiting for a newline
+const maxLineLength = 64 * 1024
+
+// client is a connection to a plugin
+type client struct {
+	conn  *grpc.ClientConn
+	token string
+}
+
+// dial connects to a plugin. Plugins listen on the local host or a private
+// network, so the connection is not encrypted.
+func dial(address, token string) (*client, error) {
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(jsonCodec{})),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to plugin at %s: %w", address, err)
+	}
+	return &client{conn: conn, token: token}, nil
+}
+
+// call calls a method of the AdapterPlugin service
+func (c *client) call(ctx context.Context, method string, req, resp interface{}, opts ...grpc.CallOption) error {
+	if c.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, tokenMetadataKey, c.token)
+	}
+	return c.conn.Invoke(ctx, "/"+ServiceName+"/"+method, req, resp, opts...)
+}
+
+// close closes the connection
+func (c *client) close() error {
+	return c.conn.Close()
+}
+
+// process is a launched plugin process
+type process struct {
+	cmd     *exec.Cmd
+	address string
+	// exited is closed when the process exits, after which err holds the exit error
+	exited chan struct{}
+	err    error
+}
+
+// startProcess launches a plugin and waits for its handshake
+func startProcess(config Config, token string, logger *observability.Logger) (*process, error) {
+	cmd := exec.Command(config.Command, config.Args...)
+	cmd.Env = append(os.Environ(), config.Env...)
+	cmd.Env = append(cmd.Env, TokenEnv+"="+token)
+	// Output copying must not outlive the process by much when it leaves
+	// children holding its stdout
+	cmd.WaitDelay = 5 * time.Second
+
+	handshake := make(chan string, 1)
+	var handshakeOnce sync.Once
+	cmd.Stdout = &lineWriter{onLine: func(line string) {
+		sent := false
+		handshakeOnce.Do(func() {
+			handshake <- line
+			sent = true
+		})
+		if !sent {
+			logger.Info("Adapter plugin output", map[string]interface{}{
+				"plugin": config.Name,
+				"line":   line,
+			})
+		}
+	}}
+	cmd.Stderr = &lineWriter{onLine: func(line string) {
+		logger.Warn("Adapter plugin error output", map[string]interface{}{
+			"plugin": config.Name,
+			"line":   line,
+		})
+	}}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to launch plugin %s: %w", config.Command, err)
+	}
+
+	p := &process{cmd: cmd, exited: make(chan struct{})}
+	go func() {
+		p.err = cmd.Wait()
+		close(p.exited)
+	}()
+
+	timer := time.NewTimer(config.StartTimeout)
+	defer timer.Stop()
+
+	select {
+	case line := <-handshake:
+		address, err := parseHandshake(line)
+		if err != nil {
+			p.stop(time.Second)
+			return nil, err
+		}
+		p.address = address
+		return p, nil
+	case <-p.exited:
+		return nil, fmt.Errorf("plugin %s exited before its handshake: %v", config.Command, p.err)
+	case <-timer.C:
+		p.stop(time.Second)
+		return nil, fmt.Errorf("plugin %s printed no handshake within %s", config.Command, config.StartTimeout)
+	}
+}
+
+// parseHandshake returns the address of a handshake line such as
+// MCP_PLUGIN|1|tcp|127.0.0.1:41234
+func parseHandshake(line string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 4 || parts[0] != HandshakePrefix {
+		return "", fmt.Errorf("invalid plugin handshake %q", line)
+	}
+
+	version, err := strconv.Atoi(parts[1])
+	if err != nil || version != ProtocolVersion {
+		return "", fmt.Errorf("unsupported plugin protocol version %s, expected %d", parts[1], ProtocolVersion)
+	}
+	if parts[2] != "tcp" {
+		return "", fmt.Errorf("unsupported plugin network %s", parts[2])
+	}
+	if parts[3] == "" {
+		return "", fmt.Errorf("plugin handshake has no address")
+	}
+
+	return parts[3], nil
+}
+
+// stop terminates the process, and kills it if it has not exited within the timeout
+func (p *process) stop(timeout time.Duration) {
+	select {
+	case <-p.exited:
+		return
+	default:
+	}
+
+	p.cmd.Process.Signal(syscall.SIGTERM)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-p.exited:
+	case <-timer.C:
+		p.cmd.Process.Kill()
+		<-p.exited
+	}
+}
+
+// lineWriter calls a function for each line written to it
+type lineWriter struct {
+	mu     sync.Mutex
+	buf    []byte
+	onLine func(string)
+}
+
+// Write buffers output and calls onLine for each complete line
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := strings.TrimRight(string(w.buf[:i]), "\r")
+		w.buf = w.buf[i+1:]
+		w.onLine(line)
+	}
+
+	if len(w.buf) > maxLineLength {
+		w.onLine(string(w.buf))
+		w.buf = nil
+	}
+
+	return len(p), nil
+}
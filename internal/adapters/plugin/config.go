@@ -0,0 +1,163 @@
+package plugin
+
+import (
+	"fmt"
+	"time"
+)
+
+// Config holds configuration for an adapter plugin. The server launches the
+// plugin when Command is set, and connects to Address otherwise.
+type Config struct {
+	// Name is the adapter type the plugin is registered under. The type
+	// reported by the plugin is used when it is empty.
+	Name string `mapstructure:"name"`
+
+	// Command, Args and Env launch the plugin process. Env is added to the
+	// environment of the server.
+	Command string   `mapstructure:"command"`
+	Args    []string `mapstructure:"args"`
+	Env     []string `mapstructure:"env"`
+
+	// Address and Token connect to a plugin that is already running
+	Address string `mapstructure:"address"`
+	Token   string `mapstructure:"token"`
+
+	// StartTimeout bounds the time for a launched plugin to print its
+	// handshake and answer its first calls
+	StartTimeout time.Duration `mapstructure:"start_timeout"`
+
+	// CallTimeout applies to calls without a deadline
+	CallTimeout time.Duration `mapstructure:"call_timeout"`
+
+	// Health checks, and restarts after a crash or failed health checks
+	HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
+	FailureThreshold    int           `mapstructure:"failure_threshold"`
+	MaxRestarts         int           `mapstructure:"max_restarts"`
+	RestartBackoff      time.Duration `mapstructure:"restart_backoff"`
+	MaxRestartBackoff   time.Duration `mapstructure:"max_restart_backoff"`
+}
+
+// DefaultConfig returns a default configuration for an adapter plugin
+func DefaultConfig() Config {
+	return Config{
+		StartTimeout:        10 * time.Second,
+		CallTimeout:         30 * time.Second,
+		HealthCheckInterval: 15 * time.Second,
+		FailureThreshold:    3,
+		MaxRestarts:         5,
+		RestartBackoff:      time.Second,
+		MaxRestartBackoff:   time.Minute,
+	}
+}
+
+// Validate checks that a plugin can be launched or connected to
+func (c Config) Validate() error {
+	if c.Command == "" && c.Address == "" {
+		return fmt.Errorf("plugin %s needs a command or an address", c.Name)
+	}
+	if c.Command != "" && c.Address != "" {
+		return fmt.Errorf("plugin %s has both a command and an address", c.Name)
+	}
+	if c.StartTimeout <= 0 || c.CallTimeout <= 0 || c.HealthCheckInterval <= 0 {
+		return fmt.Errorf("plugin %s timeouts and health check interval must be positive", c.Name)
+	}
+	return nil
+}
+
+// ParseConfigs builds plugin configurations from the plugins entry of the
+// adapter configuration: a list of maps, as read from the configuration
+// file. Durations are in seconds.
+func ParseConfigs(raw interface{}) ([]Config, error) {
+	var entries []interface{}
+	switch cfg := raw.(type) {
+	case nil:
+		return nil, nil
+	case []Config:
+		return cfg, nil
+	case []interface{}:
+		entries = cfg
+	case []map[string]interface{}:
+		for _, entry := range cfg {
+			entries = append(entries, entry)
+		}
+	default:
+		return nil, fmt.Errorf("plugins must be a list, got %T", raw)
+	}
+
+	configs := make([]Config, 0, len(entries))
+	for i, entry := range entries {
+		cfg, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("plugin %d must be a map, got %T", i, entry)
+		}
+
+		config := DefaultConfig()
+
+		if name, ok := cfg["name"].(string); ok {
+			config.Name = name
+		}
+
+		if command, ok := cfg["command"].(string); ok {
+			config.Command = command
+		}
+
+		config.Args = stringList(cfg["args"])
+		config.Env = stringList(cfg["env"])
+
+		if address, ok := cfg["address"].(string); ok {
+			config.Address = address
+		}
+
+		if token, ok := cfg["token"].(string); ok {
+			config.Token = token
+		}
+
+		if timeout, ok := cfg["start_timeout"].(int); ok {
+			config.StartTimeout = time.Duration(timeout) * time.Second
+		}
+
+		if timeout, ok := cfg["call_timeout"].(int); ok {
+			config.CallTimeout = time.Duration(timeout) * time.Second
+		}
+
+		if interval, ok := cfg["health_check_interval"].(int); ok {
+			config.HealthCheckInterval = time.Duration(interval) * time.Second
+		}
+
+		if threshold, ok := cfg["failure_threshold"].(int); ok {
+			config.FailureThreshold = threshold
+		}
+
+		if maxRestarts, ok := cfg["max_restarts"].(int); ok {
+			config.MaxRestarts = maxRestarts
+		}
+
+		if backoff, ok := cfg["restart_backoff"].(int); ok {
+			config.RestartBackoff = time.Duration(backoff) * time.Second
+		}
+
+		if err := config.Validate(); err != nil {
+			return nil, err
+		}
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+// stringList returns the strings of a list value
+func stringList(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
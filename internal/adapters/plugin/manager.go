@@ -0,0 +1,86 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// Manager starts the configured plugins and registers them in an adapter registry
+type Manager struct {
+	registry *core.AdapterRegistry
+	configs  []Config
+	logger   *observability.Logger
+
+	mu      sync.Mutex
+	plugins []*PluginAdapter
+}
+
+// NewManager creates a new plugin manager
+func NewManager(registry *core.AdapterRegistry, configs []Config, logger *observability.Logger) *Manager {
+	if logger == nil {
+		logger = observability.NewLogger("plugin_manager")
+	}
+
+	return &Manager{
+		registry: registry,
+		configs:  configs,
+		logger:   logger,
+	}
+}
+
+// Start launches or connects to each plugin and registers it under its
+// configured name or the type it reports. Plugins that fail to start are
+// skipped, and their errors are returned together.
+func (m *Manager) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var errs []error
+	for _, config := range m.configs {
+		adapter, err := Start(ctx, config, m.logger)
+		if err != nil {
+			name := config.Name
+			if name == "" {
+				name = config.Command + config.Address
+			}
+			m.logger.Error("Failed to start adapter plugin", map[string]interface{}{
+				"plugin": name,
+				"error":  err.Error(),
+			})
+			errs = append(errs, fmt.Errorf("plugin %s: %w", name, err))
+			continue
+		}
+
+		m.registry.RegisterAdapter(adapter.Type(), adapter)
+		m.plugins = append(m.plugins, adapter)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Plugins returns the started plugins
+func (m *Manager) Plugins() []*PluginAdapter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*PluginAdapter(nil), m.plugins...)
+}
+
+// Stop deregisters and closes the started plugins
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, adapter := range m.plugins {
+		// Plugins closed elsewhere may already be deregistered
+		if err := m.registry.DeregisterAdapter(adapter.Type()); err != nil {
+			adapter.Close()
+		}
+	}
+	m.plugins = nil
+}
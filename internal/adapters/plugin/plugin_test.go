@@ -0,0 +1,268 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// helperEnv makes the test binary serve echoAdapter as a plugin
+const helperEnv = "MCP_PLUGIN_TEST_HELPER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(helperEnv) == "1" {
+		if err := Serve(&echoAdapter{}); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// echoAdapter is the adapter served by the test plugin
+type echoAdapter struct{}
+
+func (a *echoAdapter) Type() string    { return "echo" }
+func (a *echoAdapter) Version() string { return "1.2.3" }
+func (a *echoAdapter) Health() string  { return "healthy" }
+func (a *echoAdapter) Close() error    { return nil }
+
+func (a *echoAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	switch action {
+	case "echo":
+		return map[string]interface{}{"context_id": contextID, "params": params}, nil
+	case "missing":
+		return nil, adapterErrors.NewResourceNotFoundError("echo", action, errors.New("no such thing"), map[string]interface{}{"id": "42"})
+	case "crash":
+		os.Exit(3)
+	}
+	return nil, adapterErrors.NewUnsupportedOperationError("echo", action, nil, nil)
+}
+
+func (a *echoAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{{
+		Name:        "echo",
+		Description: "Returns the context ID and params",
+		Params:      map[string]interface{}{"type": "object"},
+		Safety:      core.SafetyRead,
+	}}
+}
+
+func (a *echoAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	if eventType != "push" {
+		return adapterErrors.NewInvalidRequestError("echo", eventType, errors.New("unexpected event"), nil)
+	}
+	return nil
+}
+
+// helperConfig returns the configuration of a launched test plugin
+func helperConfig() Config {
+	config := DefaultConfig()
+	config.Command = os.Args[0]
+	config.Env = []string{helperEnv + "=1"}
+	config.HealthCheckInterval = 50 * time.Millisecond
+	config.RestartBackoff = 10 * time.Millisecond
+	return config
+}
+
+func TestLaunchedPlugin(t *testing.T) {
+	adapter, err := Start(context.Background(), helperConfig(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "echo", adapter.Type())
+	assert.Equal(t, "1.2.3", adapter.Version())
+	assert.Equal(t, "healthy", adapter.Health())
+	assert.Equal(t, StateRunning, adapter.State())
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "echo", actions[0].Name)
+	assert.Equal(t, core.SafetyRead, actions[0].Safety)
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "echo", map[string]interface{}{"n": 1})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"context_id": "ctx-1",
+		"params":     map[string]interface{}{"n": float64(1)},
+	}, result)
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "missing", nil)
+	require.Error(t, err)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+	var adapterErr *adapterErrors.AdapterError
+	require.True(t, errors.As(err, &adapterErr))
+	assert.Equal(t, "echo", adapterErr.AdapterType)
+	assert.Equal(t, "42", adapterErr.Context["id"])
+	assert.Contains(t, err.Error(), "no such thing")
+
+	assert.NoError(t, adapter.HandleWebhook(context.Background(), "push", []byte(`{}`)))
+	err = adapter.HandleWebhook(context.Background(), "tag", []byte(`{}`))
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	adapter.mu.RLock()
+	proc := adapter.process
+	adapter.mu.RUnlock()
+
+	require.NoError(t, adapter.Close())
+	assert.Equal(t, StateClosed, adapter.State())
+	select {
+	case <-proc.exited:
+	default:
+		t.Fatal("plugin process is still running after Close")
+	}
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "echo", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeServiceUnavailable))
+}
+
+func TestPluginRestartsAfterCrash(t *testing.T) {
+	adapter, err := Start(context.Background(), helperConfig(), nil)
+	require.NoError(t, err)
+	defer adapter.Close()
+
+	adapter.mu.RLock()
+	first := adapter.process
+	adapter.mu.RUnlock()
+
+	_, err = adapter.ExecuteAction(context.Background(), "", "crash", nil)
+	require.Error(t, err)
+
+	require.Eventually(t, func() bool {
+		adapter.mu.RLock()
+		defer adapter.mu.RUnlock()
+		return adapter.process != nil && adapter.process != first
+	}, 10*time.Second, 20*time.Millisecond)
+
+	assert.Equal(t, "healthy", adapter.Health())
+	_, err = adapter.ExecuteAction(context.Background(), "", "echo", nil)
+	assert.NoError(t, err)
+}
+
+func TestPluginFailsAfterMaxRestarts(t *testing.T) {
+	config := helperConfig()
+	config.MaxRestarts = 1
+	// No health check resets the restarts between the crashes
+	config.HealthCheckInterval = time.Hour
+
+	adapter, err := Start(context.Background(), config, nil)
+	require.NoError(t, err)
+	defer adapter.Close()
+
+	adapter.ExecuteAction(context.Background(), "", "crash", nil)
+	require.Eventually(t, func() bool {
+		_, err := adapter.ExecuteAction(context.Background(), "", "echo", nil)
+		return err == nil
+	}, 10*time.Second, 20*time.Millisecond)
+
+	adapter.ExecuteAction(context.Background(), "", "crash", nil)
+
+	require.Eventually(t, func() bool {
+		return adapter.State() == StateFailed
+	}, 10*time.Second, 20*time.Millisecond)
+	assert.Contains(t, adapter.Health(), "unhealthy: plugin failed")
+}
+
+func TestConnectedPlugin(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := NewServer(&echoAdapter{}, "secret")
+	go server.Serve(listener)
+	defer server.Stop()
+
+	config := DefaultConfig()
+	config.Name = "custom"
+	config.Address = listener.Addr().String()
+	config.StartTimeout = 2 * time.Second
+
+	t.Run("wrong token", func(t *testing.T) {
+		config := config
+		config.Token = "wrong"
+		_, err := Start(context.Background(), config, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid plugin token")
+	})
+
+	t.Run("registered in the registry", func(t *testing.T) {
+		config := config
+		config.Token = "secret"
+
+		registry := core.NewAdapterRegistry(core.NewAdapterFactory(nil, nil, nil), observability.NewLogger("test"))
+		manager := NewManager(registry, []Config{config}, nil)
+		require.NoError(t, manager.Start(context.Background()))
+
+		adapter, err := registry.GetAdapter(context.Background(), "custom")
+		require.NoError(t, err)
+		assert.Equal(t, "custom", adapter.Type())
+		assert.Equal(t, "healthy", adapter.Health())
+
+		result, err := adapter.ExecuteAction(context.Background(), "ctx-2", "echo", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "ctx-2", result.(map[string]interface{})["context_id"])
+
+		manager.Stop()
+		assert.Empty(t, registry.ListAdapters())
+
+		// Connected plugins keep running when closed
+		again, err := Start(context.Background(), config, nil)
+		require.NoError(t, err)
+		again.Close()
+	})
+}
+
+func TestParseConfigs(t *testing.T) {
+	configs, err := ParseConfigs([]interface{}{
+		map[string]interface{}{
+			"name":                  "scanner",
+			"command":               "/opt/plugins/scanner",
+			"args":                  []interface{}{"--verbose"},
+			"call_timeout":          5,
+			"health_check_interval": 30,
+		},
+		map[string]interface{}{
+			"address": "plugins.internal:9000",
+			"token":   "secret",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+
+	assert.Equal(t, "scanner", configs[0].Name)
+	assert.Equal(t, []string{"--verbose"}, configs[0].Args)
+	assert.Equal(t, 5*time.Second, configs[0].CallTimeout)
+	assert.Equal(t, 30*time.Second, configs[0].HealthCheckInterval)
+	assert.Equal(t, DefaultConfig().StartTimeout, configs[0].StartTimeout)
+	assert.Equal(t, "secret", configs[1].Token)
+
+	_, err = ParseConfigs([]interface{}{map[string]interface{}{"name": "none"}})
+	assert.Error(t, err)
+
+	_, err = ParseConfigs([]interface{}{map[string]interface{}{"command": "x", "address": "y:1"}})
+	assert.Error(t, err)
+
+	configs, err = ParseConfigs(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, configs)
+}
+
+func TestParseHandshake(t *testing.T) {
+	address, err := parseHandshake("MCP_PLUGIN|1|tcp|127.0.0.1:4000\n")
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:4000", address)
+
+	for _, line := range []string{"hello", "MCP_PLUGIN|2|tcp|127.0.0.1:4000", "MCP_PLUGIN|1|unix|/tmp/sock", "MCP_PLUGIN|1|tcp|"} {
+		_, err := parseHandshake(line)
+		assert.Error(t, err, line)
+	}
+}
@@ -0,0 +1,168 @@
+// Package plugin runs adapters as separate processes over gRPC, so adapters
+// can be shipped without being compiled into the server.
+//
+// The AdapterPlugin service mirrors core.Adapter, and core.ActionDescriber
+// through the optional Actions call. Messages are encoded as JSON (content
+// subtype application/grpc+json) rather than protobuf, so plugins can be
+// written in any language with a gRPC implementation and no generated code. Plugins written in Go call Serve with their adapter.
+//
+// Plugins launched by the server print a handshake line on stdout once they
+// are listening:
+//
+//	MCP_PLUGIN|1|tcp|127.0.0.1:41234
+//
+// and require the token passed in the MCP_PLUGIN_TOKEN environment variable
+// in the mcp-plugin-token metadata of each call.
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+const (
+	// ProtocolVersion is the version of the plugin protocol
+	ProtocolVersion = 1
+
+	// ServiceName is the full name of the gRPC service
+	ServiceName = "mcp.adapter.v1.AdapterPlugin"
+
+	// HandshakePrefix starts the handshake line printed by launched plugins
+	HandshakePrefix = "MCP_PLUGIN"
+
+	// TokenEnv is the environment variable holding the token of a launched plugin
+	TokenEnv = "MCP_PLUGIN_TOKEN"
+
+	// AddressEnv is the environment variable holding the address a plugin
+	// listens on. Launched plugins listen on a free local port when it is unset.
+	AddressEnv = "MCP_PLUGIN_ADDRESS"
+
+	// tokenMetadataKey is the call metadata holding the plugin token
+	tokenMetadataKey = "mcp-plugin-token"
+)
+
+// Empty is the message of calls without parameters or results
+type Empty struct{}
+
+// TypeResponse is the result of the Type call
+type TypeResponse struct {
+	Type string `json:"type"`
+}
+
+// VersionResponse is the result of the Version call
+type VersionResponse struct {
+	Version string `json:"version"`
+}
+
+// HealthResponse is the result of the Health call
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+// ActionsResponse is the result of the Actions call. Actions is null when the
+// adapter does not describe its actions.
+type ActionsResponse struct {
+	Actions []core.ActionDescriptor `json:"actions"`
+}
+
+// ExecuteActionRequest holds the parameters of the ExecuteAction call
+type ExecuteActionRequest struct {
+	ContextID string                 `json:"context_id"`
+	Action    string                 `json:"action"`
+	Params    map[string]interface{} `json:"params,omitempty"`
+}
+
+// ExecuteActionResponse is the result of the ExecuteAction call. The result
+// is JSON, so structured results reach the server as maps.
+type ExecuteActionResponse struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *Error          `json:"error,omitempty"`
+}
+
+// HandleWebhookRequest holds the parameters of the HandleWebhook call
+type HandleWebhookRequest struct {
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+}
+
+// ErrorResponse is the result of calls that only return an error
+type ErrorResponse struct {
+	Error *Error `json:"error,omitempty"`
+}
+
+// Error is an adapter error returned by a plugin. Errors are part of the
+// response rather than a gRPC status so that the error code, type and
+// retryability of adapter errors survive the call.
+type Error struct {
+	Code      string                 `json:"code"`
+	Type      int                    `json:"type"`
+	Message   string                 `json:"message"`
+	Retryable bool                   `json:"retryable"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+}
+
+// newError converts an error returned by an adapter
+func newError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var adapterErr *adapterErrors.AdapterError
+	if !errors.As(err, &adapterErr) {
+		return &Error{
+			Code:      adapterErrors.ErrCodeUnknown,
+			Type:      int(adapterErrors.ErrorTypeUnknown),
+			Message:   err.Error(),
+			Retryable: adapterErrors.IsRetryable(err),
+		}
+	}
+
+	message := adapterErr.Error()
+	if adapterErr.OriginalError != nil {
+		message = adapterErr.OriginalError.Error()
+	}
+	return &Error{
+		Code:      adapterErr.ErrorCode,
+		Type:      int(adapterErr.ErrorType),
+		Message:   message,
+		Retryable: adapterErr.Retryable,
+		Context:   adapterErr.Context,
+	}
+}
+
+// adapterError converts an error returned by a plugin back to an adapter error
+func (e *Error) adapterError(adapterType, operation string) error {
+	if e == nil {
+		return nil
+	}
+	return adapterErrors.New(adapterType, operation, errors.New(e.Message), e.Code,
+		adapterErrors.ErrorType(e.Type), e.Retryable, e.Context)
+}
+
+// jsonCodec encodes gRPC messages as JSON
+type jsonCodec struct{}
+
+// Marshal encodes a message
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal decodes a message
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode plugin message: %w", err)
+	}
+	return nil
+}
+
+// Name returns the content subtype of the codec
+func (jsonCodec) Name() string {
+	return "json"
+}
@@ -0,0 +1,176 @@
+package plugin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// adapterServer serves an adapter as the AdapterPlugin service
+type adapterServer struct {
+	adapter core.Adapter
+	// closed is called after the Close call, to stop serving
+	closed func()
+}
+
+// serviceDesc describes the AdapterPlugin service
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Type", Handler: unaryHandler("Type", func(s *adapterServer, _ context.Context, _ *Empty) (interface{}, error) {
+			return &TypeResponse{Type: s.adapter.Type()}, nil
+		})},
+		{MethodName: "Version", Handler: unaryHandler("Version", func(s *adapterServer, _ context.Context, _ *Empty) (interface{}, error) {
+			return &VersionResponse{Version: s.adapter.Version()}, nil
+		})},
+		{MethodName: "Health", Handler: unaryHandler("Health", func(s *adapterServer, _ context.Context, _ *Empty) (interface{}, error) {
+			return &HealthResponse{Status: s.adapter.Health()}, nil
+		})},
+		{MethodName: "Actions", Handler: unaryHandler("Actions", func(s *adapterServer, _ context.Context, _ *Empty) (interface{}, error) {
+			actions, _ := core.DescribeActions(s.adapter)
+			return &ActionsResponse{Actions: actions}, nil
+		})},
+		{MethodName: "ExecuteAction", Handler: unaryHandler("ExecuteAction", (*adapterServer).executeAction)},
+		{MethodName: "HandleWebhook", Handler: unaryHandler("HandleWebhook", func(s *adapterServer, ctx context.Context, req *HandleWebhookRequest) (interface{}, error) {
+			return &ErrorResponse{Error: newError(s.adapter.HandleWebhook(ctx, req.EventType, req.Payload))}, nil
+		})},
+		{MethodName: "Close", Handler: unaryHandler("Close", (*adapterServer).close)},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "adapter_plugin",
+}
+
+// unaryHandler adapts a typed method to a gRPC method handler
+func unaryHandler[Req any](method string, fn func(*adapterServer, context.Context, *Req) (interface{}, error)) grpc.MethodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		req := new(Req)
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+
+		server := srv.(*adapterServer)
+		if interceptor == nil {
+			return fn(server, ctx, req)
+		}
+
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: "/" + ServiceName + "/" + method,
+		}
+		return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return fn(server, ctx, req.(*Req))
+		})
+	}
+}
+
+// executeAction runs an action and encodes its result
+func (s *adapterServer) executeAction(ctx context.Context, req *ExecuteActionRequest) (interface{}, error) {
+	result, err := s.adapter.ExecuteAction(ctx, req.ContextID, req.Action, req.Params)
+	if err != nil {
+		return &ExecuteActionResponse{Error: newError(err)}, nil
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode result of action %s: %v", req.Action, err)
+	}
+	return &ExecuteActionResponse{Result: data}, nil
+}
+
+// close closes the adapter and stops serving
+func (s *adapterServer) close(_ context.Context, _ *Empty) (interface{}, error) {
+	err := s.adapter.Close()
+	if s.closed != nil {
+		// Stop once the response is sent
+		go s.closed()
+	}
+	return &ErrorResponse{Error: newError(err)}, nil
+}
+
+// NewServer returns a gRPC server serving an adapter as the AdapterPlugin
+// service. Calls must carry the token in their metadata unless it is empty.
+func NewServer(adapter core.Adapter, token string) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(jsonCodec{}),
+		grpc.UnaryInterceptor(tokenInterceptor(token)),
+	)
+	RegisterAdapterServer(server, adapter, nil)
+	return server
+}
+
+// RegisterAdapterServer registers an adapter as the AdapterPlugin service of
+// a gRPC server using the JSON codec. closed is called after the Close call.
+func RegisterAdapterServer(server *grpc.Server, adapter core.Adapter, closed func()) {
+	server.RegisterService(&serviceDesc, &adapterServer{adapter: adapter, closed: closed})
+}
+
+// tokenInterceptor rejects calls without the plugin token
+func tokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if token == "" {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(tokenMetadataKey)
+		if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid plugin token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Serve serves an adapter as a plugin until the server closes it or the
+// process is interrupted. It is called from the main function of plugin
+// binaries. The plugin listens on the address in MCP_PLUGIN_ADDRESS, or on a
+// free local port, and prints the handshake line on stdout.
+func Serve(adapter core.Adapter) error {
+	address := os.Getenv(AddressEnv)
+	if address == "" {
+		address = "127.0.0.1:0"
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(jsonCodec{}),
+		grpc.UnaryInterceptor(tokenInterceptor(os.Getenv(TokenEnv))),
+	)
+
+	var stopOnce sync.Once
+	stop := func() {
+		stopOnce.Do(server.GracefulStop)
+	}
+	RegisterAdapterServer(server, adapter, stop)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		if _, ok := <-signals; ok {
+			adapter.Close()
+			stop()
+		}
+	}()
+
+	fmt.Fprintf(os.Stdout, "%s|%d|tcp|%s\n", HandshakePrefix, ProtocolVersion, listener.Addr())
+
+	return server.Serve(listener)
+}
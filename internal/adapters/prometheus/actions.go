@@ -0,0 +1,161 @@
+package prometheus
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// timeSchema is the schema of a time given as an RFC 3339 time or a unix
+// timestamp
+func timeSchema(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        []interface{}{"string", "number"},
+		"description": description + ", as an RFC 3339 time or unix timestamp",
+	}
+}
+
+// durationSchema is the schema of a duration given as a Go duration string
+// such as 90s or 2h, or as a number of seconds
+func durationSchema(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        []interface{}{"string", "number"},
+		"description": description + ", such as 90s or 2h, or a number of seconds",
+	}
+}
+
+// stringOrList is the schema of params taking a string or a list of strings
+func stringOrList(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        []interface{}{"string", "array"},
+		"description": description,
+		"items":       map[string]interface{}{"type": "string"},
+	}
+}
+
+// expressionParam is the schema of a PromQL expression
+var expressionParam = map[string]interface{}{
+	"type":        "string",
+	"description": "PromQL expression",
+	"minLength":   1,
+}
+
+// Actions returns the descriptors of the supported Prometheus and
+// Alertmanager actions
+func (a *PrometheusAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{
+		{
+			Name:        "query",
+			Description: "Run an instant PromQL query",
+			Params: paramsSchema([]string{"query"}, map[string]interface{}{
+				"query": expressionParam,
+				"time":  timeSchema("Evaluation time. Defaults to now"),
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "query_range",
+			Description: "Run a PromQL query over a time range",
+			Params: paramsSchema([]string{"query"}, map[string]interface{}{
+				"query": expressionParam,
+				"start": timeSchema("Start of the range. Defaults to the range before the end"),
+				"end":   timeSchema("End of the range. Defaults to now"),
+				"range": durationSchema("Length of the range when no start is given"),
+				"step":  durationSchema("Resolution step. Defaults to a step fitting the configured points per series"),
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "series",
+			Description: "Find the series matching series selectors",
+			Params: paramsSchema([]string{"match"}, map[string]interface{}{
+				"match": stringOrList("Series selectors, such as up{job=\"api\"}"),
+				"start": timeSchema("Start of the range"),
+				"end":   timeSchema("End of the range"),
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "labels",
+			Description: "List label names, or the values of a label",
+			Params: paramsSchema(nil, map[string]interface{}{
+				"label": map[string]interface{}{
+					"type":        "string",
+					"description": "Label to list the values of",
+				},
+				"match": stringOrList("Series selectors limiting the series labels are read from"),
+				"start": timeSchema("Start of the range"),
+				"end":   timeSchema("End of the range"),
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "list_alerts",
+			Description: "List Alertmanager alerts, newest first",
+			Params: paramsSchema(nil, map[string]interface{}{
+				"active":    map[string]interface{}{"type": "boolean", "description": "Include active alerts. Defaults to true"},
+				"silenced":  map[string]interface{}{"type": "boolean", "description": "Include silenced alerts. Defaults to true"},
+				"inhibited": map[string]interface{}{"type": "boolean", "description": "Include inhibited alerts. Defaults to true"},
+				"filter":    stringOrList("Label matchers alerts must match, such as severity=\"critical\""),
+				"receiver": map[string]interface{}{
+					"type":        "string",
+					"description": "Regular expression receivers must match",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum number of alerts to return",
+					"minimum":     1,
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "list_silences",
+			Description: "List Alertmanager silences",
+			Params: paramsSchema(nil, map[string]interface{}{
+				"filter": stringOrList("Label matchers silences must match"),
+				"include_expired": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Include expired silences. Defaults to false",
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "create_silence",
+			Description: "Silence the alerts matching label matchers, starting now",
+			Params: paramsSchema([]string{"matchers", "comment"}, map[string]interface{}{
+				"matchers": stringOrList("Label matchers, such as alertname=\"HighLatency\" or instance=~\"web-.*\""),
+				"comment": map[string]interface{}{
+					"type":        "string",
+					"description": "Reason for the silence",
+					"minLength":   1,
+				},
+				"duration": durationSchema("Duration of the silence, up to the configured maximum"),
+				"created_by": map[string]interface{}{
+					"type":        "string",
+					"description": "Creator of the silence. Defaults to the configured creator",
+				},
+			}),
+			Safety: core.SafetyWrite,
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,819 @@
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Prometheus adapter
+const adapterType = "prometheus"
+
+// ContextRecorder records webhook events in MCP contexts. It is implemented
+// by the core engine, which sets itself as the recorder when the adapter is
+// registered.
+type ContextRecorder = core.ContextRecorder
+
+// PrometheusAdapter provides an adapter for Prometheus queries and
+// Alertmanager alerts and silences
+type PrometheusAdapter struct {
+	config          *Config
+	prometheusURL   string
+	alertmanagerURL string
+	client          *http.Client
+	metricsClient   *observability.MetricsClient
+	logger          *observability.Logger
+	eventBus        *events.EventBus
+	now             func() time.Time
+
+	// Contexts opened for alert groups received by webhook, by group key
+	contextMutex    sync.RWMutex
+	contextRecorder ContextRecorder
+	groupContexts   map[string]string
+}
+
+// New creates a new Prometheus adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*PrometheusAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("prometheus_adapter")
+	}
+
+	prometheusURL := config.PrometheusURL
+	alertmanagerURL := config.AlertmanagerURL
+	if config.MockResponses {
+		prometheusURL = config.MockURL
+		alertmanagerURL = config.MockURL
+	}
+	if prometheusURL == "" && alertmanagerURL == "" {
+		return nil, fmt.Errorf("a Prometheus or Alertmanager URL is required")
+	}
+
+	return &PrometheusAdapter{
+		config:          config,
+		prometheusURL:   strings.TrimRight(prometheusURL, "/"),
+		alertmanagerURL: strings.TrimRight(alertmanagerURL, "/"),
+		client:          &http.Client{Timeout: config.RequestTimeout},
+		metricsClient:   metricsClient,
+		logger:          logger,
+		eventBus:        eventBus,
+		now:             time.Now,
+		groupContexts:   make(map[string]string),
+	}, nil
+}
+
+// SetContextRecorder sets the recorder used to open or attach a context for
+// each alert group received by webhook
+func (a *PrometheusAdapter) SetContextRecorder(recorder ContextRecorder) {
+	a.contextMutex.Lock()
+	defer a.contextMutex.Unlock()
+	a.contextRecorder = recorder
+}
+
+// GroupContextID returns the ID of the context webhooks for an alert group are recorded in
+func (a *PrometheusAdapter) GroupContextID(groupKey string) (string, bool) {
+	a.contextMutex.RLock()
+	defer a.contextMutex.RUnlock()
+	contextID, ok := a.groupContexts[groupKey]
+	return contextID, ok
+}
+
+// Type returns the adapter type
+func (a *PrometheusAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *PrometheusAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status. The adapter is degraded when only
+// one of the configured APIs is reachable.
+func (a *PrometheusAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var failures []string
+	checked := 0
+	if a.prometheusURL != "" {
+		checked++
+		if _, err := a.doRequest(ctx, "health", a.prometheusURL, http.MethodGet, "/api/v1/status/buildinfo", nil, nil); err != nil {
+			failures = append(failures, fmt.Sprintf("prometheus: %v", err))
+		}
+	}
+	if a.alertmanagerURL != "" {
+		checked++
+		if _, err := a.doRequest(ctx, "health", a.alertmanagerURL, http.MethodGet, "/api/v2/status", nil, nil); err != nil {
+			failures = append(failures, fmt.Sprintf("alertmanager: %v", err))
+		}
+	}
+
+	switch {
+	case len(failures) == checked:
+		return "unhealthy: " + strings.Join(failures, "; ")
+	case len(failures) > 0:
+		return "degraded: " + strings.Join(failures, "; ")
+	case a.config.MockResponses:
+		return "healthy (mock)"
+	default:
+		return "healthy"
+	}
+}
+
+// ExecuteAction executes a Prometheus or Alertmanager action
+func (a *PrometheusAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing Prometheus action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	// Prometheus
+	case "query":
+		result, err = a.query(ctx, params)
+	case "query_range":
+		result, err = a.queryRange(ctx, params)
+	case "series":
+		result, err = a.series(ctx, params)
+	case "labels":
+		result, err = a.labels(ctx, params)
+
+	// Alertmanager
+	case "list_alerts":
+		result, err = a.listAlerts(ctx, params)
+	case "list_silences":
+		result, err = a.listSilences(ctx, params)
+	case "create_silence":
+		result, err = a.createSilence(ctx, contextID, params)
+
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported Prometheus action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// query runs an instant query
+func (a *PrometheusAdapter) query(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	expression, err := core.RequiredStringParam(params, "query")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "query", err, nil)
+	}
+
+	query := url.Values{"query": {expression}}
+	if _, ok := params["time"]; ok {
+		evaluationTime, err := timeParam(params, "time", time.Time{})
+		if err != nil {
+			return nil, adapterErrors.NewInvalidParameterError(adapterType, "query", err, nil)
+		}
+		query.Set("time", formatTime(evaluationTime))
+	}
+
+	return a.runQuery(ctx, "query", "/api/v1/query", query)
+}
+
+// queryRange runs a range query. Without a step, the step is chosen so that
+// each series fits in MaxPointsPerSeries points.
+func (a *PrometheusAdapter) queryRange(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	expression, err := core.RequiredStringParam(params, "query")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "query_range", err, nil)
+	}
+
+	start, end, err := a.timeRange(params)
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "query_range", err, nil)
+	}
+
+	step, err := durationParam(params, "step", 0)
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "query_range", err, nil)
+	}
+	if step <= 0 {
+		step = time.Second
+		if a.config.MaxPointsPerSeries > 0 {
+			perPoint := end.Sub(start) / time.Duration(a.config.MaxPointsPerSeries)
+			step = time.Duration(math.Ceil(perPoint.Seconds())) * time.Second
+			if step < time.Second {
+				step = time.Second
+			}
+		}
+	}
+
+	query := url.Values{
+		"query": {expression},
+		"start": {formatTime(start)},
+		"end":   {formatTime(end)},
+		"step":  {strconv.FormatFloat(step.Seconds(), 'f', -1, 64)},
+	}
+
+	return a.runQuery(ctx, "query_range", "/api/v1/query_range", query)
+}
+
+// runQuery runs a query and summarizes the result
+func (a *PrometheusAdapter) runQuery(ctx context.Context, operation, path string, query url.Values) (interface{}, error) {
+	response, err := a.prometheusRequest(ctx, operation, path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var data queryData
+	if err := json.Unmarshal(response.Data, &data); err != nil {
+		return nil, adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode query result: %w", err), nil)
+	}
+
+	result, err := summarizeQuery(data, response.Warnings, a.config.MaxSeries, a.config.MaxPointsPerSeries)
+	if err != nil {
+		return nil, adapterErrors.NewUnknownError(adapterType, operation, err, nil)
+	}
+
+	return result, nil
+}
+
+// series finds the series matching one or more series selectors
+func (a *PrometheusAdapter) series(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	matches := core.StringSliceParam(params, "match")
+	if len(matches) == 0 {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "series",
+			fmt.Errorf("missing required parameter: match"), nil)
+	}
+
+	query := url.Values{"match[]": matches}
+	if err := a.setOptionalRange(query, params); err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "series", err, nil)
+	}
+
+	response, err := a.prometheusRequest(ctx, "series", "/api/v1/series", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var series []map[string]string
+	if err := json.Unmarshal(response.Data, &series); err != nil {
+		return nil, adapterErrors.NewUnknownError(adapterType, "series",
+			fmt.Errorf("failed to decode series: %w", err), nil)
+	}
+
+	result := SeriesResult{Series: series, Total: len(series)}
+	if a.config.MaxSeries > 0 && len(series) > a.config.MaxSeries {
+		result.Series = series[:a.config.MaxSeries]
+		result.Truncated = true
+	}
+
+	return result, nil
+}
+
+// labels lists label names, or the values of a label if one is given
+func (a *PrometheusAdapter) labels(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	query := url.Values{}
+	if matches := core.StringSliceParam(params, "match"); len(matches) > 0 {
+		query["match[]"] = matches
+	}
+	if err := a.setOptionalRange(query, params); err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "labels", err, nil)
+	}
+
+	label := core.StringParam(params, "label")
+	path := "/api/v1/labels"
+	if label != "" {
+		path = "/api/v1/label/" + url.PathEscape(label) + "/values"
+	}
+
+	response, err := a.prometheusRequest(ctx, "labels", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+	if err := json.Unmarshal(response.Data, &values); err != nil {
+		return nil, adapterErrors.NewUnknownError(adapterType, "labels",
+			fmt.Errorf("failed to decode labels: %w", err), nil)
+	}
+
+	result := LabelsResult{Label: label, Values: values, Total: len(values)}
+	if a.config.MaxLabelValues > 0 && len(values) > a.config.MaxLabelValues {
+		result.Values = values[:a.config.MaxLabelValues]
+		result.Truncated = true
+	}
+
+	return result, nil
+}
+
+// listAlerts lists Alertmanager alerts, newest first
+func (a *PrometheusAdapter) listAlerts(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	query := url.Values{
+		"active":    {strconv.FormatBool(core.BoolParam(params, "active", true))},
+		"silenced":  {strconv.FormatBool(core.BoolParam(params, "silenced", true))},
+		"inhibited": {strconv.FormatBool(core.BoolParam(params, "inhibited", true))},
+	}
+	if filters := core.StringSliceParam(params, "filter"); len(filters) > 0 {
+		query["filter"] = filters
+	}
+	if receiver := core.StringParam(params, "receiver"); receiver != "" {
+		query.Set("receiver", receiver)
+	}
+
+	var alerts []alert
+	if err := a.alertmanagerRequest(ctx, "list_alerts", http.MethodGet, "/api/v2/alerts", query, nil, &alerts); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return alerts[i].StartsAt.After(alerts[j].StartsAt)
+	})
+
+	result := AlertsResult{Alerts: []Alert{}, Total: len(alerts)}
+	limit := core.IntParam(params, "limit", a.config.MaxAlerts)
+	for _, alert := range alerts {
+		if limit > 0 && len(result.Alerts) >= limit {
+			result.Truncated = true
+			break
+		}
+		result.Alerts = append(result.Alerts, toAlert(alert))
+	}
+
+	return result, nil
+}
+
+// listSilences lists Alertmanager silences. Expired silences are left out
+// unless requested.
+func (a *PrometheusAdapter) listSilences(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	query := url.Values{}
+	if filters := core.StringSliceParam(params, "filter"); len(filters) > 0 {
+		query["filter"] = filters
+	}
+
+	var silences []silence
+	if err := a.alertmanagerRequest(ctx, "list_silences", http.MethodGet, "/api/v2/silences", query, nil, &silences); err != nil {
+		return nil, err
+	}
+
+	includeExpired := core.BoolParam(params, "include_expired", false)
+	result := []Silence{}
+	for _, s := range silences {
+		if s.Status.State == "expired" && !includeExpired {
+			continue
+		}
+		result = append(result, toSilence(s))
+	}
+
+	return result, nil
+}
+
+// createSilence creates a silence starting now. The duration is capped by
+// MaxSilenceDuration so that alerts are not muted indefinitely.
+func (a *PrometheusAdapter) createSilence(ctx context.Context, contextID string, params map[string]interface{}) (interface{}, error) {
+	expressions := core.StringSliceParam(params, "matchers")
+	if len(expressions) == 0 {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_silence",
+			fmt.Errorf("missing required parameter: matchers"), nil)
+	}
+
+	comment, err := core.RequiredStringParam(params, "comment")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_silence", err, nil)
+	}
+
+	matchers := make([]matcher, 0, len(expressions))
+	for _, expression := range expressions {
+		m, err := parseMatcher(expression)
+		if err != nil {
+			return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_silence", err, nil)
+		}
+		matchers = append(matchers, m)
+	}
+
+	duration, err := durationParam(params, "duration", a.config.DefaultSilenceDuration)
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_silence", err, nil)
+	}
+	if duration <= 0 {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_silence",
+			fmt.Errorf("silence duration must be positive"), nil)
+	}
+	if a.config.MaxSilenceDuration > 0 && duration > a.config.MaxSilenceDuration {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "create_silence",
+			fmt.Errorf("silence duration %s exceeds the maximum of %s", duration, a.config.MaxSilenceDuration), nil)
+	}
+
+	createdBy := core.StringParam(params, "created_by")
+	if createdBy == "" {
+		createdBy = a.config.SilenceCreatedBy
+	}
+	if contextID != "" {
+		comment = fmt.Sprintf("%s (MCP context %s)", comment, contextID)
+	}
+
+	startsAt := a.now().UTC()
+	created := silence{
+		Matchers:  matchers,
+		StartsAt:  startsAt,
+		EndsAt:    startsAt.Add(duration),
+		CreatedBy: createdBy,
+		Comment:   comment,
+	}
+
+	var response struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if err := a.alertmanagerRequest(ctx, "create_silence", http.MethodPost, "/api/v2/silences", nil, created, &response); err != nil {
+		return nil, err
+	}
+
+	created.ID = response.SilenceID
+	created.Status.State = "active"
+	return toSilence(created), nil
+}
+
+// HandleWebhook handles an Alertmanager webhook notification. Each alert group
+// is recorded in a context, which is opened by the first notification of the
+// group unless its alerts carry a context ID annotation.
+func (a *PrometheusAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	event, err := ParseWebhook(payload)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook", err, nil)
+	}
+
+	if eventType == "" {
+		eventType = "alertmanager." + event.Status
+	}
+
+	a.logger.Info("Received Alertmanager webhook", map[string]interface{}{
+		"eventType": eventType,
+		"groupKey":  event.GroupKey,
+		"alerts":    len(event.Alerts),
+	})
+
+	contextID, err := a.recordInContext(ctx, eventType, event)
+	if err != nil {
+		// The event is still published so that other subscribers see it
+		a.logger.Warn("Failed to record Alertmanager webhook in context", map[string]interface{}{
+			"eventType": eventType,
+			"groupKey":  event.GroupKey,
+			"error":     err.Error(),
+		})
+	}
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, event).
+			WithMetadata("eventType", eventType).
+			WithMetadata("groupKey", event.GroupKey).
+			WithMetadata("contextId", contextID)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// recordInContext records an alert group notification in the group's context.
+// A context ID annotation on the alerts takes precedence over the context of
+// earlier notifications of the group. The group's context is forgotten once
+// the group resolves, so that it opens a new context if it fires again.
+func (a *PrometheusAdapter) recordInContext(ctx context.Context, eventType string, event *WebhookEvent) (string, error) {
+	if event.GroupKey == "" {
+		return "", nil
+	}
+
+	a.contextMutex.RLock()
+	recorder := a.contextRecorder
+	contextID := a.groupContexts[event.GroupKey]
+	a.contextMutex.RUnlock()
+
+	if annotated := event.annotatedContextID(a.config.ContextAnnotation); annotated != "" {
+		contextID = annotated
+	}
+
+	if recorder == nil {
+		return contextID, nil
+	}
+
+	record := &AlertContextEvent{
+		ContextID:    contextID,
+		ContextKey:   AlertGroupContextKey(event.GroupKey),
+		WebhookEvent: event,
+	}
+	recordedID, err := recorder.RecordWebhookInContext(ctx, a.config.AgentID, adapterType, eventType, record)
+	if err != nil {
+		return contextID, err
+	}
+	if recordedID != "" {
+		contextID = recordedID
+	}
+
+	a.contextMutex.Lock()
+	if event.Status == "resolved" {
+		delete(a.groupContexts, event.GroupKey)
+	} else if contextID != "" {
+		a.groupContexts[event.GroupKey] = contextID
+	}
+	a.contextMutex.Unlock()
+
+	return contextID, nil
+}
+
+// Close closes the adapter
+func (a *PrometheusAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// timeRange returns the start and end parameters. The end defaults to now and
+// the start to the range parameter, or DefaultRange, before the end.
+func (a *PrometheusAdapter) timeRange(params map[string]interface{}) (time.Time, time.Time, error) {
+	end, err := timeParam(params, "end", a.now())
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	lookback, err := durationParam(params, "range", a.config.DefaultRange)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	start, err := timeParam(params, "start", end.Add(-lookback))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("start must be before end")
+	}
+
+	return start, end, nil
+}
+
+// setOptionalRange sets the start and end query parameters if given
+func (a *PrometheusAdapter) setOptionalRange(query url.Values, params map[string]interface{}) error {
+	for _, key := range []string{"start", "end"} {
+		if _, ok := params[key]; !ok {
+			continue
+		}
+		value, err := timeParam(params, key, time.Time{})
+		if err != nil {
+			return err
+		}
+		query.Set(key, formatTime(value))
+	}
+	return nil
+}
+
+// prometheusRequest performs a Prometheus API request and decodes the response envelope
+func (a *PrometheusAdapter) prometheusRequest(ctx context.Context, operation, path string, query url.Values) (*apiResponse, error) {
+	if a.prometheusURL == "" {
+		return nil, adapterErrors.NewMissingConfigurationError(adapterType, operation,
+			fmt.Errorf("prometheus URL is not configured"), nil)
+	}
+
+	body, err := a.doRequest(ctx, operation, a.prometheusURL, http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response apiResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Prometheus response: %w", err), nil)
+	}
+	if response.Status != "success" {
+		return nil, adapterErrors.NewInvalidRequestError(adapterType, operation,
+			fmt.Errorf("prometheus query failed: %s: %s", response.ErrorType, response.Error), nil)
+	}
+
+	return &response, nil
+}
+
+// alertmanagerRequest performs an Alertmanager API request and decodes the JSON response into out
+func (a *PrometheusAdapter) alertmanagerRequest(ctx context.Context, operation, method, path string, query url.Values, body interface{}, out interface{}) error {
+	if a.alertmanagerURL == "" {
+		return adapterErrors.NewMissingConfigurationError(adapterType, operation,
+			fmt.Errorf("alertmanager URL is not configured"), nil)
+	}
+
+	respBody, err := a.doRequest(ctx, operation, a.alertmanagerURL, method, path, query, body)
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Alertmanager response: %w", err), nil)
+	}
+
+	return nil
+}
+
+// doRequest performs an API request and returns the response body
+func (a *PrometheusAdapter) doRequest(ctx context.Context, operation, baseURL, method, path string, query url.Values, body interface{}) ([]byte, error) {
+	requestURL := baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return nil, adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch {
+	case a.config.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+a.config.BearerToken)
+	case a.config.Username != "":
+		req.SetBasicAuth(a.config.Username, a.config.Password)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return nil, adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("API returned %d: %s", resp.StatusCode, errorMessage(respBody)), nil)
+	}
+
+	return respBody, nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *PrometheusAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// errorMessage extracts the message from a Prometheus or Alertmanager error
+// response. Alertmanager returns errors as a JSON string or plain text.
+func errorMessage(body []byte) string {
+	var response apiResponse
+	if json.Unmarshal(body, &response) == nil && response.Error != "" {
+		return response.Error
+	}
+	var message string
+	if json.Unmarshal(body, &message) == nil {
+		return message
+	}
+	return strings.TrimSpace(string(body))
+}
+
+// timeParam returns a time parameter given as RFC 3339 or as a unix timestamp
+func timeParam(params map[string]interface{}, key string, defaultValue time.Time) (time.Time, error) {
+	switch value := params[key].(type) {
+	case nil:
+		return defaultValue, nil
+	case time.Time:
+		return value, nil
+	case float64:
+		seconds := int64(value)
+		return time.Unix(seconds, int64((value-float64(seconds))*1e9)), nil
+	case int:
+		return time.Unix(int64(value), 0), nil
+	case int64:
+		return time.Unix(value, 0), nil
+	case string:
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+			return parsed, nil
+		}
+		if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+			return timeParam(map[string]interface{}{key: seconds}, key, defaultValue)
+		}
+		return time.Time{}, fmt.Errorf("invalid %s: %q is not an RFC 3339 time or unix timestamp", key, value)
+	default:
+		return time.Time{}, fmt.Errorf("invalid %s: unsupported type %T", key, value)
+	}
+}
+
+// durationParam returns a duration parameter given as a Go duration string
+// such as 90s or 2h, or as a number of seconds
+func durationParam(params map[string]interface{}, key string, defaultValue time.Duration) (time.Duration, error) {
+	switch value := params[key].(type) {
+	case nil:
+		return defaultValue, nil
+	case time.Duration:
+		return value, nil
+	case float64:
+		return time.Duration(value * float64(time.Second)), nil
+	case int:
+		return time.Duration(value) * time.Second, nil
+	case string:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		return duration, nil
+	default:
+		return 0, fmt.Errorf("invalid %s: unsupported type %T", key, value)
+	}
+}
+
+// formatTime formats a time as a unix timestamp for the Prometheus API
+func formatTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixNano())/1e9, 'f', 3, 64)
+}
+
+// toAlert summarizes an Alertmanager alert
+func toAlert(a alert) Alert {
+	return Alert{
+		Name:         a.Labels["alertname"],
+		State:        a.Status.State,
+		Severity:     a.Labels["severity"],
+		Summary:      a.Annotations["summary"],
+		Description:  a.Annotations["description"],
+		Labels:       a.Labels,
+		StartsAt:     a.StartsAt,
+		EndsAt:       a.EndsAt,
+		Fingerprint:  a.Fingerprint,
+		GeneratorURL: a.GeneratorURL,
+		SilencedBy:   a.Status.SilencedBy,
+		InhibitedBy:  a.Status.InhibitedBy,
+	}
+}
+
+// toSilence summarizes an Alertmanager silence
+func toSilence(s silence) Silence {
+	matchers := make([]string, 0, len(s.Matchers))
+	for _, m := range s.Matchers {
+		matchers = append(matchers, formatMatcher(m))
+	}
+
+	return Silence{
+		ID:        s.ID,
+		State:     s.Status.State,
+		Matchers:  matchers,
+		StartsAt:  s.StartsAt,
+		EndsAt:    s.EndsAt,
+		CreatedBy: s.CreatedBy,
+		Comment:   s.Comment,
+	}
+}
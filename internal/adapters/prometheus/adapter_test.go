@@ -0,0 +1,278 @@
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// recordedRequest captures the parts of a request the tests assert on
+type recordedRequest struct {
+	method  string
+	path    string
+	query   map[string][]string
+	body    map[string]interface{}
+	headers http.Header
+}
+
+var testNow = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func newTestAdapter(t *testing.T, responses map[string]string) (*PrometheusAdapter, *[]recordedRequest) {
+	requests := []recordedRequest{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded := recordedRequest{
+			method:  r.Method,
+			path:    r.URL.Path,
+			query:   r.URL.Query(),
+			headers: r.Header,
+		}
+		json.NewDecoder(r.Body).Decode(&recorded.body)
+		requests = append(requests, recorded)
+
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"invalid parameter \"query\": 1:5: parse error: unexpected end of input"}`))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.PrometheusURL = server.URL
+	config.AlertmanagerURL = server.URL
+	config.BearerToken = "prom-token"
+
+	logger := observability.NewLogger("prometheus_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+	adapter.now = func() time.Time { return testNow }
+
+	return adapter, &requests
+}
+
+func TestQuery(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v1/query": `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"instance":"web-1"},"value":[1760875200,"0.25"]},
+			{"metric":{"instance":"web-2"},"value":[1760875200,"0.75"]},
+			{"metric":{"instance":"web-3"},"value":[1760875200,"NaN"]}]}}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "query", map[string]interface{}{
+		"query": "rate(http_requests_total[5m])",
+	})
+	require.NoError(t, err)
+
+	query := result.(QueryResult)
+	assert.Equal(t, "vector", query.ResultType)
+	assert.Equal(t, 3, query.TotalSeries)
+	require.Len(t, query.Vector, 2, "NaN samples are dropped")
+	assert.Equal(t, "web-2", query.Vector[0].Labels["instance"], "highest values first")
+	assert.Equal(t, 0.75, query.Vector[0].Value)
+
+	request := (*requests)[0]
+	assert.Equal(t, "Bearer prom-token", request.headers.Get("Authorization"))
+	assert.Equal(t, []string{"rate(http_requests_total[5m])"}, request.query["query"])
+}
+
+func TestQueryRange(t *testing.T) {
+	values := make([]string, 0, 240)
+	for i := 0; i < 240; i++ {
+		values = append(values, fmt.Sprintf(`[%d,"%d"]`, 1760871600+i*15, i))
+	}
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v1/query_range": `{"status":"success","warnings":["results truncated"],"data":{"resultType":"matrix","result":[
+			{"metric":{"instance":"web-1"},"values":[[1760871600,"1"],[1760871660,"3"]]},
+			{"metric":{"instance":"web-2"},"values":[` + strings.Join(values, ",") + `]}]}}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "query_range", map[string]interface{}{
+		"query": "up",
+		"range": "1h",
+	})
+	require.NoError(t, err)
+
+	request := (*requests)[0]
+	assert.Equal(t, []string{"60"}, request.query["step"], "step fits the range in MaxPointsPerSeries points")
+	assert.Equal(t, []string{formatTime(testNow.Add(-time.Hour))}, request.query["start"])
+	assert.Equal(t, []string{formatTime(testNow)}, request.query["end"])
+
+	query := result.(QueryResult)
+	assert.Equal(t, []string{"results truncated"}, query.Warnings)
+	require.Len(t, query.Matrix, 2)
+
+	busy := query.Matrix[0]
+	assert.Equal(t, "web-2", busy.Labels["instance"], "series with the highest peak first")
+	assert.Equal(t, 240, busy.Samples)
+	assert.Equal(t, 0.0, busy.Min)
+	assert.Equal(t, 239.0, busy.Max)
+	assert.Equal(t, 119.5, busy.Avg)
+	assert.Equal(t, 239.0, busy.Last)
+	assert.True(t, busy.Downsampled)
+	assert.Len(t, busy.Points, 60)
+	assert.Equal(t, 1.5, busy.Points[0].Value, "points are bucket averages")
+
+	quiet := query.Matrix[1]
+	assert.False(t, quiet.Downsampled)
+	assert.Equal(t, 2.0, quiet.Avg)
+}
+
+func TestSeriesAndLabels(t *testing.T) {
+	series := make([]string, 0, 25)
+	for i := 0; i < 25; i++ {
+		series = append(series, fmt.Sprintf(`{"__name__":"up","instance":"web-%d"}`, i))
+	}
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v1/series":                `{"status":"success","data":[` + strings.Join(series, ",") + `]}`,
+		"GET /api/v1/label/instance/values": `{"status":"success","data":["web-1","web-2"]}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "series", map[string]interface{}{
+		"match": []interface{}{`up{job="web"}`},
+	})
+	require.NoError(t, err)
+
+	seriesResult := result.(SeriesResult)
+	assert.Equal(t, 25, seriesResult.Total)
+	assert.Len(t, seriesResult.Series, 20)
+	assert.True(t, seriesResult.Truncated)
+	assert.Equal(t, []string{`up{job="web"}`}, (*requests)[0].query["match[]"])
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "labels", map[string]interface{}{"label": "instance"})
+	require.NoError(t, err)
+	assert.Equal(t, LabelsResult{Label: "instance", Values: []string{"web-1", "web-2"}, Total: 2}, result)
+}
+
+func TestListAlertsAndSilences(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v2/alerts": `[
+			{"labels":{"alertname":"HighLatency","severity":"warning"},"annotations":{"summary":"p99 above 2s"},"startsAt":"2026-10-19T10:00:00Z","fingerprint":"a1","status":{"state":"active"}},
+			{"labels":{"alertname":"InstanceDown","severity":"critical"},"annotations":{},"startsAt":"2026-10-19T11:00:00Z","fingerprint":"b2","status":{"state":"suppressed","silencedBy":["s1"]}}]`,
+		"GET /api/v2/silences": `[
+			{"id":"s1","status":{"state":"active"},"matchers":[{"name":"alertname","value":"InstanceDown","isRegex":false,"isEqual":true}],"createdBy":"alice","comment":"maintenance"},
+			{"id":"s0","status":{"state":"expired"},"matchers":[{"name":"instance","value":"web-.*","isRegex":true,"isEqual":true}]}]`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_alerts", map[string]interface{}{
+		"filter":   []interface{}{`severity="critical"`},
+		"silenced": false,
+	})
+	require.NoError(t, err)
+
+	alerts := result.(AlertsResult)
+	require.Len(t, alerts.Alerts, 2)
+	assert.Equal(t, "InstanceDown", alerts.Alerts[0].Name, "newest first")
+	assert.Equal(t, []string{"s1"}, alerts.Alerts[0].SilencedBy)
+	assert.Equal(t, "p99 above 2s", alerts.Alerts[1].Summary)
+	assert.Equal(t, []string{`severity="critical"`}, (*requests)[0].query["filter"])
+	assert.Equal(t, []string{"false"}, (*requests)[0].query["silenced"])
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "list_silences", nil)
+	require.NoError(t, err)
+
+	silences := result.([]Silence)
+	require.Len(t, silences, 1, "expired silences are left out")
+	assert.Equal(t, []string{`alertname="InstanceDown"`}, silences[0].Matchers)
+}
+
+func TestCreateSilence(t *testing.T) {
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"POST /api/v2/silences": `{"silenceID":"7f1c"}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "create_silence", map[string]interface{}{
+		"matchers": []interface{}{`alertname="HighLatency"`, `instance=~"web-.*"`},
+		"duration": "30m",
+		"comment":  "Investigating latency",
+	})
+	require.NoError(t, err)
+
+	created := result.(Silence)
+	assert.Equal(t, "7f1c", created.ID)
+	assert.Equal(t, testNow.Add(30*time.Minute), created.EndsAt)
+
+	body := (*requests)[0].body
+	assert.Equal(t, "mcp-server", body["createdBy"])
+	assert.Equal(t, "Investigating latency (MCP context ctx-1)", body["comment"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "alertname", "value": "HighLatency", "isRegex": false, "isEqual": true},
+		map[string]interface{}{"name": "instance", "value": "web-.*", "isRegex": true, "isEqual": true},
+	}, body["matchers"])
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "create_silence", map[string]interface{}{
+		"matchers": []interface{}{`alertname="HighLatency"`},
+		"duration": "24h",
+		"comment":  "Mute for a day",
+	})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidParameter))
+	assert.Contains(t, err.Error(), "exceeds the maximum")
+	assert.Len(t, *requests, 1, "silences above the cap are not sent")
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 7)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	queryRange, _ := core.FindAction(actions, "query_range")
+	assert.Equal(t, core.SafetyRead, queryRange.Safety)
+	assert.NoError(t, queryRange.ValidateParams(map[string]interface{}{"query": "up", "start": "2024-01-01T00:00:00Z", "end": float64(1704070800), "step": "1m"}))
+	assert.Error(t, queryRange.ValidateParams(map[string]interface{}{"query": "up", "step": true}))
+
+	createSilence, _ := core.FindAction(actions, "create_silence")
+	assert.Equal(t, core.SafetyWrite, createSilence.Safety)
+	assert.NoError(t, createSilence.ValidateParams(map[string]interface{}{"matchers": []interface{}{"alertname=\"HighLatency\""}, "comment": "Deploying", "duration": "1h"}))
+	assert.Error(t, createSilence.ValidateParams(map[string]interface{}{"matchers": "alertname=\"HighLatency\""}))
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "query", map[string]interface{}{"query": "rate("})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidRequest))
+	assert.Contains(t, err.Error(), "parse error")
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "query_range", map[string]interface{}{"query": "up", "start": "yesterday"})
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "create_silence", map[string]interface{}{
+		"matchers": []interface{}{"not a matcher"},
+		"comment":  "test",
+	})
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "delete_silence", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+
+	alertsOnly, err := New(&Config{AlertmanagerURL: "http://alertmanager.example.com"}, nil, nil, nil)
+	require.NoError(t, err)
+	_, err = alertsOnly.ExecuteAction(context.Background(), "ctx-1", "query", map[string]interface{}{"query": "up"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeMissingConfiguration))
+
+	_, err = New(&Config{}, nil, nil, nil)
+	assert.Error(t, err)
+}
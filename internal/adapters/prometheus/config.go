@@ -0,0 +1,67 @@
+package prometheus
+
+import (
+	"time"
+)
+
+// Config holds configuration for the Prometheus and Alertmanager adapter
+type Config struct {
+	// API endpoints. Either may be left empty if only the other is used.
+	PrometheusURL   string `mapstructure:"prometheus_url"`
+	AlertmanagerURL string `mapstructure:"alertmanager_url"`
+
+	// Authentication, sent to both endpoints. A bearer token takes precedence
+	// over basic auth.
+	BearerToken string `mapstructure:"bearer_token"`
+	Username    string `mapstructure:"username"`
+	Password    string `mapstructure:"password"`
+
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// Result budget. Series beyond MaxSeries are dropped and range queries are
+	// downsampled to at most MaxPointsPerSeries points per series.
+	MaxSeries          int `mapstructure:"max_series"`
+	MaxPointsPerSeries int `mapstructure:"max_points_per_series"`
+	MaxLabelValues     int `mapstructure:"max_label_values"`
+	MaxAlerts          int `mapstructure:"max_alerts"`
+
+	// Default lookback of range queries without an explicit start
+	DefaultRange time.Duration `mapstructure:"default_range"`
+
+	// Silence settings. Silences longer than MaxSilenceDuration are rejected.
+	DefaultSilenceDuration time.Duration `mapstructure:"default_silence_duration"`
+	MaxSilenceDuration     time.Duration `mapstructure:"max_silence_duration"`
+	SilenceCreatedBy       string        `mapstructure:"silence_created_by"`
+
+	// Bearer token expected on Alertmanager webhook deliveries
+	WebhookToken string `mapstructure:"webhook_token"`
+
+	// AgentID owns the contexts opened for alert groups received by webhook.
+	// Alerts annotated with ContextAnnotation are attached to that context instead.
+	AgentID           string `mapstructure:"agent_id"`
+	ContextAnnotation string `mapstructure:"context_annotation"`
+
+	// Mock settings for local development. The mock serves both APIs.
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+}
+
+// DefaultConfig returns a default configuration for the Prometheus adapter
+func DefaultConfig() *Config {
+	return &Config{
+		PrometheusURL:          "http://localhost:9090",
+		AlertmanagerURL:        "http://localhost:9093",
+		RequestTimeout:         30 * time.Second,
+		MaxSeries:              20,
+		MaxPointsPerSeries:     60,
+		MaxLabelValues:         200,
+		MaxAlerts:              50,
+		DefaultRange:           time.Hour,
+		DefaultSilenceDuration: time.Hour,
+		MaxSilenceDuration:     4 * time.Hour,
+		SilenceCreatedBy:       "mcp-server",
+		AgentID:                "incident-response",
+		ContextAnnotation:      "mcp_context_id",
+		MockURL:                "http://localhost:8081/mock-prometheus",
+	}
+}
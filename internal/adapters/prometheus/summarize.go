@@ -0,0 +1,213 @@
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// summarizeQuery converts a query result into a QueryResult that fits the
+// result budget. Vectors and matrices are ordered by value, highest first, so
+// that the series dropped by truncation are the least significant ones.
+func summarizeQuery(data queryData, warnings []string, maxSeries, maxPoints int) (QueryResult, error) {
+	result := QueryResult{ResultType: data.ResultType, Warnings: warnings}
+
+	switch data.ResultType {
+	case "vector":
+		var samples []vectorSample
+		if err := json.Unmarshal(data.Result, &samples); err != nil {
+			return result, fmt.Errorf("failed to parse vector result: %w", err)
+		}
+		result.TotalSeries = len(samples)
+		result.Vector = []InstantSample{}
+		for _, sample := range samples {
+			value, err := sample.Value.float()
+			if err != nil {
+				return result, fmt.Errorf("failed to parse sample value: %w", err)
+			}
+			if !isFinite(value) {
+				continue
+			}
+			result.Vector = append(result.Vector, InstantSample{
+				Labels: sample.Metric,
+				Time:   sample.Value.time(),
+				Value:  value,
+			})
+		}
+		sort.SliceStable(result.Vector, func(i, j int) bool {
+			return result.Vector[i].Value > result.Vector[j].Value
+		})
+		if maxSeries > 0 && len(result.Vector) > maxSeries {
+			result.Vector = result.Vector[:maxSeries]
+			result.Truncated = true
+		}
+
+	case "matrix":
+		var series []matrixSeries
+		if err := json.Unmarshal(data.Result, &series); err != nil {
+			return result, fmt.Errorf("failed to parse matrix result: %w", err)
+		}
+		result.TotalSeries = len(series)
+		result.Matrix = []SeriesSummary{}
+		for _, s := range series {
+			summary, err := summarizeSeries(s, maxPoints)
+			if err != nil {
+				return result, err
+			}
+			result.Matrix = append(result.Matrix, summary)
+		}
+		sort.SliceStable(result.Matrix, func(i, j int) bool {
+			return result.Matrix[i].Max > result.Matrix[j].Max
+		})
+		if maxSeries > 0 && len(result.Matrix) > maxSeries {
+			result.Matrix = result.Matrix[:maxSeries]
+			result.Truncated = true
+		}
+
+	case "scalar":
+		var pair samplePair
+		if err := json.Unmarshal(data.Result, &pair); err != nil {
+			return result, fmt.Errorf("failed to parse scalar result: %w", err)
+		}
+		value, err := pair.float()
+		if err != nil {
+			return result, fmt.Errorf("failed to parse scalar value: %w", err)
+		}
+		if isFinite(value) {
+			result.Scalar = &Sample{Time: pair.time(), Value: value}
+		}
+
+	case "string":
+		var pair samplePair
+		if err := json.Unmarshal(data.Result, &pair); err != nil {
+			return result, fmt.Errorf("failed to parse string result: %w", err)
+		}
+		result.String = pair.Value
+
+	default:
+		return result, fmt.Errorf("unsupported result type: %s", data.ResultType)
+	}
+
+	return result, nil
+}
+
+// summarizeSeries computes the statistics of a series and downsamples its points
+func summarizeSeries(series matrixSeries, maxPoints int) (SeriesSummary, error) {
+	summary := SeriesSummary{Labels: series.Metric, Samples: len(series.Values)}
+
+	points := make([]Sample, 0, len(series.Values))
+	sum := 0.0
+	for _, pair := range series.Values {
+		value, err := pair.float()
+		if err != nil {
+			return summary, fmt.Errorf("failed to parse sample value: %w", err)
+		}
+		if !isFinite(value) {
+			summary.NonFinite++
+			continue
+		}
+
+		if len(points) == 0 {
+			summary.Min, summary.Max, summary.First = value, value, value
+		}
+		summary.Min = math.Min(summary.Min, value)
+		summary.Max = math.Max(summary.Max, value)
+		summary.Last = value
+		sum += value
+		points = append(points, Sample{Time: pair.time(), Value: value})
+	}
+
+	if len(points) > 0 {
+		summary.Avg = sum / float64(len(points))
+	}
+
+	summary.Points = downsample(points, maxPoints)
+	summary.Downsampled = len(summary.Points) < len(points)
+
+	return summary, nil
+}
+
+// downsample reduces points to at most maxPoints by averaging consecutive
+// buckets of points. Each bucket is reported at the time of its last point.
+func downsample(points []Sample, maxPoints int) []Sample {
+	if maxPoints <= 0 || len(points) <= maxPoints {
+		return points
+	}
+
+	result := make([]Sample, 0, maxPoints)
+	for bucket := 0; bucket < maxPoints; bucket++ {
+		start := bucket * len(points) / maxPoints
+		end := (bucket + 1) * len(points) / maxPoints
+		if start == end {
+			continue
+		}
+
+		sum := 0.0
+		for _, point := range points[start:end] {
+			sum += point.Value
+		}
+		result = append(result, Sample{
+			Time:  points[end-1].Time,
+			Value: sum / float64(end-start),
+		})
+	}
+
+	return result
+}
+
+// parseMatcher parses a matcher in PromQL syntax, e.g. alertname="HighLatency"
+// or instance=~"web-.*". Quotes around the value are optional.
+func parseMatcher(expression string) (matcher, error) {
+	for _, operator := range []string{"=~", "!~", "!=", "="} {
+		index := strings.Index(expression, operator)
+		if index <= 0 {
+			continue
+		}
+
+		name := strings.TrimSpace(expression[:index])
+		value := strings.TrimSpace(expression[index+len(operator):])
+		if unquoted, err := unquote(value); err == nil {
+			value = unquoted
+		}
+
+		return matcher{
+			Name:    name,
+			Value:   value,
+			IsRegex: strings.HasSuffix(operator, "~"),
+			IsEqual: !strings.HasPrefix(operator, "!"),
+		}, nil
+	}
+
+	return matcher{}, fmt.Errorf("invalid matcher %q: expected name=value, name!=value, name=~regex or name!~regex", expression)
+}
+
+// formatMatcher formats a matcher in PromQL syntax
+func formatMatcher(m matcher) string {
+	operator := "="
+	switch {
+	case m.IsRegex && m.IsEqual:
+		operator = "=~"
+	case m.IsRegex:
+		operator = "!~"
+	case !m.IsEqual:
+		operator = "!="
+	}
+	return fmt.Sprintf("%s%s%q", m.Name, operator, m.Value)
+}
+
+func unquote(value string) (string, error) {
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		var unquoted string
+		if err := json.Unmarshal([]byte(value), &unquoted); err != nil {
+			return "", err
+		}
+		return unquoted, nil
+	}
+	return value, nil
+}
+
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
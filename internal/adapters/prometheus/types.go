@@ -0,0 +1,201 @@
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// The types below are the subset of the Prometheus and Alertmanager API objects
+// used by the adapter
+
+type apiResponse struct {
+	Status    string          `json:"status"`
+	Data      json.RawMessage `json:"data"`
+	ErrorType string          `json:"errorType"`
+	Error     string          `json:"error"`
+	Warnings  []string        `json:"warnings"`
+}
+
+type queryData struct {
+	ResultType string          `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+}
+
+type vectorSample struct {
+	Metric map[string]string `json:"metric"`
+	Value  samplePair        `json:"value"`
+}
+
+type matrixSeries struct {
+	Metric map[string]string `json:"metric"`
+	Values []samplePair      `json:"values"`
+}
+
+// samplePair is a [unix timestamp, "value"] pair. Values are strings so that
+// NaN and infinities can be represented.
+type samplePair struct {
+	Time  float64
+	Value string
+}
+
+func (p *samplePair) UnmarshalJSON(data []byte) error {
+	var pair []interface{}
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("invalid sample: %s", string(data))
+	}
+	timestamp, ok := pair[0].(float64)
+	if !ok {
+		return fmt.Errorf("invalid sample timestamp: %v", pair[0])
+	}
+	value, ok := pair[1].(string)
+	if !ok {
+		return fmt.Errorf("invalid sample value: %v", pair[1])
+	}
+	p.Time = timestamp
+	p.Value = value
+	return nil
+}
+
+func (p samplePair) time() time.Time {
+	seconds := int64(p.Time)
+	return time.Unix(seconds, int64((p.Time-float64(seconds))*1e9)).UTC()
+}
+
+func (p samplePair) float() (float64, error) {
+	return strconv.ParseFloat(p.Value, 64)
+}
+
+type alert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
+	Fingerprint  string            `json:"fingerprint"`
+	GeneratorURL string            `json:"generatorURL"`
+	Status       struct {
+		State       string   `json:"state"`
+		SilencedBy  []string `json:"silencedBy"`
+		InhibitedBy []string `json:"inhibitedBy"`
+	} `json:"status"`
+	Receivers []struct {
+		Name string `json:"name"`
+	} `json:"receivers"`
+}
+
+type matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+type silence struct {
+	ID        string    `json:"id"`
+	Matchers  []matcher `json:"matchers"`
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	CreatedBy string    `json:"createdBy"`
+	Comment   string    `json:"comment"`
+	Status    struct {
+		State string `json:"state"`
+	} `json:"status"`
+}
+
+// Sample is a single point of a series
+type Sample struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// InstantSample is a series of an instant query result
+type InstantSample struct {
+	Labels map[string]string `json:"labels"`
+	Time   time.Time         `json:"time"`
+	Value  float64           `json:"value"`
+}
+
+// SeriesSummary summarizes a series of a range query result. The statistics
+// are computed over all samples; Points is downsampled to fit the result budget.
+type SeriesSummary struct {
+	Labels  map[string]string `json:"labels"`
+	Samples int               `json:"samples"`
+	Min     float64           `json:"min"`
+	Max     float64           `json:"max"`
+	Avg     float64           `json:"avg"`
+	First   float64           `json:"first"`
+	Last    float64           `json:"last"`
+	Points  []Sample          `json:"points"`
+	// Downsampled is set when Points holds bucket averages rather than samples
+	Downsampled bool `json:"downsampled,omitempty"`
+	// NonFinite counts NaN and infinite samples, which are left out of the
+	// statistics and points
+	NonFinite int `json:"non_finite,omitempty"`
+}
+
+// QueryResult is the summarized result of a query or range query
+type QueryResult struct {
+	ResultType string          `json:"result_type"`
+	Vector     []InstantSample `json:"vector,omitempty"`
+	Matrix     []SeriesSummary `json:"matrix,omitempty"`
+	Scalar     *Sample         `json:"scalar,omitempty"`
+	String     string          `json:"string,omitempty"`
+	// TotalSeries is the number of series before truncation to MaxSeries
+	TotalSeries int      `json:"total_series"`
+	Truncated   bool     `json:"truncated,omitempty"`
+	Warnings    []string `json:"warnings,omitempty"`
+}
+
+// SeriesResult is the result of a series lookup
+type SeriesResult struct {
+	Series    []map[string]string `json:"series"`
+	Total     int                 `json:"total"`
+	Truncated bool                `json:"truncated,omitempty"`
+}
+
+// LabelsResult is the result of a label name or label value lookup
+type LabelsResult struct {
+	Label     string   `json:"label,omitempty"`
+	Values    []string `json:"values"`
+	Total     int      `json:"total"`
+	Truncated bool     `json:"truncated,omitempty"`
+}
+
+// Alert is a summary of an Alertmanager alert
+type Alert struct {
+	Name         string            `json:"name"`
+	State        string            `json:"state"`
+	Severity     string            `json:"severity,omitempty"`
+	Summary      string            `json:"summary,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Labels       map[string]string `json:"labels"`
+	StartsAt     time.Time         `json:"starts_at"`
+	EndsAt       time.Time         `json:"ends_at,omitempty"`
+	Fingerprint  string            `json:"fingerprint"`
+	GeneratorURL string            `json:"generator_url,omitempty"`
+	SilencedBy   []string          `json:"silenced_by,omitempty"`
+	InhibitedBy  []string          `json:"inhibited_by,omitempty"`
+}
+
+// AlertsResult is the result of an alert listing, newest alerts first
+type AlertsResult struct {
+	Alerts    []Alert `json:"alerts"`
+	Total     int     `json:"total"`
+	Truncated bool    `json:"truncated,omitempty"`
+}
+
+// Silence is a summary of an Alertmanager silence
+type Silence struct {
+	ID        string    `json:"id"`
+	State     string    `json:"state"`
+	Matchers  []string  `json:"matchers"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	CreatedBy string    `json:"created_by"`
+	Comment   string    `json:"comment"`
+}
@@ -0,0 +1,158 @@
+package prometheus
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// WebhookEvent is the normalized summary of an Alertmanager webhook notification
+type WebhookEvent struct {
+	Receiver string `json:"receiver"`
+	// Status is firing if any alert of the group is firing, resolved otherwise
+	Status            string            `json:"status"`
+	GroupKey          string            `json:"group_key"`
+	GroupLabels       map[string]string `json:"group_labels"`
+	CommonLabels      map[string]string `json:"common_labels"`
+	CommonAnnotations map[string]string `json:"common_annotations"`
+	ExternalURL       string            `json:"external_url,omitempty"`
+	Alerts            []Alert           `json:"alerts"`
+	Firing            int               `json:"firing"`
+	Resolved          int               `json:"resolved"`
+	// TruncatedAlerts is the number of alerts Alertmanager left out of the notification
+	TruncatedAlerts int `json:"truncated_alerts,omitempty"`
+
+	// annotations of the alerts, used to find context ID annotations
+	annotations []map[string]string
+}
+
+// AlertContextEvent is the payload recorded in an alert group's context.
+// ContextID is empty for the first notification of a group, in which case a
+// new context is opened; ContextKey identifies the group across notifications.
+type AlertContextEvent struct {
+	ContextID  string `json:"context_id,omitempty"`
+	ContextKey string `json:"context_key"`
+	*WebhookEvent
+}
+
+// AlertGroupContextKey returns the key identifying the context of an alert group
+func AlertGroupContextKey(groupKey string) string {
+	return "alertmanager:group:" + groupKey
+}
+
+// webhookPayload is the Alertmanager webhook payload, version 4
+type webhookPayload struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	TruncatedAlerts   int               `json:"truncatedAlerts"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []struct {
+		Status       string            `json:"status"`
+		Labels       map[string]string `json:"labels"`
+		Annotations  map[string]string `json:"annotations"`
+		StartsAt     time.Time         `json:"startsAt"`
+		EndsAt       time.Time         `json:"endsAt"`
+		GeneratorURL string            `json:"generatorURL"`
+		Fingerprint  string            `json:"fingerprint"`
+	} `json:"alerts"`
+}
+
+// VerifyWebhookToken checks the bearer token of a webhook delivery, set with
+// http_config.authorization in the Alertmanager receiver. If no token is
+// configured every delivery is rejected.
+func (a *PrometheusAdapter) VerifyWebhookToken(authorizationHeader string) bool {
+	if a.config.WebhookToken == "" {
+		return false
+	}
+
+	token, ok := strings.CutPrefix(authorizationHeader, "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(a.config.WebhookToken)) == 1
+}
+
+// ReceiveWebhook implements core.WebhookReceiver by checking the bearer token
+// Alertmanager sends in the Authorization header
+func (a *PrometheusAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifyWebhookToken(header.Get("Authorization")) {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid webhook token"), nil)
+	}
+	return body, nil
+}
+
+// ParseWebhook decodes an Alertmanager webhook payload into a normalized event
+func ParseWebhook(payload []byte) (*WebhookEvent, error) {
+	var body webhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse Alertmanager webhook payload: %w", err)
+	}
+	if body.GroupKey == "" || body.Status == "" {
+		return nil, fmt.Errorf("alertmanager webhook payload is missing groupKey or status")
+	}
+
+	event := &WebhookEvent{
+		Receiver:          body.Receiver,
+		Status:            body.Status,
+		GroupKey:          body.GroupKey,
+		GroupLabels:       body.GroupLabels,
+		CommonLabels:      body.CommonLabels,
+		CommonAnnotations: body.CommonAnnotations,
+		ExternalURL:       body.ExternalURL,
+		Alerts:            make([]Alert, 0, len(body.Alerts)),
+		TruncatedAlerts:   body.TruncatedAlerts,
+	}
+
+	for _, a := range body.Alerts {
+		event.Alerts = append(event.Alerts, Alert{
+			Name:         a.Labels["alertname"],
+			State:        a.Status,
+			Severity:     a.Labels["severity"],
+			Summary:      a.Annotations["summary"],
+			Description:  a.Annotations["description"],
+			Labels:       a.Labels,
+			StartsAt:     a.StartsAt,
+			EndsAt:       a.EndsAt,
+			Fingerprint:  a.Fingerprint,
+			GeneratorURL: a.GeneratorURL,
+		})
+		event.annotations = append(event.annotations, a.Annotations)
+
+		if a.Status == "firing" {
+			event.Firing++
+		} else {
+			event.Resolved++
+		}
+	}
+
+	return event, nil
+}
+
+// annotatedContextID returns the context ID set by the given annotation on the
+// group or on any of its alerts
+func (e *WebhookEvent) annotatedContextID(annotation string) string {
+	if annotation == "" {
+		return ""
+	}
+	if contextID := e.CommonAnnotations[annotation]; contextID != "" {
+		return contextID
+	}
+	for _, annotations := range e.annotations {
+		if contextID := annotations[annotation]; contextID != "" {
+			return contextID
+		}
+	}
+	return ""
+}
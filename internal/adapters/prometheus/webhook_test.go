@@ -0,0 +1,141 @@
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// eventRecorder records adapter events emitted during a test
+type eventRecorder struct {
+	events []*events.AdapterEvent
+}
+
+func (r *eventRecorder) Handle(ctx context.Context, event *events.AdapterEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+// fakeContextRecorder opens a context for payloads without a context ID and
+// attaches to the given context otherwise
+type fakeContextRecorder struct {
+	opened   int
+	recorded []*AlertContextEvent
+}
+
+func (r *fakeContextRecorder) RecordWebhookInContext(ctx context.Context, agentID, adapterType, eventType string, payload interface{}) (string, error) {
+	event := payload.(*AlertContextEvent)
+	r.recorded = append(r.recorded, event)
+	if event.ContextID != "" {
+		return event.ContextID, nil
+	}
+	r.opened++
+	return fmt.Sprintf("context-%d", r.opened), nil
+}
+
+func testWebhookPayload(groupKey, status, annotations string) string {
+	return `{"version":"4","groupKey":"` + groupKey + `","status":"` + status + `","receiver":"mcp",
+		"groupLabels":{"alertname":"HighLatency"},"commonLabels":{"alertname":"HighLatency","severity":"warning"},
+		"commonAnnotations":{},"externalURL":"http://alertmanager:9093","alerts":[
+		{"status":"` + status + `","labels":{"alertname":"HighLatency","instance":"web-1"},"annotations":` + annotations + `,
+		"startsAt":"2026-10-19T10:00:00Z","fingerprint":"a1"},
+		{"status":"resolved","labels":{"alertname":"HighLatency","instance":"web-2"},"annotations":{},
+		"startsAt":"2026-10-19T09:00:00Z","endsAt":"2026-10-19T09:30:00Z","fingerprint":"b2"}]}`
+}
+
+func TestParseWebhook(t *testing.T) {
+	event, err := ParseWebhook([]byte(testWebhookPayload(`{}:{alertname=\"HighLatency\"}`, "firing", `{"summary":"p99 above 2s"}`)))
+	require.NoError(t, err)
+	assert.Equal(t, `{}:{alertname="HighLatency"}`, event.GroupKey)
+	assert.Equal(t, "firing", event.Status)
+	assert.Equal(t, "mcp", event.Receiver)
+	assert.Equal(t, 1, event.Firing)
+	assert.Equal(t, 1, event.Resolved)
+	require.Len(t, event.Alerts, 2)
+	assert.Equal(t, "HighLatency", event.Alerts[0].Name)
+	assert.Equal(t, "p99 above 2s", event.Alerts[0].Summary)
+
+	_, err = ParseWebhook([]byte(`{"alerts":[]}`))
+	assert.Error(t, err)
+}
+
+func TestWebhookContexts(t *testing.T) {
+	logger := observability.NewLogger("prometheus_test")
+	eventBus := events.NewEventBus(logger)
+	recorder := &eventRecorder{}
+	eventBus.SubscribeAll(recorder)
+
+	config := DefaultConfig()
+	config.WebhookToken = "am-token"
+	adapter, err := New(config, logger, nil, eventBus)
+	require.NoError(t, err)
+
+	contexts := &fakeContextRecorder{}
+	adapter.SetContextRecorder(contexts)
+
+	deliver := func(payload, token string) error {
+		header := http.Header{}
+		header.Set("Authorization", "Bearer "+token)
+		received, err := adapter.ReceiveWebhook(header, nil, []byte(payload))
+		if err != nil {
+			return err
+		}
+		return adapter.HandleWebhook(context.Background(), "", received)
+	}
+
+	err = deliver(testWebhookPayload("g1", "firing", `{}`), "wrong")
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized))
+
+	// The first notification opens the group context and later ones attach to it
+	require.NoError(t, deliver(testWebhookPayload("g1", "firing", `{}`), "am-token"))
+	require.NoError(t, deliver(testWebhookPayload("g1", "firing", `{}`), "am-token"))
+	assert.Equal(t, 1, contexts.opened)
+	assert.Equal(t, "context-1", contexts.recorded[1].ContextID)
+	assert.Equal(t, AlertGroupContextKey("g1"), contexts.recorded[1].ContextKey)
+
+	contextID, ok := adapter.GroupContextID("g1")
+	assert.True(t, ok)
+	assert.Equal(t, "context-1", contextID)
+
+	// Resolving the group forgets its context
+	require.NoError(t, deliver(testWebhookPayload("g1", "resolved", `{}`), "am-token"))
+	_, ok = adapter.GroupContextID("g1")
+	assert.False(t, ok)
+
+	// Alerts annotated with a context ID attach to that context
+	require.NoError(t, deliver(testWebhookPayload("g2", "firing", `{"mcp_context_id":"ctx-42"}`), "am-token"))
+	assert.Equal(t, "ctx-42", contexts.recorded[3].ContextID)
+	assert.Equal(t, 1, contexts.opened)
+
+	require.Len(t, recorder.events, 4)
+	assert.Equal(t, "alertmanager.resolved", recorder.events[2].Metadata["eventType"])
+	assert.Equal(t, "ctx-42", recorder.events[3].Metadata["contextId"])
+
+	adapter.config.WebhookToken = ""
+	err = deliver(testWebhookPayload("g1", "firing", `{}`), "")
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized), "deliveries are rejected when no token is configured")
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.WebhookToken = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("prometheus_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"alerts-firing.json":   "alertmanager.firing",
+		"alerts-resolved.json": "alertmanager.resolved",
+	})
+}
@@ -0,0 +1,101 @@
+// Package argocd registers the Argo CD adapter for application status, diffs,
+// syncs and rollbacks.
+package argocd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	argocdAdapter "github.com/S-Corkum/mcp-server/internal/adapters/argocd"
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Argo CD adapter
+const adapterType = "argocd"
+
+// RegisterAdapter registers the Argo CD adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		argocdConfig := argocdAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *argocdAdapter.Config:
+			argocdConfig = cfg
+		case map[string]interface{}:
+			if serverURL, ok := cfg["server_url"].(string); ok {
+				argocdConfig.ServerURL = serverURL
+			}
+
+			if authToken, ok := cfg["auth_token"].(string); ok {
+				argocdConfig.AuthToken = authToken
+			}
+
+			if insecure, ok := cfg["insecure_skip_tls_verify"].(bool); ok {
+				argocdConfig.InsecureSkipTLSVerify = insecure
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				argocdConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if namespace, ok := cfg["app_namespace"].(string); ok {
+				argocdConfig.AppNamespace = namespace
+			}
+
+			if token, ok := cfg["webhook_token"].(string); ok {
+				argocdConfig.WebhookToken = token
+			}
+
+			switch labels := cfg["production_labels"].(type) {
+			case []string:
+				argocdConfig.ProductionLabels = labels
+			case []interface{}:
+				argocdConfig.ProductionLabels = nil
+				for _, label := range labels {
+					if s, ok := label.(string); ok {
+						argocdConfig.ProductionLabels = append(argocdConfig.ProductionLabels, s)
+					}
+				}
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				argocdConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				argocdConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := argocdAdapter.New(argocdConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Argo CD adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
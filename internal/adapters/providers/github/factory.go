@@ -57,6 +57,10 @@ func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.Event
 			if baseURL, ok := configMap["base_url"].(string); ok {
 				githubConfig.BaseURL = baseURL
 			}
+			
+			if secret, ok := configMap["webhook_secret"].(string); ok {
+				githubConfig.WebhookSecret = secret
+			}
 		}
 		
 		// Create adapter
@@ -0,0 +1,89 @@
+// Package gitlab registers the GitLab adapter for projects, issues, merge
+// requests, pipelines and notes.
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	gitlabAdapter "github.com/S-Corkum/mcp-server/internal/adapters/gitlab"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the GitLab adapter
+const adapterType = "gitlab"
+
+// RegisterAdapter registers the GitLab adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		gitlabConfig := gitlabAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *gitlabAdapter.Config:
+			gitlabConfig = cfg
+		case map[string]interface{}:
+			if token, ok := cfg["token"].(string); ok {
+				gitlabConfig.Token = token
+			}
+
+			if tokenType, ok := cfg["token_type"].(string); ok {
+				gitlabConfig.TokenType = tokenType
+			}
+
+			if baseURL, ok := cfg["base_url"].(string); ok {
+				gitlabConfig.BaseURL = baseURL
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				gitlabConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if project, ok := cfg["default_project"].(string); ok {
+				gitlabConfig.DefaultProject = project
+			}
+
+			if secret, ok := cfg["webhook_secret"].(string); ok {
+				gitlabConfig.WebhookSecret = secret
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				gitlabConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				gitlabConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := gitlabAdapter.New(gitlabConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GitLab adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Package jenkins registers the Jenkins adapter for triggering builds and
+// reading build status, test reports and console output.
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	jenkinsAdapter "github.com/S-Corkum/mcp-server/internal/adapters/jenkins"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Jenkins adapter
+const adapterType = "jenkins"
+
+// RegisterAdapter registers the Jenkins adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		jenkinsConfig := jenkinsAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *jenkinsAdapter.Config:
+			jenkinsConfig = cfg
+		case map[string]interface{}:
+			if baseURL, ok := cfg["base_url"].(string); ok {
+				jenkinsConfig.BaseURL = baseURL
+			}
+
+			if username, ok := cfg["username"].(string); ok {
+				jenkinsConfig.Username = username
+			}
+
+			if apiToken, ok := cfg["api_token"].(string); ok {
+				jenkinsConfig.APIToken = apiToken
+			}
+
+			if disableCrumb, ok := cfg["disable_crumb"].(bool); ok {
+				jenkinsConfig.DisableCrumb = disableCrumb
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				jenkinsConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if timeout, ok := cfg["build_timeout"].(int); ok {
+				jenkinsConfig.BuildTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if token, ok := cfg["webhook_token"].(string); ok {
+				jenkinsConfig.WebhookToken = token
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				jenkinsConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				jenkinsConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := jenkinsAdapter.New(jenkinsConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Jenkins adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
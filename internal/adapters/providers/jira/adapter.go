@@ -0,0 +1,97 @@
+// Package jira registers the Jira adapter for issue search, creation,
+// workflow transitions, comments and links on Jira Cloud and Data Center.
+package jira
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	jiraAdapter "github.com/S-Corkum/mcp-server/internal/adapters/jira"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Jira adapter
+const adapterType = "jira"
+
+// RegisterAdapter registers the Jira adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		jiraConfig := jiraAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *jiraAdapter.Config:
+			jiraConfig = cfg
+		case map[string]interface{}:
+			if baseURL, ok := cfg["base_url"].(string); ok {
+				jiraConfig.BaseURL = baseURL
+			}
+
+			if deployment, ok := cfg["deployment"].(string); ok {
+				jiraConfig.Deployment = deployment
+			}
+
+			if email, ok := cfg["email"].(string); ok {
+				jiraConfig.Email = email
+			}
+
+			if apiToken, ok := cfg["api_token"].(string); ok {
+				jiraConfig.APIToken = apiToken
+			}
+
+			if pat, ok := cfg["personal_access_token"].(string); ok {
+				jiraConfig.PersonalAccessToken = pat
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				jiraConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if secret, ok := cfg["webhook_secret"].(string); ok {
+				jiraConfig.WebhookSecret = secret
+			}
+
+			if propertyKey, ok := cfg["context_property_key"].(string); ok {
+				jiraConfig.ContextPropertyKey = propertyKey
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				jiraConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				jiraConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := jiraAdapter.New(jiraConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Jira adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
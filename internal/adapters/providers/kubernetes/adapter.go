@@ -0,0 +1,113 @@
+// Package kubernetes registers the Kubernetes adapter for cluster introspection
+// and guarded rollout operations.
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	kubernetesAdapter "github.com/S-Corkum/mcp-server/internal/adapters/kubernetes"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Kubernetes adapter
+const adapterType = "kubernetes"
+
+// RegisterAdapter registers the Kubernetes adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		k8sConfig := kubernetesAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *kubernetesAdapter.Config:
+			k8sConfig = cfg
+		case map[string]interface{}:
+			if inCluster, ok := cfg["in_cluster"].(bool); ok {
+				k8sConfig.InCluster = inCluster
+			}
+
+			if kubeconfig, ok := cfg["kubeconfig"].(string); ok {
+				k8sConfig.KubeconfigPath = kubeconfig
+			}
+
+			if contextName, ok := cfg["context"].(string); ok {
+				k8sConfig.Context = contextName
+			}
+
+			if host, ok := cfg["host"].(string); ok {
+				k8sConfig.Host = host
+			}
+
+			if token, ok := cfg["token"].(string); ok {
+				k8sConfig.Token = token
+			}
+
+			if caFile, ok := cfg["ca_file"].(string); ok {
+				k8sConfig.CAFile = caFile
+			}
+
+			if insecure, ok := cfg["insecure_skip_tls_verify"].(bool); ok {
+				k8sConfig.InsecureSkipTLSVerify = insecure
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				k8sConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if namespace, ok := cfg["default_namespace"].(string); ok {
+				k8sConfig.DefaultNamespace = namespace
+			}
+
+			if maxLogLines, ok := cfg["max_log_lines"].(int); ok {
+				k8sConfig.MaxLogLines = maxLogLines
+			}
+
+			if token, ok := cfg["webhook_token"].(string); ok {
+				k8sConfig.WebhookToken = token
+			}
+
+			switch namespaces := cfg["protected_namespaces"].(type) {
+			case []string:
+				k8sConfig.ProtectedNamespaces = namespaces
+			case []interface{}:
+				k8sConfig.ProtectedNamespaces = nil
+				for _, namespace := range namespaces {
+					if s, ok := namespace.(string); ok {
+						k8sConfig.ProtectedNamespaces = append(k8sConfig.ProtectedNamespaces, s)
+					}
+				}
+			}
+		}
+
+		adapter, err := kubernetesAdapter.New(k8sConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Kubernetes adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
@@ -0,0 +1,189 @@
+// Package openapi registers adapters generated from OpenAPI documents. Each
+// document configured under the openapi key of the factory is registered as
+// its own adapter type, so internal services become available without code
+// changes.
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	adapterConfig "github.com/S-Corkum/mcp-server/internal/adapters/config"
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	openapiAdapter "github.com/S-Corkum/mcp-server/internal/adapters/openapi"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// configKey is the factory configuration key listing the OpenAPI documents
+const configKey = "openapi"
+
+// RegisterAdapter registers an adapter with the factory for each OpenAPI
+// document configured under the openapi key. The configuration is a list of
+// *openapi.Config or of maps with the keys read by ParseConfig.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If a document configuration is invalid or registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	config, ok := factory.GetConfig(configKey)
+	if !ok || config == nil {
+		return nil
+	}
+
+	var specs []interface{}
+	switch cfg := config.(type) {
+	case []*openapiAdapter.Config:
+		for _, spec := range cfg {
+			specs = append(specs, spec)
+		}
+	case []interface{}:
+		specs = cfg
+	case []map[string]interface{}:
+		for _, spec := range cfg {
+			specs = append(specs, spec)
+		}
+	default:
+		return fmt.Errorf("openapi configuration must be a list of documents, got %T", config)
+	}
+
+	for i, spec := range specs {
+		var specConfig *openapiAdapter.Config
+		switch cfg := spec.(type) {
+		case *openapiAdapter.Config:
+			specConfig = cfg
+		case map[string]interface{}:
+			specConfig = ParseConfig(cfg)
+		default:
+			return fmt.Errorf("openapi document %d: unsupported configuration type %T", i, spec)
+		}
+
+		if err := RegisterSpec(factory, specConfig, eventBus, metricsClient, logger); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RegisterSpec registers an adapter for one OpenAPI document under its
+// configured name. The document is loaded when the adapter is created.
+func RegisterSpec(factory *core.DefaultAdapterFactory, specConfig *openapiAdapter.Config, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if specConfig == nil || specConfig.Name == "" {
+		return fmt.Errorf("openapi document configuration must have a name")
+	}
+
+	// A document must not replace a built-in adapter or another document
+	for _, registered := range factory.ListRegisteredAdapterTypes() {
+		if registered == specConfig.Name {
+			return fmt.Errorf("openapi document name %s is already registered", specConfig.Name)
+		}
+	}
+
+	factory.RegisterAdapterCreator(specConfig.Name, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		// A configuration set under the document name replaces the registered one
+		adapterCfg := specConfig
+		if cfg, ok := config.(*openapiAdapter.Config); ok {
+			adapterCfg = cfg
+		}
+
+		adapter, err := openapiAdapter.New(adapterCfg, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OpenAPI adapter %s: %w", specConfig.Name, err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
+
+// ParseConfig builds a document configuration from a map. Timeouts are in seconds.
+func ParseConfig(cfg map[string]interface{}) *openapiAdapter.Config {
+	specConfig := openapiAdapter.DefaultConfig()
+
+	if name, ok := cfg["name"].(string); ok {
+		specConfig.Name = name
+	}
+
+	if specPath, ok := cfg["spec_path"].(string); ok {
+		specConfig.SpecPath = specPath
+	}
+
+	if spec, ok := cfg["spec"].(string); ok {
+		specConfig.Spec = spec
+	}
+
+	if baseURL, ok := cfg["base_url"].(string); ok {
+		specConfig.BaseURL = baseURL
+	}
+
+	switch operations := cfg["allowed_operations"].(type) {
+	case []string:
+		specConfig.AllowedOperations = operations
+	case []interface{}:
+		specConfig.AllowedOperations = make([]string, 0, len(operations))
+		for _, op := range operations {
+			if s, ok := op.(string); ok {
+				specConfig.AllowedOperations = append(specConfig.AllowedOperations, s)
+			}
+		}
+	}
+
+	if healthPath, ok := cfg["health_path"].(string); ok {
+		specConfig.HealthPath = healthPath
+	}
+
+	if timeout, ok := cfg["request_timeout"].(int); ok {
+		specConfig.RequestTimeout = time.Duration(timeout) * time.Second
+	}
+
+	if maxResponseSize, ok := cfg["max_response_size"].(int); ok {
+		specConfig.MaxResponseSize = int64(maxResponseSize)
+	}
+
+	if resilience, ok := cfg["resilience"].(adapterConfig.ResilienceConfig); ok {
+		specConfig.Resilience = resilience
+	}
+
+	if security, ok := cfg["security"].(adapterConfig.SecurityConfig); ok {
+		specConfig.Security = security
+	}
+
+	if authType, ok := cfg["auth_type"].(string); ok {
+		specConfig.Security.Enabled = true
+		specConfig.Security.Authentication.Type = authType
+	}
+
+	if authSettings, ok := cfg["auth_settings"].(map[string]interface{}); ok {
+		specConfig.Security.Authentication.Settings = authSettings
+	}
+
+	if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+		specConfig.MockResponses = mockResponses
+	}
+
+	if mockURL, ok := cfg["mock_url"].(string); ok {
+		specConfig.MockURL = mockURL
+	}
+
+	return specConfig
+}
@@ -0,0 +1,101 @@
+// Package pagerduty registers the PagerDuty adapter for incidents, notes,
+// on-call schedules and Events API v2 alerts.
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	pagerdutyAdapter "github.com/S-Corkum/mcp-server/internal/adapters/pagerduty"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the PagerDuty adapter
+const adapterType = "pagerduty"
+
+// RegisterAdapter registers the PagerDuty adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		pagerdutyConfig := pagerdutyAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *pagerdutyAdapter.Config:
+			pagerdutyConfig = cfg
+		case map[string]interface{}:
+			if apiToken, ok := cfg["api_token"].(string); ok {
+				pagerdutyConfig.APIToken = apiToken
+			}
+
+			if baseURL, ok := cfg["base_url"].(string); ok {
+				pagerdutyConfig.BaseURL = baseURL
+			}
+
+			if fromEmail, ok := cfg["from_email"].(string); ok {
+				pagerdutyConfig.FromEmail = fromEmail
+			}
+
+			if eventsURL, ok := cfg["events_url"].(string); ok {
+				pagerdutyConfig.EventsURL = eventsURL
+			}
+
+			if routingKey, ok := cfg["routing_key"].(string); ok {
+				pagerdutyConfig.RoutingKey = routingKey
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				pagerdutyConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if secret, ok := cfg["webhook_secret"].(string); ok {
+				pagerdutyConfig.WebhookSecret = secret
+			}
+
+			if agentID, ok := cfg["agent_id"].(string); ok {
+				pagerdutyConfig.AgentID = agentID
+			}
+
+			if maxBulk, ok := cfg["max_bulk_incidents"].(int); ok {
+				pagerdutyConfig.MaxBulkIncidents = maxBulk
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				pagerdutyConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				pagerdutyConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := pagerdutyAdapter.New(pagerdutyConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create PagerDuty adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Package prometheus registers the Prometheus adapter for metric queries and
+// Alertmanager alerts, silences and webhook notifications.
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	prometheusAdapter "github.com/S-Corkum/mcp-server/internal/adapters/prometheus"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Prometheus adapter
+const adapterType = "prometheus"
+
+// RegisterAdapter registers the Prometheus adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		prometheusConfig := prometheusAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *prometheusAdapter.Config:
+			prometheusConfig = cfg
+		case map[string]interface{}:
+			if prometheusURL, ok := cfg["prometheus_url"].(string); ok {
+				prometheusConfig.PrometheusURL = prometheusURL
+			}
+
+			if alertmanagerURL, ok := cfg["alertmanager_url"].(string); ok {
+				prometheusConfig.AlertmanagerURL = alertmanagerURL
+			}
+
+			if bearerToken, ok := cfg["bearer_token"].(string); ok {
+				prometheusConfig.BearerToken = bearerToken
+			}
+
+			if username, ok := cfg["username"].(string); ok {
+				prometheusConfig.Username = username
+			}
+
+			if password, ok := cfg["password"].(string); ok {
+				prometheusConfig.Password = password
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				prometheusConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if maxSeries, ok := cfg["max_series"].(int); ok {
+				prometheusConfig.MaxSeries = maxSeries
+			}
+
+			if maxPoints, ok := cfg["max_points_per_series"].(int); ok {
+				prometheusConfig.MaxPointsPerSeries = maxPoints
+			}
+
+			if maxSilence, ok := cfg["max_silence_duration"].(int); ok {
+				prometheusConfig.MaxSilenceDuration = time.Duration(maxSilence) * time.Second
+			}
+
+			if webhookToken, ok := cfg["webhook_token"].(string); ok {
+				prometheusConfig.WebhookToken = webhookToken
+			}
+
+			if agentID, ok := cfg["agent_id"].(string); ok {
+				prometheusConfig.AgentID = agentID
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				prometheusConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				prometheusConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := prometheusAdapter.New(prometheusConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Prometheus adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
@@ -8,7 +8,18 @@ import (
 
 	"github.com/S-Corkum/mcp-server/internal/adapters/core"
 	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/argocd"
 	"github.com/S-Corkum/mcp-server/internal/adapters/providers/github"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/gitlab"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/jenkins"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/jira"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/kubernetes"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/openapi"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/pagerduty"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/prometheus"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/slack"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/terraform"
+	"github.com/S-Corkum/mcp-server/internal/adapters/providers/xray"
 	"github.com/S-Corkum/mcp-server/internal/observability"
 )
 
@@ -33,10 +44,64 @@ func RegisterAllProviders(factory *core.DefaultAdapterFactory, eventBus *events.
 		return fmt.Errorf("failed to register GitHub adapter: %w", err)
 	}
 	
+	// Register GitLab adapter
+	if err := gitlab.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register GitLab adapter: %w", err)
+	}
+	
+	// Register JFrog Xray adapter
+	if err := xray.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register Xray adapter: %w", err)
+	}
+	
+	// Register Kubernetes adapter
+	if err := kubernetes.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register Kubernetes adapter: %w", err)
+	}
+	
+	// Register Jenkins adapter
+	if err := jenkins.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register Jenkins adapter: %w", err)
+	}
+	
+	// Register PagerDuty adapter
+	if err := pagerduty.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register PagerDuty adapter: %w", err)
+	}
+	
+	// Register Jira adapter
+	if err := jira.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register Jira adapter: %w", err)
+	}
+	
+	// Register Slack adapter
+	if err := slack.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register Slack adapter: %w", err)
+	}
+	
+	// Register Argo CD adapter
+	if err := argocd.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register Argo CD adapter: %w", err)
+	}
+	
+	// Register Prometheus adapter
+	if err := prometheus.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register Prometheus adapter: %w", err)
+	}
+	
+	// Register Terraform adapter
+	if err := terraform.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register Terraform adapter: %w", err)
+	}
+	
+	// Register adapters generated from OpenAPI documents. This comes last so
+	// that documents cannot take the name of a built-in adapter. Their types
+	// are the configured names, so they are not in GetSupportedProviders.
+	if err := openapi.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
+		return fmt.Errorf("failed to register OpenAPI adapters: %w", err)
+	}
+	
 	// Register other adapters here
-	// example: if err := jfrog.RegisterAdapter(factory, eventBus, metricsClient, logger); err != nil {
-	//     return fmt.Errorf("failed to register JFrog adapter: %w", err)
-	// }
 	
 	return nil
 }
@@ -46,6 +111,16 @@ func RegisterAllProviders(factory *core.DefaultAdapterFactory, eventBus *events.
 func GetSupportedProviders() []string {
 	return []string{
 		"github",
+		"gitlab",
+		"xray",
+		"kubernetes",
+		"jenkins",
+		"pagerduty",
+		"jira",
+		"slack",
+		"argocd",
+		"prometheus",
+		"terraform",
 		// Add other provider types as they are implemented
 	}
 }
@@ -92,6 +92,58 @@ func TestProviderCompleteness(t *testing.T) {
 				githubConfig.DefaultOwner = "test-owner"
 				githubConfig.DefaultRepo = "test-repo"
 				config = githubConfig
+			case "gitlab":
+				config = map[string]interface{}{
+					"base_url": "https://gitlab.example.com",
+					"token":    "test-token",
+				}
+			case "xray":
+				config = map[string]interface{}{
+					"base_url": "http://localhost:8082/xray",
+					"token":    "test-token",
+				}
+			case "jenkins":
+				config = map[string]interface{}{
+					"base_url":  "https://jenkins.example.com",
+					"username":  "ci-bot",
+					"api_token": "test-token",
+				}
+			case "pagerduty":
+				config = map[string]interface{}{
+					"api_token":  "test-token",
+					"from_email": "oncall@example.com",
+				}
+			case "jira":
+				config = map[string]interface{}{
+					"base_url":  "https://example.atlassian.net",
+					"email":     "bot@example.com",
+					"api_token": "test-token",
+				}
+			case "slack":
+				config = map[string]interface{}{
+					"bot_token":      "xoxb-test",
+					"signing_secret": "signing-secret",
+				}
+			case "argocd":
+				config = map[string]interface{}{
+					"server_url": "https://argocd.example.com",
+					"auth_token": "test-token",
+				}
+			case "prometheus":
+				config = map[string]interface{}{
+					"prometheus_url":   "http://localhost:9090",
+					"alertmanager_url": "http://localhost:9093",
+				}
+			case "terraform":
+				config = map[string]interface{}{
+					"binary":      "terraform",
+					"cloud_token": "test-token",
+				}
+			case "kubernetes":
+				config = map[string]interface{}{
+					"host":  "https://localhost:6443",
+					"token": "test-token",
+				}
 			default:
 				t.Fatalf("Test case not implemented for provider type: %s", providerType)
 				return
@@ -109,6 +161,43 @@ func TestProviderCompleteness(t *testing.T) {
 	}
 }
 
+// TestRegisterOpenAPIDocuments verifies that each configured OpenAPI document
+// is registered as its own adapter type
+func TestRegisterOpenAPIDocuments(t *testing.T) {
+	spec := `{"openapi":"3.0.0","info":{"title":"Billing","version":"1"},
+		"paths":{"/invoices":{"get":{"operationId":"listInvoices","responses":{"200":{"description":"ok"}}}}}}`
+
+	configs := map[string]interface{}{
+		"openapi": []interface{}{
+			map[string]interface{}{
+				"name":               "billing",
+				"spec":               spec,
+				"base_url":           "https://billing.internal.example.com",
+				"allowed_operations": []interface{}{"listInvoices"},
+			},
+		},
+	}
+	metricsClient := observability.NewMetricsClient()
+	logger := observability.NewLogger("providers_test")
+	factory := core.NewAdapterFactory(configs, metricsClient, logger)
+	eventBus := events.NewEventBus(logger)
+
+	err := RegisterAllProviders(factory, eventBus, metricsClient, logger)
+	require.NoError(t, err, "Provider registration should succeed")
+
+	adapter, err := factory.CreateAdapter(context.Background(), "billing")
+	require.NoError(t, err, "Should be able to create the billing adapter")
+	assert.Equal(t, "billing", adapter.Type())
+
+	// A document cannot take the name of a built-in adapter
+	configs["openapi"] = []interface{}{
+		map[string]interface{}{"name": "github", "spec": spec, "allowed_operations": []string{"listInvoices"}},
+	}
+	factory = core.NewAdapterFactory(configs, metricsClient, logger)
+	err = RegisterAllProviders(factory, eventBus, metricsClient, logger)
+	assert.Error(t, err, "Registering a document named after a built-in adapter should fail")
+}
+
 // TestGetSupportedProviders verifies that the GetSupportedProviders function
 // returns all expected provider types
 func TestGetSupportedProviders(t *testing.T) {
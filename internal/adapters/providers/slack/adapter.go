@@ -0,0 +1,97 @@
+// Package slack registers the Slack adapter for messages, threads, snippets
+// and interactive approval requests.
+package slack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	slackAdapter "github.com/S-Corkum/mcp-server/internal/adapters/slack"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Slack adapter
+const adapterType = "slack"
+
+// RegisterAdapter registers the Slack adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		slackConfig := slackAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *slackAdapter.Config:
+			slackConfig = cfg
+		case map[string]interface{}:
+			if baseURL, ok := cfg["base_url"].(string); ok {
+				slackConfig.BaseURL = baseURL
+			}
+
+			if botToken, ok := cfg["bot_token"].(string); ok {
+				slackConfig.BotToken = botToken
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				slackConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if channel, ok := cfg["default_channel"].(string); ok {
+				slackConfig.DefaultChannel = channel
+			}
+
+			if secret, ok := cfg["signing_secret"].(string); ok {
+				slackConfig.SigningSecret = secret
+			}
+
+			if timeout, ok := cfg["approval_timeout"].(int); ok {
+				slackConfig.ApprovalTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if timeout, ok := cfg["max_approval_timeout"].(int); ok {
+				slackConfig.MaxApprovalTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if approvers, ok := cfg["approvers"].([]string); ok {
+				slackConfig.Approvers = approvers
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				slackConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				slackConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := slackAdapter.New(slackConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Slack adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
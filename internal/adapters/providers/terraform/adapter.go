@@ -0,0 +1,109 @@
+// Package terraform registers the Terraform adapter for summarizing plan files
+// and Terraform Cloud runs.
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	terraformAdapter "github.com/S-Corkum/mcp-server/internal/adapters/terraform"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Terraform adapter
+const adapterType = "terraform"
+
+// RegisterAdapter registers the Terraform adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		terraformConfig := terraformAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *terraformAdapter.Config:
+			terraformConfig = cfg
+		case map[string]interface{}:
+			if binary, ok := cfg["binary"].(string); ok {
+				terraformConfig.Binary = binary
+			}
+
+			if workingDir, ok := cfg["working_dir"].(string); ok {
+				terraformConfig.WorkingDir = workingDir
+			}
+
+			if timeout, ok := cfg["command_timeout"].(int); ok {
+				terraformConfig.CommandTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if cloudURL, ok := cfg["cloud_url"].(string); ok {
+				terraformConfig.CloudURL = cloudURL
+			}
+
+			if cloudToken, ok := cfg["cloud_token"].(string); ok {
+				terraformConfig.CloudToken = cloudToken
+			}
+
+			if organization, ok := cfg["organization"].(string); ok {
+				terraformConfig.Organization = organization
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				terraformConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if secret, ok := cfg["webhook_secret"].(string); ok {
+				terraformConfig.WebhookSecret = secret
+			}
+
+			switch types := cfg["stateful_resource_types"].(type) {
+			case []string:
+				terraformConfig.StatefulResourceTypes = types
+			case []interface{}:
+				terraformConfig.StatefulResourceTypes = make([]string, 0, len(types))
+				for _, t := range types {
+					if s, ok := t.(string); ok {
+						terraformConfig.StatefulResourceTypes = append(terraformConfig.StatefulResourceTypes, s)
+					}
+				}
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				terraformConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				terraformConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := terraformAdapter.New(terraformConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Terraform adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Package xray registers the JFrog Xray adapter for vulnerability, license
+// and policy violation data.
+package xray
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	xrayAdapter "github.com/S-Corkum/mcp-server/internal/adapters/xray"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+)
+
+// adapterType is the unique identifier for the Xray adapter
+const adapterType = "xray"
+
+// RegisterAdapter registers the Xray adapter with the factory.
+//
+// Parameters:
+//   - factory: The adapter factory to register with
+//   - eventBus: The event bus for adapter events
+//   - metricsClient: The metrics client for telemetry
+//   - logger: The logger for diagnostic information
+//
+// Returns:
+//   - error: If registration fails
+func RegisterAdapter(factory *core.DefaultAdapterFactory, eventBus *events.EventBus,
+	metricsClient *observability.MetricsClient, logger *observability.Logger) error {
+
+	if factory == nil {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	if logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	factory.RegisterAdapterCreator(adapterType, func(ctx context.Context, config interface{}) (core.Adapter, error) {
+		xrayConfig := xrayAdapter.DefaultConfig()
+
+		switch cfg := config.(type) {
+		case *xrayAdapter.Config:
+			xrayConfig = cfg
+		case map[string]interface{}:
+			if token, ok := cfg["token"].(string); ok {
+				xrayConfig.Token = token
+			}
+
+			if username, ok := cfg["username"].(string); ok {
+				xrayConfig.Username = username
+			}
+
+			if password, ok := cfg["password"].(string); ok {
+				xrayConfig.Password = password
+			}
+
+			if baseURL, ok := cfg["base_url"].(string); ok {
+				xrayConfig.BaseURL = baseURL
+			}
+
+			if timeout, ok := cfg["request_timeout"].(int); ok {
+				xrayConfig.RequestTimeout = time.Duration(timeout) * time.Second
+			}
+
+			if secret, ok := cfg["webhook_secret"].(string); ok {
+				xrayConfig.WebhookSecret = secret
+			}
+
+			if mockResponses, ok := cfg["mock_responses"].(bool); ok {
+				xrayConfig.MockResponses = mockResponses
+			}
+
+			if mockURL, ok := cfg["mock_url"].(string); ok {
+				xrayConfig.MockURL = mockURL
+			}
+		}
+
+		adapter, err := xrayAdapter.New(xrayConfig, logger, metricsClient, eventBus)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Xray adapter: %w", err)
+		}
+
+		return adapter, nil
+	})
+
+	return nil
+}
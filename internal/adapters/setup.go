@@ -2,10 +2,16 @@ package adapters
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
 	
 	"github.com/S-Corkum/mcp-server/internal/adapters/bridge"
 	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
 	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/adapters/plugin"
 	"github.com/S-Corkum/mcp-server/internal/adapters/providers"
 	"github.com/S-Corkum/mcp-server/internal/config"
 	"github.com/S-Corkum/mcp-server/internal/events/system"
@@ -18,6 +24,7 @@ type AdapterManager struct {
 	registry       *core.AdapterRegistry
 	eventBus       *events.EventBus
 	eventBridge    *bridge.EventBridge
+	plugins        *plugin.Manager
 	logger         *observability.Logger
 	metricsClient  *observability.MetricsClient
 }
@@ -56,12 +63,21 @@ func NewAdapterManager(
 	// Register adapter providers
 	providers.RegisterAllProviders(factory, eventBus, metricsClient, logger)
 	
+	// Configure adapter plugins, which are started by Initialize
+	pluginConfigs, err := plugin.ParseConfigs(adapterConfigs["plugins"])
+	if err != nil {
+		logger.Error("Invalid adapter plugin configuration", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	
 	// Create manager
 	manager := &AdapterManager{
 		factory:       factory,
 		registry:      registry,
 		eventBus:      eventBus,
 		eventBridge:   eventBridge,
+		plugins:       plugin.NewManager(registry, pluginConfigs, logger),
 		logger:        logger,
 		metricsClient: metricsClient,
 	}
@@ -88,6 +104,13 @@ func (m *AdapterManager) Initialize(ctx context.Context) error {
 		}
 	}
 	
+	// Start adapter plugins. Plugins that fail to start are logged and skipped.
+	if err := m.plugins.Start(ctx); err != nil {
+		m.logger.Warn("Some adapter plugins failed to start", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	
 	return nil
 }
 
@@ -108,6 +131,18 @@ func (m *AdapterManager) ExecuteAction(ctx context.Context, contextID string, ad
 		return nil, err
 	}
 	
+	// Validate params against the action schema of adapters that describe their actions
+	if actions, ok := core.DescribeActions(adapter); ok {
+		descriptor, found := core.FindAction(actions, action)
+		if !found {
+			return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+				fmt.Errorf("unknown action %s for adapter %s", action, adapterType), nil)
+		}
+		if err := descriptor.ValidateParams(params); err != nil {
+			return nil, adapterErrors.NewInvalidParameterError(adapterType, action, err, nil)
+		}
+	}
+	
 	// Log the operation
 	m.logger.Info("Executing adapter action", map[string]interface{}{
 		"adapterType": adapterType,
@@ -122,9 +157,138 @@ func (m *AdapterManager) ExecuteAction(ctx context.Context, contextID string, ad
 }
 
 
+// ReceiveWebhook verifies a webhook delivery for an adapter and returns the
+// payload to pass to HandleWebhook. Deliveries for adapters that don't
+// implement core.WebhookReceiver can't be verified, so they are rejected with
+// an unauthorized adapter error. Unknown adapters return core.ErrAdapterNotFound.
+func (m *AdapterManager) ReceiveWebhook(ctx context.Context, adapterType string, header http.Header, query url.Values, body []byte) ([]byte, error) {
+	adapter, err := m.registry.GetAdapter(ctx, adapterType)
+	if err != nil {
+		return nil, err
+	}
+	
+	receiver, ok := adapter.(core.WebhookReceiver)
+	if !ok {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook",
+			fmt.Errorf("adapter %s does not verify webhooks", adapterType), nil)
+	}
+	return receiver.ReceiveWebhook(header, query, body)
+}
+
+// HandleWebhook passes a webhook event to an adapter
+func (m *AdapterManager) HandleWebhook(ctx context.Context, adapterType string, eventType string, payload []byte) error {
+	adapter, err := m.registry.GetAdapter(ctx, adapterType)
+	if err != nil {
+		return err
+	}
+	
+	return adapter.HandleWebhook(ctx, eventType, payload)
+}
+
+// ToolDescription describes an adapter exposed as a tool
+type ToolDescription struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// Described is false for adapters that do not describe their actions
+	Described bool                    `json:"described"`
+	Actions   []core.ActionDescriptor `json:"actions"`
+}
+
+// DescribeTool describes an adapter, creating it if it doesn't exist
+func (m *AdapterManager) DescribeTool(ctx context.Context, adapterType string) (ToolDescription, error) {
+	adapter, err := m.registry.GetAdapter(ctx, adapterType)
+	if err != nil {
+		return ToolDescription{}, err
+	}
+	
+	actions, described := core.DescribeActions(adapter)
+	if actions == nil {
+		actions = []core.ActionDescriptor{}
+	}
+	
+	return ToolDescription{
+		Name:      adapterType,
+		Version:   adapter.Version(),
+		Described: described,
+		Actions:   actions,
+	}, nil
+}
+
+// IsReadOnlyAction returns whether an adapter describes an action as only
+// reading data. Actions of adapters that don't describe them are assumed to
+// change data.
+func (m *AdapterManager) IsReadOnlyAction(ctx context.Context, adapterType string, action string) bool {
+	adapter, err := m.registry.GetAdapter(ctx, adapterType)
+	if err != nil {
+		return false
+	}
+
+	actions, ok := core.DescribeActions(adapter)
+	if !ok {
+		return false
+	}
+	descriptor, found := core.FindAction(actions, action)
+	return found && descriptor.Safety == core.SafetyRead
+}
+
+// DescribeTools describes the registered adapters and the adapters the
+// factory can create, sorted by name. Adapters that cannot be created are
+// left out.
+func (m *AdapterManager) DescribeTools(ctx context.Context) []ToolDescription {
+	names := map[string]bool{}
+	for adapterType := range m.registry.ListAdapters() {
+		names[adapterType] = true
+	}
+	for _, adapterType := range m.factory.ListRegisteredAdapterTypes() {
+		names[adapterType] = true
+	}
+	
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	
+	tools := make([]ToolDescription, 0, len(sorted))
+	for _, adapterType := range sorted {
+		tool, err := m.DescribeTool(ctx, adapterType)
+		if err != nil {
+			m.logger.Debug("Leaving adapter out of tool descriptions", map[string]interface{}{
+				"adapterType": adapterType,
+				"error":       err.Error(),
+			})
+			continue
+		}
+		tools = append(tools, tool)
+	}
+	
+	return tools
+}
+
+// OnHealthChange registers a callback for health status changes of adapters,
+// which are checked every 30 seconds
+func (m *AdapterManager) OnHealthChange(callback func(adapterType, oldStatus, newStatus string)) {
+	m.registry.OnHealthChange(func(adapterType string, oldStatus, newStatus core.HealthStatus) {
+		callback(adapterType, oldStatus.Status, newStatus.Status)
+	})
+}
+
+// OnAdapterCreated registers a callback called with every adapter the
+// manager creates, before the adapter is used
+func (m *AdapterManager) OnAdapterCreated(callback func(adapterType string, adapter core.Adapter)) {
+	m.registry.OnRegister(callback)
+}
+
+// SubscribeEvents registers a listener for every event emitted by adapters
+func (m *AdapterManager) SubscribeEvents(listener events.EventListener) {
+	m.eventBus.SubscribeAll(listener)
+}
 
 // Shutdown gracefully shuts down all adapters
 func (m *AdapterManager) Shutdown(ctx context.Context) error {
+	// Stop adapter plugins
+	m.plugins.Stop()
+	
 	// Get all adapters
 	adapters := m.registry.ListAdapters()
 	
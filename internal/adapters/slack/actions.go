@@ -0,0 +1,170 @@
+package slack
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// channelParam is the schema of the channel a message is posted to. The
+// configured default channel is used when none is given.
+var channelParam = map[string]interface{}{
+	"type":        "string",
+	"description": "Channel ID or name. Defaults to the configured channel",
+}
+
+// textParam is the schema of the text of a message
+var textParam = map[string]interface{}{
+	"type":        "string",
+	"description": "Message text, in Slack mrkdwn",
+	"minLength":   1,
+}
+
+// blocksParam is the schema of the Block Kit blocks of a message
+var blocksParam = map[string]interface{}{
+	"type":        "array",
+	"description": "Block Kit blocks. The text is used as the notification fallback",
+	"items":       map[string]interface{}{"type": "object"},
+}
+
+// threadParam is the schema of the timestamp of a thread's parent message
+var threadParam = map[string]interface{}{
+	"type":        "string",
+	"description": "Timestamp of the parent message of the thread",
+}
+
+// Actions returns the descriptors of the supported Slack actions
+func (a *SlackAdapter) Actions() []core.ActionDescriptor {
+	messageParams := map[string]interface{}{
+		"channel": channelParam,
+		"text":    textParam,
+		"blocks":  blocksParam,
+		"broadcast": map[string]interface{}{
+			"type":        "boolean",
+			"description": "Also post a thread reply to the channel. Defaults to false",
+		},
+	}
+
+	return []core.ActionDescriptor{
+		{
+			Name:        "post_message",
+			Description: "Post a message to a channel, or to a thread if thread_ts is set",
+			Params: paramsSchema([]string{"text"}, messageParams, map[string]interface{}{
+				"thread_ts": threadParam,
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"chat:write"},
+		},
+		{
+			Name:        "post_thread_reply",
+			Description: "Reply to a thread",
+			Params: paramsSchema([]string{"thread_ts", "text"}, messageParams, map[string]interface{}{
+				"thread_ts": threadParam,
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"chat:write"},
+		},
+		{
+			Name:        "update_message",
+			Description: "Update a message posted by the bot",
+			Params: paramsSchema([]string{"ts", "text"}, map[string]interface{}{
+				"channel": channelParam,
+				"ts": map[string]interface{}{
+					"type":        "string",
+					"description": "Timestamp of the message to update",
+					"minLength":   1,
+				},
+				"text":   textParam,
+				"blocks": blocksParam,
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"chat:write"},
+		},
+		{
+			Name:        "upload_snippet",
+			Description: "Upload a text snippet, such as a log excerpt, to a channel or thread",
+			Params: paramsSchema([]string{"content"}, map[string]interface{}{
+				"channel": channelParam,
+				"content": map[string]interface{}{
+					"type":        "string",
+					"description": "Snippet content",
+					"minLength":   1,
+				},
+				"filename": map[string]interface{}{
+					"type":        "string",
+					"description": "File name. Defaults to snippet.txt",
+				},
+				"title": map[string]interface{}{
+					"type":        "string",
+					"description": "Snippet title. Defaults to the file name",
+				},
+				"thread_ts": threadParam,
+				"initial_comment": map[string]interface{}{
+					"type":        "string",
+					"description": "Message posted with the snippet",
+				},
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"files:write"},
+		},
+		{
+			Name:        "request_approval",
+			Description: "Ask for approval with approve and reject buttons, and by default wait for the answer",
+			Params: paramsSchema([]string{"text"}, map[string]interface{}{
+				"channel": channelParam,
+				"text": map[string]interface{}{
+					"type":        "string",
+					"description": "What is to be approved",
+					"minLength":   1,
+				},
+				"approvers": map[string]interface{}{
+					"type":        []interface{}{"string", "array"},
+					"description": "IDs of the users allowed to answer. Defaults to the configured approvers",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+				"timeout": map[string]interface{}{
+					"type":        "integer",
+					"description": "Seconds before the request expires, up to the configured maximum",
+					"minimum":     1,
+				},
+				"thread_ts": threadParam,
+				"wait": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Wait for the request to be answered or to expire. Defaults to true",
+				},
+			}),
+			Safety: core.SafetyWrite,
+			Scopes: []string{"chat:write"},
+		},
+		{
+			Name:        "get_approval",
+			Description: "Get the state of an approval request",
+			Params: paramsSchema([]string{"approval_id"}, map[string]interface{}{
+				"approval_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID returned by request_approval",
+					"minLength":   1,
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
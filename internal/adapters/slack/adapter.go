@@ -0,0 +1,870 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// adapterType is the unique identifier for the Slack adapter
+const adapterType = "slack"
+
+// ContextUpdater updates MCP contexts. It is implemented by the context
+// manager, which the engine sets when the adapter is registered.
+type ContextUpdater = core.ContextUpdater
+
+// pendingApproval is an approval request and the channel closed when it is answered
+type pendingApproval struct {
+	Approval
+	approvers []string
+	done      chan struct{}
+	timer     *time.Timer
+}
+
+// SlackAdapter provides an adapter for the Slack Web API: messages, threads,
+// snippets and interactive approval requests
+type SlackAdapter struct {
+	config        *Config
+	baseURL       string
+	client        *http.Client
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+
+	approvalMutex sync.Mutex
+	approvals     map[string]*pendingApproval
+
+	contextMutex   sync.RWMutex
+	contextUpdater ContextUpdater
+}
+
+// New creates a new Slack adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*SlackAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("slack_adapter")
+	}
+
+	baseURL := config.BaseURL
+	if config.MockResponses {
+		baseURL = config.MockURL
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("slack base URL is required")
+	}
+
+	return &SlackAdapter{
+		config:        config,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		client:        &http.Client{Timeout: config.RequestTimeout},
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+		approvals:     make(map[string]*pendingApproval),
+	}, nil
+}
+
+// SetContextUpdater sets the context manager used to record approval
+// decisions on the context that requested them
+func (a *SlackAdapter) SetContextUpdater(updater ContextUpdater) {
+	a.contextMutex.Lock()
+	defer a.contextMutex.Unlock()
+	a.contextUpdater = updater
+}
+
+// Type returns the adapter type
+func (a *SlackAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *SlackAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status
+func (a *SlackAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := a.call(ctx, "health", "auth.test", map[string]interface{}{}, nil); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+
+	if a.config.MockResponses {
+		return "healthy (mock)"
+	}
+	return "healthy"
+}
+
+// ExecuteAction executes a Slack action
+func (a *SlackAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing Slack action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	// Messages
+	case "post_message":
+		result, err = a.postMessage(ctx, "post_message", params)
+	case "post_thread_reply":
+		if core.StringParam(params, "thread_ts") == "" {
+			err = adapterErrors.NewInvalidParameterError(adapterType, action,
+				fmt.Errorf("missing required parameter: thread_ts"), nil)
+			break
+		}
+		result, err = a.postMessage(ctx, "post_thread_reply", params)
+	case "update_message":
+		result, err = a.updateMessage(ctx, params)
+
+	// Files
+	case "upload_snippet":
+		result, err = a.uploadSnippet(ctx, params)
+
+	// Approvals
+	case "request_approval":
+		result, err = a.requestApproval(ctx, contextID, params)
+	case "get_approval":
+		result, err = a.getApproval(params)
+
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported Slack action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// postMessage posts a message to a channel, or to a thread if thread_ts is set
+func (a *SlackAdapter) postMessage(ctx context.Context, operation string, params map[string]interface{}) (interface{}, error) {
+	channel, err := a.channel(operation, params)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := core.RequiredStringParam(params, "text")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, operation, err, nil)
+	}
+
+	body := map[string]interface{}{
+		"channel": channel,
+		"text":    text,
+	}
+	if threadTS := core.StringParam(params, "thread_ts"); threadTS != "" {
+		body["thread_ts"] = threadTS
+		body["reply_broadcast"] = core.BoolParam(params, "broadcast", false)
+	}
+	if blocks, ok := params["blocks"]; ok {
+		body["blocks"] = blocks
+	}
+
+	var response struct {
+		Channel string  `json:"channel"`
+		TS      string  `json:"ts"`
+		Message message `json:"message"`
+	}
+	if err := a.call(ctx, operation, "chat.postMessage", body, &response); err != nil {
+		return nil, err
+	}
+
+	return Message{
+		Channel:  response.Channel,
+		TS:       response.TS,
+		ThreadTS: response.Message.ThreadTS,
+		Text:     text,
+	}, nil
+}
+
+// updateMessage replaces the text and blocks of a message
+func (a *SlackAdapter) updateMessage(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	channel, err := a.channel("update_message", params)
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := core.RequiredStringParam(params, "ts")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "update_message", err, nil)
+	}
+
+	text, err := core.RequiredStringParam(params, "text")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "update_message", err, nil)
+	}
+
+	body := map[string]interface{}{
+		"channel": channel,
+		"ts":      ts,
+		"text":    text,
+	}
+	if blocks, ok := params["blocks"]; ok {
+		body["blocks"] = blocks
+	}
+
+	var response struct {
+		Channel string `json:"channel"`
+		TS      string `json:"ts"`
+	}
+	if err := a.call(ctx, "update_message", "chat.update", body, &response); err != nil {
+		return nil, err
+	}
+
+	return Message{Channel: response.Channel, TS: response.TS, Text: text}, nil
+}
+
+// uploadSnippet uploads text as a file shared in a channel or thread, using
+// the external upload flow: reserve an upload URL, upload the content, then
+// complete the upload to share the file
+func (a *SlackAdapter) uploadSnippet(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	channel, err := a.channel("upload_snippet", params)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := core.RequiredStringParam(params, "content")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "upload_snippet", err, nil)
+	}
+
+	filename := core.StringParam(params, "filename")
+	if filename == "" {
+		filename = "snippet.txt"
+	}
+	title := core.StringParam(params, "title")
+	if title == "" {
+		title = filename
+	}
+
+	var reserved struct {
+		UploadURL string `json:"upload_url"`
+		FileID    string `json:"file_id"`
+	}
+	form := url.Values{
+		"filename": {filename},
+		"length":   {strconv.Itoa(len(content))},
+	}
+	if err := a.call(ctx, "upload_snippet", "files.getUploadURLExternal", form, &reserved); err != nil {
+		return nil, err
+	}
+
+	if err := a.upload(ctx, reserved.UploadURL, content); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"files":      []map[string]interface{}{{"id": reserved.FileID, "title": title}},
+		"channel_id": channel,
+	}
+	threadTS := core.StringParam(params, "thread_ts")
+	if threadTS != "" {
+		body["thread_ts"] = threadTS
+	}
+	if comment := core.StringParam(params, "initial_comment"); comment != "" {
+		body["initial_comment"] = comment
+	}
+	if err := a.call(ctx, "upload_snippet", "files.completeUploadExternal", body, nil); err != nil {
+		return nil, err
+	}
+
+	return Snippet{
+		FileID:   reserved.FileID,
+		Channel:  channel,
+		Title:    title,
+		Filename: filename,
+		ThreadTS: threadTS,
+	}, nil
+}
+
+// upload sends file content to an upload URL reserved with files.getUploadURLExternal
+func (a *SlackAdapter) upload(ctx context.Context, uploadURL, content string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, strings.NewReader(content))
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "upload_snippet", err, nil)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return adapterErrors.NewTimeoutError(adapterType, "upload_snippet", err, nil)
+		}
+		return adapterErrors.NewConnectionFailedError(adapterType, "upload_snippet", err, nil)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return adapterErrors.FromHTTPStatus(adapterType, "upload_snippet", resp.StatusCode,
+			fmt.Errorf("slack file upload returned %d", resp.StatusCode), nil)
+	}
+	return nil
+}
+
+// requestApproval asks the channel to approve an action with approve and
+// reject buttons. By default it blocks until the request is answered or
+// expires; with wait=false it returns the pending request, whose state can be
+// read with get_approval. Rejected and expired requests are not errors: the
+// caller must check the returned status.
+func (a *SlackAdapter) requestApproval(ctx context.Context, contextID string, params map[string]interface{}) (interface{}, error) {
+	channel, err := a.channel("request_approval", params)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := core.RequiredStringParam(params, "text")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "request_approval", err, nil)
+	}
+
+	timeout := a.config.ApprovalTimeout
+	if seconds := core.IntParam(params, "timeout", 0); seconds > 0 {
+		timeout = time.Duration(seconds) * time.Second
+	}
+	if a.config.MaxApprovalTimeout > 0 && timeout > a.config.MaxApprovalTimeout {
+		timeout = a.config.MaxApprovalTimeout
+	}
+
+	approvers := core.StringSliceParam(params, "approvers")
+	if len(approvers) == 0 {
+		approvers = a.config.Approvers
+	}
+
+	now := time.Now().UTC()
+	pending := &pendingApproval{
+		Approval: Approval{
+			ID:          uuid.New().String(),
+			ContextID:   contextID,
+			Status:      ApprovalPending,
+			Text:        text,
+			Channel:     channel,
+			RequestedAt: now,
+			ExpiresAt:   now.Add(timeout),
+		},
+		approvers: approvers,
+		done:      make(chan struct{}),
+	}
+
+	body := map[string]interface{}{
+		"channel": channel,
+		"text":    "Approval requested: " + text,
+		"blocks":  approvalBlocks(pending.Approval),
+	}
+	if threadTS := core.StringParam(params, "thread_ts"); threadTS != "" {
+		body["thread_ts"] = threadTS
+	}
+
+	var response struct {
+		Channel string `json:"channel"`
+		TS      string `json:"ts"`
+	}
+	if err := a.call(ctx, "request_approval", "chat.postMessage", body, &response); err != nil {
+		return nil, err
+	}
+	pending.Channel = response.Channel
+	pending.MessageTS = response.TS
+
+	a.approvalMutex.Lock()
+	a.pruneApprovals(now)
+	a.approvals[pending.ID] = pending
+	pending.timer = time.AfterFunc(timeout, func() {
+		a.expireApproval(pending.ID)
+	})
+	requested := pending.Approval
+	a.approvalMutex.Unlock()
+
+	a.logger.Info("Requested approval", map[string]interface{}{
+		"approvalId": requested.ID,
+		"contextId":  contextID,
+		"channel":    requested.Channel,
+		"expiresAt":  requested.ExpiresAt,
+	})
+
+	if !core.BoolParam(params, "wait", true) {
+		return requested, nil
+	}
+
+	select {
+	case <-pending.done:
+	case <-ctx.Done():
+		// The request stays open until it is answered or expires
+		return nil, adapterErrors.NewTimeoutError(adapterType, "request_approval",
+			fmt.Errorf("stopped waiting for approval %s: %w", pending.ID, ctx.Err()), nil)
+	}
+
+	approval, _ := a.approval(pending.ID)
+	return approval, nil
+}
+
+// getApproval returns the state of an approval request
+func (a *SlackAdapter) getApproval(params map[string]interface{}) (interface{}, error) {
+	approvalID, err := core.RequiredStringParam(params, "approval_id")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "get_approval", err, nil)
+	}
+
+	approval, ok := a.approval(approvalID)
+	if !ok {
+		return nil, adapterErrors.NewResourceNotFoundError(adapterType, "get_approval",
+			fmt.Errorf("approval %s not found", approvalID), nil)
+	}
+	return approval, nil
+}
+
+// approval returns a copy of the state of an approval request
+func (a *SlackAdapter) approval(approvalID string) (Approval, bool) {
+	a.approvalMutex.Lock()
+	defer a.approvalMutex.Unlock()
+
+	pending, ok := a.approvals[approvalID]
+	if !ok {
+		return Approval{}, false
+	}
+	return pending.Approval, true
+}
+
+// answerApproval records the answer to an approval request from an
+// interactive callback. It returns false if the request is unknown, already
+// answered, or the user is not one of its approvers.
+func (a *SlackAdapter) answerApproval(ctx context.Context, approvalID, status, userID, userName string) (Approval, bool) {
+	a.approvalMutex.Lock()
+	pending, ok := a.approvals[approvalID]
+	if !ok || pending.Status != ApprovalPending {
+		a.approvalMutex.Unlock()
+		return Approval{}, false
+	}
+	if len(pending.approvers) > 0 && !contains(pending.approvers, userID) {
+		a.approvalMutex.Unlock()
+		a.logger.Warn("Ignored approval answer from a user who is not an approver", map[string]interface{}{
+			"approvalId": approvalID,
+			"userId":     userID,
+		})
+		return Approval{}, false
+	}
+
+	now := time.Now().UTC()
+	pending.Status = status
+	pending.UserID = userID
+	pending.UserName = userName
+	pending.RespondedAt = &now
+	pending.timer.Stop()
+	close(pending.done)
+	approval := pending.Approval
+	a.approvalMutex.Unlock()
+
+	a.finishApproval(ctx, approval)
+	return approval, true
+}
+
+// expireApproval expires an approval request that was not answered in time
+func (a *SlackAdapter) expireApproval(approvalID string) {
+	a.approvalMutex.Lock()
+	pending, ok := a.approvals[approvalID]
+	if !ok || pending.Status != ApprovalPending {
+		a.approvalMutex.Unlock()
+		return
+	}
+
+	pending.Status = ApprovalExpired
+	close(pending.done)
+	approval := pending.Approval
+	a.approvalMutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.RequestTimeout)
+	defer cancel()
+	a.finishApproval(ctx, approval)
+}
+
+// finishApproval replaces the buttons of an answered or expired request with
+// its outcome, records the outcome on the requesting context and emits an
+// approval event. Failures are logged since the answer itself is final.
+func (a *SlackAdapter) finishApproval(ctx context.Context, approval Approval) {
+	body := map[string]interface{}{
+		"channel": approval.Channel,
+		"ts":      approval.MessageTS,
+		"text":    approvalOutcome(approval),
+		"blocks":  approvalBlocks(approval),
+	}
+	if err := a.call(ctx, "request_approval", "chat.update", body, nil); err != nil {
+		a.logger.Warn("Failed to update approval message", map[string]interface{}{
+			"approvalId": approval.ID,
+			"error":      err.Error(),
+		})
+	}
+
+	if err := a.recordApproval(ctx, approval); err != nil {
+		a.logger.Warn("Failed to record approval in context", map[string]interface{}{
+			"approvalId": approval.ID,
+			"contextId":  approval.ContextID,
+			"error":      err.Error(),
+		})
+	}
+
+	if a.eventBus != nil {
+		eventType := events.EventTypeOperationSuccess
+		if !approval.Approved() {
+			eventType = events.EventTypeOperationFailure
+		}
+		event := events.NewAdapterEvent(adapterType, eventType, approval).
+			WithMetadata("operation", "approval").
+			WithMetadata("contextId", approval.ContextID).
+			WithMetadata("approvalId", approval.ID).
+			WithMetadata("status", approval.Status)
+		a.eventBus.Emit(ctx, event)
+	}
+}
+
+// recordApproval appends the outcome of an approval request to the context
+// that requested it
+func (a *SlackAdapter) recordApproval(ctx context.Context, approval Approval) error {
+	a.contextMutex.RLock()
+	updater := a.contextUpdater
+	a.contextMutex.RUnlock()
+
+	if updater == nil || approval.ContextID == "" {
+		return nil
+	}
+
+	metadata := map[string]interface{}{
+		"tool":        adapterType,
+		"action":      "request_approval",
+		"approval_id": approval.ID,
+		"status":      approval.Status,
+		"channel":     approval.Channel,
+		"message_ts":  approval.MessageTS,
+	}
+	if approval.UserID != "" {
+		metadata["user_id"] = approval.UserID
+		metadata["user_name"] = approval.UserName
+	}
+
+	item := mcp.ContextItem{
+		Role:      "tool",
+		Content:   fmt.Sprintf("%s: %s", approvalOutcome(approval), approval.Text),
+		Timestamp: time.Now(),
+		Metadata:  metadata,
+	}
+
+	// The item is appended to the content of the context
+	update := &mcp.Context{Content: []mcp.ContextItem{item}}
+	if _, err := updater.UpdateContext(ctx, approval.ContextID, update, nil); err != nil {
+		return fmt.Errorf("failed to update context: %w", err)
+	}
+	return nil
+}
+
+// pruneApprovals removes answered requests older than the retention period.
+// The caller must hold approvalMutex.
+func (a *SlackAdapter) pruneApprovals(now time.Time) {
+	for id, pending := range a.approvals {
+		if pending.Status != ApprovalPending && now.Sub(pending.ExpiresAt) > a.config.ApprovalRetention {
+			delete(a.approvals, id)
+		}
+	}
+}
+
+// HandleWebhook handles an interactive callback payload. The request
+// signature must be verified with VerifySignature before the payload is
+// passed in. Clicks on approval buttons answer the matching request.
+func (a *SlackAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	interaction, err := ParseInteraction(payload)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook", err, nil)
+	}
+
+	if eventType == "" {
+		eventType = interaction.Type
+	}
+
+	a.logger.Info("Received Slack interaction", map[string]interface{}{
+		"eventType": eventType,
+		"userId":    interaction.UserID,
+		"channel":   interaction.ChannelID,
+	})
+
+	var answered []Approval
+	for _, action := range interaction.Actions {
+		var status string
+		switch action.ActionID {
+		case approveActionID:
+			status = ApprovalApproved
+		case rejectActionID:
+			status = ApprovalRejected
+		default:
+			continue
+		}
+		if approval, ok := a.answerApproval(ctx, action.Value, status, interaction.UserID, interaction.UserName); ok {
+			answered = append(answered, approval)
+		}
+	}
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, interaction).
+			WithMetadata("eventType", eventType).
+			WithMetadata("userId", interaction.UserID)
+		if len(answered) > 0 {
+			adapterEvent.WithMetadata("approvalId", answered[0].ID).
+				WithMetadata("contextId", answered[0].ContextID)
+		}
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// Close closes the adapter. Pending approval requests are left to expire.
+func (a *SlackAdapter) Close() error {
+	a.approvalMutex.Lock()
+	for _, pending := range a.approvals {
+		if pending.timer != nil {
+			pending.timer.Stop()
+		}
+	}
+	a.approvalMutex.Unlock()
+
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// channel returns the channel parameter or the default channel
+func (a *SlackAdapter) channel(operation string, params map[string]interface{}) (string, error) {
+	channel := core.StringParam(params, "channel")
+	if channel == "" {
+		channel = a.config.DefaultChannel
+	}
+	if channel == "" {
+		return "", adapterErrors.NewInvalidParameterError(adapterType, operation,
+			fmt.Errorf("missing required parameter: channel"), nil)
+	}
+	return channel, nil
+}
+
+// call calls a Web API method and decodes the response into out. The body is
+// sent as a form if it is url.Values and as JSON otherwise.
+func (a *SlackAdapter) call(ctx context.Context, operation, method string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	contentType := "application/json; charset=utf-8"
+	if form, ok := body.(url.Values); ok {
+		reader = strings.NewReader(form.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	} else {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+"/"+method, reader)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if a.config.BotToken != "" {
+		req.Header.Set("Authorization", "Bearer "+a.config.BotToken)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var details map[string]interface{}
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			details = map[string]interface{}{"retryAfter": retryAfter}
+		}
+		return adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("slack API %s returned %d: %s", method, resp.StatusCode, strings.TrimSpace(string(respBody))), details)
+	}
+
+	// The Web API reports errors in the body of 200 responses
+	var envelope apiResponse
+	if err := json.Unmarshal(respBody, &envelope); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Slack response: %w", err), nil)
+	}
+	if !envelope.OK {
+		return apiError(operation, method, envelope)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Slack response: %w", err), nil)
+	}
+
+	return nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *SlackAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// apiError maps a Web API error code to an adapter error
+func apiError(operation, method string, response apiResponse) error {
+	message := response.Error
+	if response.Metadata != nil && len(response.Metadata.Messages) > 0 {
+		message += ": " + strings.Join(response.Metadata.Messages, "; ")
+	}
+	err := fmt.Errorf("slack API %s failed: %s", method, message)
+
+	switch response.Error {
+	case "not_authed", "invalid_auth", "account_inactive", "token_revoked":
+		return adapterErrors.NewUnauthorizedError(adapterType, operation, err, nil)
+	case "token_expired":
+		return adapterErrors.NewTokenExpiredError(adapterType, operation, err, nil)
+	case "missing_scope", "not_in_channel", "is_archived", "restricted_action", "cant_update_message":
+		return adapterErrors.NewForbiddenError(adapterType, operation, err, nil)
+	case "channel_not_found", "message_not_found", "file_not_found", "thread_not_found":
+		return adapterErrors.NewResourceNotFoundError(adapterType, operation, err, nil)
+	case "ratelimited", "rate_limited":
+		return adapterErrors.NewRateLimitExceededError(adapterType, operation, err, nil)
+	case "service_unavailable", "fatal_error", "internal_error":
+		return adapterErrors.NewServiceUnavailableError(adapterType, operation, err, nil)
+	default:
+		return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+}
+
+// approvalBlocks returns the Block Kit layout of an approval message. Pending
+// requests show approve and reject buttons; answered ones show the outcome.
+func approvalBlocks(approval Approval) []interface{} {
+	blocks := []interface{}{
+		map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": "*Approval requested*\n" + approval.Text,
+			},
+		},
+	}
+
+	if approval.Status != ApprovalPending {
+		return append(blocks, map[string]interface{}{
+			"type": "context",
+			"elements": []interface{}{
+				map[string]interface{}{"type": "mrkdwn", "text": approvalOutcome(approval)},
+			},
+		})
+	}
+
+	contextText := fmt.Sprintf("Expires <!date^%d^{date_short_pretty} at {time}|%s>",
+		approval.ExpiresAt.Unix(), approval.ExpiresAt.Format(time.RFC1123))
+	if approval.ContextID != "" {
+		contextText = fmt.Sprintf("Context `%s` · %s", approval.ContextID, contextText)
+	}
+
+	return append(blocks,
+		map[string]interface{}{
+			"type": "context",
+			"elements": []interface{}{
+				map[string]interface{}{"type": "mrkdwn", "text": contextText},
+			},
+		},
+		map[string]interface{}{
+			"type":     "actions",
+			"block_id": "mcp_approval",
+			"elements": []interface{}{
+				map[string]interface{}{
+					"type":      "button",
+					"action_id": approveActionID,
+					"style":     "primary",
+					"value":     approval.ID,
+					"text":      map[string]interface{}{"type": "plain_text", "text": "Approve"},
+				},
+				map[string]interface{}{
+					"type":      "button",
+					"action_id": rejectActionID,
+					"style":     "danger",
+					"value":     approval.ID,
+					"text":      map[string]interface{}{"type": "plain_text", "text": "Reject"},
+				},
+			},
+		},
+	)
+}
+
+// approvalOutcome describes the outcome of an approval request
+func approvalOutcome(approval Approval) string {
+	switch approval.Status {
+	case ApprovalApproved:
+		return fmt.Sprintf("Approved by <@%s>", approval.UserID)
+	case ApprovalRejected:
+		return fmt.Sprintf("Rejected by <@%s>", approval.UserID)
+	case ApprovalExpired:
+		return "Expired without an answer"
+	default:
+		return "Waiting for approval"
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
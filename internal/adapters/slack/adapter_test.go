@@ -0,0 +1,362 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/contexts"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/storage/providers"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// recordedRequest captures the parts of a request the tests assert on
+type recordedRequest struct {
+	path    string
+	body    map[string]interface{}
+	form    url.Values
+	raw     string
+	headers http.Header
+}
+
+// testServer serves canned Web API responses and records requests
+type testServer struct {
+	mutex    sync.Mutex
+	requests []recordedRequest
+}
+
+func (s *testServer) recorded(path string) []recordedRequest {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var matching []recordedRequest
+	for _, request := range s.requests {
+		if request.path == path {
+			matching = append(matching, request)
+		}
+	}
+	return matching
+}
+
+// newContextManager returns a context manager holding ctx-1, which already
+// has a message
+func newContextManager(t *testing.T) *contexts.Manager {
+	t.Helper()
+	manager := contexts.NewManager(providers.NewMemoryContextStorage())
+	_, err := manager.CreateContext(context.Background(), &mcp.Context{
+		ID:      "ctx-1",
+		AgentID: "agent-1",
+		ModelID: "model-1",
+		Content: []mcp.ContextItem{{Role: "user", Content: "Roll back payments-api"}},
+	})
+	require.NoError(t, err)
+	return manager
+}
+
+// contextItems returns the content of a context
+func contextItems(t *testing.T, manager *contexts.Manager, contextID string) []mcp.ContextItem {
+	t.Helper()
+	stored, err := manager.GetContext(context.Background(), contextID)
+	require.NoError(t, err)
+	return stored.Content
+}
+
+func newTestAdapter(t *testing.T, responses map[string]string) (*SlackAdapter, *testServer) {
+	recorder := &testServer{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		recorded := recordedRequest{path: r.URL.Path, raw: string(data), headers: r.Header}
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			json.Unmarshal(data, &recorded.body)
+		} else {
+			recorded.form, _ = url.ParseQuery(string(data))
+		}
+
+		recorder.mutex.Lock()
+		recorder.requests = append(recorder.requests, recorded)
+		recorder.mutex.Unlock()
+
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.Write([]byte(`{"ok":false,"error":"unknown_method"}`))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.BaseURL = server.URL
+	config.BotToken = "xoxb-test"
+	config.SigningSecret = "signing-secret"
+	config.DefaultChannel = "C0OPS"
+
+	logger := observability.NewLogger("slack_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+	t.Cleanup(func() { adapter.Close() })
+
+	return adapter, recorder
+}
+
+func TestPostMessage(t *testing.T) {
+	adapter, server := newTestAdapter(t, map[string]string{
+		"/chat.postMessage": `{"ok":true,"channel":"C0OPS","ts":"1700000000.000200","message":{"ts":"1700000000.000200","thread_ts":"1700000000.000100"}}`,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "post_thread_reply", map[string]interface{}{
+		"text":      "Deploy finished",
+		"thread_ts": "1700000000.000100",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1700000000.000100", result.(Message).ThreadTS)
+
+	request := server.recorded("/chat.postMessage")[0]
+	assert.Equal(t, "Bearer xoxb-test", request.headers.Get("Authorization"))
+	assert.Equal(t, "C0OPS", request.body["channel"])
+	assert.Equal(t, "1700000000.000100", request.body["thread_ts"])
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "post_thread_reply", map[string]interface{}{"text": "No thread"})
+	assert.True(t, adapterErrors.IsValidationError(err))
+}
+
+func TestUploadSnippet(t *testing.T) {
+	var uploadURL string
+	responses := map[string]string{
+		"/files.completeUploadExternal": `{"ok":true,"files":[{"id":"F123"}]}`,
+		"/upload/F123":                  `OK`,
+	}
+	adapter, server := newTestAdapter(t, responses)
+	uploadURL = adapter.baseURL + "/upload/F123"
+	responses["/files.getUploadURLExternal"] = `{"ok":true,"upload_url":"` + uploadURL + `","file_id":"F123"}`
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "upload_snippet", map[string]interface{}{
+		"content":   "terraform plan: 2 to add, 0 to change",
+		"filename":  "plan.txt",
+		"thread_ts": "1700000000.000100",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "F123", result.(Snippet).FileID)
+
+	reserve := server.recorded("/files.getUploadURLExternal")[0]
+	assert.Equal(t, "plan.txt", reserve.form.Get("filename"))
+	assert.Equal(t, "37", reserve.form.Get("length"))
+
+	assert.Equal(t, "terraform plan: 2 to add, 0 to change", server.recorded("/upload/F123")[0].raw)
+
+	complete := server.recorded("/files.completeUploadExternal")[0]
+	assert.Equal(t, "C0OPS", complete.body["channel_id"])
+	assert.Equal(t, "1700000000.000100", complete.body["thread_ts"])
+}
+
+// clickButton sends a signed interactive callback for an approval button
+func clickButton(t *testing.T, adapter *SlackAdapter, userID, actionID, approvalID string) error {
+	payload := fmt.Sprintf(`{"type":"block_actions","user":{"id":%q,"username":"alice"},"channel":{"id":"C0OPS"},
+		"actions":[{"action_id":%q,"block_id":"mcp_approval","value":%q}]}`, userID, actionID, approvalID)
+	body := url.Values{"payload": {payload}}.Encode()
+
+	timestamp := fmt.Sprintf("%d", time.Now().Unix())
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set(TimestampHeader, timestamp)
+	header.Set(SignatureHeader, sign("signing-secret", timestamp, body))
+
+	received, err := adapter.ReceiveWebhook(header, nil, []byte(body))
+	if err != nil {
+		return err
+	}
+	return adapter.HandleWebhook(context.Background(), "", received)
+}
+
+// waitForApproval waits until an approval request has been posted and returns its ID
+func waitForApproval(t *testing.T, adapter *SlackAdapter) string {
+	var approvalID string
+	require.Eventually(t, func() bool {
+		adapter.approvalMutex.Lock()
+		defer adapter.approvalMutex.Unlock()
+		for id := range adapter.approvals {
+			approvalID = id
+			return true
+		}
+		return false
+	}, time.Second, 5*time.Millisecond)
+	return approvalID
+}
+
+func TestRequestApproval(t *testing.T) {
+	adapter, server := newTestAdapter(t, map[string]string{
+		"/chat.postMessage": `{"ok":true,"channel":"C0OPS","ts":"1700000000.000300"}`,
+		"/chat.update":      `{"ok":true,"channel":"C0OPS","ts":"1700000000.000300"}`,
+	})
+	manager := newContextManager(t)
+	adapter.SetContextUpdater(manager)
+
+	type outcome struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "request_approval", map[string]interface{}{
+			"text":      "Roll back payments-api to v41 in production",
+			"approvers": []interface{}{"U0ALICE"},
+		})
+		done <- outcome{result, err}
+	}()
+
+	approvalID := waitForApproval(t, adapter)
+
+	// Answers from users who are not approvers are ignored
+	assert.NoError(t, clickButton(t, adapter, "U0MALLORY", approveActionID, approvalID))
+	select {
+	case <-done:
+		t.Fatal("approval answered by a user who is not an approver")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	assert.NoError(t, clickButton(t, adapter, "U0ALICE", approveActionID, approvalID))
+
+	var answer outcome
+	select {
+	case answer = <-done:
+	case <-time.After(time.Second):
+		t.Fatal("request_approval did not return after the approval")
+	}
+	require.NoError(t, answer.err)
+
+	approval := answer.result.(Approval)
+	assert.True(t, approval.Approved())
+	assert.Equal(t, "U0ALICE", approval.UserID)
+	assert.Equal(t, "1700000000.000300", approval.MessageTS)
+
+	// The request message shows the buttons, the update replaces them with the outcome
+	posted := server.recorded("/chat.postMessage")[0]
+	assert.Contains(t, posted.raw, approveActionID)
+	updated := server.recorded("/chat.update")[0]
+	assert.Equal(t, "Approved by <@U0ALICE>", updated.body["text"])
+	assert.NotContains(t, updated.raw, approveActionID)
+
+	// The outcome is appended once to the content of the context
+	items := contextItems(t, manager, "ctx-1")
+	require.Len(t, items, 2)
+	assert.Equal(t, "user", items[0].Role)
+	assert.Equal(t, ApprovalApproved, items[1].Metadata["status"])
+	assert.Equal(t, approvalID, items[1].Metadata["approval_id"])
+	assert.Contains(t, items[1].Content, "Roll back payments-api to v41")
+
+	// A second click on an answered request changes nothing
+	clickButton(t, adapter, "U0ALICE", rejectActionID, approvalID)
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_approval", map[string]interface{}{"approval_id": approvalID})
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalApproved, result.(Approval).Status)
+	assert.Len(t, contextItems(t, manager, "ctx-1"), 2)
+}
+
+func TestRequestApprovalRejected(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{
+		"/chat.postMessage": `{"ok":true,"channel":"C0OPS","ts":"1700000000.000300"}`,
+		"/chat.update":      `{"ok":true}`,
+	})
+	manager := newContextManager(t)
+	adapter.SetContextUpdater(manager)
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "request_approval", map[string]interface{}{
+		"text": "Drop table audit_log",
+		"wait": false,
+	})
+	require.NoError(t, err)
+	approval := result.(Approval)
+	assert.Equal(t, ApprovalPending, approval.Status)
+
+	clickButton(t, adapter, "U0BOB", rejectActionID, approval.ID)
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_approval", map[string]interface{}{"approval_id": approval.ID})
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalRejected, result.(Approval).Status)
+	assert.False(t, result.(Approval).Approved())
+
+	items := contextItems(t, manager, "ctx-1")
+	require.Len(t, items, 2)
+	assert.Equal(t, ApprovalRejected, items[1].Metadata["status"])
+	assert.Equal(t, "U0BOB", items[1].Metadata["user_id"])
+}
+
+func TestRequestApprovalExpires(t *testing.T) {
+	adapter, server := newTestAdapter(t, map[string]string{
+		"/chat.postMessage": `{"ok":true,"channel":"C0OPS","ts":"1700000000.000300"}`,
+		"/chat.update":      `{"ok":true}`,
+	})
+	adapter.config.ApprovalTimeout = 20 * time.Millisecond
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "request_approval", map[string]interface{}{
+		"text": "Scale checkout to 0 replicas",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalExpired, result.(Approval).Status)
+
+	require.Eventually(t, func() bool {
+		return len(server.recorded("/chat.update")) == 1
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 6)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	reply, _ := core.FindAction(actions, "post_thread_reply")
+	assert.Equal(t, core.SafetyWrite, reply.Safety)
+	assert.NoError(t, reply.ValidateParams(map[string]interface{}{"thread_ts": "1700000000.000100", "text": "Rolled back", "broadcast": true}))
+	assert.Error(t, reply.ValidateParams(map[string]interface{}{"text": "Rolled back"}))
+
+	approval, _ := core.FindAction(actions, "request_approval")
+	assert.NoError(t, approval.ValidateParams(map[string]interface{}{"text": "Restart the api?", "approvers": []interface{}{"U1"}, "timeout": float64(300), "wait": false}))
+	assert.Error(t, approval.ValidateParams(map[string]interface{}{"text": "Restart the api?", "timeout": "5m"}))
+
+	getApproval, _ := core.FindAction(actions, "get_approval")
+	assert.Equal(t, core.SafetyRead, getApproval.Safety)
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{
+		"/chat.postMessage": `{"ok":false,"error":"channel_not_found"}`,
+		"/chat.update":      `{"ok":false,"error":"invalid_auth"}`,
+	})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "post_message", map[string]interface{}{"text": "hello"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+	assert.Contains(t, err.Error(), "channel_not_found")
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "update_message", map[string]interface{}{"ts": "1", "text": "hello"})
+	assert.True(t, adapterErrors.IsAuthorizationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_approval", map[string]interface{}{"approval_id": "missing"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "delete_channel", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+}
@@ -0,0 +1,47 @@
+package slack
+
+import (
+	"time"
+)
+
+// Config holds configuration for the Slack adapter. Any chat service that
+// implements the Slack Web API, such as a self-hosted Slack-compatible
+// server, can be used by changing BaseURL.
+type Config struct {
+	BaseURL        string        `mapstructure:"base_url"`
+	BotToken       string        `mapstructure:"bot_token"`
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// DefaultChannel is used when an action does not specify a channel
+	DefaultChannel string `mapstructure:"default_channel"`
+
+	// Secret used to verify the X-Slack-Signature header of interactive
+	// callbacks. Requests older than SignatureMaxAge are rejected to prevent
+	// replays.
+	SigningSecret   string        `mapstructure:"signing_secret"`
+	SignatureMaxAge time.Duration `mapstructure:"signature_max_age"`
+
+	// Approval settings. Approvers restricts who may answer an approval
+	// request to the given user IDs; if empty anyone in the channel may answer.
+	ApprovalTimeout    time.Duration `mapstructure:"approval_timeout"`
+	MaxApprovalTimeout time.Duration `mapstructure:"max_approval_timeout"`
+	ApprovalRetention  time.Duration `mapstructure:"approval_retention"`
+	Approvers          []string      `mapstructure:"approvers"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+}
+
+// DefaultConfig returns a default configuration for the Slack adapter
+func DefaultConfig() *Config {
+	return &Config{
+		BaseURL:            "https://slack.com/api",
+		RequestTimeout:     30 * time.Second,
+		SignatureMaxAge:    5 * time.Minute,
+		ApprovalTimeout:    15 * time.Minute,
+		MaxApprovalTimeout: time.Hour,
+		ApprovalRetention:  time.Hour,
+		MockURL:            "http://localhost:8081/mock-slack",
+	}
+}
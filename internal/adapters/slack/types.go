@@ -0,0 +1,76 @@
+package slack
+
+import (
+	"time"
+)
+
+// Approval statuses
+const (
+	ApprovalPending  = "pending"
+	ApprovalApproved = "approved"
+	ApprovalRejected = "rejected"
+	ApprovalExpired  = "expired"
+)
+
+// Action IDs of the approval buttons. The button value is the approval ID.
+const (
+	approveActionID = "mcp_approval_approve"
+	rejectActionID  = "mcp_approval_reject"
+)
+
+// apiResponse is the envelope of every Web API response
+type apiResponse struct {
+	OK       bool   `json:"ok"`
+	Error    string `json:"error"`
+	Warning  string `json:"warning"`
+	Metadata *struct {
+		Messages []string `json:"messages"`
+	} `json:"response_metadata"`
+}
+
+type message struct {
+	TS       string `json:"ts"`
+	ThreadTS string `json:"thread_ts"`
+	Text     string `json:"text"`
+	User     string `json:"user"`
+	BotID    string `json:"bot_id"`
+}
+
+// Message is a posted chat message
+type Message struct {
+	Channel  string `json:"channel"`
+	TS       string `json:"ts"`
+	ThreadTS string `json:"thread_ts,omitempty"`
+	Text     string `json:"text"`
+}
+
+// Snippet is an uploaded text file
+type Snippet struct {
+	FileID   string `json:"file_id"`
+	Channel  string `json:"channel"`
+	Title    string `json:"title"`
+	Filename string `json:"filename"`
+	ThreadTS string `json:"thread_ts,omitempty"`
+}
+
+// Approval is the state of an approval request
+type Approval struct {
+	ID        string `json:"id"`
+	ContextID string `json:"context_id,omitempty"`
+	Status    string `json:"status"`
+	Text      string `json:"text"`
+	Channel   string `json:"channel"`
+	MessageTS string `json:"message_ts"`
+	// UserID and UserName identify who answered the request
+	UserID      string     `json:"user_id,omitempty"`
+	UserName    string     `json:"user_name,omitempty"`
+	Reason      string     `json:"reason,omitempty"`
+	RequestedAt time.Time  `json:"requested_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+}
+
+// Approved returns whether the request was approved
+func (a Approval) Approved() bool {
+	return a.Status == ApprovalApproved
+}
@@ -0,0 +1,141 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// Headers carrying the signature of interactive callbacks
+const (
+	SignatureHeader = "X-Slack-Signature"
+	TimestampHeader = "X-Slack-Request-Timestamp"
+)
+
+// Interaction is the normalized summary of an interactive callback
+type Interaction struct {
+	// Type is the interaction type, e.g. block_actions
+	Type      string              `json:"type"`
+	UserID    string              `json:"user_id"`
+	UserName  string              `json:"user_name,omitempty"`
+	ChannelID string              `json:"channel_id,omitempty"`
+	MessageTS string              `json:"message_ts,omitempty"`
+	Actions   []InteractionAction `json:"actions,omitempty"`
+}
+
+// InteractionAction is an action taken on an interactive component
+type InteractionAction struct {
+	ActionID string `json:"action_id"`
+	BlockID  string `json:"block_id,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+// interactionPayload is the subset of the interactive callback payload used by the adapter
+type interactionPayload struct {
+	Type string `json:"type"`
+	User *struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"user"`
+	Channel *struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	Container *struct {
+		MessageTS string `json:"message_ts"`
+	} `json:"container"`
+	Actions []InteractionAction `json:"actions"`
+}
+
+// VerifySignature checks the X-Slack-Signature header, v0=<hex>, against the
+// HMAC-SHA256 of "v0:<timestamp>:<body>". Requests with a timestamp older
+// than SignatureMaxAge are rejected. If no signing secret is configured every
+// request is rejected.
+func (a *SlackAdapter) VerifySignature(timestamp string, body []byte, signature string) bool {
+	if a.config.SigningSecret == "" {
+		return false
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	age := time.Since(time.Unix(seconds, 0))
+	if age < 0 {
+		age = -age
+	}
+	if a.config.SignatureMaxAge > 0 && age > a.config.SignatureMaxAge {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(a.config.SigningSecret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// ReceiveWebhook implements core.WebhookReceiver. It verifies the request
+// signature and extracts the payload field of form encoded interactivity
+// requests.
+func (a *SlackAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifySignature(header.Get(TimestampHeader), body, header.Get(SignatureHeader)) {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid request signature"), nil)
+	}
+
+	if !isFormEncoded(header) {
+		return body, nil
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, adapterErrors.NewInvalidRequestError(adapterType, "webhook", fmt.Errorf("invalid form payload: %w", err), nil)
+	}
+	return []byte(form.Get("payload")), nil
+}
+
+// isFormEncoded reports whether a request has a form encoded body
+func isFormEncoded(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), "application/x-www-form-urlencoded")
+}
+
+// ParseInteraction decodes an interactive callback payload
+func ParseInteraction(payload []byte) (*Interaction, error) {
+	var body interactionPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse Slack interaction payload: %w", err)
+	}
+	if body.Type == "" {
+		return nil, fmt.Errorf("Slack interaction payload is missing type")
+	}
+
+	interaction := &Interaction{
+		Type:    body.Type,
+		Actions: body.Actions,
+	}
+	if body.User != nil {
+		interaction.UserID = body.User.ID
+		interaction.UserName = body.User.Username
+		if interaction.UserName == "" {
+			interaction.UserName = body.User.Name
+		}
+	}
+	if body.Channel != nil {
+		interaction.ChannelID = body.Channel.ID
+	}
+	if body.Container != nil {
+		interaction.MessageTS = body.Container.MessageTS
+	}
+
+	return interaction, nil
+}
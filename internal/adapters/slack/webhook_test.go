@@ -0,0 +1,68 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+func sign(secret, timestamp, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseInteraction(t *testing.T) {
+	interaction, err := ParseInteraction([]byte(`{"type":"block_actions","user":{"id":"U1","name":"alice"},
+		"channel":{"id":"C1"},"container":{"message_ts":"1700000000.000100"},
+		"actions":[{"action_id":"mcp_approval_approve","block_id":"mcp_approval","value":"a1"}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "block_actions", interaction.Type)
+	assert.Equal(t, "alice", interaction.UserName)
+	assert.Equal(t, "1700000000.000100", interaction.MessageTS)
+	assert.Equal(t, []InteractionAction{{ActionID: approveActionID, BlockID: "mcp_approval", Value: "a1"}}, interaction.Actions)
+
+	_, err = ParseInteraction([]byte(`{"user":{"id":"U1"}}`))
+	assert.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	adapter, err := New(&Config{BaseURL: "https://slack.example.com/api", SigningSecret: "signing-secret", SignatureMaxAge: 5 * time.Minute}, nil, nil, nil)
+	require.NoError(t, err)
+
+	body := "payload=%7B%7D"
+	now := fmt.Sprintf("%d", time.Now().Unix())
+	stale := fmt.Sprintf("%d", time.Now().Add(-10*time.Minute).Unix())
+
+	assert.True(t, adapter.VerifySignature(now, []byte(body), sign("signing-secret", now, body)))
+	assert.False(t, adapter.VerifySignature(now, []byte(body), sign("wrong", now, body)))
+	assert.False(t, adapter.VerifySignature(stale, []byte(body), sign("signing-secret", stale, body)), "replayed requests are rejected")
+	assert.False(t, adapter.VerifySignature("not-a-timestamp", []byte(body), sign("signing-secret", "not-a-timestamp", body)))
+
+	adapter.config.SigningSecret = ""
+	assert.False(t, adapter.VerifySignature(now, []byte(body), sign("", now, body)), "requests are rejected when no secret is configured")
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.SigningSecret = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("slack_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"block-actions.json": "block_actions",
+	})
+}
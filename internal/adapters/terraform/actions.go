@@ -0,0 +1,110 @@
+package terraform
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// planSummarySchema is the schema of a plan summary result
+var planSummarySchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"counts":      map[string]interface{}{"type": "object"},
+		"changes":     map[string]interface{}{"type": "array"},
+		"destructive": map[string]interface{}{"type": "array"},
+		"safe":        map[string]interface{}{"type": "boolean"},
+	},
+}
+
+// Actions returns the descriptors of the supported Terraform actions. All
+// of them are read only: plans are summarized but never applied.
+func (a *TerraformAdapter) Actions() []core.ActionDescriptor {
+	return []core.ActionDescriptor{
+		{
+			Name:        "show_plan",
+			Description: "Render a saved plan file with terraform show -json and summarize its changes",
+			Params: paramsSchema([]string{"plan_file"}, map[string]interface{}{
+				"plan_file": map[string]interface{}{
+					"type":        "string",
+					"description": "Plan file, relative to the root module directory",
+					"minLength":   1,
+				},
+				"dir": map[string]interface{}{
+					"type":        "string",
+					"description": "Initialized root module directory, relative to the working directory",
+				},
+			}),
+			Result: planSummarySchema,
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "summarize_plan_json",
+			Description: "Summarize the changes of a plan already rendered as JSON, such as a CI artifact",
+			Params: paramsSchema([]string{"plan_json"}, map[string]interface{}{
+				"plan_json": map[string]interface{}{
+					"type":        "string",
+					"description": "Output of terraform show -json",
+					"minLength":   1,
+				},
+			}),
+			Result: planSummarySchema,
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "list_runs",
+			Description: "List the Terraform Cloud runs of a workspace, newest first",
+			Params: paramsSchema(nil, map[string]interface{}{
+				"workspace_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Workspace ID, such as ws-123",
+				},
+				"workspace": map[string]interface{}{
+					"type":        "string",
+					"description": "Workspace name, when no workspace_id is given",
+				},
+				"organization": map[string]interface{}{
+					"type":        "string",
+					"description": "Organization of the workspace. Defaults to the configured organization",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum number of runs to return",
+					"minimum":     1,
+					"maximum":     100,
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+		{
+			Name:        "get_run_plan",
+			Description: "Get a Terraform Cloud run and summarize its plan",
+			Params: paramsSchema([]string{"run_id"}, map[string]interface{}{
+				"run_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Run ID, such as run-123",
+					"minLength":   1,
+				},
+			}),
+			Safety: core.SafetyRead,
+		},
+	}
+}
+
+// paramsSchema returns the schema of an object with the given properties
+func paramsSchema(required []string, properties ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, props := range properties {
+		for name, schema := range props {
+			merged[name] = schema
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           merged,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,558 @@
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/safety"
+)
+
+// adapterType is the unique identifier for the Terraform adapter
+const adapterType = "terraform"
+
+// maxCommandErrorLength limits the command output included in errors
+const maxCommandErrorLength = 2000
+
+// TerraformAdapter provides an adapter for inspecting Terraform and OpenTofu
+// plans, from local plan files or from Terraform Cloud runs
+type TerraformAdapter struct {
+	config        *Config
+	cloudURL      string
+	client        *http.Client
+	checker       *safety.TerraformChecker
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+}
+
+// New creates a new Terraform adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*TerraformAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("terraform_adapter")
+	}
+
+	if config.Binary == "" && config.CloudURL == "" && !config.MockResponses {
+		return nil, fmt.Errorf("a Terraform binary or a Terraform Cloud URL is required")
+	}
+
+	cloudURL := config.CloudURL
+	if config.MockResponses {
+		cloudURL = config.MockURL
+	}
+
+	return &TerraformAdapter{
+		config:        config,
+		cloudURL:      strings.TrimRight(cloudURL, "/"),
+		client:        &http.Client{Timeout: config.RequestTimeout},
+		checker:       safety.NewTerraformChecker(config.StatefulResourceTypes...),
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+	}, nil
+}
+
+// Type returns the adapter type
+func (a *TerraformAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *TerraformAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status. The binary is checked when it is
+// configured, and the Terraform Cloud API when a token is configured.
+func (a *TerraformAdapter) Health() string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if a.config.Binary != "" && !a.config.MockResponses {
+		if _, err := exec.LookPath(a.config.Binary); err != nil {
+			return fmt.Sprintf("unhealthy: %v", err)
+		}
+	}
+
+	if a.config.CloudToken != "" || a.config.MockResponses {
+		if err := a.doRequest(ctx, "health", http.MethodGet, "/api/v2/account/details", nil, nil); err != nil {
+			return fmt.Sprintf("unhealthy: %v", err)
+		}
+	}
+
+	if a.config.MockResponses {
+		return "healthy (mock)"
+	}
+	return "healthy"
+}
+
+// ExecuteAction executes a Terraform action
+func (a *TerraformAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing Terraform action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	// Plans
+	case "show_plan":
+		result, err = a.showPlan(ctx, params)
+	case "summarize_plan_json":
+		result, err = a.summarizePlanJSON(params)
+
+	// Terraform Cloud
+	case "list_runs":
+		result, err = a.listRuns(ctx, params)
+	case "get_run_plan":
+		result, err = a.getRunPlan(ctx, params)
+
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported Terraform action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// showPlan renders a plan file with terraform show -json and summarizes it.
+// The plan is rendered in its root module directory, which must have been
+// initialized, relative to the working directory.
+func (a *TerraformAdapter) showPlan(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	planFile, err := core.RequiredStringParam(params, "plan_file")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "show_plan", err, nil)
+	}
+
+	dir, err := a.resolvePath(core.StringParam(params, "dir"))
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "show_plan", err, nil)
+	}
+
+	planPath := planFile
+	if !filepath.IsAbs(planPath) {
+		planPath = filepath.Join(dir, planPath)
+	}
+	planPath, err = a.resolvePath(planPath)
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "show_plan", err, nil)
+	}
+
+	output, err := a.runShow(ctx, dir, planPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.summarize("show_plan", output)
+}
+
+// summarizePlanJSON summarizes a plan already rendered as JSON, such as a CI artifact
+func (a *TerraformAdapter) summarizePlanJSON(params map[string]interface{}) (interface{}, error) {
+	planJSON, err := core.RequiredStringParam(params, "plan_json")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "summarize_plan_json", err, nil)
+	}
+
+	return a.summarize("summarize_plan_json", []byte(planJSON))
+}
+
+// listRuns lists the runs of a workspace, newest first. The workspace is given
+// by ID, or by name within the organization.
+func (a *TerraformAdapter) listRuns(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	workspaceID, err := a.workspaceID(ctx, "list_runs", params)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("page[size]", strconv.Itoa(core.IntParam(params, "limit", a.config.DefaultRunLimit)))
+
+	var response struct {
+		Data []runData `json:"data"`
+	}
+	path := "/api/v2/workspaces/" + url.PathEscape(workspaceID) + "/runs?" + query.Encode()
+	if err := a.doRequest(ctx, "list_runs", http.MethodGet, path, nil, &response); err != nil {
+		return nil, err
+	}
+
+	runs := make([]Run, 0, len(response.Data))
+	for _, run := range response.Data {
+		runs = append(runs, toRun(run))
+	}
+
+	return runs, nil
+}
+
+// getRunPlan gets a Terraform Cloud run and summarizes its plan
+func (a *TerraformAdapter) getRunPlan(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	runID, err := core.RequiredStringParam(params, "run_id")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "get_run_plan", err, nil)
+	}
+
+	var response struct {
+		Data runData `json:"data"`
+	}
+	if err := a.doRequest(ctx, "get_run_plan", http.MethodGet, "/api/v2/runs/"+url.PathEscape(runID), nil, &response); err != nil {
+		return nil, err
+	}
+
+	run := toRun(response.Data)
+	if run.PlanID == "" {
+		return nil, adapterErrors.NewInvalidRequestError(adapterType, "get_run_plan",
+			fmt.Errorf("run %s has no plan", runID), nil)
+	}
+
+	// The JSON plan is served through a redirect to temporary storage
+	var output json.RawMessage
+	if err := a.doRequest(ctx, "get_run_plan", http.MethodGet, "/api/v2/plans/"+url.PathEscape(run.PlanID)+"/json-output", nil, &output); err != nil {
+		return nil, err
+	}
+	if len(output) == 0 {
+		return nil, adapterErrors.NewInvalidRequestError(adapterType, "get_run_plan",
+			fmt.Errorf("the plan of run %s is not available yet (run status %s)", runID, run.Status), nil)
+	}
+
+	summary, err := a.summarize("get_run_plan", output)
+	if err != nil {
+		return nil, err
+	}
+
+	return RunPlan{Run: run, Plan: summary}, nil
+}
+
+// summarize summarizes a JSON plan and flags it when it deletes stateful resources
+func (a *TerraformAdapter) summarize(operation string, output []byte) (PlanSummary, error) {
+	summary, err := summarizePlan(output, a.checker.IsStateful, a.config.MaxChangedAttributes)
+	if err != nil {
+		return PlanSummary{}, adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+
+	deleted := make(map[string]string, len(summary.Destructive))
+	for _, change := range summary.Destructive {
+		deleted[change.Address] = change.Type
+	}
+
+	if safe, err := a.checker.IsSafeOperation(operation, map[string]interface{}{"deleted_resources": deleted}); !safe {
+		if err == nil {
+			err = safety.ErrOperationNotAllowed
+		}
+		summary.Safe = false
+		summary.SafetyWarning = err.Error()
+
+		a.logger.Warn("Terraform plan deletes stateful resources", map[string]interface{}{
+			"operation": operation,
+			"warning":   summary.SafetyWarning,
+		})
+	}
+
+	return summary, nil
+}
+
+// HandleWebhook handles a Terraform Cloud run notification
+func (a *TerraformAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	var notification struct {
+		RunID         string `json:"run_id"`
+		RunURL        string `json:"run_url"`
+		WorkspaceName string `json:"workspace_name"`
+		Organization  string `json:"organization_name"`
+		Notifications []struct {
+			Trigger   string `json:"trigger"`
+			RunStatus string `json:"run_status"`
+			Message   string `json:"message"`
+		} `json:"notifications"`
+	}
+	if err := json.Unmarshal(payload, &notification); err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook",
+			fmt.Errorf("failed to parse Terraform notification payload: %w", err), nil)
+	}
+
+	status := ""
+	if len(notification.Notifications) > 0 {
+		if eventType == "" {
+			eventType = notification.Notifications[0].Trigger
+		}
+		status = notification.Notifications[0].RunStatus
+	}
+
+	a.logger.Info("Received Terraform notification", map[string]interface{}{
+		"eventType": eventType,
+		"runId":     notification.RunID,
+		"workspace": notification.WorkspaceName,
+	})
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, notification).
+			WithMetadata("eventType", eventType).
+			WithMetadata("runId", notification.RunID).
+			WithMetadata("runStatus", status).
+			WithMetadata("workspace", notification.WorkspaceName)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// Close closes the adapter
+func (a *TerraformAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// resolvePath resolves a path relative to the working directory and rejects
+// paths, including symlinks, that lead outside of it
+func (a *TerraformAdapter) resolvePath(path string) (string, error) {
+	base, err := filepath.Abs(a.config.WorkingDir)
+	if err != nil {
+		return "", fmt.Errorf("invalid working directory: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(base); err == nil {
+		base = resolved
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(base, path)
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", fmt.Errorf("path %s does not exist", path)
+	}
+
+	relative, err := filepath.Rel(base, resolved)
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path %s is outside of the working directory", path)
+	}
+
+	return resolved, nil
+}
+
+// runShow runs terraform show -json on a plan file
+func (a *TerraformAdapter) runShow(ctx context.Context, dir, planPath string) ([]byte, error) {
+	if a.config.Binary == "" {
+		return nil, adapterErrors.NewMissingConfigurationError(adapterType, "show_plan",
+			fmt.Errorf("no Terraform binary is configured"), nil)
+	}
+
+	if a.config.CommandTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.config.CommandTimeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, a.config.Binary, "show", "-json", "-no-color", planPath)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "TF_IN_AUTOMATION=1")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, adapterErrors.NewTimeoutError(adapterType, "show_plan", err, nil)
+		}
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, adapterErrors.NewMissingConfigurationError(adapterType, "show_plan", err, nil)
+		}
+
+		message := strings.TrimSpace(stderr.String())
+		if len(message) > maxCommandErrorLength {
+			message = message[len(message)-maxCommandErrorLength:]
+		}
+		return nil, adapterErrors.NewInvalidRequestError(adapterType, "show_plan",
+			fmt.Errorf("%s show failed: %w: %s", filepath.Base(a.config.Binary), err, message), nil)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// workspaceID returns the workspace_id parameter, or looks up the workspace
+// given by name in the organization parameter or the configured organization
+func (a *TerraformAdapter) workspaceID(ctx context.Context, operation string, params map[string]interface{}) (string, error) {
+	if id := core.StringParam(params, "workspace_id"); id != "" {
+		return id, nil
+	}
+
+	name := core.StringParam(params, "workspace")
+	if name == "" {
+		return "", adapterErrors.NewInvalidParameterError(adapterType, operation,
+			fmt.Errorf("missing required parameter: workspace_id or workspace"), nil)
+	}
+
+	organization := core.StringParam(params, "organization")
+	if organization == "" {
+		organization = a.config.Organization
+	}
+	if organization == "" {
+		return "", adapterErrors.NewInvalidParameterError(adapterType, operation,
+			fmt.Errorf("missing required parameter: organization"), nil)
+	}
+
+	var response struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	path := "/api/v2/organizations/" + url.PathEscape(organization) + "/workspaces/" + url.PathEscape(name)
+	if err := a.doRequest(ctx, operation, http.MethodGet, path, nil, &response); err != nil {
+		return "", err
+	}
+
+	return response.Data.ID, nil
+}
+
+// doRequest performs a Terraform Cloud API request and decodes the JSON response into out
+func (a *TerraformAdapter) doRequest(ctx context.Context, operation, method, path string, body interface{}, out interface{}) error {
+	if a.cloudURL == "" {
+		return adapterErrors.NewMissingConfigurationError(adapterType, operation,
+			fmt.Errorf("no Terraform Cloud URL is configured"), nil)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, a.cloudURL+path, reader)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	req.Header.Set("Accept", "application/vnd.api+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/vnd.api+json")
+	}
+	if a.config.CloudToken != "" {
+		req.Header.Set("Authorization", "Bearer "+a.config.CloudToken)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("terraform Cloud API returned %d: %s", resp.StatusCode, errorMessage(respBody)), nil)
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if raw, ok := out.(*json.RawMessage); ok {
+		*raw = respBody
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Terraform Cloud response: %w", err), nil)
+	}
+
+	return nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *TerraformAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// errorMessage extracts the messages from a JSON:API error response
+func errorMessage(body []byte) string {
+	var response struct {
+		Errors []struct {
+			Title  string `json:"title"`
+			Detail string `json:"detail"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(body, &response) == nil && len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			message := e.Title
+			if e.Detail != "" {
+				message = e.Detail
+			}
+			messages = append(messages, message)
+		}
+		return strings.Join(messages, "; ")
+	}
+	return strings.TrimSpace(string(body))
+}
+
+// toRun summarizes a Terraform Cloud run
+func toRun(run runData) Run {
+	result := Run{
+		ID:         run.ID,
+		Status:     run.Attributes.Status,
+		Message:    run.Attributes.Message,
+		Source:     run.Attributes.Source,
+		HasChanges: run.Attributes.HasChanges,
+		IsDestroy:  run.Attributes.IsDestroy,
+		CreatedAt:  run.Attributes.CreatedAt,
+	}
+	if plan := run.Relationships.Plan.Data; plan != nil {
+		result.PlanID = plan.ID
+	}
+	if workspace := run.Relationships.Workspace.Data; workspace != nil {
+		result.WorkspaceID = workspace.ID
+	}
+	return result
+}
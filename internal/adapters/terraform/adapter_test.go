@@ -0,0 +1,302 @@
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// recordedRequest captures the parts of a request the tests assert on
+type recordedRequest struct {
+	method  string
+	path    string
+	query   map[string][]string
+	headers http.Header
+}
+
+const planJSON = `{"format_version":"1.2","terraform_version":"1.9.5","resource_changes":[
+	{"address":"aws_instance.web","mode":"managed","type":"aws_instance","name":"web","provider_name":"registry.terraform.io/hashicorp/aws",
+	 "change":{"actions":["update"],"before":{"instance_type":"t3.small","tags":{"team":"web"},"ami":"ami-1"},
+	 "after":{"instance_type":"t3.medium","tags":{"team":"web"},"ami":"ami-1"},"after_unknown":{"public_ip":true}}},
+	{"address":"aws_db_instance.main","mode":"managed","type":"aws_db_instance","name":"main",
+	 "change":{"actions":["delete","create"],"before":{"engine_version":"14"},"after":{"engine_version":"16"},
+	 "replace_paths":[["engine_version"]]},"action_reason":"replace_because_cannot_update"},
+	{"address":"aws_security_group.legacy","mode":"managed","type":"aws_security_group","name":"legacy",
+	 "change":{"actions":["delete"],"before":{},"after":null},"action_reason":"delete_because_no_resource_config"},
+	{"address":"aws_s3_bucket.assets","mode":"managed","type":"aws_s3_bucket","name":"assets","change":{"actions":["create"]}},
+	{"address":"aws_iam_role.ci","mode":"managed","type":"aws_iam_role","name":"ci","change":{"actions":["no-op"]}},
+	{"address":"data.aws_ami.ubuntu","mode":"data","type":"aws_ami","name":"ubuntu","change":{"actions":["read"]}}],
+	"output_changes":{"db_endpoint":{"actions":["update"]},"region":{"actions":["no-op"]}},
+	"resource_drift":[{"address":"aws_instance.web"}]}`
+
+const safePlanJSON = `{"format_version":"1.2","terraform_version":"1.9.5","resource_changes":[
+	{"address":"aws_instance.web","mode":"managed","type":"aws_instance","name":"web","change":{"actions":["create"]}}]}`
+
+func newTestAdapter(t *testing.T, responses map[string]string) (*TerraformAdapter, *[]recordedRequest) {
+	requests := []recordedRequest{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, recordedRequest{
+			method:  r.Method,
+			path:    r.URL.Path,
+			query:   r.URL.Query(),
+			headers: r.Header,
+		})
+
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"status":"404","title":"not found"}]}`))
+			return
+		}
+		if response == "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.CloudURL = server.URL
+	config.CloudToken = "tfc-token"
+	config.Organization = "acme"
+	config.WorkingDir = t.TempDir()
+
+	logger := observability.NewLogger("terraform_test")
+	adapter, err := New(config, logger, observability.NewMetricsClient(), events.NewEventBus(logger))
+	require.NoError(t, err)
+
+	return adapter, &requests
+}
+
+func TestSummarizePlan(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "summarize_plan_json", map[string]interface{}{"plan_json": planJSON})
+	require.NoError(t, err)
+
+	summary := result.(PlanSummary)
+	assert.Equal(t, "1.9.5", summary.TerraformVersion)
+	assert.Equal(t, ChangeCounts{Create: 1, Update: 1, Delete: 1, Replace: 1, Read: 1}, summary.Counts)
+	assert.Len(t, summary.Changes, 5, "no-op changes are left out")
+	assert.Equal(t, 1, summary.Drift)
+	assert.Equal(t, []OutputChange{{Name: "db_endpoint", Action: "update"}}, summary.Outputs)
+
+	update := summary.Changes[0]
+	assert.Equal(t, "update", update.Action)
+	assert.Equal(t, []string{"instance_type", "public_ip"}, update.Attributes)
+
+	require.Len(t, summary.Destructive, 2)
+	replace := summary.Destructive[0]
+	assert.Equal(t, "aws_db_instance.main", replace.Address)
+	assert.Equal(t, "replace", replace.Action)
+	assert.Equal(t, "replace_because_cannot_update", replace.Reason)
+	assert.Equal(t, []string{"engine_version"}, replace.Attributes)
+	assert.True(t, replace.Stateful)
+	assert.False(t, summary.Destructive[1].Stateful)
+
+	// Replacing the database deletes its data
+	assert.False(t, summary.Safe)
+	assert.Contains(t, summary.SafetyWarning, "aws_db_instance.main")
+	assert.NotContains(t, summary.SafetyWarning, "aws_security_group.legacy")
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "summarize_plan_json", map[string]interface{}{"plan_json": safePlanJSON})
+	require.NoError(t, err)
+	assert.True(t, result.(PlanSummary).Safe)
+	assert.Empty(t, result.(PlanSummary).Destructive)
+}
+
+func TestShowPlan(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{})
+
+	// A stand-in for terraform that prints the plan JSON for its last argument
+	moduleDir := filepath.Join(adapter.config.WorkingDir, "infra")
+	require.NoError(t, os.MkdirAll(moduleDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "tfplan"), []byte("binary plan"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "tfplan.json"), []byte(planJSON), 0644))
+
+	binary := filepath.Join(t.TempDir(), "terraform")
+	script := "#!/bin/sh\n" +
+		"[ \"$1 $2 $3\" = \"show -json -no-color\" ] || { echo \"unexpected arguments: $*\" >&2; exit 1; }\n" +
+		"[ \"$TF_IN_AUTOMATION\" = \"1\" ] || exit 1\n" +
+		"[ -f \"$4.json\" ] || { echo \"Error: Failed to read the given file as a state or plan file\" >&2; exit 1; }\n" +
+		"cat \"$4.json\"\n"
+	require.NoError(t, os.WriteFile(binary, []byte(script), 0755))
+	adapter.config.Binary = binary
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "show_plan", map[string]interface{}{
+		"dir":       "infra",
+		"plan_file": "tfplan",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.(PlanSummary).Counts.Replace)
+
+	// Command failures carry the command output
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "other"), []byte("binary plan"), 0644))
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "show_plan", map[string]interface{}{
+		"dir":       "infra",
+		"plan_file": "other",
+	})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidRequest))
+	assert.Contains(t, err.Error(), "Failed to read the given file")
+
+	// Plans outside of the working directory are rejected
+	outside := filepath.Join(t.TempDir(), "tfplan")
+	require.NoError(t, os.WriteFile(outside, []byte("binary plan"), 0644))
+	for _, planFile := range []string{outside, "../../" + filepath.Base(filepath.Dir(outside)) + "/tfplan"} {
+		_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "show_plan", map[string]interface{}{"plan_file": planFile})
+		assert.True(t, adapterErrors.IsValidationError(err), planFile)
+	}
+
+	require.NoError(t, os.Symlink(outside, filepath.Join(moduleDir, "link")))
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "show_plan", map[string]interface{}{"dir": "infra", "plan_file": "link"})
+	assert.True(t, adapterErrors.IsValidationError(err), "symlinks out of the working directory are rejected")
+}
+
+func TestRuns(t *testing.T) {
+	run := `{"data":{"id":"run-CZcmD7eagjhyX0vN","attributes":{"status":"planned","message":"Upgrade database",
+		"source":"tfe-configuration-version","has-changes":true,"is-destroy":false,"created-at":"2026-10-19T10:00:00Z"},
+		"relationships":{"plan":{"data":{"id":"plan-8F5JFydVYAmtTjET","type":"plans"}},"workspace":{"data":{"id":"ws-1","type":"workspaces"}}}}}`
+
+	adapter, requests := newTestAdapter(t, map[string]string{
+		"GET /api/v2/organizations/acme/workspaces/networking": `{"data":{"id":"ws-1","attributes":{"name":"networking"}}}`,
+		"GET /api/v2/workspaces/ws-1/runs":                     `{"data":[` + mustData(t, run) + `]}`,
+		"GET /api/v2/runs/run-CZcmD7eagjhyX0vN":                run,
+		"GET /api/v2/plans/plan-8F5JFydVYAmtTjET/json-output":  planJSON,
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "list_runs", map[string]interface{}{"workspace": "networking", "limit": 5})
+	require.NoError(t, err)
+
+	runs := result.([]Run)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "planned", runs[0].Status)
+	assert.Equal(t, "plan-8F5JFydVYAmtTjET", runs[0].PlanID)
+	assert.Equal(t, []string{"5"}, (*requests)[1].query["page[size]"])
+	assert.Equal(t, "Bearer tfc-token", (*requests)[0].headers.Get("Authorization"))
+
+	result, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_run_plan", map[string]interface{}{"run_id": "run-CZcmD7eagjhyX0vN"})
+	require.NoError(t, err)
+
+	runPlan := result.(RunPlan)
+	assert.Equal(t, "Upgrade database", runPlan.Run.Message)
+	assert.Equal(t, 1, runPlan.Plan.Counts.Delete)
+	assert.False(t, runPlan.Plan.Safe)
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 4)
+
+	// Every action the adapter dispatches is described, and none changes
+	// infrastructure
+	for _, action := range actions {
+		assert.Equal(t, core.SafetyRead, action.Safety, action.Name)
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	listRuns, _ := core.FindAction(actions, "list_runs")
+	assert.NoError(t, listRuns.ValidateParams(map[string]interface{}{"workspace": "networking", "organization": "example", "limit": float64(5)}))
+	assert.Error(t, listRuns.ValidateParams(map[string]interface{}{"workspace_id": "ws-1", "limit": float64(500)}))
+
+	showPlan, _ := core.FindAction(actions, "show_plan")
+	assert.Error(t, showPlan.ValidateParams(map[string]interface{}{"dir": "envs/prod"}))
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, map[string]string{
+		"GET /api/v2/runs/run-pending": `{"data":{"id":"run-pending","attributes":{"status":"planning"},
+			"relationships":{"plan":{"data":{"id":"plan-pending"}}}}}`,
+		"GET /api/v2/plans/plan-pending/json-output": "",
+	})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_run_plan", map[string]interface{}{"run_id": "run-missing"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeResourceNotFound))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "get_run_plan", map[string]interface{}{"run_id": "run-pending"})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidRequest))
+	assert.Contains(t, err.Error(), "not available yet")
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "summarize_plan_json", map[string]interface{}{"plan_json": `{"values":{}}`})
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidRequest))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "list_runs", nil)
+	assert.True(t, adapterErrors.IsValidationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "apply_plan", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+}
+
+// mustData returns the data member of a JSON:API document
+func mustData(t *testing.T, document string) string {
+	var body struct {
+		Data json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(document), &body))
+	return string(body.Data)
+}
+
+func TestReceiveWebhook(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+	delivery, err := fixture.Load("testdata/webhooks/run-errored.json")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		configured string
+		signedWith string
+		expectCode string
+	}{
+		{"valid signature", fixture.TestSecret, fixture.TestSecret, ""},
+		{"invalid signature", fixture.TestSecret, "wrong", adapterErrors.ErrCodeUnauthorized},
+		{"unsigned delivery", fixture.TestSecret, "", adapterErrors.ErrCodeUnauthorized},
+		{"no secret configured", "", fixture.TestSecret, adapterErrors.ErrCodeUnauthorized},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adapter.config.WebhookSecret = test.configured
+			signed := delivery.Sign(test.signedWith, time.Now())
+
+			payload, err := adapter.ReceiveWebhook(signed.Header, signed.Query, signed.Payload())
+			if test.expectCode == "" {
+				require.NoError(t, err)
+				assert.Equal(t, delivery.Payload(), payload)
+				return
+			}
+			assert.True(t, adapterErrors.IsSpecificErrorCode(err, test.expectCode), "%v", err)
+		})
+	}
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.WebhookSecret = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("terraform_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"run-errored.json": "run:errored",
+	})
+}
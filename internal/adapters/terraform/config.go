@@ -0,0 +1,54 @@
+package terraform
+
+import (
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/safety"
+)
+
+// Config holds configuration for the Terraform adapter
+type Config struct {
+	// Binary is the terraform or tofu executable used to render plan files
+	Binary string `mapstructure:"binary"`
+
+	// WorkingDir is the directory plan files are read from. Plan paths given
+	// to the adapter must resolve inside it.
+	WorkingDir     string        `mapstructure:"working_dir"`
+	CommandTimeout time.Duration `mapstructure:"command_timeout"`
+
+	// Terraform Cloud or Terraform Enterprise compatible API settings
+	CloudURL       string        `mapstructure:"cloud_url"`
+	CloudToken     string        `mapstructure:"cloud_token"`
+	Organization   string        `mapstructure:"organization"`
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// Token of the notification configuration, used to verify the
+	// X-TFE-Notification-Signature header of run notifications
+	WebhookSecret string `mapstructure:"webhook_secret"`
+
+	// Resource types whose deletion is flagged as destructive to data
+	StatefulResourceTypes []string `mapstructure:"stateful_resource_types"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+
+	// Result settings
+	MaxChangedAttributes int `mapstructure:"max_changed_attributes"`
+	DefaultRunLimit      int `mapstructure:"default_run_limit"`
+}
+
+// DefaultConfig returns a default configuration for the Terraform adapter
+func DefaultConfig() *Config {
+	return &Config{
+		Binary:                "terraform",
+		WorkingDir:            ".",
+		CommandTimeout:        2 * time.Minute,
+		CloudURL:              "https://app.terraform.io",
+		RequestTimeout:        30 * time.Second,
+		StatefulResourceTypes: append([]string(nil), safety.DefaultStatefulResourceTypes...),
+		MockURL:               "http://localhost:8081/mock-terraform",
+		MaxChangedAttributes:  20,
+		DefaultRunLimit:       20,
+	}
+}
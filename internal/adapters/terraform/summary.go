@@ -0,0 +1,185 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// summarizePlan parses a JSON plan, as rendered by terraform show -json, and
+// summarizes its resource changes. No-op changes are left out.
+func summarizePlan(data []byte, isStateful func(string) bool, maxAttributes int) (PlanSummary, error) {
+	var p plan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return PlanSummary{}, fmt.Errorf("failed to parse plan JSON: %w", err)
+	}
+	if p.FormatVersion == "" {
+		return PlanSummary{}, fmt.Errorf("input is not a Terraform JSON plan: format_version is missing")
+	}
+
+	summary := PlanSummary{
+		TerraformVersion: p.TerraformVersion,
+		Changes:          []ResourceChange{},
+		Destructive:      []ResourceChange{},
+		Drift:            len(p.ResourceDrift),
+		Errored:          p.Errored,
+		Safe:             true,
+	}
+
+	for _, rc := range p.ResourceChanges {
+		action := actionName(rc.Change.Actions)
+		switch action {
+		case "no-op":
+			continue
+		case "create":
+			summary.Counts.Create++
+		case "update":
+			summary.Counts.Update++
+		case "delete":
+			summary.Counts.Delete++
+		case "replace":
+			summary.Counts.Replace++
+		case "read":
+			summary.Counts.Read++
+		case "forget":
+			summary.Counts.Forget++
+		}
+
+		change := ResourceChange{
+			Address:    rc.Address,
+			Type:       rc.Type,
+			Provider:   rc.ProviderName,
+			Action:     action,
+			Reason:     rc.ActionReason,
+			Attributes: changedAttributes(rc, action, maxAttributes),
+		}
+		if rc.Mode != "data" && isStateful != nil {
+			change.Stateful = isStateful(rc.Type)
+		}
+
+		summary.Changes = append(summary.Changes, change)
+		if (action == "delete" || action == "replace") && rc.Mode != "data" {
+			summary.Destructive = append(summary.Destructive, change)
+		}
+	}
+
+	names := make([]string, 0, len(p.OutputChanges))
+	for name := range p.OutputChanges {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if action := actionName(p.OutputChanges[name].Actions); action != "no-op" {
+			summary.Outputs = append(summary.Outputs, OutputChange{Name: name, Action: action})
+		}
+	}
+
+	return summary, nil
+}
+
+// actionName maps the action list of a change to a single action. A delete
+// combined with a create, in either order, is a replacement.
+func actionName(actions []string) string {
+	switch len(actions) {
+	case 0:
+		return "no-op"
+	case 1:
+		return actions[0]
+	}
+
+	hasCreate, hasDelete := false, false
+	for _, action := range actions {
+		hasCreate = hasCreate || action == "create"
+		hasDelete = hasDelete || action == "delete"
+	}
+	if hasCreate && hasDelete {
+		return "replace"
+	}
+	return strings.Join(actions, "-")
+}
+
+// changedAttributes lists the attributes forcing a replacement, or the top
+// level attributes changed by an update
+func changedAttributes(rc resourceChange, action string, maxAttributes int) []string {
+	var attributes []string
+
+	switch action {
+	case "replace":
+		for _, path := range rc.Change.ReplacePaths {
+			attributes = append(attributes, formatPath(path))
+		}
+		if len(attributes) > 0 {
+			break
+		}
+		fallthrough
+	case "update":
+		before, _ := rc.Change.Before.(map[string]interface{})
+		after, _ := rc.Change.After.(map[string]interface{})
+		unknown, _ := rc.Change.AfterUnknown.(map[string]interface{})
+
+		seen := map[string]bool{}
+		for _, values := range []map[string]interface{}{before, after, unknown} {
+			for key := range values {
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				if !reflect.DeepEqual(before[key], after[key]) || isUnknown(unknown[key]) {
+					attributes = append(attributes, key)
+				}
+			}
+		}
+		sort.Strings(attributes)
+	}
+
+	if maxAttributes > 0 && len(attributes) > maxAttributes {
+		more := len(attributes) - maxAttributes
+		attributes = append(attributes[:maxAttributes], fmt.Sprintf("(+%d more)", more))
+	}
+
+	return attributes
+}
+
+// isUnknown returns whether an after_unknown value marks a value, or any
+// value nested in it, as known only after apply
+func isUnknown(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case map[string]interface{}:
+		for _, nested := range v {
+			if isUnknown(nested) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, nested := range v {
+			if isUnknown(nested) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// formatPath formats an attribute path such as ["ebs_block_device", 0, "size"]
+// as ebs_block_device[0].size
+func formatPath(path []interface{}) string {
+	var builder strings.Builder
+	for _, step := range path {
+		switch s := step.(type) {
+		case string:
+			if builder.Len() > 0 {
+				builder.WriteString(".")
+			}
+			builder.WriteString(s)
+		case float64:
+			fmt.Fprintf(&builder, "[%d]", int(s))
+		default:
+			fmt.Fprintf(&builder, "[%v]", s)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,128 @@
+package terraform
+
+import (
+	"time"
+)
+
+// The types below are the subset of the Terraform JSON plan format and the
+// Terraform Cloud API used by the adapter
+
+type plan struct {
+	FormatVersion    string           `json:"format_version"`
+	TerraformVersion string           `json:"terraform_version"`
+	ResourceChanges  []resourceChange `json:"resource_changes"`
+	ResourceDrift    []resourceChange `json:"resource_drift"`
+	OutputChanges    map[string]struct {
+		Actions []string `json:"actions"`
+	} `json:"output_changes"`
+	Errored bool `json:"errored"`
+}
+
+type resourceChange struct {
+	Address      string `json:"address"`
+	Mode         string `json:"mode"`
+	Type         string `json:"type"`
+	Name         string `json:"name"`
+	ProviderName string `json:"provider_name"`
+	ActionReason string `json:"action_reason"`
+	Change       struct {
+		Actions      []string        `json:"actions"`
+		Before       interface{}     `json:"before"`
+		After        interface{}     `json:"after"`
+		AfterUnknown interface{}     `json:"after_unknown"`
+		ReplacePaths [][]interface{} `json:"replace_paths"`
+	} `json:"change"`
+}
+
+type runData struct {
+	ID         string `json:"id"`
+	Attributes struct {
+		Status     string    `json:"status"`
+		Message    string    `json:"message"`
+		Source     string    `json:"source"`
+		HasChanges bool      `json:"has-changes"`
+		IsDestroy  bool      `json:"is-destroy"`
+		CreatedAt  time.Time `json:"created-at"`
+	} `json:"attributes"`
+	Relationships struct {
+		Plan struct {
+			Data *struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"plan"`
+		Workspace struct {
+			Data *struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"workspace"`
+	} `json:"relationships"`
+}
+
+// ChangeCounts counts the resource changes of a plan by action
+type ChangeCounts struct {
+	Create  int `json:"create"`
+	Update  int `json:"update"`
+	Delete  int `json:"delete"`
+	Replace int `json:"replace"`
+	Read    int `json:"read,omitempty"`
+	Forget  int `json:"forget,omitempty"`
+}
+
+// ResourceChange is a planned change to a resource. Attribute values are left
+// out since they may be sensitive; only the names of changed attributes are listed.
+type ResourceChange struct {
+	Address  string `json:"address"`
+	Type     string `json:"type"`
+	Provider string `json:"provider,omitempty"`
+	// Action is create, update, delete, replace, read or forget
+	Action string `json:"action"`
+	// Reason explains replacements and deletions, e.g. replace_because_cannot_update
+	Reason string `json:"reason,omitempty"`
+	// Attributes are the changed attributes of updates, or the attributes
+	// forcing replacement of replacements
+	Attributes []string `json:"attributes,omitempty"`
+	// Stateful is set for resource types whose deletion loses data
+	Stateful bool `json:"stateful,omitempty"`
+}
+
+// OutputChange is a planned change to a root module output
+type OutputChange struct {
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+// PlanSummary is the structured summary of a plan
+type PlanSummary struct {
+	TerraformVersion string           `json:"terraform_version"`
+	Counts           ChangeCounts     `json:"counts"`
+	Changes          []ResourceChange `json:"changes"`
+	// Destructive lists the deletions and replacements
+	Destructive []ResourceChange `json:"destructive"`
+	Outputs     []OutputChange   `json:"outputs,omitempty"`
+	// Drift is the number of resources changed outside of Terraform
+	Drift int `json:"drift,omitempty"`
+	// Errored is set for plans that failed part way
+	Errored bool `json:"errored,omitempty"`
+	// Safe is false when the safety checker flags the plan, with the reason in SafetyWarning
+	Safe          bool   `json:"safe"`
+	SafetyWarning string `json:"safety_warning,omitempty"`
+}
+
+// Run is a summary of a Terraform Cloud run
+type Run struct {
+	ID          string    `json:"id"`
+	Status      string    `json:"status"`
+	Message     string    `json:"message,omitempty"`
+	Source      string    `json:"source,omitempty"`
+	HasChanges  bool      `json:"has_changes"`
+	IsDestroy   bool      `json:"is_destroy"`
+	CreatedAt   time.Time `json:"created_at"`
+	PlanID      string    `json:"plan_id,omitempty"`
+	WorkspaceID string    `json:"workspace_id,omitempty"`
+}
+
+// RunPlan is a Terraform Cloud run with the summary of its plan
+type RunPlan struct {
+	Run  Run         `json:"run"`
+	Plan PlanSummary `json:"plan"`
+}
@@ -0,0 +1,42 @@
+package terraform
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// WebhookSignatureHeader is the header carrying the signature of run
+// notifications
+const WebhookSignatureHeader = "X-TFE-Notification-Signature"
+
+// VerifyWebhookSignature checks the X-TFE-Notification-Signature header
+// against the HMAC-SHA512 of the payload, keyed with the token of the
+// notification configuration. If no secret is configured every payload is
+// rejected.
+func (a *TerraformAdapter) VerifyWebhookSignature(payload []byte, signatureHeader string) bool {
+	if a.config.WebhookSecret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha512.New, []byte(a.config.WebhookSecret))
+	mac.Write(payload)
+	expected := []byte(hex.EncodeToString(mac.Sum(nil)))
+
+	return hmac.Equal([]byte(strings.ToLower(strings.TrimSpace(signatureHeader))), expected)
+}
+
+// ReceiveWebhook implements core.WebhookReceiver by checking the signature
+// of run notifications
+func (a *TerraformAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifyWebhookSignature(body, header.Get(WebhookSignatureHeader)) {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid webhook signature"), nil)
+	}
+	return body, nil
+}
@@ -0,0 +1,148 @@
+package xray
+
+import (
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+)
+
+// minSeverityParam is the schema of the minimum severity filter. Xray and
+// normalized severity names are both accepted.
+var minSeverityParam = map[string]interface{}{
+	"type":        "string",
+	"description": "Lowest severity to return: critical, high, medium, low or info",
+}
+
+// stringOrList is the schema of params taking a string or a list of strings
+func stringOrList(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        []interface{}{"string", "array"},
+		"description": description,
+		"items":       map[string]interface{}{"type": "string"},
+	}
+}
+
+// artifactParams are the params identifying artifacts by path or checksum.
+// At least one path or checksum is required.
+func artifactParams() map[string]interface{} {
+	return map[string]interface{}{
+		"path":      stringOrList("Artifact path, such as default/libs-release-local/app/app-1.0.jar"),
+		"paths":     stringOrList("Artifact paths"),
+		"checksums": stringOrList("SHA-256 checksums of artifacts"),
+	}
+}
+
+// findingsSchema is the schema of the findings results
+var findingsSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"findings": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"id":       map[string]interface{}{"type": "string"},
+					"type":     map[string]interface{}{"type": "string"},
+					"severity": map[string]interface{}{"type": "string"},
+					"title":    map[string]interface{}{"type": "string"},
+					"cves":     map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+				},
+			},
+		},
+		"total":       map[string]interface{}{"type": "integer"},
+		"by_severity": map[string]interface{}{"type": "object"},
+	},
+}
+
+// Actions returns the descriptors of the supported Xray actions
+func (a *XrayAdapter) Actions() []core.ActionDescriptor {
+	vulnerabilityParams := artifactParams()
+	vulnerabilityParams["cve"] = stringOrList("CVE identifiers to return")
+	vulnerabilityParams["severity"] = stringOrList("Severities to return")
+	vulnerabilityParams["min_severity"] = minSeverityParam
+
+	return []core.ActionDescriptor{
+		{
+			Name:        "scan_artifact",
+			Description: "Requests an on-demand Xray scan of a component",
+			Params: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"component_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Component identifier, such as gav://org.example:app:1.0",
+						"minLength":   1,
+					},
+				},
+				"required":             []string{"component_id"},
+				"additionalProperties": false,
+			},
+			Result: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"component_id": map[string]interface{}{"type": "string"},
+					"status":       map[string]interface{}{"type": "string"},
+					"info":         map[string]interface{}{"type": "string"},
+				},
+			},
+			Safety: core.SafetyWrite,
+			Scopes: []string{"xray:scan"},
+		},
+		{
+			Name:        "get_vulnerabilities",
+			Description: "Returns the security issues affecting artifacts",
+			Params: map[string]interface{}{
+				"type":                 "object",
+				"properties":           vulnerabilityParams,
+				"additionalProperties": false,
+			},
+			Result: findingsSchema,
+			Safety: core.SafetyRead,
+			Scopes: []string{"xray:read"},
+		},
+		{
+			Name:        "get_licenses",
+			Description: "Returns the licenses detected in artifacts",
+			Params: map[string]interface{}{
+				"type":                 "object",
+				"properties":           artifactParams(),
+				"additionalProperties": false,
+			},
+			Result: findingsSchema,
+			Safety: core.SafetyRead,
+			Scopes: []string{"xray:read"},
+		},
+		{
+			Name:        "get_violations",
+			Description: "Returns the policy violations raised by Xray watches",
+			Params: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"violation_type": map[string]interface{}{
+						"type":        "string",
+						"description": "Violation type: Security, License or Operational_Risk",
+					},
+					"watch_name": map[string]interface{}{
+						"type":        "string",
+						"description": "Watch that raised the violations",
+					},
+					"min_severity": minSeverityParam,
+					"severity":     stringOrList("Severities to return"),
+					"artifacts":    stringOrList("Artifact paths the violations impact"),
+					"limit": map[string]interface{}{
+						"type":        "integer",
+						"description": "Maximum number of violations",
+						"minimum":     1,
+					},
+					"offset": map[string]interface{}{
+						"type":        "integer",
+						"description": "Page of violations, starting at 1",
+						"minimum":     1,
+					},
+				},
+				"additionalProperties": false,
+			},
+			Result: findingsSchema,
+			Safety: core.SafetyRead,
+			Scopes: []string{"xray:read"},
+		},
+	}
+}
@@ -0,0 +1,715 @@
+package xray
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/models"
+)
+
+// adapterType is the unique identifier for the Xray adapter
+const adapterType = "xray"
+
+// Finding types
+const (
+	FindingTypeVulnerability = "vulnerability"
+	FindingTypeLicense       = "license"
+	FindingTypeViolation     = "violation"
+)
+
+// Normalized severities, ordered from most to least severe
+const (
+	SeverityCritical = "critical"
+	SeverityHigh     = "high"
+	SeverityMedium   = "medium"
+	SeverityLow      = "low"
+	SeverityInfo     = "info"
+	SeverityUnknown  = "unknown"
+)
+
+// severityRank orders normalized severities for minimum severity filtering
+var severityRank = map[string]int{
+	SeverityCritical: 5,
+	SeverityHigh:     4,
+	SeverityMedium:   3,
+	SeverityLow:      2,
+	SeverityInfo:     1,
+	SeverityUnknown:  0,
+}
+
+// Finding is the normalized shape of a vulnerability, license or policy violation reported by Xray
+type Finding struct {
+	ID            string   `json:"id"`
+	Source        string   `json:"source"`
+	Type          string   `json:"type"`
+	Severity      string   `json:"severity"`
+	Title         string   `json:"title"`
+	Description   string   `json:"description,omitempty"`
+	CVEs          []string `json:"cves,omitempty"`
+	CVSSScore     float64  `json:"cvss_score,omitempty"`
+	Components    []string `json:"components,omitempty"`
+	FixedVersions []string `json:"fixed_versions,omitempty"`
+	ImpactPath    []string `json:"impact_path,omitempty"`
+	Artifacts     []string `json:"artifacts,omitempty"`
+	License       string   `json:"license,omitempty"`
+	Policy        string   `json:"policy,omitempty"`
+	Watch         string   `json:"watch,omitempty"`
+	URL           string   `json:"url,omitempty"`
+	CreatedAt     string   `json:"created_at,omitempty"`
+}
+
+// FindingsResult is the result of a query returning findings
+type FindingsResult struct {
+	Findings   []Finding      `json:"findings"`
+	Total      int            `json:"total"`
+	BySeverity map[string]int `json:"by_severity"`
+}
+
+// summaryRequest is the request body of the artifact summary API
+type summaryRequest struct {
+	Paths     []string `json:"paths,omitempty"`
+	Checksums []string `json:"checksums,omitempty"`
+}
+
+// summaryResponse is the response body of the artifact summary API
+type summaryResponse struct {
+	Artifacts []summaryArtifact `json:"artifacts"`
+	Errors    []struct {
+		Identifier string `json:"identifier"`
+		Error      string `json:"error"`
+	} `json:"errors"`
+}
+
+type summaryArtifact struct {
+	General struct {
+		Name        string `json:"name"`
+		Path        string `json:"path"`
+		PkgType     string `json:"pkg_type"`
+		SHA256      string `json:"sha256"`
+		ComponentID string `json:"component_id"`
+	} `json:"general"`
+	Issues   []summaryIssue   `json:"issues"`
+	Licenses []summaryLicense `json:"licenses"`
+}
+
+type summaryIssue struct {
+	IssueID     string `json:"issue_id"`
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+	IssueType   string `json:"issue_type"`
+	Severity    string `json:"severity"`
+	Provider    string `json:"provider"`
+	Created     string `json:"created"`
+	CVEs        []struct {
+		CVE    string `json:"cve"`
+		CVSSV2 string `json:"cvss_v2"`
+		CVSSV3 string `json:"cvss_v3"`
+	} `json:"cves"`
+	ImpactPath []string `json:"impact_path"`
+	Components []struct {
+		ComponentID   string   `json:"component_id"`
+		FixedVersions []string `json:"fixed_versions"`
+	} `json:"components"`
+}
+
+type summaryLicense struct {
+	Name        string   `json:"name"`
+	FullName    string   `json:"full_name"`
+	MoreInfoURL string   `json:"more_info_url"`
+	Components  []string `json:"components"`
+}
+
+// violationsResponse is the response body of the violations API
+type violationsResponse struct {
+	TotalViolations int `json:"total_violations"`
+	Violations      []struct {
+		IssueID             string   `json:"issue_id"`
+		Description         string   `json:"description"`
+		Severity            string   `json:"severity"`
+		Type                string   `json:"type"`
+		Created             string   `json:"created"`
+		WatchName           string   `json:"watch_name"`
+		ViolationDetailsURL string   `json:"violation_details_url"`
+		InfectedComponents  []string `json:"infected_components"`
+		ImpactedArtifacts   []string `json:"impacted_artifacts"`
+		MatchedPolicies     []struct {
+			Policy string `json:"policy"`
+			Rule   string `json:"rule"`
+		} `json:"matched_policies"`
+	} `json:"violations"`
+}
+
+// XrayAdapter provides an adapter for JFrog Xray security and license scanning
+type XrayAdapter struct {
+	config        *Config
+	baseURL       string
+	client        *http.Client
+	metricsClient *observability.MetricsClient
+	logger        *observability.Logger
+	eventBus      *events.EventBus
+}
+
+// New creates a new Xray adapter
+func New(config *Config, logger *observability.Logger, metricsClient *observability.MetricsClient, eventBus *events.EventBus) (*XrayAdapter, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if logger == nil {
+		logger = observability.NewLogger("xray_adapter")
+	}
+
+	baseURL := config.BaseURL
+	if config.MockResponses {
+		baseURL = config.MockURL
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("xray base URL is required")
+	}
+
+	return &XrayAdapter{
+		config:        config,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		client:        &http.Client{Timeout: config.RequestTimeout},
+		metricsClient: metricsClient,
+		logger:        logger,
+		eventBus:      eventBus,
+	}, nil
+}
+
+// Type returns the adapter type
+func (a *XrayAdapter) Type() string {
+	return adapterType
+}
+
+// Version returns the adapter version
+func (a *XrayAdapter) Version() string {
+	return "1.0.0"
+}
+
+// Health returns the adapter health status
+func (a *XrayAdapter) Health() string {
+	if a.config.MockResponses {
+		return "healthy (mock)"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := a.doRequest(ctx, "health", http.MethodGet, "/api/v1/system/ping", nil, nil); err != nil {
+		return fmt.Sprintf("unhealthy: %v", err)
+	}
+
+	return "healthy"
+}
+
+// ExecuteAction executes an Xray action
+func (a *XrayAdapter) ExecuteAction(ctx context.Context, contextID string, action string, params map[string]interface{}) (interface{}, error) {
+	a.logger.Info("Executing Xray action", map[string]interface{}{
+		"action":    action,
+		"contextID": contextID,
+	})
+
+	startTime := time.Now()
+
+	var result interface{}
+	var err error
+
+	switch action {
+	case "scan_artifact":
+		result, err = a.scanArtifact(ctx, params)
+	case "get_vulnerabilities":
+		result, err = a.getVulnerabilities(ctx, params)
+	case "get_licenses":
+		result, err = a.getLicenses(ctx, params)
+	case "get_violations":
+		result, err = a.getViolations(ctx, params)
+	default:
+		return nil, adapterErrors.NewUnsupportedOperationError(adapterType, action,
+			fmt.Errorf("unsupported Xray action: %s", action), nil)
+	}
+
+	if a.metricsClient != nil {
+		a.metricsClient.RecordOperation(adapterType, action, err == nil, time.Since(startTime).Seconds(), nil)
+	}
+
+	a.emitOperationEvent(ctx, contextID, action, result, err)
+
+	return result, err
+}
+
+// scanArtifact requests an on-demand scan of a component
+func (a *XrayAdapter) scanArtifact(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	componentID, err := core.RequiredStringParam(params, "component_id")
+	if err != nil {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, "scan_artifact", err, nil)
+	}
+
+	var response struct {
+		Info string `json:"info"`
+	}
+	body := map[string]string{"componentID": componentID}
+	if err := a.doRequest(ctx, "scan_artifact", http.MethodPost, "/api/v1/scanArtifact", body, &response); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"component_id": componentID,
+		"status":       "scan_requested",
+		"info":         response.Info,
+	}, nil
+}
+
+// getVulnerabilities returns the security issues affecting one or more artifacts
+func (a *XrayAdapter) getVulnerabilities(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	summary, err := a.getArtifactSummary(ctx, "get_vulnerabilities", params)
+	if err != nil {
+		return nil, err
+	}
+
+	cveFilter := make(map[string]bool)
+	for _, cve := range core.StringSliceParam(params, "cve") {
+		cveFilter[strings.ToUpper(cve)] = true
+	}
+
+	findings := []Finding{}
+	for _, artifact := range summary.Artifacts {
+		for _, issue := range artifact.Issues {
+			if issue.IssueType != "" && !strings.EqualFold(issue.IssueType, "security") {
+				continue
+			}
+
+			finding := vulnerabilityFinding(issue, artifact.General.Path)
+			if len(cveFilter) > 0 && !matchesCVE(finding, cveFilter) {
+				continue
+			}
+			findings = append(findings, finding)
+		}
+	}
+
+	return newFindingsResult(filterBySeverity(findings, params)), nil
+}
+
+// getLicenses returns the licenses detected in one or more artifacts
+func (a *XrayAdapter) getLicenses(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	summary, err := a.getArtifactSummary(ctx, "get_licenses", params)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := []Finding{}
+	for _, artifact := range summary.Artifacts {
+		for _, license := range artifact.Licenses {
+			findings = append(findings, Finding{
+				ID:         license.Name,
+				Source:     adapterType,
+				Type:       FindingTypeLicense,
+				Severity:   SeverityInfo,
+				Title:      license.FullName,
+				License:    license.Name,
+				Components: license.Components,
+				Artifacts:  []string{artifact.General.Path},
+				URL:        license.MoreInfoURL,
+			})
+		}
+	}
+
+	return newFindingsResult(findings), nil
+}
+
+// getViolations returns the policy violations raised by Xray watches
+func (a *XrayAdapter) getViolations(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	filters := map[string]interface{}{}
+	if violationType := core.StringParam(params, "violation_type"); violationType != "" {
+		filters["violation_type"] = violationType
+	}
+	if watchName := core.StringParam(params, "watch_name"); watchName != "" {
+		filters["watch_name"] = watchName
+	}
+	if minSeverity := core.StringParam(params, "min_severity"); minSeverity != "" {
+		filters["min_severity"] = xraySeverityName(minSeverity)
+	}
+	if artifacts := core.StringSliceParam(params, "artifacts"); len(artifacts) > 0 {
+		resources := make([]map[string]string, 0, len(artifacts))
+		for _, artifact := range artifacts {
+			repo, path := splitArtifactPath(artifact)
+			resources = append(resources, map[string]string{"repo": repo, "path": path})
+		}
+		filters["resources"] = map[string]interface{}{"artifacts": resources}
+	}
+
+	body := map[string]interface{}{
+		"filters": filters,
+		"pagination": map[string]interface{}{
+			"order_by":  "created",
+			"direction": "desc",
+			"limit":     core.IntParam(params, "limit", a.config.DefaultViolationLimit),
+			"offset":    core.IntParam(params, "offset", 1),
+		},
+	}
+
+	var response violationsResponse
+	if err := a.doRequest(ctx, "get_violations", http.MethodPost, "/api/v1/violations", body, &response); err != nil {
+		return nil, err
+	}
+
+	findings := make([]Finding, 0, len(response.Violations))
+	for _, violation := range response.Violations {
+		policy := ""
+		if len(violation.MatchedPolicies) > 0 {
+			policy = violation.MatchedPolicies[0].Policy
+		}
+
+		findings = append(findings, Finding{
+			ID:          violation.IssueID,
+			Source:      adapterType,
+			Type:        FindingTypeViolation,
+			Severity:    NormalizeSeverity(violation.Severity),
+			Title:       fmt.Sprintf("%s violation", violation.Type),
+			Description: violation.Description,
+			Components:  violation.InfectedComponents,
+			Artifacts:   violation.ImpactedArtifacts,
+			Policy:      policy,
+			Watch:       violation.WatchName,
+			URL:         violation.ViolationDetailsURL,
+			CreatedAt:   violation.Created,
+		})
+	}
+
+	result := newFindingsResult(filterBySeverity(findings, params))
+	if response.TotalViolations > result.Total {
+		result.Total = response.TotalViolations
+	}
+
+	return result, nil
+}
+
+// getArtifactSummary fetches the Xray summary for the artifacts identified in params
+func (a *XrayAdapter) getArtifactSummary(ctx context.Context, operation string, params map[string]interface{}) (*summaryResponse, error) {
+	request := summaryRequest{
+		Paths:     append(core.StringSliceParam(params, "path"), core.StringSliceParam(params, "paths")...),
+		Checksums: core.StringSliceParam(params, "checksums"),
+	}
+	if len(request.Paths) == 0 && len(request.Checksums) == 0 {
+		return nil, adapterErrors.NewInvalidParameterError(adapterType, operation,
+			fmt.Errorf("either path or checksums is required"), nil)
+	}
+
+	var response summaryResponse
+	if err := a.doRequest(ctx, operation, http.MethodPost, "/api/v1/summary/artifact", request, &response); err != nil {
+		return nil, err
+	}
+
+	for _, summaryErr := range response.Errors {
+		a.logger.Warn("Xray summary returned an error for artifact", map[string]interface{}{
+			"identifier": summaryErr.Identifier,
+			"error":      summaryErr.Error,
+		})
+	}
+
+	return &response, nil
+}
+
+// HandleWebhook handles an Xray webhook
+func (a *XrayAdapter) HandleWebhook(ctx context.Context, eventType string, payload []byte) error {
+	var event models.XrayWebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, "handle_webhook",
+			fmt.Errorf("failed to parse Xray webhook payload: %w", err), nil)
+	}
+
+	if eventType == "" {
+		eventType = event.EventType
+	}
+
+	findings := make([]Finding, 0, len(event.Data.Issues))
+	for _, issue := range event.Data.Issues {
+		findings = append(findings, WebhookIssueFinding(issue, event.Data))
+	}
+
+	a.logger.Info("Received Xray webhook", map[string]interface{}{
+		"eventType":  eventType,
+		"watchName":  event.Data.WatchName,
+		"policyName": event.Data.PolicyName,
+		"issues":     len(findings),
+	})
+
+	if a.eventBus != nil {
+		adapterEvent := events.NewAdapterEvent(adapterType, events.EventTypeWebhookReceived, newFindingsResult(findings)).
+			WithMetadata("eventType", eventType).
+			WithMetadata("watchName", event.Data.WatchName).
+			WithMetadata("policyName", event.Data.PolicyName)
+		return a.eventBus.Emit(ctx, adapterEvent)
+	}
+
+	return nil
+}
+
+// Close closes the adapter
+func (a *XrayAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// doRequest performs a request against the Xray REST API and decodes the JSON response into out
+func (a *XrayAdapter) doRequest(ctx context.Context, operation, method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, a.baseURL+path, reader)
+	if err != nil {
+		return adapterErrors.NewInvalidRequestError(adapterType, operation, err, nil)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if a.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.config.Token)
+	} else if a.config.Username != "" {
+		req.SetBasicAuth(a.config.Username, a.config.Password)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return adapterErrors.NewTimeoutError(adapterType, operation, err, nil)
+		}
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return adapterErrors.NewConnectionFailedError(adapterType, operation, err, nil)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return adapterErrors.FromHTTPStatus(adapterType, operation, resp.StatusCode,
+			fmt.Errorf("xray API returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody))), nil)
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return adapterErrors.NewUnknownError(adapterType, operation,
+			fmt.Errorf("failed to decode Xray response: %w", err), nil)
+	}
+
+	return nil
+}
+
+// emitOperationEvent emits an operation success or failure event
+func (a *XrayAdapter) emitOperationEvent(ctx context.Context, contextID, action string, result interface{}, err error) {
+	if a.eventBus == nil {
+		return
+	}
+
+	var event *events.AdapterEvent
+	if err != nil {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationFailure, nil).
+			WithMetadata("error", err.Error())
+	} else {
+		event = events.NewAdapterEvent(adapterType, events.EventTypeOperationSuccess, result)
+	}
+	event.WithMetadata("operation", action).WithMetadata("contextId", contextID)
+
+	a.eventBus.Emit(ctx, event)
+}
+
+// NormalizeSeverity converts an Xray severity name into a normalized lowercase severity
+func NormalizeSeverity(severity string) string {
+	switch strings.ToLower(strings.TrimSpace(severity)) {
+	case "critical":
+		return SeverityCritical
+	case "high":
+		return SeverityHigh
+	case "medium":
+		return SeverityMedium
+	case "low":
+		return SeverityLow
+	case "information", "info":
+		return SeverityInfo
+	default:
+		return SeverityUnknown
+	}
+}
+
+// WebhookIssueFinding converts an issue from a violation webhook into a finding
+func WebhookIssueFinding(issue models.XrayIssue, data models.XrayWebhookData) Finding {
+	findingType := FindingTypeViolation
+	if strings.EqualFold(issue.Type, "security") {
+		findingType = FindingTypeVulnerability
+	} else if strings.EqualFold(issue.Type, "license") {
+		findingType = FindingTypeLicense
+	}
+
+	finding := Finding{
+		ID:       issue.ID,
+		Source:   adapterType,
+		Type:     findingType,
+		Severity: NormalizeSeverity(issue.Severity),
+		Title:    issue.Summary,
+		Policy:   data.PolicyName,
+		Watch:    data.WatchName,
+	}
+	if strings.HasPrefix(strings.ToUpper(issue.ID), "CVE-") {
+		finding.CVEs = []string{strings.ToUpper(issue.ID)}
+	}
+	if issue.Component.Name != "" {
+		component := issue.Component.Name
+		if issue.Component.Version != "" {
+			component += ":" + issue.Component.Version
+		}
+		finding.Components = []string{component}
+	}
+	if issue.Component.Path != "" {
+		finding.Artifacts = []string{issue.Component.Path}
+	}
+
+	return finding
+}
+
+// vulnerabilityFinding converts a summary security issue into a finding
+func vulnerabilityFinding(issue summaryIssue, artifactPath string) Finding {
+	finding := Finding{
+		ID:          issue.IssueID,
+		Source:      adapterType,
+		Type:        FindingTypeVulnerability,
+		Severity:    NormalizeSeverity(issue.Severity),
+		Title:       issue.Summary,
+		Description: issue.Description,
+		ImpactPath:  issue.ImpactPath,
+		CreatedAt:   issue.Created,
+	}
+	if artifactPath != "" {
+		finding.Artifacts = []string{artifactPath}
+	}
+
+	for _, cve := range issue.CVEs {
+		if cve.CVE != "" {
+			finding.CVEs = append(finding.CVEs, strings.ToUpper(cve.CVE))
+		}
+		for _, score := range []float64{parseCVSSScore(cve.CVSSV3), parseCVSSScore(cve.CVSSV2)} {
+			if score > finding.CVSSScore {
+				finding.CVSSScore = score
+			}
+		}
+	}
+
+	for _, component := range issue.Components {
+		finding.Components = append(finding.Components, component.ComponentID)
+		finding.FixedVersions = append(finding.FixedVersions, component.FixedVersions...)
+	}
+
+	return finding
+}
+
+// matchesCVE returns true if the finding references any CVE in the filter
+func matchesCVE(finding Finding, cveFilter map[string]bool) bool {
+	if cveFilter[strings.ToUpper(finding.ID)] {
+		return true
+	}
+	for _, cve := range finding.CVEs {
+		if cveFilter[cve] {
+			return true
+		}
+	}
+	return false
+}
+
+// filterBySeverity applies the severity and min_severity parameters to a list of findings
+func filterBySeverity(findings []Finding, params map[string]interface{}) []Finding {
+	allowed := make(map[string]bool)
+	for _, severity := range core.StringSliceParam(params, "severity") {
+		allowed[NormalizeSeverity(severity)] = true
+	}
+
+	minRank := -1
+	if minSeverity := core.StringParam(params, "min_severity"); minSeverity != "" {
+		minRank = severityRank[NormalizeSeverity(minSeverity)]
+	}
+
+	if len(allowed) == 0 && minRank < 0 {
+		return findings
+	}
+
+	filtered := make([]Finding, 0, len(findings))
+	for _, finding := range findings {
+		if len(allowed) > 0 && !allowed[finding.Severity] {
+			continue
+		}
+		if severityRank[finding.Severity] < minRank {
+			continue
+		}
+		filtered = append(filtered, finding)
+	}
+
+	return filtered
+}
+
+// newFindingsResult builds a findings result with per-severity counts
+func newFindingsResult(findings []Finding) *FindingsResult {
+	bySeverity := make(map[string]int)
+	for _, finding := range findings {
+		bySeverity[finding.Severity]++
+	}
+
+	return &FindingsResult{
+		Findings:   findings,
+		Total:      len(findings),
+		BySeverity: bySeverity,
+	}
+}
+
+// parseCVSSScore extracts the base score from an Xray CVSS string such as "9.8/CVSS:3.1/AV:N/..."
+func parseCVSSScore(cvss string) float64 {
+	if cvss == "" {
+		return 0
+	}
+	score, err := strconv.ParseFloat(strings.SplitN(cvss, "/", 2)[0], 64)
+	if err != nil {
+		return 0
+	}
+	return score
+}
+
+// xraySeverityName converts a severity into the capitalized form expected by the Xray API
+func xraySeverityName(severity string) string {
+	normalized := NormalizeSeverity(severity)
+	if normalized == SeverityInfo {
+		return "Information"
+	}
+	if normalized == SeverityUnknown {
+		return severity
+	}
+	return strings.ToUpper(normalized[:1]) + normalized[1:]
+}
+
+// splitArtifactPath splits "repo/path/to/artifact" into its repository and path
+func splitArtifactPath(artifact string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(artifact, "/"), "/", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
@@ -0,0 +1,335 @@
+package xray
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+)
+
+// eventRecorder records adapter events emitted during a test
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []*events.AdapterEvent
+}
+
+func (r *eventRecorder) Handle(ctx context.Context, event *events.AdapterEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+const summaryFixture = `{
+	"artifacts": [{
+		"general": {"path": "default/libs-release-local/app/1.0/app-1.0.jar"},
+		"issues": [
+			{
+				"issue_id": "XRAY-1",
+				"summary": "Remote code execution in log4j",
+				"issue_type": "security",
+				"severity": "Critical",
+				"cves": [{"cve": "CVE-2021-44228", "cvss_v3": "10.0/CVSS:3.1/AV:N/AC:L"}],
+				"components": [{"component_id": "gav://org.apache.logging.log4j:log4j-core:2.14.1", "fixed_versions": ["2.17.1"]}]
+			},
+			{
+				"issue_id": "XRAY-2",
+				"summary": "Denial of service in jackson",
+				"issue_type": "security",
+				"severity": "Medium",
+				"cves": [{"cve": "CVE-2020-36518", "cvss_v2": "5.0/AV:N"}]
+			},
+			{
+				"issue_id": "XRAY-3",
+				"summary": "Operational risk",
+				"issue_type": "operational_risk",
+				"severity": "Low"
+			}
+		],
+		"licenses": [
+			{"name": "Apache-2.0", "full_name": "The Apache Software License, Version 2.0", "components": ["gav://org.apache.commons:commons-lang3:3.12.0"]}
+		]
+	}]
+}`
+
+func newTestAdapter(t *testing.T, handler http.HandlerFunc) (*XrayAdapter, *eventRecorder) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.BaseURL = server.URL
+	config.Token = "test-token"
+
+	logger := observability.NewLogger("xray_test")
+	eventBus := events.NewEventBus(logger)
+	recorder := &eventRecorder{}
+	eventBus.SubscribeAll(recorder)
+
+	adapter, err := New(config, logger, observability.NewMetricsClient(), eventBus)
+	require.NoError(t, err)
+
+	return adapter, recorder
+}
+
+func TestGetVulnerabilities(t *testing.T) {
+	adapter, _ := newTestAdapter(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/summary/artifact", r.URL.Path)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+		var body summaryRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"default/libs-release-local/app/1.0/app-1.0.jar"}, body.Paths)
+
+		w.Write([]byte(summaryFixture))
+	})
+
+	tests := []struct {
+		name     string
+		params   map[string]interface{}
+		expected []string
+	}{
+		{"all security issues", map[string]interface{}{}, []string{"XRAY-1", "XRAY-2"}},
+		{"cve filter", map[string]interface{}{"cve": "cve-2020-36518"}, []string{"XRAY-2"}},
+		{"severity filter", map[string]interface{}{"severity": []interface{}{"critical"}}, []string{"XRAY-1"}},
+		{"min severity filter", map[string]interface{}{"min_severity": "high"}, []string{"XRAY-1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.params["path"] = "default/libs-release-local/app/1.0/app-1.0.jar"
+
+			result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_vulnerabilities", test.params)
+			require.NoError(t, err)
+
+			findings := result.(*FindingsResult)
+			ids := make([]string, 0, len(findings.Findings))
+			for _, finding := range findings.Findings {
+				ids = append(ids, finding.ID)
+				assert.Equal(t, FindingTypeVulnerability, finding.Type)
+			}
+			assert.Equal(t, test.expected, ids)
+			assert.Equal(t, len(test.expected), findings.Total)
+		})
+	}
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_vulnerabilities", map[string]interface{}{
+		"path": "default/libs-release-local/app/1.0/app-1.0.jar",
+		"cve":  "CVE-2021-44228",
+	})
+	require.NoError(t, err)
+
+	finding := result.(*FindingsResult).Findings[0]
+	assert.Equal(t, SeverityCritical, finding.Severity)
+	assert.Equal(t, []string{"CVE-2021-44228"}, finding.CVEs)
+	assert.Equal(t, 10.0, finding.CVSSScore)
+	assert.Equal(t, []string{"2.17.1"}, finding.FixedVersions)
+}
+
+func TestGetLicenses(t *testing.T) {
+	adapter, _ := newTestAdapter(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(summaryFixture))
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_licenses", map[string]interface{}{
+		"checksums": []interface{}{"abc123"},
+	})
+	require.NoError(t, err)
+
+	findings := result.(*FindingsResult)
+	require.Len(t, findings.Findings, 1)
+	assert.Equal(t, FindingTypeLicense, findings.Findings[0].Type)
+	assert.Equal(t, "Apache-2.0", findings.Findings[0].License)
+}
+
+func TestGetViolations(t *testing.T) {
+	adapter, _ := newTestAdapter(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/violations", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		filters := body["filters"].(map[string]interface{})
+		assert.Equal(t, "prod-watch", filters["watch_name"])
+		assert.Equal(t, "High", filters["min_severity"])
+
+		w.Write([]byte(`{
+			"total_violations": 1,
+			"violations": [{
+				"issue_id": "XRAY-1",
+				"description": "log4j RCE",
+				"severity": "Critical",
+				"type": "Security",
+				"watch_name": "prod-watch",
+				"matched_policies": [{"policy": "block-critical", "rule": "critical"}],
+				"impacted_artifacts": ["default/libs-release-local/app/1.0/app-1.0.jar"]
+			}]
+		}`))
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_violations", map[string]interface{}{
+		"watch_name":   "prod-watch",
+		"min_severity": "high",
+	})
+	require.NoError(t, err)
+
+	findings := result.(*FindingsResult)
+	require.Len(t, findings.Findings, 1)
+	assert.Equal(t, FindingTypeViolation, findings.Findings[0].Type)
+	assert.Equal(t, "block-critical", findings.Findings[0].Policy)
+	assert.Equal(t, 1, findings.BySeverity[SeverityCritical])
+}
+
+func TestScanArtifact(t *testing.T) {
+	adapter, recorder := newTestAdapter(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/scanArtifact", r.URL.Path)
+		w.Write([]byte(`{"info": "Scan of artifact is in progress"}`))
+	})
+
+	result, err := adapter.ExecuteAction(context.Background(), "ctx-1", "scan_artifact", map[string]interface{}{
+		"component_id": "docker://app:1.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "scan_requested", result.(map[string]interface{})["status"])
+
+	require.Len(t, recorder.events, 1)
+	assert.Equal(t, events.EventTypeOperationSuccess, recorder.events[0].EventType)
+	assert.Equal(t, "ctx-1", recorder.events[0].Metadata["contextId"])
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "scan_artifact", map[string]interface{}{})
+	assert.True(t, adapterErrors.IsValidationError(err))
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	adapter, _ := newTestAdapter(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := adapter.ExecuteAction(context.Background(), "ctx-1", "get_licenses", map[string]interface{}{"path": "repo/a.jar"})
+	assert.True(t, adapterErrors.IsAuthorizationError(err))
+
+	_, err = adapter.ExecuteAction(context.Background(), "ctx-1", "delete_everything", nil)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation))
+}
+
+func TestActions(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+
+	actions, ok := core.DescribeActions(adapter)
+	require.True(t, ok)
+	require.Len(t, actions, 4)
+
+	// Every action the adapter dispatches is described
+	for _, action := range actions {
+		_, err := adapter.ExecuteAction(context.Background(), "ctx-1", action.Name, nil)
+		assert.False(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation), action.Name)
+	}
+
+	vulnerabilities, _ := core.FindAction(actions, "get_vulnerabilities")
+	assert.Equal(t, core.SafetyRead, vulnerabilities.Safety)
+	assert.NoError(t, vulnerabilities.ValidateParams(map[string]interface{}{"path": "repo/a.jar", "min_severity": "High"}))
+	assert.NoError(t, vulnerabilities.ValidateParams(map[string]interface{}{"paths": []interface{}{"repo/a.jar"}, "cve": "CVE-2021-44228"}))
+	assert.Error(t, vulnerabilities.ValidateParams(map[string]interface{}{"path": "repo/a.jar", "watch_name": "prod"}))
+
+	scan, _ := core.FindAction(actions, "scan_artifact")
+	assert.Equal(t, core.SafetyWrite, scan.Safety)
+	assert.Error(t, scan.ValidateParams(map[string]interface{}{}))
+}
+
+func TestHandleWebhook(t *testing.T) {
+	adapter, recorder := newTestAdapter(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	payload := []byte(`{
+		"event_type": "violation",
+		"data": {
+			"watch_name": "prod-watch",
+			"policy_name": "block-critical",
+			"issues": [{
+				"id": "CVE-2021-44228",
+				"type": "security",
+				"severity": "Critical",
+				"summary": "log4j RCE",
+				"component": {"name": "log4j-core", "version": "2.14.1", "path": "libs-release-local/app.jar"}
+			}]
+		}
+	}`)
+
+	require.NoError(t, adapter.HandleWebhook(context.Background(), "", payload))
+	require.Len(t, recorder.events, 1)
+
+	event := recorder.events[0]
+	assert.Equal(t, events.EventTypeWebhookReceived, event.EventType)
+	assert.Equal(t, "violation", event.Metadata["eventType"])
+
+	findings := event.Payload.(*FindingsResult)
+	require.Len(t, findings.Findings, 1)
+	assert.Equal(t, FindingTypeVulnerability, findings.Findings[0].Type)
+	assert.Equal(t, []string{"CVE-2021-44228"}, findings.Findings[0].CVEs)
+	assert.Equal(t, []string{"log4j-core:2.14.1"}, findings.Findings[0].Components)
+	assert.Equal(t, "block-critical", findings.Findings[0].Policy)
+
+	assert.Error(t, adapter.HandleWebhook(context.Background(), "violation", []byte("not json")))
+}
+
+func TestReceiveWebhook(t *testing.T) {
+	adapter, _ := newTestAdapter(t, nil)
+	delivery, err := fixture.Load("testdata/webhooks/security-violation.json")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		configured string
+		signedWith string
+		expectCode string
+	}{
+		{"valid signature", fixture.TestSecret, fixture.TestSecret, ""},
+		{"invalid signature", fixture.TestSecret, "wrong", adapterErrors.ErrCodeUnauthorized},
+		{"unsigned delivery", fixture.TestSecret, "", adapterErrors.ErrCodeUnauthorized},
+		{"no secret configured", "", fixture.TestSecret, adapterErrors.ErrCodeUnauthorized},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adapter.config.WebhookSecret = test.configured
+			signed := delivery.Sign(test.signedWith, time.Now())
+
+			payload, err := adapter.ReceiveWebhook(signed.Header, signed.Query, signed.Payload())
+			if test.expectCode == "" {
+				require.NoError(t, err)
+				assert.Equal(t, delivery.Payload(), payload)
+				return
+			}
+			assert.True(t, adapterErrors.IsSpecificErrorCode(err, test.expectCode), "%v", err)
+		})
+	}
+
+	// Webhooks that don't sign their payloads send the secret itself
+	adapter.config.WebhookSecret = fixture.TestSecret
+	header := http.Header{}
+	header.Set(WebhookAuthHeader, fixture.TestSecret)
+	_, err = adapter.ReceiveWebhook(header, nil, delivery.Payload())
+	assert.NoError(t, err)
+}
+
+func TestWebhookFixtures(t *testing.T) {
+	fixture.Run(t, "testdata/webhooks", func(t *testing.T, eventBus *events.EventBus) core.Adapter {
+		config := DefaultConfig()
+		config.MockResponses = true
+		config.WebhookSecret = fixture.TestSecret
+		adapter, err := New(config, observability.NewLogger("xray_test"), nil, eventBus)
+		require.NoError(t, err)
+		return adapter
+	}, map[string]string{
+		"security-violation.json": "security_violation",
+	})
+}
@@ -0,0 +1,37 @@
+package xray
+
+import (
+	"time"
+)
+
+// Config holds configuration for the JFrog Xray adapter
+type Config struct {
+	// Authentication settings (access token takes precedence over basic auth)
+	Token    string `mapstructure:"token"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+
+	// Connection settings
+	BaseURL        string        `mapstructure:"base_url"`
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// Secret used to verify the X-JFrog-Event-Auth header of webhooks
+	WebhookSecret string `mapstructure:"webhook_secret"`
+
+	// Mock settings for local development
+	MockResponses bool   `mapstructure:"mock_responses"`
+	MockURL       string `mapstructure:"mock_url"`
+
+	// Query settings
+	DefaultViolationLimit int `mapstructure:"default_violation_limit"`
+}
+
+// DefaultConfig returns a default configuration for the Xray adapter
+func DefaultConfig() *Config {
+	return &Config{
+		BaseURL:               "http://localhost:8082/xray",
+		RequestTimeout:        30 * time.Second,
+		MockURL:               "http://localhost:8081/mock-xray",
+		DefaultViolationLimit: 100,
+	}
+}
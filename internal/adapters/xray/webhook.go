@@ -0,0 +1,42 @@
+package xray
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+)
+
+// WebhookAuthHeader is the header carrying the webhook secret, or the
+// signature of the payload when the webhook signs payloads with its secret
+const WebhookAuthHeader = "X-JFrog-Event-Auth"
+
+// VerifyWebhook checks the X-JFrog-Event-Auth header of a webhook. Webhooks
+// signing their payloads send the hex HMAC-SHA256 of the payload; others send
+// the secret itself. If no secret is configured every payload is rejected.
+func (a *XrayAdapter) VerifyWebhook(payload []byte, authHeader string) bool {
+	if a.config.WebhookSecret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(a.config.WebhookSecret))
+	mac.Write(payload)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	received := []byte(strings.TrimSpace(authHeader))
+	return hmac.Equal(received, []byte(signature)) || hmac.Equal(received, []byte(a.config.WebhookSecret))
+}
+
+// ReceiveWebhook implements core.WebhookReceiver by checking the
+// X-JFrog-Event-Auth header
+func (a *XrayAdapter) ReceiveWebhook(header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if !a.VerifyWebhook(body, header.Get(WebhookAuthHeader)) {
+		return nil, adapterErrors.NewUnauthorizedError(adapterType, "webhook", errors.New("invalid webhook secret"), nil)
+	}
+	return body, nil
+}
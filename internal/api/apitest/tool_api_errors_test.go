@@ -0,0 +1,192 @@
+package apitest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters"
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/api"
+	"github.com/S-Corkum/mcp-server/internal/contexts"
+	"github.com/S-Corkum/mcp-server/internal/storage/providers"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/gin-gonic/gin"
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeToolBridge describes tools and runs their actions, failing them with err
+type fakeToolBridge struct {
+	tools    map[string]adapters.ToolDescription
+	err      error
+	executed []string
+}
+
+func (b *fakeToolBridge) DescribeTools(ctx context.Context) []adapters.ToolDescription {
+	tools := make([]adapters.ToolDescription, 0, len(b.tools))
+	for _, tool := range b.tools {
+		tools = append(tools, tool)
+	}
+	return tools
+}
+
+func (b *fakeToolBridge) DescribeTool(ctx context.Context, name string) (adapters.ToolDescription, error) {
+	tool, ok := b.tools[name]
+	if !ok {
+		return adapters.ToolDescription{}, errors.New("no creator registered")
+	}
+	return tool, nil
+}
+
+func (b *fakeToolBridge) ExecuteAction(ctx context.Context, contextID string, adapterType string, action string, params map[string]interface{}) (interface{}, error) {
+	b.executed = append(b.executed, action)
+	if b.err != nil {
+		return nil, b.err
+	}
+	return map[string]interface{}{"id": 42}, nil
+}
+
+// setupToolErrorServer serves the tool API with a described github tool, an
+// undescribed custom tool and a context to record actions in
+func setupToolErrorServer(t *testing.T, err error) (*gin.Engine, *fakeToolBridge, string) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	bridge := &fakeToolBridge{
+		err: err,
+		tools: map[string]adapters.ToolDescription{
+			"github": {
+				Name:      "github",
+				Described: true,
+				Actions: []core.ActionDescriptor{
+					{Name: "getRepository", Safety: core.SafetyRead},
+					{Name: "createIssue", Safety: core.SafetyWrite},
+					{Name: "delete_repository", Safety: core.SafetyDestructive},
+				},
+			},
+			"custom": {Name: "custom"},
+		},
+	}
+
+	contextManager := contexts.NewManager(providers.NewMemoryContextStorage())
+	created, createErr := contextManager.CreateContext(context.Background(), &mcp.Context{AgentID: "agent-1", ModelID: "model-1"})
+	require.NoError(t, createErr)
+
+	router := gin.New()
+	router.Use(api.ErrorHandlerMiddleware())
+	api.NewToolAPI(bridge).WithContextManager(contextManager).RegisterRoutes(router.Group("/api/v1"))
+	return router, bridge, created.ID
+}
+
+// postJSON posts a body to a path and returns the response
+func postJSON(router *gin.Engine, path string, body interface{}) *httptest.ResponseRecorder {
+	payload, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(payload))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestExecuteToolActionErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+		err    error
+		status int
+		code   api.ErrorCode
+	}{
+		{
+			name:   "unknown action",
+			action: "getRepository",
+			err:    adapterErrors.NewUnsupportedOperationError("github", "getRepository", errors.New("unknown action"), nil),
+			status: http.StatusNotFound,
+			code:   api.ErrActionNotFound,
+		},
+		{
+			name:   "rate limit",
+			action: "getRepository",
+			err:    adapterErrors.NewRateLimitExceededError("github", "getRepository", errors.New("rate limited"), nil),
+			status: http.StatusTooManyRequests,
+			code:   api.ErrTooManyRequests,
+		},
+		{
+			name:   "open circuit",
+			action: "getRepository",
+			err:    gobreaker.ErrOpenState,
+			status: http.StatusServiceUnavailable,
+			code:   api.ErrServiceUnavailable,
+		},
+		{
+			name:   "failed action",
+			action: "getRepository",
+			err:    errors.New("boom"),
+			status: http.StatusInternalServerError,
+			code:   api.ErrActionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, _, contextID := setupToolErrorServer(t, tt.err)
+
+			w := postJSON(router, "/api/v1/tools/github/actions/"+tt.action+"?context_id="+contextID, map[string]interface{}{})
+
+			assert.Equal(t, tt.status, w.Code)
+			var response api.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.code, response.Code)
+		})
+	}
+}
+
+func TestExecuteToolActionDeniedBySafetyPolicy(t *testing.T) {
+	router, bridge, contextID := setupToolErrorServer(t, nil)
+
+	w := postJSON(router, "/api/v1/tools/github/actions/delete_repository?context_id="+contextID, map[string]interface{}{})
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	var response api.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, api.ErrForbidden, response.Code)
+	assert.Empty(t, bridge.executed, "denied actions should not run")
+}
+
+func TestQueryToolDataRunsOnlyReadActions(t *testing.T) {
+	tests := []struct {
+		name   string
+		tool   string
+		action string
+		status int
+	}{
+		{name: "read action", tool: "github", action: "getRepository", status: http.StatusOK},
+		{name: "write action", tool: "github", action: "createIssue", status: http.StatusBadRequest},
+		{name: "unknown action", tool: "github", action: "getNothing", status: http.StatusNotFound},
+		{name: "undescribed tool", tool: "custom", action: "getThing", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, bridge, contextID := setupToolErrorServer(t, nil)
+
+			w := postJSON(router, "/api/v1/tools/"+tt.tool+"/queries?context_id="+contextID, map[string]interface{}{
+				"action": tt.action,
+				"owner":  "example-user",
+			})
+
+			assert.Equal(t, tt.status, w.Code)
+			if tt.status == http.StatusOK {
+				assert.Equal(t, []string{tt.action}, bridge.executed)
+			} else {
+				assert.Empty(t, bridge.executed, "rejected queries should not run")
+			}
+		})
+	}
+}
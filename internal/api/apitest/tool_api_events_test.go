@@ -0,0 +1,85 @@
+package apitest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters"
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/api"
+	"github.com/S-Corkum/mcp-server/internal/contexts"
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/eventstore"
+	"github.com/S-Corkum/mcp-server/internal/storage/providers"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolActionsArePublished(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	bridge := &fakeToolBridge{
+		tools: map[string]adapters.ToolDescription{
+			"github": {
+				Name:      "github",
+				Described: true,
+				Actions:   []core.ActionDescriptor{{Name: "getRepository", Safety: core.SafetyRead}},
+			},
+		},
+	}
+
+	// The bus records events in the event store and appends executed
+	// actions to their context, as the engine does
+	bus := events.NewEventBus(1)
+	defer bus.Close()
+	contextManager := contexts.NewManager(providers.NewMemoryContextStorage())
+	events.NewContextEventHandler(contextManager).RegisterWithEventBus(bus)
+	store := eventstore.NewMemoryStore()
+	eventstore.NewRecorder(store, eventstore.Config{}, nil).Attach(bus, events.AllEventTypes)
+
+	created, err := contextManager.CreateContext(ctx, &mcp.Context{AgentID: "agent-1", ModelID: "model-1"})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(api.ErrorHandlerMiddleware())
+	api.NewToolAPI(bridge).WithContextManager(contextManager).WithEventBus(bus).RegisterRoutes(router.Group("/api/v1"))
+
+	path := "/api/v1/tools/github/actions/getRepository?context_id=" + created.ID
+	w := postJSON(router, path, map[string]interface{}{"owner": "example-user"})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	bridge.err = errors.New("boom")
+	w = postJSON(router, path, map[string]interface{}{"owner": "example-user"})
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+
+	var recorded []*mcp.Event
+	require.Eventually(t, func() bool {
+		page, err := store.Query(ctx, eventstore.Query{Filter: mcp.EventFilter{
+			Types: []string{string(events.EventToolActionExecuted), string(events.EventToolActionFailed)},
+		}})
+		require.NoError(t, err)
+		recorded = page.Events
+		return len(recorded) == 2
+	}, 5*time.Second, 10*time.Millisecond, "both actions should be in the event store")
+
+	executed := recorded[0].Data.(map[string]interface{})
+	assert.Equal(t, string(events.EventToolActionExecuted), recorded[0].Type)
+	assert.Equal(t, "getRepository", executed["action"])
+	assert.Equal(t, created.ID, executed["context_id"])
+	assert.Equal(t, true, executed["recorded"])
+
+	failed := recorded[1].Data.(map[string]interface{})
+	assert.Equal(t, string(events.EventToolActionFailed), recorded[1].Type)
+	assert.Equal(t, "boom", failed["error"])
+
+	// The executed action was recorded by the API and not appended again
+	stored, err := contextManager.GetContext(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Len(t, stored.Content, 1)
+}
@@ -0,0 +1,211 @@
+// Package apitest tests the API handlers through their exported surface.
+package apitest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/api"
+	engineCore "github.com/S-Corkum/mcp-server/internal/core"
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/S-Corkum/mcp-server/internal/webhooks"
+	"github.com/S-Corkum/mcp-server/internal/webhooks/fixture"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWebhookReceiver fails verification with err
+type fakeWebhookReceiver struct {
+	err error
+}
+
+func (r *fakeWebhookReceiver) ReceiveWebhook(ctx context.Context, adapterType string, header http.Header, query url.Values, body []byte) ([]byte, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return body, nil
+}
+
+// fakeWebhookInbox fails to persist deliveries with err
+type fakeWebhookInbox struct {
+	err      error
+	received int
+}
+
+func (i *fakeWebhookInbox) Receive(ctx context.Context, adapterType string, eventType string, payload []byte) (*mcp.WebhookDelivery, error) {
+	if i.err != nil {
+		return nil, i.err
+	}
+	i.received++
+	return &mcp.WebhookDelivery{ID: "delivery-1", Adapter: adapterType, EventType: eventType}, nil
+}
+
+func (i *fakeWebhookInbox) Get(ctx context.Context, id string) (*mcp.WebhookDelivery, error) {
+	return nil, webhooks.ErrDeliveryNotFound
+}
+
+func (i *fakeWebhookInbox) List(ctx context.Context, options webhooks.ListOptions) ([]*mcp.WebhookDelivery, error) {
+	return nil, nil
+}
+
+func (i *fakeWebhookInbox) Replay(ctx context.Context, id string) (*mcp.WebhookDelivery, error) {
+	return nil, webhooks.ErrDeliveryNotFound
+}
+
+func TestReceiveWebhookErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		receiveErr error
+		inboxErr   error
+		status     int
+	}{
+		{
+			name:   "accepted",
+			status: http.StatusAccepted,
+		},
+		{
+			name:       "unknown adapter",
+			receiveErr: fmt.Errorf("%w: no creator registered for adapter type: unknown", core.ErrAdapterNotFound),
+			status:     http.StatusNotFound,
+		},
+		{
+			name:       "failed verification",
+			receiveErr: adapterErrors.NewUnauthorizedError("github", "webhook", errors.New("invalid webhook signature"), nil),
+			status:     http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid payload",
+			receiveErr: adapterErrors.NewInvalidRequestError("slack", "webhook", errors.New("invalid form payload"), nil),
+			status:     http.StatusBadRequest,
+		},
+		{
+			name:       "adapter not created",
+			receiveErr: errors.New("failed to create GitHub adapter"),
+			status:     http.StatusInternalServerError,
+		},
+		{
+			name:     "payload not JSON",
+			inboxErr: webhooks.ErrInvalidPayload,
+			status:   http.StatusBadRequest,
+		},
+		{
+			name:     "storage unavailable",
+			inboxErr: errors.New("connection refused"),
+			status:   http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			inbox := &fakeWebhookInbox{err: tt.inboxErr}
+			router := gin.New()
+			router.Use(api.ErrorHandlerMiddleware())
+			api.NewWebhookAPI(&fakeWebhookReceiver{err: tt.receiveErr}, inbox).RegisterIntakeRoutes(router)
+
+			req, _ := http.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewBufferString(`{"action":"opened"}`))
+			req.Header.Set("X-GitHub-Event", "pull_request")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+			if tt.receiveErr != nil {
+				assert.Zero(t, inbox.received, "rejected deliveries should not be persisted")
+			}
+		})
+	}
+}
+
+func TestReceiveXrayWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	engine, err := engineCore.NewEngine(ctx, interfaces.CoreConfig{
+		Adapters: map[string]interface{}{
+			"xray": map[string]interface{}{"webhook_secret": "s3cret", "mock_responses": true},
+		},
+	}, nil, nil, nil)
+	require.NoError(t, err)
+	defer engine.Shutdown(ctx)
+
+	inbox := &fakeWebhookInbox{}
+	router := gin.New()
+	router.Use(api.ErrorHandlerMiddleware())
+	api.NewWebhookAPI(engine.AdapterManager(), inbox).RegisterIntakeRoutes(router)
+
+	delivery, err := fixture.Load("../../adapters/xray/testdata/webhooks/security-violation.json")
+	require.NoError(t, err)
+
+	post := func(f *fixture.Fixture) int {
+		req, err := f.NewRequest(ctx, "")
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusAccepted, post(delivery.Sign("s3cret", time.Now())))
+	assert.Equal(t, http.StatusUnauthorized, post(delivery.Sign("wrong", time.Now())))
+	assert.Equal(t, http.StatusUnauthorized, post(delivery))
+	assert.Equal(t, 1, inbox.received, "only the signed delivery should be persisted")
+}
+
+func TestReceiveSlackURLVerification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	engine, err := engineCore.NewEngine(ctx, interfaces.CoreConfig{
+		Adapters: map[string]interface{}{
+			"slack": map[string]interface{}{"signing_secret": "s3cret", "mock_responses": true},
+		},
+	}, nil, nil, nil)
+	require.NoError(t, err)
+	defer engine.Shutdown(ctx)
+
+	inbox := &fakeWebhookInbox{}
+	router := gin.New()
+	router.Use(api.ErrorHandlerMiddleware())
+	api.NewWebhookAPI(engine.AdapterManager(), inbox).RegisterIntakeRoutes(router)
+
+	verification := &fixture.Fixture{
+		Adapter: "slack",
+		Header:  http.Header{"Content-Type": []string{"application/json"}},
+		Body:    []byte(`{"token":"legacy","challenge":"3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P","type":"url_verification"}`),
+	}
+
+	post := func(f *fixture.Fixture) *httptest.ResponseRecorder {
+		req, err := f.NewRequest(ctx, "")
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := post(verification.Sign("s3cret", time.Now()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"challenge":"3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P"}`, w.Body.String())
+	assert.Zero(t, inbox.received, "handshakes should not be persisted")
+
+	w = post(verification.Sign("wrong", time.Now()))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.NotContains(t, w.Body.String(), "3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P")
+
+	event := &fixture.Fixture{
+		Adapter: "slack",
+		Header:  http.Header{"Content-Type": []string{"application/json"}},
+		Body:    []byte(`{"type":"event_callback","event":{"type":"app_mention"}}`),
+	}
+	assert.Equal(t, http.StatusAccepted, post(event.Sign("s3cret", time.Now())).Code)
+	assert.Equal(t, 1, inbox.received, "events should be persisted")
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/S-Corkum/mcp-server/internal/correlation"
+	"github.com/gin-gonic/gin"
+)
+
+// CorrelationBinder binds correlation keys of webhooks to contexts
+type CorrelationBinder interface {
+	ContextID(ctx context.Context, key string) (string, error)
+	Bind(ctx context.Context, key, contextID string) error
+}
+
+// Correlation is the context a correlation key is bound to
+type Correlation struct {
+	Key       string            `json:"key" binding:"required"`
+	ContextID string            `json:"context_id" binding:"required"`
+	Links     map[string]string `json:"_links,omitempty"`
+}
+
+// CorrelationAPI handles API endpoints for webhook correlation keys
+type CorrelationAPI struct {
+	correlator CorrelationBinder
+}
+
+// NewCorrelationAPI creates a new correlation API handler
+func NewCorrelationAPI(correlator CorrelationBinder) *CorrelationAPI {
+	return &CorrelationAPI{correlator: correlator}
+}
+
+// RegisterRoutes registers all correlation API routes
+func (api *CorrelationAPI) RegisterRoutes(router *gin.RouterGroup) {
+	correlationRoutes := router.Group("/correlations")
+	correlationRoutes.GET("", api.getCorrelation)
+	correlationRoutes.PUT("", api.bindCorrelation)
+}
+
+// @Summary Get correlation
+// @Description Get the context webhooks with a correlation key are recorded in
+// @Tags correlations
+// @Produce json
+// @Param key query string true "Correlation key, e.g. github:org/repo#42"
+// @Success 200 {object} Correlation "Context bound to the key"
+// @Failure 400 {object} ErrorResponse "Missing key"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "No context bound to the key"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /correlations [get]
+// getCorrelation returns the context bound to a correlation key
+func (api *CorrelationAPI) getCorrelation(c *gin.Context) {
+	key := c.Query("key")
+	if key == "" {
+		c.Error(NewBadRequestError("Query parameter key is required", nil))
+		return
+	}
+
+	contextID, err := api.correlator.ContextID(c.Request.Context(), key)
+	if err != nil {
+		if errors.Is(err, correlation.ErrKeyNotFound) {
+			c.Error(NewAPIError(ErrCorrelationNotFound, fmt.Sprintf("No context bound to correlation key %s", key), http.StatusNotFound, err))
+			return
+		}
+		c.Error(NewInternalServerError("Failed to get correlation", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, newCorrelation(c, key, contextID))
+}
+
+// @Summary Bind correlation
+// @Description Bind a correlation key to a context, so that later webhooks with the key are appended to it
+// @Tags correlations
+// @Accept json
+// @Produce json
+// @Param correlation body Correlation true "Correlation key and context ID"
+// @Success 200 {object} Correlation "Context bound to the key"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /correlations [put]
+// bindCorrelation binds a correlation key to a context
+func (api *CorrelationAPI) bindCorrelation(c *gin.Context) {
+	var request Correlation
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(HandleValidationErrors(err))
+		return
+	}
+
+	if err := api.correlator.Bind(c.Request.Context(), request.Key, request.ContextID); err != nil {
+		c.Error(NewInternalServerError("Failed to bind correlation key", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, newCorrelation(c, request.Key, request.ContextID))
+}
+
+// newCorrelation creates a correlation with HATEOAS links
+func newCorrelation(c *gin.Context, key, contextID string) *Correlation {
+	baseURL := getBaseURLFromContext(c)
+	return &Correlation{
+		Key:       key,
+		ContextID: contextID,
+		Links: map[string]string{
+			"context": fmt.Sprintf("%s/api/v1/mcp/context/%s", baseURL, contextID),
+		},
+	}
+}
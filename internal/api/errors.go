@@ -39,6 +39,13 @@ const (
 	ErrActionNotFound   ErrorCode = "ACTION_NOT_FOUND"
 	ErrActionFailed     ErrorCode = "ACTION_FAILED"
 	ErrActionInvalid    ErrorCode = "ACTION_INVALID"
+	ErrJobNotFound      ErrorCode = "JOB_NOT_FOUND"
+	
+	// Webhook errors
+	ErrDeliveryNotFound ErrorCode = "DELIVERY_NOT_FOUND"
+	ErrCorrelationNotFound ErrorCode = "CORRELATION_NOT_FOUND"
+	ErrSubscriptionNotFound ErrorCode = "SUBSCRIPTION_NOT_FOUND"
+	ErrEventSchemaNotFound ErrorCode = "EVENT_SCHEMA_NOT_FOUND"
 	
 	// Model-specific errors
 	ErrModelNotFound    ErrorCode = "MODEL_NOT_FOUND"
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/S-Corkum/mcp-server/internal/eventstore"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/gin-gonic/gin"
+)
+
+// Limits of event history pages
+const (
+	defaultEventPageSize = 100
+	maxEventPageSize     = 1000
+)
+
+// EventHistory queries and exports recorded events
+type EventHistory interface {
+	Query(ctx context.Context, query eventstore.Query) (*eventstore.Page, error)
+	Export(ctx context.Context, w io.Writer, filter mcp.EventFilter) (int, error)
+}
+
+// EventHistoryAPI handles API endpoints for the event history
+type EventHistoryAPI struct {
+	history EventHistory
+}
+
+// NewEventHistoryAPI creates a new event history API handler
+func NewEventHistoryAPI(history EventHistory) *EventHistoryAPI {
+	return &EventHistoryAPI{history: history}
+}
+
+// RegisterRoutes registers all event history API routes
+func (api *EventHistoryAPI) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/events", api.queryEvents)
+	router.GET("/events/export", api.exportEvents)
+}
+
+// @Summary Query events
+// @Description Query the recorded events matching a filter, oldest first, as structured CloudEvents. Pages are linked by cursors.
+// @Tags events
+// @Produce json
+// @Param sources query string false "Comma-separated event sources"
+// @Param types query string false "Comma-separated event types"
+// @Param agent_ids query string false "Comma-separated agent IDs"
+// @Param session_ids query string false "Comma-separated session IDs"
+// @Param after query string false "Only events at or after this time (RFC 3339)"
+// @Param before query string false "Only events at or before this time (RFC 3339)"
+// @Param limit query int false "Maximum number of events" default(100)
+// @Param cursor query string false "Cursor of the next page, from a previous page"
+// @Success 200 {object} object "Events with a link to the next page"
+// @Failure 400 {object} ErrorResponse "Invalid filter, limit or cursor"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /events [get]
+// queryEvents lists a page of the recorded events matching a filter
+func (api *EventHistoryAPI) queryEvents(c *gin.Context) {
+	filter, err := parseEventFilter(c)
+	if err != nil {
+		c.Error(NewBadRequestError(err.Error(), err))
+		return
+	}
+
+	limit := defaultEventPageSize
+	if raw := c.Query("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 || limit > maxEventPageSize {
+			c.Error(NewBadRequestError(fmt.Sprintf("Invalid limit %s: must be between 1 and %d", raw, maxEventPageSize), err))
+			return
+		}
+	}
+
+	page, err := api.history.Query(c.Request.Context(), eventstore.Query{
+		Filter: filter,
+		Limit:  limit,
+		Cursor: c.Query("cursor"),
+	})
+	if err != nil {
+		if errors.Is(err, eventstore.ErrInvalidCursor) {
+			c.Error(NewBadRequestError(fmt.Sprintf("Invalid cursor %s", c.Query("cursor")), err))
+			return
+		}
+		c.Error(NewInternalServerError("Failed to query events", err))
+		return
+	}
+
+	cloudEvents := make([]*cloudevents.Event, 0, len(page.Events))
+	for _, event := range page.Events {
+		cloudEvent, err := cloudevents.FromMCPEvent(event)
+		if err != nil {
+			c.Error(NewInternalServerError("Failed to encode events", err))
+			return
+		}
+		cloudEvents = append(cloudEvents, cloudEvent)
+	}
+
+	response := gin.H{
+		"events": cloudEvents,
+		"count":  len(cloudEvents),
+	}
+	if page.NextCursor != "" {
+		query := c.Request.URL.Query()
+		query.Set("cursor", page.NextCursor)
+		response["next_cursor"] = page.NextCursor
+		response["_links"] = map[string]string{
+			"next": getBaseURLFromContext(c) + c.Request.URL.Path + "?" + query.Encode(),
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Export events
+// @Description Export the recorded events matching a filter, oldest first, as newline-delimited structured CloudEvents
+// @Tags events
+// @Produce application/x-ndjson
+// @Param sources query string false "Comma-separated event sources"
+// @Param types query string false "Comma-separated event types"
+// @Param agent_ids query string false "Comma-separated agent IDs"
+// @Param session_ids query string false "Comma-separated session IDs"
+// @Param after query string false "Only events at or after this time (RFC 3339)"
+// @Param before query string false "Only events at or before this time (RFC 3339)"
+// @Success 200 {string} string "Newline-delimited events"
+// @Failure 400 {object} ErrorResponse "Invalid filter"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /events/export [get]
+// exportEvents writes the recorded events matching a filter as
+// newline-delimited JSON
+func (api *EventHistoryAPI) exportEvents(c *gin.Context) {
+	filter, err := parseEventFilter(c)
+	if err != nil {
+		c.Error(NewBadRequestError(err.Error(), err))
+		return
+	}
+
+	// Exports of long histories outlive the server's write timeout
+	http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Content-Disposition", `attachment; filename="events.ndjson"`)
+	if _, err := api.history.Export(c.Request.Context(), c.Writer, filter); err != nil {
+		// Once the export started, the response can only be cut short
+		if !c.Writer.Written() {
+			c.Writer.Header().Del("Content-Type")
+			c.Writer.Header().Del("Content-Disposition")
+			c.Error(NewInternalServerError("Failed to export events", err))
+			return
+		}
+		c.Abort()
+	}
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/gin-gonic/gin"
+)
+
+// EventSchemaAPI handles API endpoints for the JSON schemas of event data
+type EventSchemaAPI struct{}
+
+// NewEventSchemaAPI creates a new event schema API handler
+func NewEventSchemaAPI() *EventSchemaAPI {
+	return &EventSchemaAPI{}
+}
+
+// RegisterRoutes registers all event schema API routes
+func (api *EventSchemaAPI) RegisterRoutes(router *gin.RouterGroup) {
+	schemaRoutes := router.Group("/events/schemas")
+	schemaRoutes.GET("", api.listEventSchemas)
+	schemaRoutes.GET("/:type", api.getEventSchema)
+}
+
+// @Summary List event schemas
+// @Description List the event types whose data has a JSON schema
+// @Tags events
+// @Produce json
+// @Success 200 {object} object "Event types with links to their schemas"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /events/schemas [get]
+// listEventSchemas lists the event types that have a schema
+func (api *EventSchemaAPI) listEventSchemas(c *gin.Context) {
+	baseURL := getBaseURLFromContext(c)
+	types := cloudevents.SchemaTypes()
+
+	links := make(map[string]string, len(types))
+	for _, eventType := range types {
+		links[eventType] = fmt.Sprintf("%s/api/v1/events/schemas/%s", baseURL, eventType)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"types":  types,
+		"count":  len(types),
+		"_links": links,
+	})
+}
+
+// @Summary Get event schema
+// @Description Get the JSON schema of the data of CloudEvents of a type
+// @Tags events
+// @Produce json
+// @Param type path string true "Event type, e.g. context.updated"
+// @Success 200 {object} object "JSON schema"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Event type has no schema"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /events/schemas/{type} [get]
+// getEventSchema returns the schema of an event type
+func (api *EventSchemaAPI) getEventSchema(c *gin.Context) {
+	eventType := c.Param("type")
+	schema, ok := cloudevents.Schema(eventType)
+	if !ok {
+		c.Error(NewAPIError(ErrEventSchemaNotFound, fmt.Sprintf("Event type %s has no schema", eventType), http.StatusNotFound, nil))
+		return
+	}
+
+	c.Data(http.StatusOK, "application/schema+json", schema)
+}
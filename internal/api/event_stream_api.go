@@ -0,0 +1,250 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// keepaliveInterval is how often idle streams are sent a keepalive, so
+// proxies don't close them
+const keepaliveInterval = 15 * time.Second
+
+// EventStream fans the events of the event bus out to listeners
+type EventStream interface {
+	Listen(filter mcp.EventFilter, lastEventID string) ([]*mcp.Event, *events.Listener)
+}
+
+// EventStreamAPI handles API endpoints for watching events live
+type EventStreamAPI struct {
+	stream   EventStream
+	upgrader websocket.Upgrader
+}
+
+// NewEventStreamAPI creates a new event stream API handler
+func NewEventStreamAPI(stream EventStream) *EventStreamAPI {
+	return &EventStreamAPI{stream: stream}
+}
+
+// RegisterRoutes registers all event stream API routes
+func (api *EventStreamAPI) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/events/stream", api.streamEvents)
+}
+
+// @Summary Stream events
+// @Description Stream the events matching a filter as they are published, as server-sent events or over a WebSocket. Each event is a CloudEvent in the structured JSON format. Streams resume after the event given in the Last-Event-ID header, replaying recent events.
+// @Tags events
+// @Produce text/event-stream
+// @Param sources query string false "Comma-separated event sources"
+// @Param types query string false "Comma-separated event types"
+// @Param agent_ids query string false "Comma-separated agent IDs"
+// @Param session_ids query string false "Comma-separated session IDs"
+// @Param after query string false "Only events after this time (RFC 3339); recent events after it are replayed"
+// @Param before query string false "Only events before this time (RFC 3339); the stream ends then"
+// @Param Last-Event-ID header string false "ID of the last event received, to resume a stream"
+// @Success 200 {string} string "Event stream"
+// @Failure 400 {object} ErrorResponse "Invalid filter"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /events/stream [get]
+// streamEvents streams the events matching a filter
+func (api *EventStreamAPI) streamEvents(c *gin.Context) {
+	filter, err := parseEventFilter(c)
+	if err != nil {
+		c.Error(NewBadRequestError(err.Error(), err))
+		return
+	}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+
+	if websocket.IsWebSocketUpgrade(c.Request) {
+		api.streamWebSocket(c, filter, lastEventID)
+		return
+	}
+	api.streamSSE(c, filter, lastEventID)
+}
+
+// streamSSE streams events as server-sent events
+func (api *EventStreamAPI) streamSSE(c *gin.Context, filter mcp.EventFilter, lastEventID string) {
+	// Streams outlive the server's write timeout
+	http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	replay, listener := api.stream.Listen(filter, lastEventID)
+	defer listener.Close()
+
+	send := func(event *mcp.Event) error {
+		data, err := encodeStreamEvent(event)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+	keepalive := func() error {
+		if _, err := fmt.Fprint(c.Writer, ": keepalive\n\n"); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+
+	pumpEvents(c.Request.Context(), replay, listener, filter.Before, send, keepalive)
+}
+
+// streamWebSocket streams events as text messages over a WebSocket
+func (api *EventStreamAPI) streamWebSocket(c *gin.Context, filter mcp.EventFilter, lastEventID string) {
+	conn, err := api.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already responded
+		return
+	}
+	defer conn.Close()
+
+	replay, listener := api.stream.Listen(filter, lastEventID)
+	defer listener.Close()
+
+	// Clients don't send messages, but reading handles their pings and
+	// notices when they close the connection
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	send := func(event *mcp.Event) error {
+		data, err := encodeStreamEvent(event)
+		if err != nil {
+			return err
+		}
+		conn.SetWriteDeadline(time.Now().Add(keepaliveInterval))
+		return conn.WriteMessage(websocket.TextMessage, data)
+	}
+	keepalive := func() error {
+		return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(keepaliveInterval))
+	}
+
+	pumpEvents(ctx, replay, listener, filter.Before, send, keepalive)
+	conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+}
+
+// pumpEvents sends the replayed events, then the listener's events, until
+// the client goes away, the listener is dropped or the filter's time window
+// ends
+func pumpEvents(ctx context.Context, replay []*mcp.Event, listener *events.Listener, before time.Time,
+	send func(*mcp.Event) error, keepalive func() error) {
+	for _, event := range replay {
+		if err := send(event); err != nil {
+			return
+		}
+	}
+
+	var end <-chan time.Time
+	if !before.IsZero() {
+		timer := time.NewTimer(time.Until(before))
+		defer timer.Stop()
+		end = timer.C
+	}
+	ticker := time.NewTicker(keepaliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-end:
+			return
+		case event, ok := <-listener.Events():
+			// Listeners that fall behind are dropped; clients reconnect
+			// with the ID of the last event they received
+			if !ok {
+				return
+			}
+			if err := send(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := keepalive(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// encodeStreamEvent encodes an event as a structured CloudEvent
+func encodeStreamEvent(event *mcp.Event) ([]byte, error) {
+	cloudEvent, err := cloudevents.FromMCPEvent(event)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(cloudEvent)
+}
+
+// parseEventFilter reads an event filter from query parameters. Lists may be
+// comma-separated or repeated.
+func parseEventFilter(c *gin.Context) (mcp.EventFilter, error) {
+	filter := mcp.EventFilter{
+		Sources:    queryList(c, "sources"),
+		Types:      queryList(c, "types"),
+		AgentIDs:   queryList(c, "agent_ids"),
+		SessionIDs: queryList(c, "session_ids"),
+	}
+
+	for param, value := range map[string]*time.Time{"after": &filter.After, "before": &filter.Before} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid %s %s: must be an RFC 3339 time", param, raw)
+		}
+		*value = parsed
+	}
+	if !filter.After.IsZero() && !filter.Before.IsZero() && !filter.After.Before(filter.Before) {
+		return filter, errors.New("after must be earlier than before")
+	}
+	return filter, nil
+}
+
+// queryList returns the values of a list query parameter
+func queryList(c *gin.Context, param string) []string {
+	var values []string
+	for _, raw := range c.QueryArray(param) {
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/S-Corkum/mcp-server/internal/jobs"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/gin-gonic/gin"
+)
+
+// JobManager runs tool actions asynchronously
+type JobManager interface {
+	Submit(ctx context.Context, tool, action, contextID string, params map[string]interface{}) (*mcp.Job, error)
+	Get(ctx context.Context, id string) (*mcp.Job, error)
+	Cancel(ctx context.Context, id string) (*mcp.Job, error)
+}
+
+// JobAPI handles API endpoints for jobs running tool actions
+type JobAPI struct {
+	jobs JobManager
+}
+
+// NewJobAPI creates a new job API handler
+func NewJobAPI(jobs JobManager) *JobAPI {
+	return &JobAPI{jobs: jobs}
+}
+
+// RegisterRoutes registers all job API routes
+func (api *JobAPI) RegisterRoutes(router *gin.RouterGroup) {
+	jobRoutes := router.Group("/jobs")
+	jobRoutes.GET("/:id", api.getJob)
+	jobRoutes.POST("/:id/cancel", api.cancelJob)
+}
+
+// @Summary Get job
+// @Description Get the status, progress and result of a job running a tool action
+// @Tags jobs
+// @Produce json
+// @Param id path string true "Job ID"
+// @Success 200 {object} object "Job with HATEOAS links"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /jobs/{id} [get]
+// getJob returns a job
+func (api *JobAPI) getJob(c *gin.Context) {
+	job, err := api.jobs.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(jobError(c.Param("id"), err))
+		return
+	}
+
+	addJobLinks(c, job)
+	c.JSON(http.StatusOK, job)
+}
+
+// @Summary Cancel job
+// @Description Cancel a pending or running job. The result of a cancelled job is discarded.
+// @Tags jobs
+// @Produce json
+// @Param id path string true "Job ID"
+// @Success 200 {object} object "Cancelled job with HATEOAS links"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Failure 409 {object} ErrorResponse "Job has already finished"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /jobs/{id}/cancel [post]
+// cancelJob cancels a job
+func (api *JobAPI) cancelJob(c *gin.Context) {
+	job, err := api.jobs.Cancel(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(jobError(c.Param("id"), err))
+		return
+	}
+
+	addJobLinks(c, job)
+	c.JSON(http.StatusOK, job)
+}
+
+// jobError maps a job manager error to an API error
+func jobError(id string, err error) *APIError {
+	switch {
+	case errors.Is(err, jobs.ErrJobNotFound):
+		return NewAPIError(ErrJobNotFound, fmt.Sprintf("Job with ID %s not found", id), http.StatusNotFound, err)
+	case errors.Is(err, jobs.ErrJobFinished):
+		return NewAPIError(ErrConflict, fmt.Sprintf("Job %s has already finished", id), http.StatusConflict, err)
+	default:
+		return NewInternalServerError("Failed to get job", err)
+	}
+}
+
+// addJobLinks adds HATEOAS links to a job
+func addJobLinks(c *gin.Context, job *mcp.Job) {
+	baseURL := getBaseURLFromContext(c)
+	job.Links = map[string]string{
+		"self":   fmt.Sprintf("%s/api/v1/jobs/%s", baseURL, job.ID),
+		"cancel": fmt.Sprintf("%s/api/v1/jobs/%s/cancel", baseURL, job.ID),
+		"action": fmt.Sprintf("%s/api/v1/tools/%s/actions/%s", baseURL, job.Tool, job.Action),
+	}
+}
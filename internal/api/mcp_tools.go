@@ -0,0 +1,145 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/gin-gonic/gin"
+)
+
+// JSON-RPC error codes
+const (
+	jsonRPCParseError     = -32700
+	jsonRPCInvalidRequest = -32600
+	jsonRPCMethodNotFound = -32601
+)
+
+// mcpRequest is a JSON-RPC 2.0 request of the Model Context Protocol
+type mcpRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// mcpResponse is a JSON-RPC 2.0 response
+type mcpResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *mcpError       `json:"error,omitempty"`
+}
+
+// mcpError is a JSON-RPC 2.0 error
+type mcpError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// mcpTool is a tool in the result of tools/list
+type mcpTool struct {
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description"`
+	InputSchema  map[string]interface{} `json:"inputSchema"`
+	OutputSchema map[string]interface{} `json:"outputSchema,omitempty"`
+	Annotations  mcpToolAnnotations     `json:"annotations"`
+	Meta         map[string]interface{} `json:"_meta,omitempty"`
+}
+
+// mcpToolAnnotations are the behaviour hints of a tool
+type mcpToolAnnotations struct {
+	ReadOnlyHint    bool `json:"readOnlyHint"`
+	DestructiveHint bool `json:"destructiveHint"`
+}
+
+// @Summary MCP JSON-RPC endpoint
+// @Description Handles Model Context Protocol JSON-RPC requests. tools/list returns one tool per adapter action.
+// @Tags mcp
+// @Accept json
+// @Produce json
+// @Success 200 {object} object "JSON-RPC response"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /mcp [post]
+// handleMCPRequest handles an MCP JSON-RPC request
+func (api *ToolAPI) handleMCPRequest(c *gin.Context) {
+	var req mcpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, mcpErrorResponse(nil, jsonRPCParseError, "Parse error"))
+		return
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		c.JSON(http.StatusOK, mcpErrorResponse(req.ID, jsonRPCInvalidRequest, "Invalid request"))
+		return
+	}
+
+	// Notifications get no response
+	if len(req.ID) == 0 {
+		c.Status(http.StatusAccepted)
+		return
+	}
+
+	switch req.Method {
+	case "tools/list":
+		c.JSON(http.StatusOK, mcpResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Result:  gin.H{"tools": api.mcpTools(c)},
+		})
+	default:
+		c.JSON(http.StatusOK, mcpErrorResponse(req.ID, jsonRPCMethodNotFound, "Method not found: "+req.Method))
+	}
+}
+
+// mcpTools returns an MCP tool for each described adapter action. Tools are
+// named <tool>_<action>.
+func (api *ToolAPI) mcpTools(c *gin.Context) []mcpTool {
+	mcpTools := []mcpTool{}
+	for _, tool := range api.describeTools(c.Request.Context()) {
+		for _, action := range tool.Actions {
+			inputSchema := action.Params
+			if inputSchema == nil {
+				inputSchema = map[string]interface{}{"type": "object"}
+			}
+
+			mcpTool := mcpTool{
+				Name:        tool.Name + "_" + action.Name,
+				Description: action.Description,
+				InputSchema: inputSchema,
+				Annotations: mcpToolAnnotations{
+					ReadOnlyHint:    action.Safety == core.SafetyRead,
+					DestructiveHint: action.Safety == core.SafetyDestructive,
+				},
+				Meta: map[string]interface{}{
+					"tool":   tool.Name,
+					"action": action.Name,
+					"safety": action.Safety,
+				},
+			}
+			// MCP output schemas describe objects
+			if action.Result["type"] == "object" {
+				mcpTool.OutputSchema = action.Result
+			}
+			if len(action.Scopes) > 0 {
+				mcpTool.Meta["scopes"] = action.Scopes
+			}
+
+			mcpTools = append(mcpTools, mcpTool)
+		}
+	}
+	return mcpTools
+}
+
+// mcpErrorResponse returns a JSON-RPC error response
+func mcpErrorResponse(id json.RawMessage, code int, message string) mcpResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return mcpResponse{
+		JSONRPC: "2.0",
+		ID:      id,
+		Error:   &mcpError{Code: code, Message: message},
+	}
+}
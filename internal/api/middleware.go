@@ -226,6 +226,14 @@ func CompressionMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Event streams are flushed event by event, and upgraded connections
+		// are written to directly, so neither can be compressed
+		if strings.Contains(c.Request.Header.Get("Accept"), "text/event-stream") ||
+			strings.EqualFold(c.Request.Header.Get("Upgrade"), "websocket") {
+			c.Next()
+			return
+		}
+
 		// Create gzip writer
 		gz, err := gzip.NewWriterLevel(c.Writer, gzip.BestCompression)
 		if err != nil {
@@ -271,6 +279,12 @@ func (g *gzipResponseWriter) WriteString(s string) (int, error) {
 	return g.Writer.Write([]byte(s))
 }
 
+// Flush flushes compressed data written so far to the client
+func (g *gzipResponseWriter) Flush() {
+	g.Writer.Flush()
+	g.ResponseWriter.Flush()
+}
+
 // CORSConfig defines configuration for CORS middleware
 type CORSConfig struct {
 	AllowedOrigins []string `mapstructure:"allowed_origins"`
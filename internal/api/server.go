@@ -118,6 +118,10 @@ func (s *Server) setupRoutes() {
 		s.router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	}
 	
+	// Webhooks sent by external services, which are verified by their adapter
+	webhookAPI := NewWebhookAPI(s.engine.AdapterManager(), s.engine.Webhooks())
+	webhookAPI.RegisterIntakeRoutes(s.router)
+	
 	// Metrics endpoints - add authentication
 	s.router.GET("/metrics", AuthMiddleware("api_key"), s.metricsHandler)
 
@@ -141,21 +145,48 @@ func (s *Server) setupRoutes() {
 	
 
 	
-	// Skip MCPAPI initialization for now since the interface doesn't match
-	// This would need proper interface adaptation or an implementation with the expected methods
+	// Contexts of agents, which tool actions and webhooks are recorded in
+	mcpAPI := NewMCPAPI(s.engine.ContextManager())
+	mcpAPI.RegisterRoutes(v1)
 	
-	// Tool integration API - using resource-based approach
-	adapterBridge, err := s.engine.GetAdapter("adapter_bridge")
-	if err != nil {
-		s.logger.Warn("Failed to get adapter bridge, using mock implementation", map[string]interface{}{
-			"error": err.Error(),
-		})
-		// Use a nil interface, the ToolAPI will use mock implementations
-		adapterBridge = nil
-	}
-	toolAPI := NewToolAPI(adapterBridge)
+	// Tool integration API - using resource-based approach. Tools are
+	// described and executed by the adapter manager. Executed actions are
+	// recorded in the contexts given by context_id and published as events.
+	toolAPI := NewToolAPI(s.engine.AdapterManager()).
+		WithJobs(s.engine.Jobs()).
+		WithContextManager(s.engine.ContextManager()).
+		WithEventBus(s.engine.EventBus())
 	toolAPI.RegisterRoutes(v1)
 	
+	// Jobs running asynchronous tool actions
+	jobAPI := NewJobAPI(s.engine.Jobs())
+	jobAPI.RegisterRoutes(v1)
+	
+	// Webhook deliveries, to inspect and replay failed deliveries
+	webhookAPI.RegisterRoutes(v1)
+	
+	// Correlation keys binding webhooks to contexts
+	if correlator := s.engine.Correlator(); correlator != nil {
+		correlationAPI := NewCorrelationAPI(correlator)
+		correlationAPI.RegisterRoutes(v1)
+	}
+
+	// Subscriptions of external consumers to events
+	subscriptionAPI := NewSubscriptionAPI(s.engine.Subscriptions())
+	subscriptionAPI.RegisterRoutes(v1)
+
+	// JSON schemas of the data of events
+	eventSchemaAPI := NewEventSchemaAPI()
+	eventSchemaAPI.RegisterRoutes(v1)
+
+	// Live stream of events, for dashboards and debugging
+	eventStreamAPI := NewEventStreamAPI(s.engine.EventStream())
+	eventStreamAPI.RegisterRoutes(v1)
+
+	// History of recorded events, for audits
+	eventHistoryAPI := NewEventHistoryAPI(s.engine.EventStore())
+	eventHistoryAPI.RegisterRoutes(v1)
+	
 	// Note: We removed the duplicate /tools route registration that was causing a conflict
 	// The ToolAPI.RegisterRoutes method already registers this endpoint
 	
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/S-Corkum/mcp-server/internal/subscriptions"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionManager manages subscriptions of external consumers to events
+type SubscriptionManager interface {
+	Create(ctx context.Context, subscription *mcp.Subscription) (*mcp.Subscription, error)
+	Get(ctx context.Context, id string) (*mcp.Subscription, error)
+	List(ctx context.Context) ([]*mcp.Subscription, error)
+	Delete(ctx context.Context, id string) error
+	Enable(ctx context.Context, id string) (*mcp.Subscription, error)
+	Deliveries(ctx context.Context, id string, limit, offset int) ([]*mcp.SubscriptionDelivery, error)
+}
+
+// SubscriptionRequest is the body of a request creating a subscription
+type SubscriptionRequest struct {
+	URL         string          `json:"url" binding:"required"`
+	Description string          `json:"description"`
+	Filter      mcp.EventFilter `json:"filter"`
+	Secret      string          `json:"secret" binding:"required"`
+}
+
+// SubscriptionAPI handles API endpoints for event subscriptions
+type SubscriptionAPI struct {
+	subscriptions SubscriptionManager
+}
+
+// NewSubscriptionAPI creates a new subscription API handler
+func NewSubscriptionAPI(subscriptions SubscriptionManager) *SubscriptionAPI {
+	return &SubscriptionAPI{subscriptions: subscriptions}
+}
+
+// RegisterRoutes registers all subscription API routes
+func (api *SubscriptionAPI) RegisterRoutes(router *gin.RouterGroup) {
+	subscriptionRoutes := router.Group("/subscriptions")
+	subscriptionRoutes.POST("", api.createSubscription)
+	subscriptionRoutes.GET("", api.listSubscriptions)
+	subscriptionRoutes.GET("/:id", api.getSubscription)
+	subscriptionRoutes.DELETE("/:id", api.deleteSubscription)
+	subscriptionRoutes.POST("/:id/enable", api.enableSubscription)
+	subscriptionRoutes.GET("/:id/deliveries", api.listSubscriptionDeliveries)
+}
+
+// @Summary Create subscription
+// @Description Register a URL to which events matching the filter are posted. Deliveries are signed with the secret in the X-MCP-Signature header, the hex-encoded HMAC-SHA256 of the body. URLs targeting loopback, link-local or private addresses are rejected unless engine.subscriptions.allow_private_targets is set.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param subscription body SubscriptionRequest true "URL, event filter and secret"
+// @Success 201 {object} object "Subscription with HATEOAS links"
+// @Failure 400 {object} ErrorResponse "Invalid subscription"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /subscriptions [post]
+// createSubscription creates a subscription
+func (api *SubscriptionAPI) createSubscription(c *gin.Context) {
+	var request SubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(HandleValidationErrors(err))
+		return
+	}
+
+	subscription, err := api.subscriptions.Create(c.Request.Context(), &mcp.Subscription{
+		URL:         request.URL,
+		Description: request.Description,
+		Filter:      request.Filter,
+		Secret:      request.Secret,
+	})
+	if err != nil {
+		c.Error(subscriptionError("", err))
+		return
+	}
+
+	addSubscriptionLinks(c, subscription)
+	c.JSON(http.StatusCreated, subscription)
+}
+
+// @Summary List subscriptions
+// @Description List event subscriptions, oldest first
+// @Tags subscriptions
+// @Produce json
+// @Success 200 {object} object "Subscriptions with HATEOAS links"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /subscriptions [get]
+// listSubscriptions lists subscriptions
+func (api *SubscriptionAPI) listSubscriptions(c *gin.Context) {
+	subscriptions, err := api.subscriptions.List(c.Request.Context())
+	if err != nil {
+		c.Error(NewInternalServerError("Failed to list subscriptions", err))
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		addSubscriptionLinks(c, subscription)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"subscriptions": subscriptions,
+		"count":         len(subscriptions),
+	})
+}
+
+// @Summary Get subscription
+// @Description Get an event subscription and its status
+// @Tags subscriptions
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} object "Subscription with HATEOAS links"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Subscription not found"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /subscriptions/{id} [get]
+// getSubscription returns a subscription
+func (api *SubscriptionAPI) getSubscription(c *gin.Context) {
+	subscription, err := api.subscriptions.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(subscriptionError(c.Param("id"), err))
+		return
+	}
+
+	addSubscriptionLinks(c, subscription)
+	c.JSON(http.StatusOK, subscription)
+}
+
+// @Summary Delete subscription
+// @Description Delete an event subscription and its delivery log
+// @Tags subscriptions
+// @Param id path string true "Subscription ID"
+// @Success 204 "Subscription deleted"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Subscription not found"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /subscriptions/{id} [delete]
+// deleteSubscription deletes a subscription
+func (api *SubscriptionAPI) deleteSubscription(c *gin.Context) {
+	if err := api.subscriptions.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		c.Error(subscriptionError(c.Param("id"), err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Enable subscription
+// @Description Enable a subscription that was disabled after repeated failed deliveries
+// @Tags subscriptions
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} object "Subscription with HATEOAS links"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Subscription not found"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /subscriptions/{id}/enable [post]
+// enableSubscription enables a subscription
+func (api *SubscriptionAPI) enableSubscription(c *gin.Context) {
+	subscription, err := api.subscriptions.Enable(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(subscriptionError(c.Param("id"), err))
+		return
+	}
+
+	addSubscriptionLinks(c, subscription)
+	c.JSON(http.StatusOK, subscription)
+}
+
+// @Summary List subscription deliveries
+// @Description List the delivery log of a subscription, most recent first
+// @Tags subscriptions
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param limit query int false "Maximum number of deliveries" default(50)
+// @Param offset query int false "Number of deliveries to skip" default(0)
+// @Success 200 {object} object "Deliveries"
+// @Failure 400 {object} ErrorResponse "Invalid limit or offset"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Subscription not found"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /subscriptions/{id}/deliveries [get]
+// listSubscriptionDeliveries lists the deliveries of a subscription
+func (api *SubscriptionAPI) listSubscriptionDeliveries(c *gin.Context) {
+	limit, offset := 50, 0
+	for param, value := range map[string]*int{"limit": &limit, "offset": &offset} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			c.Error(NewBadRequestError(fmt.Sprintf("Invalid %s %s", param, raw), err))
+			return
+		}
+		*value = parsed
+	}
+
+	deliveries, err := api.subscriptions.Deliveries(c.Request.Context(), c.Param("id"), limit, offset)
+	if err != nil {
+		c.Error(subscriptionError(c.Param("id"), err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"deliveries": deliveries,
+		"count":      len(deliveries),
+	})
+}
+
+// subscriptionError maps a subscription manager error to an API error
+func subscriptionError(id string, err error) *APIError {
+	switch {
+	case errors.Is(err, subscriptions.ErrSubscriptionNotFound):
+		return NewAPIError(ErrSubscriptionNotFound, fmt.Sprintf("Subscription with ID %s not found", id), http.StatusNotFound, err)
+	case errors.Is(err, subscriptions.ErrInvalidSubscription):
+		return NewBadRequestError(err.Error(), err)
+	default:
+		return NewInternalServerError("Failed to manage subscription", err)
+	}
+}
+
+// addSubscriptionLinks adds HATEOAS links to a subscription
+func addSubscriptionLinks(c *gin.Context, subscription *mcp.Subscription) {
+	baseURL := getBaseURLFromContext(c)
+	subscription.Links = map[string]string{
+		"self":       fmt.Sprintf("%s/api/v1/subscriptions/%s", baseURL, subscription.ID),
+		"deliveries": fmt.Sprintf("%s/api/v1/subscriptions/%s/deliveries", baseURL, subscription.ID),
+	}
+	if subscription.Status == mcp.SubscriptionDisabled {
+		subscription.Links["enable"] = fmt.Sprintf("%s/api/v1/subscriptions/%s/enable", baseURL, subscription.ID)
+	}
+}
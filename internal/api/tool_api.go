@@ -1,16 +1,45 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/S-Corkum/mcp-server/internal/adapters"
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/safety"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
 	"github.com/gin-gonic/gin"
+	"github.com/sony/gobreaker"
 )
 
+// ToolCatalog describes the tools served by the tool API. Tool and action
+// listings are generated from the action descriptors of the adapters.
+type ToolCatalog interface {
+	DescribeTools(ctx context.Context) []adapters.ToolDescription
+	DescribeTool(ctx context.Context, name string) (adapters.ToolDescription, error)
+}
+
+// ActionExecutor executes tool actions through the adapters
+type ActionExecutor interface {
+	ExecuteAction(ctx context.Context, contextID string, adapterType string, action string, params map[string]interface{}) (interface{}, error)
+}
+
 // ToolAPI handles API endpoints for tool operations
 type ToolAPI struct {
-	adapterBridge interface{}
+	adapterBridge  interface{}
+	catalog        ToolCatalog
+	executor       ActionExecutor
+	contextManager ContextManagerInterface
+	jobs           JobManager
+	eventBus       events.Bus
 	
 	// Handler functions for testing
 	executeToolAction   func(c *gin.Context)
@@ -25,6 +54,16 @@ func NewToolAPI(adapterBridge interface{}) *ToolAPI {
 		adapterBridge: adapterBridge,
 	}
 	
+	// Tools are listed when the bridge describes them
+	if catalog, ok := adapterBridge.(ToolCatalog); ok {
+		api.catalog = catalog
+	}
+	
+	// Actions are dispatched when the bridge executes them
+	if executor, ok := adapterBridge.(ActionExecutor); ok {
+		api.executor = executor
+	}
+	
 	// Initialize handler functions
 	api.executeToolAction = api.handleExecuteToolAction
 	api.queryToolData = api.handleQueryToolData
@@ -34,6 +73,28 @@ func NewToolAPI(adapterBridge interface{}) *ToolAPI {
 	return api
 }
 
+// WithContextManager records executed actions and their results in the
+// contexts given by context_id
+func (api *ToolAPI) WithContextManager(contextManager ContextManagerInterface) *ToolAPI {
+	api.contextManager = contextManager
+	return api
+}
+
+// WithJobs runs actions requested with async=true as jobs
+func (api *ToolAPI) WithJobs(jobs JobManager) *ToolAPI {
+	api.jobs = jobs
+	return api
+}
+
+// WithEventBus publishes an EventToolActionExecuted or EventToolActionFailed
+// event for every action run synchronously. Executed actions recorded in
+// their context are marked recorded, so that the context event handler does
+// not append them again.
+func (api *ToolAPI) WithEventBus(eventBus events.Bus) *ToolAPI {
+	api.eventBus = eventBus
+	return api
+}
+
 // RegisterRoutes registers all tool API routes
 func (api *ToolAPI) RegisterRoutes(router *gin.RouterGroup) {
 	tools := router.Group("/tools")
@@ -53,6 +114,9 @@ func (api *ToolAPI) RegisterRoutes(router *gin.RouterGroup) {
 	// Tool data queries
 	tools.POST("/:tool/queries", api.queryToolData)
 	
+	// MCP JSON-RPC endpoint
+	router.POST("/mcp", api.handleMCPRequest)
+	
 	// Commenting out backward compatibility endpoints to avoid route conflicts
 	// router.POST("/tools/:tool/actions/:action", api.executeToolAction)
 	// router.POST("/tools/:tool/query", api.queryToolData)
@@ -69,12 +133,17 @@ func (api *ToolAPI) RegisterRoutes(router *gin.RouterGroup) {
 // @Param tool path string true "Tool name"
 // @Param action path string true "Action name"
 // @Param context_id query string true "Context ID for tracking the operation"
+// @Param async query bool false "Run the action as a job and return 202 with the job"
 // @Param params body object true "Action parameters"
 // @Success 200 {object} object "Action result with HATEOAS links"
+// @Success 202 {object} object "Pending job running the action, with HATEOAS links"
 // @Failure 400 {object} ErrorResponse "Invalid request or missing parameters"
 // @Failure 401 {object} ErrorResponse "Authentication required"
-// @Failure 404 {object} ErrorResponse "Tool or action not found"
+// @Failure 403 {object} ErrorResponse "Action denied by the safety policy"
+// @Failure 404 {object} ErrorResponse "Tool, action or context not found"
+// @Failure 429 {object} ErrorResponse "Tool rate limit exceeded"
 // @Failure 500 {object} ErrorResponse "Error executing the action"
+// @Failure 503 {object} ErrorResponse "Tool unavailable"
 // @Security ApiKeyAuth
 // @Security BearerAuth
 // @Router /tools/{tool}/actions/{action} [post]
@@ -95,25 +164,29 @@ func (api *ToolAPI) handleExecuteToolAction(c *gin.Context) {
 		return
 	}
 
-	// Mock implementation since adapterBridge is now an interface
-	// In a real implementation, we would use type assertions or call through an interface
-	// For now, we'll just return a mock result
-	result := map[string]interface{}{
-		"status": "success",
-		"message": fmt.Sprintf("Executed %s action on %s tool", actionName, toolName),
-		"tool": toolName,
-		"action": actionName,
-		"params": params,
+	// Long-running actions run as jobs polled through /jobs/{id}
+	if async, _ := strconv.ParseBool(c.Query("async")); async {
+		api.submitAction(c, contextID, toolName, actionName, params)
+		return
 	}
 
-	// Add HATEOAS links
-	baseURL := getBaseURLFromContext(c)
-	result["_links"] = map[string]string{
-		"self": fmt.Sprintf("%s/api/v1/tools/%s/actions/%s", baseURL, toolName, actionName),
-		"tool": fmt.Sprintf("%s/api/v1/tools/%s", baseURL, toolName),
+	result, ok := api.executeAction(c, contextID, toolName, actionName, params)
+	if !ok {
+		return
 	}
 
-	c.JSON(http.StatusOK, result)
+	// Add HATEOAS links
+	baseURL := getBaseURLFromContext(c)
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+		"tool":   toolName,
+		"action": actionName,
+		"result": result,
+		"_links": map[string]string{
+			"self": fmt.Sprintf("%s/api/v1/tools/%s/actions/%s", baseURL, toolName, actionName),
+			"tool": fmt.Sprintf("%s/api/v1/tools/%s", baseURL, toolName),
+		},
+	})
 }
 
 // @Summary Get tool details
@@ -132,66 +205,16 @@ func (api *ToolAPI) handleExecuteToolAction(c *gin.Context) {
 func (api *ToolAPI) getToolDetails(c *gin.Context) {
 	toolName := c.Param("tool")
 	
-	// Map of available tools
-	toolMap := map[string]map[string]interface{}{
-		"github": {
-			"name": "github",
-			"description": "GitHub integration for repository, pull request, and code management",
-			"actions": []string{
-				"create_issue",
-				"close_issue",
-				"create_pull_request",
-				"merge_pull_request",
-				"add_comment",
-				"archive_repository",
-			},
-			"safety_notes": "Cannot delete repositories for safety reasons",
-		},
-		"harness": {
-			"name": "harness",
-			"description": "Harness CI/CD integration for builds and deployments",
-			"actions": []string{
-				"trigger_pipeline",
-				"get_pipeline_status",
-				"stop_pipeline",
-				"rollback_deployment",
-			},
-			"safety_notes": "Cannot delete production feature flags for safety reasons",
-		},
-		"sonarqube": {
-			"name": "sonarqube",
-			"description": "SonarQube integration for code quality analysis",
-			"actions": []string{
-				"trigger_analysis",
-				"get_quality_gate_status",
-				"get_issues",
-			},
-		},
-		"artifactory": {
-			"name": "artifactory",
-			"description": "JFrog Artifactory integration for artifact management (read-only)",
-			"actions": []string{
-				"download_artifact",
-				"get_artifact_info",
-				"search_artifacts",
-			},
-			"safety_notes": "Read-only access for safety reasons (no upload or delete capabilities)",
-		},
-		"xray": {
-			"name": "xray",
-			"description": "JFrog Xray integration for security scanning",
-			"actions": []string{
-				"scan_artifact",
-				"get_vulnerabilities",
-				"get_licenses",
-			},
-		},
+	tool, ok := api.describeTool(c, toolName)
+	if !ok {
+		return
 	}
 	
-	toolInfo, exists := toolMap[toolName]
-	if !exists {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Tool not found"})
-		return
+	toolInfo := gin.H{
+		"name":      tool.Name,
+		"version":   tool.Version,
+		"described": tool.Described,
+		"actions":   tool.Actions,
 	}
 	
 	// Add HATEOAS links
@@ -223,77 +246,29 @@ func (api *ToolAPI) getActionDetails(c *gin.Context) {
 	toolName := c.Param("tool")
 	actionName := c.Param("action")
 	
-	// Get allowed actions for the tool
-	var allowedActions []string
-	
-	switch toolName {
-	case "github":
-		allowedActions = []string{
-			"create_issue",
-			"close_issue",
-			"create_pull_request",
-			"merge_pull_request",
-			"add_comment",
-			"get_repository",
-			"list_repositories",
-			"get_pull_request",
-			"list_pull_requests",
-			"get_issue",
-			"list_issues",
-			"archive_repository",
-		}
-	case "harness":
-		allowedActions = []string{
-			"trigger_pipeline",
-			"get_pipeline_status",
-			"stop_pipeline",
-			"rollback_deployment",
-			"get_pipelines",
-		}
-	case "sonarqube":
-		allowedActions = []string{
-			"trigger_analysis",
-			"get_quality_gate_status",
-			"get_issues",
-			"get_metrics",
-			"get_projects",
-		}
-	case "artifactory":
-		allowedActions = []string{
-			"download_artifact",
-			"get_artifact_info",
-			"search_artifacts",
-			"get_build_info",
-			"get_repositories",
-		}
-	case "xray":
-		allowedActions = []string{
-			"scan_artifact",
-			"get_vulnerabilities",
-			"get_licenses",
-			"get_component_summary",
-		}
-	default:
-		c.JSON(http.StatusNotFound, gin.H{"error": "Tool not found"})
+	tool, ok := api.describeTool(c, toolName)
+	if !ok {
 		return
 	}
-	
-	// Check if the action exists for this tool
-	actionExists := false
-	for _, action := range allowedActions {
-		if action == actionName {
-			actionExists = true
-			break
-		}
+	if !tool.Described {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tool does not describe its actions"})
+		return
 	}
 	
-	if !actionExists {
+	action, found := core.FindAction(tool.Actions, actionName)
+	if !found {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Action not found for this tool"})
 		return
 	}
 	
-	// Get action description and parameters
-	actionDesc := getActionDescription(toolName, actionName)
+	actionDesc := gin.H{
+		"name":        action.Name,
+		"description": action.Description,
+		"parameters":  action.Params,
+		"result":      action.Result,
+		"safety":      action.Safety,
+		"scopes":      action.Scopes,
+	}
 	
 	// Add HATEOAS links
 	baseURL := getBaseURLFromContext(c)
@@ -306,49 +281,207 @@ func (api *ToolAPI) getActionDetails(c *gin.Context) {
 	c.JSON(http.StatusOK, actionDesc)
 }
 
-// getActionDescription returns detailed information about an action
-func getActionDescription(toolName, actionName string) map[string]interface{} {
-	// This would typically come from a database or configuration
-	// Here's a simplified example for a few actions
-	
-	actionDescriptions := map[string]map[string]map[string]interface{}{
-		"github": {
-			"create_issue": {
-				"name": "create_issue",
-				"description": "Creates a new issue in a GitHub repository",
-				"parameters": map[string]interface{}{
-					"owner": "Repository owner (organization or user)",
-					"repo": "Repository name",
-					"title": "Issue title",
-					"body": "Issue description",
-					"labels": "Array of label names",
-					"assignees": "Array of usernames to assign",
-				},
-				"required_parameters": []string{"owner", "repo", "title"},
-				"example": map[string]interface{}{
-					"owner": "octocat",
-					"repo": "hello-world",
-					"title": "Bug in login form",
-					"body": "The login form doesn't submit when using Safari",
-					"labels": []string{"bug", "frontend"},
-				},
-			},
-		},
+// describeTool describes a tool, responding with 404 if it is not found
+func (api *ToolAPI) describeTool(c *gin.Context, toolName string) (adapters.ToolDescription, bool) {
+	if api.catalog == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tool not found"})
+		return adapters.ToolDescription{}, false
+	}
+	
+	tool, err := api.catalog.DescribeTool(c.Request.Context(), toolName)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tool not found"})
+		return adapters.ToolDescription{}, false
+	}
+	
+	return tool, true
+}
+
+// executeAction runs an action after checking it against the tool's safety
+// policy, records it in the context and publishes its outcome. Errors are
+// added to the gin context for the error handler middleware.
+func (api *ToolAPI) executeAction(c *gin.Context, contextID, toolName, actionName string, params map[string]interface{}) (interface{}, bool) {
+	ctx := c.Request.Context()
+	
+	if !api.checkAction(c, contextID, toolName, actionName, params) {
+		return nil, false
+	}
+	
+	result, err := api.executor.ExecuteAction(ctx, contextID, toolName, actionName, params)
+	if err != nil {
+		api.publishAction(ctx, events.EventToolActionFailed, contextID, toolName, actionName, map[string]interface{}{
+			"params":     params,
+			"error":      err.Error(),
+			"error_code": adapterErrors.GetErrorCode(err),
+		})
+		c.Error(toolActionError(toolName, actionName, err))
+		return nil, false
+	}
+	
+	var recordErr error
+	if api.contextManager != nil {
+		recordErr = api.recordAction(ctx, contextID, toolName, actionName, params, result)
+	}
+	
+	api.publishAction(ctx, events.EventToolActionExecuted, contextID, toolName, actionName, map[string]interface{}{
+		"params":   params,
+		"result":   result,
+		"recorded": api.contextManager != nil && recordErr == nil,
+	})
+	
+	if recordErr != nil {
+		c.Error(NewInternalServerError("Action executed but could not be recorded in the context", recordErr))
+		return nil, false
+	}
+	
+	return result, true
+}
+
+// publishAction publishes a tool event for an action run by the API. Errors
+// are logged, since the action has already run.
+func (api *ToolAPI) publishAction(ctx context.Context, eventType events.EventType, contextID, toolName, actionName string, data map[string]interface{}) {
+	if api.eventBus == nil {
+		return
+	}
+	
+	if err := events.PublishToolEvent(api.eventBus, ctx, eventType, toolName, actionName, contextID, data); err != nil {
+		log.Printf("Warning: failed to publish %s event for action %s of tool %s: %v", eventType, actionName, toolName, err)
+	}
+}
+
+// checkAction checks an action against the tool's safety policy and that its
+// context exists before it is run
+func (api *ToolAPI) checkAction(c *gin.Context, contextID, toolName, actionName string, params map[string]interface{}) bool {
+	if api.executor == nil {
+		c.Error(NewAPIError(ErrToolNotFound, "Tool not found", http.StatusNotFound, nil))
+		return false
+	}
+	
+	safe, err := safety.GetCheckerForAdapter(toolName).IsSafeOperation(actionName, params)
+	if err != nil || !safe {
+		apiErr := NewForbiddenError(fmt.Sprintf("Action %s on tool %s is not allowed by the safety policy", actionName, toolName), err)
+		if err != nil {
+			apiErr = apiErr.WithDetails(err.Error())
+		}
+		c.Error(apiErr)
+		return false
+	}
+	
+	// Fail before running the action if it can't be recorded
+	if api.contextManager != nil {
+		if _, err := api.contextManager.GetContext(c.Request.Context(), contextID); err != nil {
+			c.Error(NewContextNotFoundError(contextID, err))
+			return false
+		}
+	}
+	
+	return true
+}
+
+// submitAction validates an action and submits it as a job, responding with
+// 202 and the pending job
+func (api *ToolAPI) submitAction(c *gin.Context, contextID, toolName, actionName string, params map[string]interface{}) {
+	if api.jobs == nil {
+		c.Error(NewAPIError(ErrServiceUnavailable, "Asynchronous actions are not available", http.StatusServiceUnavailable, nil))
+		return
 	}
 	
-	// Check if we have a detailed description for this action
-	if toolActions, ok := actionDescriptions[toolName]; ok {
-		if actionDesc, ok := toolActions[actionName]; ok {
-			return actionDesc
+	// Params are validated now since the job runs after the response
+	tool, ok := api.describeTool(c, toolName)
+	if !ok {
+		return
+	}
+	if tool.Described {
+		action, found := core.FindAction(tool.Actions, actionName)
+		if !found {
+			c.Error(NewAPIError(ErrActionNotFound, fmt.Sprintf("Action %s not found for tool %s", actionName, toolName), http.StatusNotFound, nil))
+			return
+		}
+		if err := action.ValidateParams(params); err != nil {
+			c.Error(NewAPIError(ErrActionInvalid, "Invalid action parameters", http.StatusBadRequest, err).WithDetails(err.Error()))
+			return
 		}
 	}
 	
-	// Return minimal information if no detailed description is available
-	return map[string]interface{}{
-		"name": actionName,
-		"description": fmt.Sprintf("%s action for %s", actionName, toolName),
-		"parameters": map[string]interface{}{},
+	if !api.checkAction(c, contextID, toolName, actionName, params) {
+		return
 	}
+	
+	job, err := api.jobs.Submit(c.Request.Context(), toolName, actionName, contextID, params)
+	if err != nil {
+		c.Error(NewAPIError(ErrServiceUnavailable, "Failed to submit the action", http.StatusServiceUnavailable, err))
+		return
+	}
+	
+	addJobLinks(c, job)
+	c.Header("Location", job.Links["self"])
+	c.JSON(http.StatusAccepted, job)
+}
+
+// recordAction appends an executed action and its result to a context
+func (api *ToolAPI) recordAction(ctx context.Context, contextID, toolName, actionName string, params map[string]interface{}, result interface{}) error {
+	content, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode action result: %w", err)
+	}
+	
+	item := mcp.ContextItem{
+		Role:      "tool",
+		Content:   string(content),
+		Timestamp: time.Now(),
+		Metadata: map[string]interface{}{
+			"tool":   toolName,
+			"action": actionName,
+			"params": params,
+		},
+	}
+	
+	_, err = api.contextManager.UpdateContext(ctx, contextID, &mcp.Context{
+		ID:      contextID,
+		Content: []mcp.ContextItem{item},
+	}, &mcp.ContextUpdateOptions{})
+	return err
+}
+
+// toolActionError maps an adapter error to an API error
+func toolActionError(toolName, actionName string, err error) *APIError {
+	switch {
+	case adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnsupportedOperation):
+		return NewAPIError(ErrActionNotFound, fmt.Sprintf("Action %s not found for tool %s", actionName, toolName), http.StatusNotFound, err)
+	case adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeInvalidParameter):
+		apiErr := NewAPIError(ErrActionInvalid, "Invalid action parameters", http.StatusBadRequest, err)
+		var schemaErr *core.SchemaError
+		if errors.As(err, &schemaErr) {
+			return apiErr.WithDetails(schemaErr.Error())
+		}
+		return apiErr.WithDetails(err.Error())
+	case adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeForbidden):
+		return NewForbiddenError(fmt.Sprintf("Action %s on tool %s is forbidden", actionName, toolName), err)
+	case adapterErrors.IsRateLimitError(err):
+		return NewAPIError(ErrTooManyRequests, fmt.Sprintf("Rate limit exceeded for tool %s", toolName), http.StatusTooManyRequests, err)
+	case adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeServiceUnavailable),
+		errors.Is(err, gobreaker.ErrOpenState), errors.Is(err, gobreaker.ErrTooManyRequests):
+		return NewAPIError(ErrServiceUnavailable, fmt.Sprintf("Tool %s is unavailable", toolName), http.StatusServiceUnavailable, err)
+	default:
+		return NewAPIError(ErrActionFailed, fmt.Sprintf("Action %s on tool %s failed", actionName, toolName), http.StatusInternalServerError, err).WithDetails(err.Error())
+	}
+}
+
+// describeTools describes all tools
+func (api *ToolAPI) describeTools(ctx context.Context) []adapters.ToolDescription {
+	if api.catalog == nil {
+		return []adapters.ToolDescription{}
+	}
+	return api.catalog.DescribeTools(ctx)
+}
+
+// actionNames returns the names of actions
+func actionNames(actions []core.ActionDescriptor) []string {
+	names := make([]string, 0, len(actions))
+	for _, action := range actions {
+		names = append(names, action.Name)
+	}
+	return names
 }
 
 // Helper function to get base URL from gin context
@@ -373,12 +506,15 @@ func getBaseURLFromContext(c *gin.Context) string {
 // @Produce json
 // @Param tool path string true "Tool name"
 // @Param context_id query string true "Context ID for tracking the operation"
-// @Param query body object true "Query parameters"
+// @Param query body object true "Query parameters with the name of the read action to run in action"
 // @Success 200 {object} object "Query results"
-// @Failure 400 {object} ErrorResponse "Invalid request or missing parameters"
+// @Failure 400 {object} ErrorResponse "Invalid request, missing parameters or an action that isn't described as a read"
 // @Failure 401 {object} ErrorResponse "Authentication required"
-// @Failure 404 {object} ErrorResponse "Tool not found"
+// @Failure 403 {object} ErrorResponse "Query denied by the safety policy"
+// @Failure 404 {object} ErrorResponse "Tool, action or context not found"
+// @Failure 429 {object} ErrorResponse "Tool rate limit exceeded"
 // @Failure 500 {object} ErrorResponse "Error executing the query"
+// @Failure 503 {object} ErrorResponse "Tool unavailable"
 // @Security ApiKeyAuth
 // @Security BearerAuth
 // @Router /tools/{tool}/queries [post]
@@ -398,21 +534,45 @@ func (api *ToolAPI) handleQueryToolData(c *gin.Context) {
 		return
 	}
 
-	// Mock implementation since adapterBridge is now an interface
-	// In a real implementation, we would use type assertions or call through an interface
-	// For now, we'll just return a mock result
-	result := map[string]interface{}{
-		"status": "success",
-		"message": fmt.Sprintf("Queried data from %s tool", toolName),
-		"tool": toolName,
-		"query_params": query,
-		"data": []map[string]interface{}{
-			{"id": "1", "name": "Example data item 1"},
-			{"id": "2", "name": "Example data item 2"},
-		},
+	// Queries name the read action to run, the other fields are its params
+	actionName, _ := query["action"].(string)
+	if actionName == "" {
+		c.Error(NewBadRequestError("action is required", nil))
+		return
 	}
+	delete(query, "action")
 
-	c.JSON(http.StatusOK, result)
+	// Only actions described as reading data can be run as queries
+	tool, ok := api.describeTool(c, toolName)
+	if !ok {
+		return
+	}
+	if !tool.Described {
+		c.Error(NewAPIError(ErrActionInvalid, fmt.Sprintf("Tool %s does not describe its actions, so it can't be queried", toolName), http.StatusBadRequest, nil))
+		return
+	}
+	action, found := core.FindAction(tool.Actions, actionName)
+	if !found {
+		c.Error(NewAPIError(ErrActionNotFound, fmt.Sprintf("Action %s not found for tool %s", actionName, toolName), http.StatusNotFound, nil))
+		return
+	}
+	if action.Safety != core.SafetyRead {
+		c.Error(NewAPIError(ErrActionInvalid, fmt.Sprintf("Action %s is not a read action", actionName), http.StatusBadRequest, nil))
+		return
+	}
+
+	result, ok := api.executeAction(c, contextID, toolName, actionName, query)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":       "success",
+		"tool":         toolName,
+		"action":       actionName,
+		"query_params": query,
+		"data":         result,
+	})
 }
 
 // @Summary List all available tools
@@ -430,60 +590,20 @@ func (api *ToolAPI) handleListAvailableTools(c *gin.Context) {
 	// Get base URL for HATEOAS links
 	baseURL := getBaseURLFromContext(c)
 	
-	// Create tool collection
-	toolsList := []map[string]interface{}{
-		{
-			"name": "github",
-			"description": "GitHub integration for repository, pull request, and code management",
-			"actions": []string{
-				"create_issue",
-				"close_issue",
-				"create_pull_request",
-				"merge_pull_request",
-				"add_comment",
-				"archive_repository", // Note: can archive but not delete
-			},
-			"safety_notes": "Cannot delete repositories for safety reasons",
-		},
-		{
-			"name": "harness",
-			"description": "Harness CI/CD integration for builds and deployments",
-			"actions": []string{
-				"trigger_pipeline",
-				"get_pipeline_status",
-				"stop_pipeline",
-				"rollback_deployment",
-			},
-			"safety_notes": "Cannot delete production feature flags for safety reasons",
-		},
-		{
-			"name": "sonarqube",
-			"description": "SonarQube integration for code quality analysis",
-			"actions": []string{
-				"trigger_analysis",
-				"get_quality_gate_status",
-				"get_issues",
-			},
-		},
-		{
-			"name": "artifactory",
-			"description": "JFrog Artifactory integration for artifact management (read-only)",
-			"actions": []string{
-				"download_artifact",
-				"get_artifact_info",
-				"search_artifacts",
+	// Create tool collection from the adapter descriptions
+	tools := api.describeTools(c.Request.Context())
+	toolsList := make([]map[string]interface{}, 0, len(tools))
+	for _, tool := range tools {
+		toolsList = append(toolsList, map[string]interface{}{
+			"name":      tool.Name,
+			"version":   tool.Version,
+			"described": tool.Described,
+			"actions":   actionNames(tool.Actions),
+			"_links": map[string]string{
+				"self":    fmt.Sprintf("%s/api/v1/tools/%s", baseURL, tool.Name),
+				"actions": fmt.Sprintf("%s/api/v1/tools/%s/actions", baseURL, tool.Name),
 			},
-			"safety_notes": "Read-only access for safety reasons (no upload or delete capabilities)",
-		},
-		{
-			"name": "xray",
-			"description": "JFrog Xray integration for security scanning",
-			"actions": []string{
-				"scan_artifact",
-				"get_vulnerabilities",
-				"get_licenses",
-			},
-		},
+		})
 	}
 	
 	// Add HATEOAS links for the tools collection
@@ -501,103 +621,27 @@ func (api *ToolAPI) handleListAvailableTools(c *gin.Context) {
 func (api *ToolAPI) handleListAllowedActions(c *gin.Context) {
 	toolName := c.Param("tool")
 
-	// In a real implementation, this would retrieve the list of allowed actions
-	// from the adapter based on safety restrictions
-	var allowedActions []string
-	var disallowedActions []string
-	var safetyNotes string
-
-	switch toolName {
-	case "github":
-		allowedActions = []string{
-			"create_issue",
-			"close_issue",
-			"create_pull_request",
-			"merge_pull_request",
-			"add_comment",
-			"get_repository",
-			"list_repositories",
-			"get_pull_request",
-			"list_pull_requests",
-			"get_issue",
-			"list_issues",
-			"archive_repository", // Can archive but not delete
-		}
-		disallowedActions = []string{
-			"delete_repository",
-			"delete_branch",
-			"delete_organization",
-		}
-		safetyNotes = "Repository deletion is restricted for safety reasons, but archiving is allowed."
-		
-	case "harness":
-		allowedActions = []string{
-			"trigger_pipeline",
-			"get_pipeline_status",
-			"stop_pipeline",
-			"rollback_deployment",
-			"get_pipelines",
-		}
-		disallowedActions = []string{
-			"delete_pipeline",
-			"delete_application",
-			"delete_environment",
-			"delete_service",
-		}
-		safetyNotes = "Pipeline and resource deletion operations are restricted for safety reasons."
-		
-	case "sonarqube":
-		allowedActions = []string{
-			"trigger_analysis",
-			"get_quality_gate_status",
-			"get_issues",
-			"get_metrics",
-			"get_projects",
-		}
-		disallowedActions = []string{
-			"delete_project",
-			"reset_quality_gate",
-		}
-		safetyNotes = "Project deletion and quality gate reset operations are restricted."
-		
-	case "artifactory":
-		allowedActions = []string{
-			"download_artifact",
-			"get_artifact_info",
-			"search_artifacts",
-			"get_build_info",
-			"get_repositories",
-		}
-		disallowedActions = []string{
-			"upload_artifact",
-			"delete_artifact",
-			"move_artifact",
-			"delete_repository",
-		}
-		safetyNotes = "Read-only access for safety reasons (no upload, delete, or modify operations allowed)."
-		
-	case "xray":
-		allowedActions = []string{
-			"scan_artifact",
-			"get_vulnerabilities",
-			"get_licenses",
-			"get_component_summary",
-		}
-		disallowedActions = []string{
-			"ignore_vulnerability",
-			"delete_scan",
-		}
-		safetyNotes = "Only scanning and information retrieval operations are supported."
-		
-	default:
-		c.JSON(http.StatusNotFound, gin.H{"error": "Tool not found"})
+	tool, ok := api.describeTool(c, toolName)
+	if !ok {
 		return
 	}
 
+	// Actions are grouped by safety class, so clients can tell read-only
+	// actions from actions that change or delete data
+	bySafety := map[core.SafetyClass][]string{
+		core.SafetyRead:        {},
+		core.SafetyWrite:       {},
+		core.SafetyDestructive: {},
+	}
+	for _, action := range tool.Actions {
+		bySafety[action.Safety] = append(bySafety[action.Safety], action.Name)
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"tool": toolName,
-		"allowed_actions": allowedActions,
-		"disallowed_actions": disallowedActions,
-		"safety_notes": safetyNotes,
+		"tool":            toolName,
+		"described":       tool.Described,
+		"allowed_actions": actionNames(tool.Actions),
+		"actions":         tool.Actions,
+		"by_safety":       bySafety,
 	})
 }
@@ -0,0 +1,254 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/webhooks"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/gin-gonic/gin"
+)
+
+// maxWebhookPayloadSize limits the size of accepted webhook payloads
+const maxWebhookPayloadSize = 10 << 20
+
+// WebhookReceiver verifies webhook deliveries for adapters
+type WebhookReceiver interface {
+	ReceiveWebhook(ctx context.Context, adapterType string, header http.Header, query url.Values, body []byte) ([]byte, error)
+}
+
+// WebhookInbox persists webhook deliveries and dispatches them to adapters
+type WebhookInbox interface {
+	Receive(ctx context.Context, adapterType string, eventType string, payload []byte) (*mcp.WebhookDelivery, error)
+	Get(ctx context.Context, id string) (*mcp.WebhookDelivery, error)
+	List(ctx context.Context, options webhooks.ListOptions) ([]*mcp.WebhookDelivery, error)
+	Replay(ctx context.Context, id string) (*mcp.WebhookDelivery, error)
+}
+
+// WebhookAPI handles webhooks sent by external services and the API
+// endpoints to inspect and replay their deliveries
+type WebhookAPI struct {
+	receiver WebhookReceiver
+	inbox    WebhookInbox
+}
+
+// NewWebhookAPI creates a new webhook API handler
+func NewWebhookAPI(receiver WebhookReceiver, inbox WebhookInbox) *WebhookAPI {
+	return &WebhookAPI{
+		receiver: receiver,
+		inbox:    inbox,
+	}
+}
+
+// RegisterIntakeRoutes registers the endpoint external services send
+// webhooks to. Deliveries are authenticated by their adapter, so the routes
+// are registered outside of the authenticated API.
+func (api *WebhookAPI) RegisterIntakeRoutes(router gin.IRoutes) {
+	router.POST("/webhooks/:adapter", api.receiveWebhook)
+}
+
+// RegisterRoutes registers the routes to inspect and replay deliveries
+func (api *WebhookAPI) RegisterRoutes(router *gin.RouterGroup) {
+	deliveryRoutes := router.Group("/webhooks/deliveries")
+	deliveryRoutes.GET("", api.listDeliveries)
+	deliveryRoutes.GET("/:id", api.getDelivery)
+	deliveryRoutes.POST("/:id/replay", api.replayDelivery)
+}
+
+// @Summary Receive webhook
+// @Description Receive a webhook for an adapter. The delivery is verified by the adapter and persisted before it is acknowledged, then handled asynchronously. Verified requests expecting an answer, such as Slack URL verification, are answered with 200 and not persisted. The event type is taken from the X-GitHub-Event, X-Gitlab-Event, X-Event-Key or X-Event-Type header, or the event_type query parameter.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param adapter path string true "Adapter name"
+// @Success 200 {object} object "Answer to a handshake, such as the challenge of a Slack URL verification"
+// @Success 202 {object} object "Accepted delivery with HATEOAS links"
+// @Failure 400 {object} ErrorResponse "Invalid payload"
+// @Failure 401 {object} ErrorResponse "Verification failed, or the adapter does not verify webhooks"
+// @Failure 404 {object} ErrorResponse "Adapter not found"
+// @Failure 500 {object} ErrorResponse "Adapter could not be created"
+// @Failure 503 {object} ErrorResponse "Delivery could not be persisted"
+// @Router /webhooks/{adapter} [post]
+// receiveWebhook persists a webhook delivery
+func (api *WebhookAPI) receiveWebhook(c *gin.Context) {
+	adapterType := c.Param("adapter")
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookPayloadSize))
+	if err != nil {
+		c.Error(NewBadRequestError("Failed to read webhook payload", err))
+		return
+	}
+
+	payload, err := api.receiver.ReceiveWebhook(c.Request.Context(), adapterType, c.Request.Header, c.Request.URL.Query(), body)
+	if err != nil {
+		c.Error(receiveWebhookError(adapterType, err))
+		return
+	}
+
+	// Handshakes such as Slack's URL verification are answered in the
+	// response instead of being persisted
+	if reply, ok := webhooks.Reply(adapterType, payload); ok {
+		c.JSON(http.StatusOK, reply)
+		return
+	}
+
+	eventType := webhooks.EventType(c.Request.Header, c.Request.URL.Query())
+	delivery, err := api.inbox.Receive(c.Request.Context(), adapterType, eventType, payload)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrInvalidPayload) {
+			c.Error(NewBadRequestError("Webhook payload must be JSON", err))
+			return
+		}
+		c.Error(NewAPIError(ErrServiceUnavailable, "Failed to persist webhook delivery", http.StatusServiceUnavailable, err))
+		return
+	}
+
+	addDeliveryLinks(c, delivery)
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+// @Summary List webhook deliveries
+// @Description List webhook deliveries, most recently received first. Use status=dead to list failed deliveries.
+// @Tags webhooks
+// @Produce json
+// @Param status query string false "Delivery status: pending, processing, processed or dead"
+// @Param adapter query string false "Adapter name"
+// @Param limit query int false "Maximum number of deliveries" default(50)
+// @Param offset query int false "Number of deliveries to skip"
+// @Success 200 {object} object "Deliveries"
+// @Failure 400 {object} ErrorResponse "Invalid filter"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks/deliveries [get]
+// listDeliveries lists webhook deliveries
+func (api *WebhookAPI) listDeliveries(c *gin.Context) {
+	options := webhooks.ListOptions{
+		Status:  mcp.WebhookDeliveryStatus(c.Query("status")),
+		Adapter: c.Query("adapter"),
+		Limit:   50,
+	}
+
+	switch options.Status {
+	case "", mcp.WebhookDeliveryPending, mcp.WebhookDeliveryProcessing, mcp.WebhookDeliveryProcessed, mcp.WebhookDeliveryDead:
+	default:
+		c.Error(NewBadRequestError(fmt.Sprintf("Invalid delivery status %s", options.Status), nil))
+		return
+	}
+
+	for param, value := range map[string]*int{"limit": &options.Limit, "offset": &options.Offset} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			c.Error(NewBadRequestError(fmt.Sprintf("Invalid %s %s", param, raw), err))
+			return
+		}
+		*value = parsed
+	}
+
+	deliveries, err := api.inbox.List(c.Request.Context(), options)
+	if err != nil {
+		c.Error(NewInternalServerError("Failed to list webhook deliveries", err))
+		return
+	}
+
+	for _, delivery := range deliveries {
+		addDeliveryLinks(c, delivery)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"deliveries": deliveries,
+		"count":      len(deliveries),
+	})
+}
+
+// @Summary Get webhook delivery
+// @Description Get a webhook delivery with its payload, status and last error
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Delivery ID"
+// @Success 200 {object} object "Delivery with HATEOAS links"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Delivery not found"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks/deliveries/{id} [get]
+// getDelivery returns a webhook delivery
+func (api *WebhookAPI) getDelivery(c *gin.Context) {
+	delivery, err := api.inbox.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(deliveryError(c.Param("id"), err))
+		return
+	}
+
+	addDeliveryLinks(c, delivery)
+	c.JSON(http.StatusOK, delivery)
+}
+
+// @Summary Replay webhook delivery
+// @Description Move a dead-lettered webhook delivery back to the inbox to be handled again
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Delivery ID"
+// @Success 200 {object} object "Replayed delivery with HATEOAS links"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Delivery not found"
+// @Failure 409 {object} ErrorResponse "Delivery is not dead-lettered"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks/deliveries/{id}/replay [post]
+// replayDelivery replays a dead-lettered webhook delivery
+func (api *WebhookAPI) replayDelivery(c *gin.Context) {
+	delivery, err := api.inbox.Replay(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(deliveryError(c.Param("id"), err))
+		return
+	}
+
+	addDeliveryLinks(c, delivery)
+	c.JSON(http.StatusOK, delivery)
+}
+
+// receiveWebhookError maps an error verifying a webhook to an API error
+func receiveWebhookError(adapterType string, err error) *APIError {
+	switch {
+	case errors.Is(err, core.ErrAdapterNotFound):
+		return NewAPIError(ErrToolNotFound, fmt.Sprintf("Adapter %s not found", adapterType), http.StatusNotFound, err)
+	case adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized):
+		return NewUnauthorizedError("Webhook verification failed", err)
+	case adapterErrors.IsValidationError(err):
+		return NewBadRequestError("Invalid webhook payload", err)
+	default:
+		return NewInternalServerError(fmt.Sprintf("Failed to verify webhook for adapter %s", adapterType), err)
+	}
+}
+
+// deliveryError maps an inbox error to an API error
+func deliveryError(id string, err error) *APIError {
+	switch {
+	case errors.Is(err, webhooks.ErrDeliveryNotFound):
+		return NewAPIError(ErrDeliveryNotFound, fmt.Sprintf("Webhook delivery with ID %s not found", id), http.StatusNotFound, err)
+	case errors.Is(err, webhooks.ErrDeliveryNotDead):
+		return NewAPIError(ErrConflict, fmt.Sprintf("Webhook delivery %s is not dead-lettered", id), http.StatusConflict, err)
+	default:
+		return NewInternalServerError("Failed to get webhook delivery", err)
+	}
+}
+
+// addDeliveryLinks adds HATEOAS links to a delivery
+func addDeliveryLinks(c *gin.Context, delivery *mcp.WebhookDelivery) {
+	baseURL := getBaseURLFromContext(c)
+	delivery.Links = map[string]string{
+		"self":   fmt.Sprintf("%s/api/v1/webhooks/deliveries/%s", baseURL, delivery.ID),
+		"replay": fmt.Sprintf("%s/api/v1/webhooks/deliveries/%s/replay", baseURL, delivery.ID),
+	}
+}
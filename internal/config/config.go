@@ -208,6 +208,9 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("engine.event_buffer_size", 1000)
 	v.SetDefault("engine.concurrency_limit", 5)
 	v.SetDefault("engine.event_timeout", 30*time.Second)
+	v.SetDefault("engine.correlation.enabled", true)
+	v.SetDefault("engine.event_bus.type", "memory")
+	v.SetDefault("engine.event_store.retention", 90*24*time.Hour)
 
 	// Metrics defaults
 	v.SetDefault("metrics.enabled", true)
@@ -0,0 +1,217 @@
+// Package contexts manages the contexts of agents: the conversations that
+// agents, tool actions and webhooks append items to. Contexts are kept by a
+// context storage provider.
+package contexts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/storage/providers"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/google/uuid"
+)
+
+// lockStripes is the number of locks serializing updates of contexts
+const lockStripes = 64
+
+var (
+	// ErrContextNotFound indicates a context doesn't exist
+	ErrContextNotFound = providers.ErrContextNotFound
+	// ErrInvalidContext indicates a context is missing required fields
+	ErrInvalidContext = errors.New("invalid context")
+)
+
+// Manager manages contexts kept by a context storage provider
+type Manager struct {
+	storage providers.ContextStorage
+
+	// locks serialize updates of a context so that concurrent appends aren't
+	// lost
+	locks [lockStripes]sync.Mutex
+}
+
+// NewManager creates a context manager
+func NewManager(storage providers.ContextStorage) *Manager {
+	return &Manager{storage: storage}
+}
+
+// CreateContext creates a new context. A context ID is generated if the
+// context has none.
+func (m *Manager) CreateContext(ctx context.Context, contextData *mcp.Context) (*mcp.Context, error) {
+	if contextData.AgentID == "" {
+		return nil, fmt.Errorf("%w: agent_id is required", ErrInvalidContext)
+	}
+	if contextData.ModelID == "" {
+		return nil, fmt.Errorf("%w: model_id is required", ErrInvalidContext)
+	}
+
+	if contextData.ID == "" {
+		contextData.ID = uuid.New().String()
+	}
+	now := time.Now().UTC()
+	contextData.CreatedAt = now
+	contextData.UpdatedAt = now
+	contextData.CurrentTokens = countTokens(contextData.Content)
+
+	if err := m.storage.StoreContext(ctx, contextData); err != nil {
+		return nil, fmt.Errorf("failed to create context: %w", err)
+	}
+	return contextData, nil
+}
+
+// GetContext gets a context by ID
+func (m *Manager) GetContext(ctx context.Context, contextID string) (*mcp.Context, error) {
+	return m.storage.GetContext(ctx, contextID)
+}
+
+// UpdateContext updates a context. The content of the update is appended to
+// the context unless the options replace it, and its metadata is merged into
+// the context's. Contexts over their max tokens lose their oldest items if
+// the options truncate them.
+func (m *Manager) UpdateContext(ctx context.Context, contextID string, update *mcp.Context, options *mcp.ContextUpdateOptions) (*mcp.Context, error) {
+	if options == nil {
+		options = &mcp.ContextUpdateOptions{}
+	}
+
+	lock := m.lock(contextID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	contextData, err := m.storage.GetContext(ctx, contextID)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.ReplaceContent {
+		contextData.Content = update.Content
+	} else {
+		contextData.Content = append(contextData.Content, update.Content...)
+	}
+	for key, value := range update.Metadata {
+		if contextData.Metadata == nil {
+			contextData.Metadata = make(map[string]interface{})
+		}
+		contextData.Metadata[key] = value
+	}
+	if update.MaxTokens > 0 {
+		contextData.MaxTokens = update.MaxTokens
+	}
+	if !update.ExpiresAt.IsZero() {
+		contextData.ExpiresAt = update.ExpiresAt
+	}
+
+	contextData.CurrentTokens = countTokens(contextData.Content)
+	if options.Truncate && contextData.MaxTokens > 0 {
+		truncate(contextData)
+	}
+	contextData.UpdatedAt = time.Now().UTC()
+
+	if err := m.storage.StoreContext(ctx, contextData); err != nil {
+		return nil, fmt.Errorf("failed to update context %s: %w", contextID, err)
+	}
+	return contextData, nil
+}
+
+// DeleteContext deletes a context
+func (m *Manager) DeleteContext(ctx context.Context, contextID string) error {
+	lock := m.lock(contextID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	return m.storage.DeleteContext(ctx, contextID)
+}
+
+// ListContexts lists the contexts of an agent, optionally in a session. The
+// "limit" option keeps the most recent contexts.
+func (m *Manager) ListContexts(ctx context.Context, agentID, sessionID string, options map[string]interface{}) ([]*mcp.Context, error) {
+	contexts, err := m.storage.ListContexts(ctx, agentID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit, ok := options["limit"].(int); ok && limit > 0 && len(contexts) > limit {
+		contexts = contexts[len(contexts)-limit:]
+	}
+	return contexts, nil
+}
+
+// SearchInContext returns the items of a context containing a text,
+// ignoring case
+func (m *Manager) SearchInContext(ctx context.Context, contextID, query string) ([]mcp.ContextItem, error) {
+	contextData, err := m.storage.GetContext(ctx, contextID)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.ToLower(query)
+	var items []mcp.ContextItem
+	for _, item := range contextData.Content {
+		if strings.Contains(strings.ToLower(item.Content), query) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// SummarizeContext summarizes the items of a context by role
+func (m *Manager) SummarizeContext(ctx context.Context, contextID string) (string, error) {
+	contextData, err := m.storage.GetContext(ctx, contextID)
+	if err != nil {
+		return "", err
+	}
+
+	var roles []string
+	counts := make(map[string]int)
+	for _, item := range contextData.Content {
+		if counts[item.Role] == 0 {
+			roles = append(roles, item.Role)
+		}
+		counts[item.Role]++
+	}
+
+	parts := make([]string, 0, len(roles))
+	for _, role := range roles {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[role], role))
+	}
+	summary := fmt.Sprintf("%d items, %d tokens", len(contextData.Content), contextData.CurrentTokens)
+	if len(parts) > 0 {
+		summary += " (" + strings.Join(parts, ", ") + ")"
+	}
+	return summary, nil
+}
+
+// lock returns the lock serializing updates of a context
+func (m *Manager) lock(contextID string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(contextID))
+	return &m.locks[h.Sum32()%lockStripes]
+}
+
+// countTokens returns the tokens of items. Items without a token count are
+// estimated at four characters a token.
+func countTokens(items []mcp.ContextItem) int {
+	total := 0
+	for i := range items {
+		if items[i].Tokens == 0 {
+			items[i].Tokens = (len(items[i].Content) + 3) / 4
+		}
+		total += items[i].Tokens
+	}
+	return total
+}
+
+// truncate drops the oldest items of a context until it fits its max tokens
+func truncate(contextData *mcp.Context) {
+	dropped := 0
+	for dropped < len(contextData.Content) && contextData.CurrentTokens > contextData.MaxTokens {
+		contextData.CurrentTokens -= contextData.Content[dropped].Tokens
+		dropped++
+	}
+	contextData.Content = contextData.Content[dropped:]
+}
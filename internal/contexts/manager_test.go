@@ -0,0 +1,139 @@
+package contexts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/S-Corkum/mcp-server/internal/storage/providers"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestContext creates a context with a system item
+func newTestContext(t *testing.T, manager *Manager) *mcp.Context {
+	t.Helper()
+
+	created, err := manager.CreateContext(context.Background(), &mcp.Context{
+		AgentID: "agent-1",
+		ModelID: "model-1",
+		Content: []mcp.ContextItem{{Role: "system", Content: "You are a helpful assistant.", Tokens: 8}},
+	})
+	require.NoError(t, err)
+	return created
+}
+
+func TestCreateContext(t *testing.T) {
+	manager := NewManager(providers.NewMemoryContextStorage())
+	ctx := context.Background()
+
+	created := newTestContext(t, manager)
+	assert.NotEmpty(t, created.ID)
+	assert.False(t, created.CreatedAt.IsZero())
+	assert.Equal(t, 8, created.CurrentTokens)
+
+	stored, err := manager.GetContext(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.Content, stored.Content)
+
+	_, err = manager.CreateContext(ctx, &mcp.Context{ModelID: "model-1"})
+	assert.ErrorIs(t, err, ErrInvalidContext)
+	assert.ErrorContains(t, err, "agent_id is required")
+
+	_, err = manager.GetContext(ctx, "missing")
+	assert.ErrorIs(t, err, ErrContextNotFound)
+}
+
+func TestUpdateContextAppendsContent(t *testing.T) {
+	manager := NewManager(providers.NewMemoryContextStorage())
+	created := newTestContext(t, manager)
+
+	updated, err := manager.UpdateContext(context.Background(), created.ID, &mcp.Context{
+		Content:  []mcp.ContextItem{{Role: "user", Content: "Hello, can you help me?", Tokens: 6}},
+		Metadata: map[string]interface{}{"summary": "greeting"},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, updated.Content, 2)
+	assert.Equal(t, "system", updated.Content[0].Role)
+	assert.Equal(t, "user", updated.Content[1].Role)
+	assert.Equal(t, 14, updated.CurrentTokens)
+	assert.Equal(t, "greeting", updated.Metadata["summary"])
+
+	_, err = manager.UpdateContext(context.Background(), "missing", &mcp.Context{}, nil)
+	assert.ErrorIs(t, err, ErrContextNotFound)
+}
+
+func TestUpdateContextReplacesContent(t *testing.T) {
+	manager := NewManager(providers.NewMemoryContextStorage())
+	created := newTestContext(t, manager)
+
+	updated, err := manager.UpdateContext(context.Background(), created.ID, &mcp.Context{
+		Content: []mcp.ContextItem{{Role: "user", Content: "Hello, can you help me?", Tokens: 6}},
+	}, &mcp.ContextUpdateOptions{ReplaceContent: true})
+	require.NoError(t, err)
+	require.Len(t, updated.Content, 1)
+	assert.Equal(t, "user", updated.Content[0].Role)
+	assert.Equal(t, 6, updated.CurrentTokens)
+}
+
+func TestUpdateContextTruncatesOldestItems(t *testing.T) {
+	manager := NewManager(providers.NewMemoryContextStorage())
+	created := newTestContext(t, manager)
+
+	updated, err := manager.UpdateContext(context.Background(), created.ID, &mcp.Context{
+		MaxTokens: 10,
+		Content: []mcp.ContextItem{
+			{Role: "user", Content: "first", Tokens: 4},
+			{Role: "user", Content: "second", Tokens: 4},
+		},
+	}, &mcp.ContextUpdateOptions{Truncate: true})
+	require.NoError(t, err)
+	require.Len(t, updated.Content, 2)
+	assert.Equal(t, "first", updated.Content[0].Content)
+	assert.Equal(t, 8, updated.CurrentTokens)
+}
+
+func TestSearchAndSummarizeContext(t *testing.T) {
+	manager := NewManager(providers.NewMemoryContextStorage())
+	ctx := context.Background()
+	created := newTestContext(t, manager)
+
+	_, err := manager.UpdateContext(ctx, created.ID, &mcp.Context{
+		Content: []mcp.ContextItem{
+			{Role: "tool", Content: "Build 42 FAILED", Tokens: 4},
+			{Role: "tool", Content: "Build 43 passed", Tokens: 4},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	items, err := manager.SearchInContext(ctx, created.ID, "failed")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Build 42 FAILED", items[0].Content)
+
+	summary, err := manager.SummarizeContext(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "3 items, 16 tokens (1 system, 2 tool)", summary)
+}
+
+func TestListAndDeleteContexts(t *testing.T) {
+	manager := NewManager(providers.NewMemoryContextStorage())
+	ctx := context.Background()
+	first := newTestContext(t, manager)
+	second := newTestContext(t, manager)
+
+	listed, err := manager.ListContexts(ctx, "agent-1", "", nil)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+
+	listed, err = manager.ListContexts(ctx, "agent-1", "", map[string]interface{}{"limit": 1})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, second.ID, listed[0].ID)
+
+	require.NoError(t, manager.DeleteContext(ctx, first.ID))
+	assert.ErrorIs(t, manager.DeleteContext(ctx, first.ID), ErrContextNotFound)
+	listed, err = manager.ListContexts(ctx, "agent-1", "", nil)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+}
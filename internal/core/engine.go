@@ -2,20 +2,42 @@ package core
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/S-Corkum/mcp-server/internal/adapters"
+	adapterCore "github.com/S-Corkum/mcp-server/internal/adapters/core"
 	"github.com/S-Corkum/mcp-server/internal/cache"
+	appConfig "github.com/S-Corkum/mcp-server/internal/config"
+	"github.com/S-Corkum/mcp-server/internal/contexts"
+	"github.com/S-Corkum/mcp-server/internal/correlation"
 	"github.com/S-Corkum/mcp-server/internal/database"
+	"github.com/S-Corkum/mcp-server/internal/events"
 	"github.com/S-Corkum/mcp-server/internal/events/system"
+	"github.com/S-Corkum/mcp-server/internal/eventstore"
 	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/S-Corkum/mcp-server/internal/jobs"
 	"github.com/S-Corkum/mcp-server/internal/metrics"
 	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/internal/storage/providers"
+	"github.com/S-Corkum/mcp-server/internal/subscriptions"
+	"github.com/S-Corkum/mcp-server/internal/webhooks"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
 )
 
 // Engine is the core engine of the MCP server
 type Engine struct {
 	adapterManager *adapters.AdapterManager
+	eventBus       events.Bus
+	broadcaster    *events.Broadcaster
+	eventStore     *eventstore.Recorder
+	jobs           *jobs.Manager
+	webhooks       *webhooks.Inbox
+	correlator     *correlation.Correlator
+	subscriptions  *subscriptions.Manager
+	contextManager interfaces.ContextManager
 	config         interfaces.CoreConfig
 	metricsClient  metrics.Client
 	logger         *observability.Logger
@@ -36,21 +58,241 @@ func NewEngine(
 	// Create a mock event bus for now to resolve the interface issue
 	mockEventBus := &mockEventBus{}
 
-	// Create adapter manager with nil config for now
-	// This works because the adapter manager checks for nil config internally
-	adapterManager := adapters.NewAdapterManager(nil, nil, mockEventBus, logger, observability.NewMetricsClient())
+	// Create adapter manager with the adapter configuration, which holds the
+	// secrets adapters verify webhooks with
+	adapterManager := adapters.NewAdapterManager(&appConfig.Config{Adapters: config.Adapters}, nil, mockEventBus, logger, observability.NewMetricsClient())
+
+	// Tool events are published on the event bus. The Redis bus shares them
+	// between the servers of a cluster. Handlers subscribed without a timeout
+	// are limited by event_timeout.
+	var eventBus events.Bus
+	var memoryBus *events.EventBus
+	switch config.EventBus.Type {
+	case "", "memory":
+		memoryBus = events.NewEventBus(config.ConcurrencyLimit).
+			WithPartitionKeys(config.EventBus.PartitionKeys).
+			WithHandlerTimeout(config.EventTimeout)
+		eventBus = memoryBus
+	case "redis":
+		busConfig := config.EventBus
+		if busConfig.Workers == 0 {
+			busConfig.Workers = config.ConcurrencyLimit
+		}
+		redisBus, err := events.NewRedisBus(busConfig, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Redis event bus: %w", err)
+		}
+		eventBus = redisBus.WithHandlerTimeout(config.EventTimeout)
+	default:
+		return nil, fmt.Errorf("unsupported event bus type: %s", config.EventBus.Type)
+	}
+
+	// Tool actions and webhooks are recorded in contexts, kept in Postgres or
+	// in memory without a database. Actions completed by jobs are appended to
+	// their context by the context event handler.
+	var contextStorage providers.ContextStorage
+	if db != nil {
+		contextStorage = providers.NewPostgresContextStorage(db.GetDB())
+	} else {
+		contextStorage = providers.NewMemoryContextStorage()
+	}
+	contextManager := contexts.NewManager(contextStorage)
+	events.NewContextEventHandler(contextManager).RegisterWithEventBus(eventBus)
+
+	// Jobs are persisted in Postgres, or in Redis without a database
+	var jobStore jobs.Store
+	switch {
+	case db != nil:
+		jobStore = jobs.NewPostgresStore(db.GetDB())
+	case cacheClient != nil:
+		jobStore = jobs.NewCacheStore(cacheClient, 0)
+	default:
+		jobStore = jobs.NewMemoryStore()
+	}
+	jobConfig := jobs.DefaultConfig()
+	jobConfig.Timeout = config.MaxToolDuration
+	jobManager := jobs.NewManager(jobStore, adapterManager, jobConfig, logger).WithEventBus(eventBus)
+
+	// Webhooks are recorded in the contexts of their correlation keys
+	var correlator *correlation.Correlator
+	if config.Correlation.Enabled {
+		var correlationStore correlation.Store
+		if db != nil {
+			correlationStore = correlation.NewPostgresStore(db.GetDB())
+		} else {
+			correlationStore = correlation.NewMemoryStore()
+		}
+		var err error
+		correlator, err = correlation.New(config.Correlation, config.DefaultModelID, correlationStore, contextManager, logger)
+		if err != nil {
+			eventBus.Close()
+			return nil, fmt.Errorf("invalid correlation config: %w", err)
+		}
+	}
+
+	// Every event is recorded in the event store
+	var eventStore eventstore.Store
+	switch config.EventStore.Type {
+	case "":
+		if db != nil {
+			eventStore = eventstore.NewPostgresStore(db.GetDB())
+		} else {
+			eventStore = eventstore.NewMemoryStore()
+		}
+	case "postgres":
+		if db == nil {
+			eventBus.Close()
+			return nil, fmt.Errorf("the postgres event store needs a database")
+		}
+		eventStore = eventstore.NewPostgresStore(db.GetDB())
+	case "file":
+		fileStore, err := eventstore.NewFileStore(config.EventStore.Path)
+		if err != nil {
+			eventBus.Close()
+			return nil, fmt.Errorf("failed to create file event store: %w", err)
+		}
+		eventStore = fileStore
+	case "memory":
+		eventStore = eventstore.NewMemoryStore()
+	default:
+		eventBus.Close()
+		return nil, fmt.Errorf("unsupported event store type: %s", config.EventStore.Type)
+	}
 
 	// Create engine
 	engine := &Engine{
 		adapterManager: adapterManager,
+		eventBus:       eventBus,
+		jobs:           jobManager,
+		correlator:     correlator,
+		contextManager: contextManager,
 		config:         config,
 		metricsClient:  metricsClient,
 		logger:         logger,
 	}
 
+	// Webhooks are persisted in the inbox before they are acknowledged
+	var webhookStore webhooks.Store
+	if db != nil {
+		webhookStore = webhooks.NewPostgresStore(db.GetDB())
+	} else {
+		webhookStore = webhooks.NewMemoryStore()
+	}
+	engine.webhooks = webhooks.NewInbox(webhookStore, webhooks.HandlerFunc(engine.dispatchWebhook), webhooks.DefaultConfig(), logger)
+	engine.webhooks.Start()
+
+	// Subscribed events are sent to external consumers as signed webhooks
+	var subscriptionStore subscriptions.Store
+	if db != nil {
+		subscriptionStore = subscriptions.NewPostgresStore(db.GetDB())
+	} else {
+		subscriptionStore = subscriptions.NewMemoryStore()
+	}
+	subscriptionConfig := subscriptions.DefaultConfig()
+	subscriptionConfig.AllowPrivateTargets = config.Subscriptions.AllowPrivateTargets
+	engine.subscriptions = subscriptions.NewManager(subscriptionStore, subscriptionConfig, logger)
+	engine.subscriptions.Subscribe(eventBus, subscriptions.DefaultEventTypes)
+	engine.subscriptions.Start()
+
+	adapterManager.OnHealthChange(engine.publishAdapterHealth)
+	adapterManager.OnAdapterCreated(engine.configureAdapter)
+
+	// Events published on the bus and emitted by adapters are recorded
+	recorderConfig := eventstore.Config{
+		Retention:     config.EventStore.Retention,
+		PruneInterval: config.EventStore.PruneInterval,
+	}
+	for _, policy := range config.EventStore.Policies {
+		recorderConfig.Policies = append(recorderConfig.Policies, eventstore.RetentionPolicy{
+			Types:  policy.Types,
+			MaxAge: policy.MaxAge,
+		})
+	}
+	engine.eventStore = eventstore.NewRecorder(eventStore, recorderConfig, logger)
+	engine.eventStore.Attach(eventBus, events.AllEventTypes)
+	adapterManager.SubscribeEvents(engine.eventStore)
+	engine.eventStore.Start()
+
+	// Events the memory bus's handlers fail are recorded as dead letters. The
+	// Redis bus dead-letters them in its own stream.
+	if memoryBus != nil {
+		memoryBus.WithDeadLetterSink(engine.eventStore)
+	}
+
+	// Events are streamed live to API clients watching them
+	engine.broadcaster = events.NewBroadcaster(events.DefaultReplaySize)
+	engine.broadcaster.Attach(eventBus, events.AllEventTypes)
+
 	return engine, nil
 }
 
+// AdapterManager returns the adapter manager
+func (e *Engine) AdapterManager() *adapters.AdapterManager {
+	return e.adapterManager
+}
+
+// Jobs returns the manager of asynchronous tool actions
+func (e *Engine) Jobs() *jobs.Manager {
+	return e.jobs
+}
+
+// Webhooks returns the inbox of webhook deliveries
+func (e *Engine) Webhooks() *webhooks.Inbox {
+	return e.webhooks
+}
+
+// EventBus returns the bus context and tool events are published on
+func (e *Engine) EventBus() events.Bus {
+	return e.eventBus
+}
+
+// EventStream returns the broadcaster streaming events to API clients
+func (e *Engine) EventStream() *events.Broadcaster {
+	return e.broadcaster
+}
+
+// EventStore returns the recorder of the event history
+func (e *Engine) EventStore() *eventstore.Recorder {
+	return e.eventStore
+}
+
+// Subscriptions returns the manager sending events to external consumers
+func (e *Engine) Subscriptions() *subscriptions.Manager {
+	return e.subscriptions
+}
+
+// publishAdapterHealth publishes a change of an adapter's health status
+func (e *Engine) publishAdapterHealth(adapterType, oldStatus, newStatus string) {
+	event := &mcp.Event{
+		Source:    "mcp-server",
+		Type:      string(events.EventAdapterHealthChanged),
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"adapter":    adapterType,
+			"old_status": oldStatus,
+			"new_status": newStatus,
+		},
+	}
+	if err := e.eventBus.Publish(context.Background(), event); err != nil {
+		e.logger.Warn("Failed to publish adapter health change", map[string]interface{}{
+			"adapter": adapterType,
+			"error":   err.Error(),
+		})
+	}
+}
+
+// Correlator returns the correlator recording webhooks in contexts, or nil
+// if correlation is disabled
+func (e *Engine) Correlator() *correlation.Correlator {
+	return e.correlator
+}
+
+// ContextManager returns the manager of the contexts tool actions and
+// webhooks are recorded in
+func (e *Engine) ContextManager() interfaces.ContextManager {
+	return e.contextManager
+}
+
 // GetAdapter gets an adapter by type
 func (e *Engine) GetAdapter(adapterType string) (interface{}, error) {
 	return e.adapterManager.GetAdapter(adapterType)
@@ -63,24 +305,133 @@ func (e *Engine) ExecuteAdapterAction(ctx context.Context, contextID string, ada
 	return e.adapterManager.ExecuteAction(ctx, contextID, adapterType, action, params)
 }
 
-// HandleAdapterWebhook handles a webhook event using the appropriate adapter
+// HandleAdapterWebhook persists a webhook event in the inbox, which passes it
+// to the appropriate adapter asynchronously. The event is not lost if the
+// server stops before the adapter handled it.
 func (e *Engine) HandleAdapterWebhook(ctx context.Context, adapterType string, eventType string, payload []byte) error {
-	// Basic implementation that returns nil
-	// In a real implementation, this would forward the webhook to the appropriate adapter
+	_, err := e.webhooks.Receive(ctx, adapterType, eventType, payload)
+	return err
+}
+
+// configureAdapter passes the engine's services to an adapter created by the
+// adapter manager. Adapters that track their own webhooks record them in
+// contexts through the engine, and adapters that record the outcome of their
+// actions update contexts through the context manager.
+func (e *Engine) configureAdapter(adapterType string, adapter adapterCore.Adapter) {
+	if setter, ok := adapter.(adapterCore.ContextRecorderSetter); ok {
+		setter.SetContextRecorder(e)
+	}
+	if setter, ok := adapter.(adapterCore.ContextUpdaterSetter); ok {
+		setter.SetContextUpdater(e.contextManager)
+	}
+}
+
+// dispatchWebhook passes a webhook from the inbox to its adapter, then
+// records it in the context of its correlation key unless the adapter
+// recorded it itself. Correlation failures are logged rather than returned,
+// so the adapter does not handle the webhook again when the inbox retries it.
+func (e *Engine) dispatchWebhook(ctx context.Context, adapterType string, eventType string, payload []byte) error {
+	if err := e.adapterManager.HandleWebhook(ctx, adapterType, eventType, payload); err != nil {
+		return err
+	}
+
+	if e.correlator == nil {
+		return nil
+	}
+	if adapter, err := e.adapterManager.GetAdapter(adapterType); err == nil {
+		if _, ok := adapter.(adapterCore.ContextRecorderSetter); ok {
+			return nil
+		}
+	}
+	if _, err := e.correlator.Correlate(ctx, adapterType, eventType, payload); err != nil {
+		e.logger.Warn("Failed to record webhook in context", map[string]interface{}{
+			"adapter":   adapterType,
+			"eventType": eventType,
+			"error":     err.Error(),
+		})
+	}
 	return nil
 }
 
-// RecordWebhookInContext records a webhook event in a context
+// RecordWebhookInContext records a webhook event in the context of its
+// correlation key and returns the context ID. Adapters that track their own
+// keys pass them as context_key in the payload, with the context_id of
+// earlier events if known; other payloads are keyed by the correlation
+// rules. An empty ID is returned if the webhook has no key.
 func (e *Engine) RecordWebhookInContext(ctx context.Context, agentID string, adapterType string, eventType string, payload interface{}) (string, error) {
-	// Basic implementation that returns empty string and nil error
-	// In a real implementation, this would record the webhook in the agent's context
-	return "", nil
+	if e.correlator == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", fmt.Errorf("webhook payload must be a JSON object: %w", err)
+	}
+
+	event := correlation.Event{
+		AgentID:   agentID,
+		Adapter:   adapterType,
+		EventType: eventType,
+		Payload:   fields,
+	}
+	event.Key, _ = fields["context_key"].(string)
+	event.ContextID, _ = fields["context_id"].(string)
+	if event.Key == "" {
+		var ok bool
+		if event.Key, event.Rule, ok = e.correlator.Key(adapterType, eventType, fields); !ok {
+			return "", nil
+		}
+	}
+
+	return e.correlator.Record(ctx, event)
 }
 
 
 
 // Shutdown performs a graceful shutdown of the engine
 func (e *Engine) Shutdown(ctx context.Context) error {
+	// Interrupt running jobs before their adapters are closed
+	if e.jobs != nil {
+		if err := e.jobs.Shutdown(ctx); err != nil {
+			e.logger.Warn("Error shutting down jobs", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	// Stop dispatching webhooks. Deliveries still pending are dispatched
+	// when the server starts again.
+	if e.webhooks != nil {
+		if err := e.webhooks.Stop(ctx); err != nil {
+			e.logger.Warn("Error stopping webhook inbox", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	// Stop sending subscribed events. Pending deliveries are sent when the
+	// server starts again.
+	if e.subscriptions != nil {
+		if err := e.subscriptions.Stop(ctx); err != nil {
+			e.logger.Warn("Error stopping subscription deliveries", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	// Stop deleting expired events
+	if e.eventStore != nil {
+		if err := e.eventStore.Stop(ctx); err != nil {
+			e.logger.Warn("Error stopping event store", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
+
 	// Shutdown adapter manager
 	if e.adapterManager != nil {
 		if err := e.adapterManager.Shutdown(ctx); err != nil {
@@ -90,6 +441,10 @@ func (e *Engine) Shutdown(ctx context.Context) error {
 		}
 	}
 
+	if e.eventBus != nil {
+		e.eventBus.Close()
+	}
+
 	return nil
 }
 
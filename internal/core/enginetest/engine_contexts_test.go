@@ -0,0 +1,126 @@
+// Package enginetest tests the engine end to end with in-memory state.
+package enginetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/pagerduty"
+	"github.com/S-Corkum/mcp-server/internal/core"
+	"github.com/S-Corkum/mcp-server/internal/correlation"
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestEngine creates an engine without a database or cache, which keeps
+// its state in memory
+func newTestEngine(t *testing.T, config interfaces.CoreConfig) *core.Engine {
+	t.Helper()
+
+	engine, err := core.NewEngine(context.Background(), config, nil, nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		engine.Shutdown(context.Background())
+	})
+	return engine
+}
+
+// waitForContent waits for a context to have a number of items
+func waitForContent(t *testing.T, engine *core.Engine, contextID string, items int) *mcp.Context {
+	t.Helper()
+
+	var stored *mcp.Context
+	require.Eventually(t, func() bool {
+		var err error
+		stored, err = engine.ContextManager().GetContext(context.Background(), contextID)
+		require.NoError(t, err)
+		return len(stored.Content) == items
+	}, 5*time.Second, 10*time.Millisecond, "the context should have %d items", items)
+	return stored
+}
+
+func TestEngineRecordsCompletedJobsInContexts(t *testing.T) {
+	engine := newTestEngine(t, interfaces.CoreConfig{})
+	ctx := context.Background()
+
+	created, err := engine.ContextManager().CreateContext(ctx, &mcp.Context{AgentID: "agent-1", ModelID: "model-1"})
+	require.NoError(t, err)
+
+	job, err := engine.Jobs().Submit(ctx, "github", "getRepository", created.ID, map[string]interface{}{
+		"owner": "example-user",
+		"repo":  "example-repo",
+	})
+	require.NoError(t, err)
+
+	stored := waitForContent(t, engine, created.ID, 1)
+	item := stored.Content[0]
+	assert.Equal(t, "tool", item.Role)
+	assert.Equal(t, "github", item.Metadata["tool"])
+	assert.Equal(t, "getRepository", item.Metadata["action"])
+	assert.Contains(t, item.Content, "example-repo")
+
+	done, err := engine.Jobs().Get(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusSucceeded, done.Status)
+}
+
+func TestEngineRecordsWebhooksInContexts(t *testing.T) {
+	engine := newTestEngine(t, interfaces.CoreConfig{
+		Correlation: interfaces.CorrelationConfig{Enabled: true},
+	})
+	ctx := context.Background()
+
+	opened := []byte(`{"action":"opened","repository":{"full_name":"org/repo"},"pull_request":{"number":42}}`)
+	synchronized := []byte(`{"action":"synchronize","repository":{"full_name":"org/repo"},"pull_request":{"number":42}}`)
+	for _, payload := range [][]byte{opened, synchronized} {
+		_, err := engine.Webhooks().Receive(ctx, "github", "pull_request", payload)
+		require.NoError(t, err)
+	}
+
+	var contextID string
+	require.Eventually(t, func() bool {
+		contextID, _ = engine.Correlator().ContextID(ctx, "github:org/repo#42")
+		return contextID != ""
+	}, 5*time.Second, 10*time.Millisecond, "the pull request should be bound to a context")
+
+	stored := waitForContent(t, engine, contextID, 2)
+	assert.Equal(t, correlation.DefaultAgentID, stored.AgentID)
+	assert.Equal(t, "github:org/repo#42", stored.Metadata["correlation_key"])
+	for _, item := range stored.Content {
+		assert.Equal(t, "webhook", item.Role)
+		assert.Equal(t, "pull_request", item.Metadata["event_type"])
+	}
+}
+
+func TestEngineRecordsAdapterTrackedWebhooksInContexts(t *testing.T) {
+	engine := newTestEngine(t, interfaces.CoreConfig{
+		Correlation: interfaces.CorrelationConfig{Enabled: true},
+	})
+	ctx := context.Background()
+
+	incident := `{"id":"PT4KHLK","type":"incident","title":"Checkout latency above SLO","status":"triggered","service":{"summary":"checkout-api"}}`
+	note := `{"id":"N1","type":"incident_note","content":"Rolling back","incident":{"id":"PT4KHLK"}}`
+	for _, event := range []struct{ eventType, data string }{
+		{"incident.triggered", incident},
+		{"incident.annotated", note},
+	} {
+		payload := []byte(`{"event":{"id":"01EV","event_type":"` + event.eventType + `","resource_type":"incident","data":` + event.data + `}}`)
+		_, err := engine.Webhooks().Receive(ctx, "pagerduty", event.eventType, payload)
+		require.NoError(t, err)
+	}
+
+	key := pagerduty.IncidentContextKey("PT4KHLK")
+	var contextID string
+	require.Eventually(t, func() bool {
+		contextID, _ = engine.Correlator().ContextID(ctx, key)
+		return contextID != ""
+	}, 5*time.Second, 10*time.Millisecond, "the incident should be bound to a context")
+
+	stored := waitForContent(t, engine, contextID, 2)
+	assert.Equal(t, key, stored.Metadata["correlation_key"])
+	assert.Equal(t, "incident.triggered", stored.Content[0].Metadata["event_type"])
+	assert.Equal(t, "incident.annotated", stored.Content[1].Metadata["event_type"])
+}
@@ -0,0 +1,43 @@
+package enginetest
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngineVerifiesWebhooksWithAdapterSecrets(t *testing.T) {
+	engine := newTestEngine(t, interfaces.CoreConfig{
+		Adapters: map[string]interface{}{
+			"github": map[string]interface{}{"webhook_secret": "s3cret"},
+		},
+	})
+	ctx := context.Background()
+	payload := []byte(`{"action":"opened","repository":{"full_name":"org/repo"}}`)
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(payload)
+	signed := http.Header{"X-Hub-Signature-256": {"sha256=" + hex.EncodeToString(mac.Sum(nil))}}
+
+	received, err := engine.AdapterManager().ReceiveWebhook(ctx, "github", signed, nil, payload)
+	require.NoError(t, err)
+	assert.Equal(t, payload, received)
+
+	_, err = engine.AdapterManager().ReceiveWebhook(ctx, "github", http.Header{}, nil, payload)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized), "unsigned deliveries are rejected")
+
+	_, err = engine.AdapterManager().ReceiveWebhook(ctx, "xray", http.Header{}, nil, payload)
+	assert.True(t, adapterErrors.IsSpecificErrorCode(err, adapterErrors.ErrCodeUnauthorized), "adapters without a webhook secret reject deliveries")
+
+	_, err = engine.AdapterManager().ReceiveWebhook(ctx, "unknown", http.Header{}, nil, payload)
+	assert.ErrorIs(t, err, core.ErrAdapterNotFound)
+}
@@ -3,7 +3,6 @@ package mocks
 import (
 	"context"
 
-	"github.com/S-Corkum/mcp-server/internal/adapters"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -13,12 +12,12 @@ type MockEngine struct {
 }
 
 // GetAdapter mocks the GetAdapter method
-func (m *MockEngine) GetAdapter(name string) (adapters.Adapter, error) {
+func (m *MockEngine) GetAdapter(name string) (interface{}, error) {
 	args := m.Called(name)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(adapters.Adapter), args.Error(1)
+	return args.Get(0), args.Error(1)
 }
 
 // Health mocks the Health method
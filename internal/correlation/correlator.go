@@ -0,0 +1,300 @@
+// Package correlation records webhooks in the contexts they belong to.
+// Rules map webhook payload fields to a correlation key, such as a
+// repository and pull request number; webhooks with the same key are
+// appended to the same context, which is created by the first of them or
+// bound to an existing context by an agent following the key.
+package correlation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/google/uuid"
+)
+
+// DefaultAgentID owns the contexts created for webhooks when no agent is
+// configured
+const DefaultAgentID = "webhooks"
+
+// DefaultModelID is the model of the contexts created for webhooks when no
+// default model is configured
+const DefaultModelID = "default"
+
+// maxContentLength limits the payload text of a context item. The full
+// payload is kept in the item metadata.
+const maxContentLength = 4000
+
+// lockStripes is the number of locks serializing webhooks by key
+const lockStripes = 64
+
+// ErrNoContextManager is returned when a correlator is created without a
+// context manager
+var ErrNoContextManager = errors.New("no context manager")
+
+// Event is a webhook to record in a context
+type Event struct {
+	// Key is the correlation key of the webhook
+	Key string
+
+	// Rule is the name of the rule that produced the key, if any
+	Rule string
+
+	// AgentID owns the context if a new one is created. The configured
+	// agent is used if empty.
+	AgentID string
+
+	// ContextID binds the key to a context. If empty, the context bound to
+	// the key is used, or a new one created.
+	ContextID string
+
+	Adapter   string
+	EventType string
+	Payload   interface{}
+}
+
+// Correlator records webhooks in the contexts of their correlation keys
+type Correlator struct {
+	rules   []*rule
+	store   Store
+	agentID string
+	modelID string
+	logger  *observability.Logger
+
+	// contexts records webhooks in contexts
+	contexts interfaces.ContextManager
+
+	// locks serialize webhooks of a key so that one context is created for it
+	locks [lockStripes]sync.Mutex
+}
+
+// New creates a correlator recording webhooks in the contexts of a context
+// manager. The default rules are used if the config has none.
+func New(config interfaces.CorrelationConfig, modelID string, store Store, contexts interfaces.ContextManager, logger *observability.Logger) (*Correlator, error) {
+	if contexts == nil {
+		return nil, ErrNoContextManager
+	}
+	if logger == nil {
+		logger = observability.NewLogger("correlation")
+	}
+
+	configured := config.Rules
+	if len(configured) == 0 {
+		configured = DefaultRules()
+	}
+
+	rules := make([]*rule, 0, len(configured))
+	for _, ruleConfig := range configured {
+		compiled, err := compileRule(ruleConfig)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, compiled)
+	}
+
+	agentID := config.AgentID
+	if agentID == "" {
+		agentID = DefaultAgentID
+	}
+	if modelID == "" {
+		modelID = DefaultModelID
+	}
+
+	return &Correlator{
+		rules:    rules,
+		store:    store,
+		contexts: contexts,
+		agentID:  agentID,
+		modelID:  modelID,
+		logger:   logger,
+	}, nil
+}
+
+// Key returns the correlation key of a webhook and the rule that produced
+// it. The first matching rule wins.
+func (c *Correlator) Key(adapter, eventType string, payload map[string]interface{}) (string, string, bool) {
+	for _, r := range c.rules {
+		if key, ok := r.key(adapter, eventType, payload); ok {
+			return key, r.name, true
+		}
+	}
+	return "", "", false
+}
+
+// Correlate records a webhook in the context of its correlation key. It
+// returns the ID of the context, or an empty ID if no rule matches the
+// webhook.
+func (c *Correlator) Correlate(ctx context.Context, adapter, eventType string, payload []byte) (string, error) {
+	decoded, err := decodePayload(payload)
+	if err != nil {
+		// Only JSON payloads can be correlated
+		return "", nil
+	}
+
+	key, ruleName, ok := c.Key(adapter, eventType, decoded)
+	if !ok {
+		return "", nil
+	}
+
+	return c.Record(ctx, Event{
+		Key:       key,
+		Rule:      ruleName,
+		Adapter:   adapter,
+		EventType: eventType,
+		Payload:   decoded,
+	})
+}
+
+// Record appends a webhook to the context bound to its key, binding the
+// key to a new context if there is none
+func (c *Correlator) Record(ctx context.Context, event Event) (string, error) {
+	lock := c.lock(event.Key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	item := newContextItem(event)
+
+	contextID := event.ContextID
+	if contextID == "" {
+		var err error
+		contextID, err = c.store.Get(ctx, event.Key)
+		if err != nil && !errors.Is(err, ErrKeyNotFound) {
+			return "", err
+		}
+	} else if err := c.store.Put(ctx, event.Key, contextID); err != nil {
+		return "", err
+	}
+
+	// Contexts deleted since they were bound are replaced
+	if contextID != "" {
+		if _, err := c.contexts.GetContext(ctx, contextID); err != nil {
+			c.logger.Warn("Context bound to correlation key not found, creating a new one", map[string]interface{}{
+				"key":       event.Key,
+				"contextId": contextID,
+				"error":     err.Error(),
+			})
+			contextID = ""
+		}
+	}
+
+	if contextID != "" {
+		_, err := c.contexts.UpdateContext(ctx, contextID, &mcp.Context{
+			ID:      contextID,
+			Content: []mcp.ContextItem{item},
+		}, &mcp.ContextUpdateOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to append webhook to context %s: %w", contextID, err)
+		}
+		return contextID, nil
+	}
+
+	agentID := event.AgentID
+	if agentID == "" {
+		agentID = c.agentID
+	}
+	now := time.Now().UTC()
+	contextID = uuid.New().String()
+	created, err := c.contexts.CreateContext(ctx, &mcp.Context{
+		ID:      contextID,
+		AgentID: agentID,
+		ModelID: c.modelID,
+		Content: []mcp.ContextItem{item},
+		Metadata: map[string]interface{}{
+			"correlation_key": event.Key,
+			"source":          event.Adapter,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create context for correlation key %s: %w", event.Key, err)
+	}
+	if created != nil && created.ID != "" {
+		contextID = created.ID
+	}
+
+	if err := c.store.Put(ctx, event.Key, contextID); err != nil {
+		return "", err
+	}
+
+	c.logger.Info("Created context for correlation key", map[string]interface{}{
+		"key":       event.Key,
+		"contextId": contextID,
+		"agentId":   agentID,
+	})
+	return contextID, nil
+}
+
+// Bind binds a correlation key to a context, e.g. the context of an agent
+// following a pull request. Later webhooks with the key are appended to it.
+func (c *Correlator) Bind(ctx context.Context, key, contextID string) error {
+	lock := c.lock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	return c.store.Put(ctx, key, contextID)
+}
+
+// ContextID returns the ID of the context bound to a correlation key
+func (c *Correlator) ContextID(ctx context.Context, key string) (string, error) {
+	return c.store.Get(ctx, key)
+}
+
+// lock returns the lock serializing webhooks of a key
+func (c *Correlator) lock(key string) *sync.Mutex {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return &c.locks[hash.Sum32()%lockStripes]
+}
+
+// decodePayload decodes a JSON object, keeping numbers as written
+func decodePayload(payload []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var decoded map[string]interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// newContextItem creates the context item recording a webhook
+func newContextItem(event Event) mcp.ContextItem {
+	content := fmt.Sprintf("%s webhook", event.Adapter)
+	if event.EventType != "" {
+		content = fmt.Sprintf("%s webhook %s", event.Adapter, event.EventType)
+	}
+	if data, err := json.Marshal(event.Payload); err == nil {
+		text := string(data)
+		if len(text) > maxContentLength {
+			text = text[:maxContentLength] + "..."
+		}
+		content += ": " + text
+	}
+
+	metadata := map[string]interface{}{
+		"source":          event.Adapter,
+		"event_type":      event.EventType,
+		"correlation_key": event.Key,
+		"payload":         event.Payload,
+	}
+	if event.Rule != "" {
+		metadata["correlation_rule"] = event.Rule
+	}
+
+	return mcp.ContextItem{
+		Role:      "webhook",
+		Content:   content,
+		Timestamp: time.Now().UTC(),
+		Metadata:  metadata,
+	}
+}
@@ -0,0 +1,178 @@
+package correlation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeContextManager keeps contexts in memory
+type fakeContextManager struct {
+	mu       sync.Mutex
+	contexts map[string]*mcp.Context
+}
+
+func newFakeContextManager() *fakeContextManager {
+	return &fakeContextManager{contexts: make(map[string]*mcp.Context)}
+}
+
+func (m *fakeContextManager) CreateContext(ctx context.Context, contextData *mcp.Context) (*mcp.Context, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.contexts[contextData.ID] = contextData
+	return contextData, nil
+}
+
+func (m *fakeContextManager) GetContext(ctx context.Context, contextID string) (*mcp.Context, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	contextData, ok := m.contexts[contextID]
+	if !ok {
+		return nil, errors.New("context not found")
+	}
+	return contextData, nil
+}
+
+func (m *fakeContextManager) UpdateContext(ctx context.Context, contextID string, update *mcp.Context, options *mcp.ContextUpdateOptions) (*mcp.Context, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	contextData, ok := m.contexts[contextID]
+	if !ok {
+		return nil, errors.New("context not found")
+	}
+	contextData.Content = append(contextData.Content, update.Content...)
+	return contextData, nil
+}
+
+func (m *fakeContextManager) DeleteContext(ctx context.Context, contextID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.contexts, contextID)
+	return nil
+}
+
+func (m *fakeContextManager) ListContexts(ctx context.Context, agentID, sessionID string, options map[string]interface{}) ([]*mcp.Context, error) {
+	return nil, nil
+}
+
+func (m *fakeContextManager) SearchInContext(ctx context.Context, contextID, query string) ([]mcp.ContextItem, error) {
+	return nil, nil
+}
+
+func (m *fakeContextManager) SummarizeContext(ctx context.Context, contextID string) (string, error) {
+	return "", nil
+}
+
+func newTestCorrelator(t *testing.T) (*Correlator, *fakeContextManager) {
+	contexts := newFakeContextManager()
+	correlator, err := New(interfaces.CorrelationConfig{AgentID: "ci-agent"}, "gpt-4", NewMemoryStore(), contexts, nil)
+	require.NoError(t, err)
+	return correlator, contexts
+}
+
+func TestCorrelate(t *testing.T) {
+	ctx := context.Background()
+	correlator, contexts := newTestCorrelator(t)
+
+	opened := []byte(`{"action":"opened","repository":{"full_name":"org/repo"},"pull_request":{"number":42}}`)
+	contextID, err := correlator.Correlate(ctx, "github", "pull_request", opened)
+	require.NoError(t, err)
+	require.NotEmpty(t, contextID)
+
+	created := contexts.contexts[contextID]
+	require.NotNil(t, created)
+	assert.Equal(t, "ci-agent", created.AgentID)
+	assert.Equal(t, "gpt-4", created.ModelID)
+	assert.Equal(t, "github:org/repo#42", created.Metadata["correlation_key"])
+
+	checkRun := []byte(`{"repository":{"full_name":"org/repo"},"check_run":{"pull_requests":[{"number":42}]}}`)
+	sameID, err := correlator.Correlate(ctx, "github", "check_run", checkRun)
+	require.NoError(t, err)
+	assert.Equal(t, contextID, sameID)
+
+	require.Len(t, created.Content, 2)
+	item := created.Content[1]
+	assert.Equal(t, "webhook", item.Role)
+	assert.Contains(t, item.Content, "github webhook check_run")
+	assert.Equal(t, "check_run", item.Metadata["event_type"])
+	assert.Equal(t, "github-check-run", item.Metadata["correlation_rule"])
+
+	otherID, err := correlator.Correlate(ctx, "github", "pull_request",
+		[]byte(`{"repository":{"full_name":"org/repo"},"pull_request":{"number":43}}`))
+	require.NoError(t, err)
+	assert.NotEqual(t, contextID, otherID)
+
+	// Webhooks without a key and non-JSON payloads are not recorded
+	noKey, err := correlator.Correlate(ctx, "github", "push", []byte(`{"ref":"main"}`))
+	require.NoError(t, err)
+	assert.Empty(t, noKey)
+	notJSON, err := correlator.Correlate(ctx, "github", "pull_request", []byte(`payload=1`))
+	require.NoError(t, err)
+	assert.Empty(t, notJSON)
+	assert.Len(t, contexts.contexts, 2)
+}
+
+func TestCorrelateBoundContext(t *testing.T) {
+	ctx := context.Background()
+	correlator, contexts := newTestCorrelator(t)
+
+	_, err := contexts.CreateContext(ctx, &mcp.Context{ID: "agent-context", AgentID: "reviewer"})
+	require.NoError(t, err)
+	require.NoError(t, correlator.Bind(ctx, "harness:execution:exec-1", "agent-context"))
+
+	payload := []byte(`{"eventData":{"planExecutionId":"exec-1","nodeStatus":"SUCCESS"}}`)
+	contextID, err := correlator.Correlate(ctx, "harness", "pipeline_end", payload)
+	require.NoError(t, err)
+	assert.Equal(t, "agent-context", contextID)
+	assert.Len(t, contexts.contexts["agent-context"].Content, 1)
+
+	// Deleted contexts are replaced and the key bound to the new one
+	require.NoError(t, contexts.DeleteContext(ctx, "agent-context"))
+	contextID, err = correlator.Correlate(ctx, "harness", "pipeline_end", payload)
+	require.NoError(t, err)
+	assert.NotEqual(t, "agent-context", contextID)
+
+	bound, err := correlator.ContextID(ctx, "harness:execution:exec-1")
+	require.NoError(t, err)
+	assert.Equal(t, contextID, bound)
+}
+
+func TestRecordWithContextID(t *testing.T) {
+	ctx := context.Background()
+	correlator, contexts := newTestCorrelator(t)
+
+	_, err := contexts.CreateContext(ctx, &mcp.Context{ID: "incident-context"})
+	require.NoError(t, err)
+
+	contextID, err := correlator.Record(ctx, Event{
+		Key:       "pagerduty:incident:P123",
+		ContextID: "incident-context",
+		Adapter:   "pagerduty",
+		EventType: "incident.triggered",
+		Payload:   map[string]interface{}{"id": "P123"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "incident-context", contextID)
+
+	bound, err := correlator.ContextID(ctx, "pagerduty:incident:P123")
+	require.NoError(t, err)
+	assert.Equal(t, "incident-context", bound)
+}
+
+func TestNewRequiresContextManager(t *testing.T) {
+	_, err := New(interfaces.CorrelationConfig{}, "", NewMemoryStore(), nil, nil)
+	assert.ErrorIs(t, err, ErrNoContextManager)
+}
+
+func TestNewRejectsInvalidRules(t *testing.T) {
+	_, err := New(interfaces.CorrelationConfig{
+		Rules: []interfaces.CorrelationRule{{Name: "broken", Adapter: "github", Key: "{"}},
+	}, "", NewMemoryStore(), newFakeContextManager(), nil)
+	assert.Error(t, err)
+}
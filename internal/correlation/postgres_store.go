@@ -0,0 +1,47 @@
+package correlation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// PostgresStore keeps bindings in the mcp.context_correlations table, so
+// webhooks keep arriving in the same context across restarts and replicas
+type PostgresStore struct {
+	db *sqlx.DB
+}
+
+// NewPostgresStore creates a new binding store backed by Postgres
+func NewPostgresStore(db *sqlx.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Get returns the ID of the context bound to a key
+func (s *PostgresStore) Get(ctx context.Context, key string) (string, error) {
+	var contextID string
+	err := s.db.GetContext(ctx, &contextID, `SELECT context_id FROM mcp.context_correlations WHERE key = $1`, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrKeyNotFound
+		}
+		return "", fmt.Errorf("failed to get context of correlation key %s: %w", key, err)
+	}
+	return contextID, nil
+}
+
+// Put binds a key to a context
+func (s *PostgresStore) Put(ctx context.Context, key, contextID string) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO mcp.context_correlations (key, context_id, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (key) DO UPDATE SET context_id = EXCLUDED.context_id, updated_at = NOW()`,
+		key, contextID)
+	if err != nil {
+		return fmt.Errorf("failed to bind correlation key %s: %w", key, err)
+	}
+	return nil
+}
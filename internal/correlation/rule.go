@@ -0,0 +1,251 @@
+package correlation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+)
+
+// DefaultRules returns the rules used when none are configured. Pull
+// requests, their reviews and the CI runs and quality gates reported for them
+// share a key, so they are recorded in the same context.
+func DefaultRules() []interfaces.CorrelationRule {
+	return []interfaces.CorrelationRule{
+		{
+			Name:       "github-pull-request",
+			Adapter:    "github",
+			EventTypes: []string{"pull_request", "pull_request_review", "pull_request_review_comment"},
+			Key:        "github:{repository.full_name}#{pull_request.number}",
+		},
+		{
+			Name:       "github-pull-request-comment",
+			Adapter:    "github",
+			EventTypes: []string{"issue_comment"},
+			Match:      []string{"issue.pull_request.url"},
+			Key:        "github:{repository.full_name}#{issue.number}",
+		},
+		{
+			Name:       "github-check-run",
+			Adapter:    "github",
+			EventTypes: []string{"check_run"},
+			Key:        "github:{repository.full_name}#{check_run.pull_requests.0.number}",
+		},
+		{
+			Name:       "github-check-suite",
+			Adapter:    "github",
+			EventTypes: []string{"check_suite"},
+			Key:        "github:{repository.full_name}#{check_suite.pull_requests.0.number}",
+		},
+		{
+			Name:       "github-workflow-run",
+			Adapter:    "github",
+			EventTypes: []string{"workflow_run"},
+			Key:        "github:{repository.full_name}#{workflow_run.pull_requests.0.number}",
+		},
+		{
+			Name:    "gitlab-merge-request",
+			Adapter: "gitlab",
+			Match:   []string{"object_kind=merge_request"},
+			Key:     "gitlab:{project.path_with_namespace}!{object_attributes.iid}",
+		},
+		{
+			// Pipelines and notes of merge requests carry the merge request
+			Name:    "gitlab-merge-request-activity",
+			Adapter: "gitlab",
+			Key:     "gitlab:{project.path_with_namespace}!{merge_request.iid}",
+		},
+		{
+			// Scanners report the repository with -Dsonar.analysis.repository
+			Name:    "sonarqube-pull-request",
+			Adapter: "sonarqube",
+			Match:   []string{"branch.type=PULL_REQUEST"},
+			Key:     "github:{properties.sonar.analysis.repository}#{branch.name}",
+		},
+		{
+			Name:    "harness-pipeline-execution",
+			Adapter: "harness",
+			Key:     "harness:execution:{eventData.planExecutionId}",
+		},
+		{
+			Name:       "pagerduty-incident-note",
+			Adapter:    "pagerduty",
+			EventTypes: []string{"incident.annotated"},
+			Key:        "pagerduty:incident:{event.data.incident.id}",
+		},
+		{
+			Name:    "pagerduty-incident",
+			Adapter: "pagerduty",
+			Match:   []string{"event.data.type=incident"},
+			Key:     "pagerduty:incident:{event.data.id}",
+		},
+		{
+			Name:    "alertmanager-group",
+			Adapter: "prometheus",
+			Key:     "alertmanager:group:{groupKey}",
+		},
+		{
+			Name:    "jira-issue",
+			Adapter: "jira",
+			Key:     "jira:issue:{issue.key}",
+		},
+	}
+}
+
+// condition is a parsed Match entry. Without a value, the field only needs
+// to be present.
+type condition struct {
+	field    string
+	value    string
+	hasValue bool
+}
+
+// rule is a compiled correlation rule
+type rule struct {
+	name       string
+	adapter    string
+	eventTypes map[string]bool
+	conditions []condition
+	// literals and fields alternate in the key: literals[0] fields[0]
+	// literals[1] ... literals[len(fields)]
+	literals []string
+	fields   []string
+}
+
+// compileRule validates a rule and parses its key template
+func compileRule(config interfaces.CorrelationRule) (*rule, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("correlation rule without a name")
+	}
+	if config.Adapter == "" {
+		return nil, fmt.Errorf("correlation rule %s has no adapter", config.Name)
+	}
+
+	r := &rule{
+		name:    config.Name,
+		adapter: config.Adapter,
+	}
+
+	if len(config.EventTypes) > 0 {
+		r.eventTypes = make(map[string]bool, len(config.EventTypes))
+		for _, eventType := range config.EventTypes {
+			r.eventTypes[eventType] = true
+		}
+	}
+
+	for _, match := range config.Match {
+		field, value, hasValue := strings.Cut(match, "=")
+		if field == "" {
+			return nil, fmt.Errorf("correlation rule %s has an invalid condition %q", config.Name, match)
+		}
+		r.conditions = append(r.conditions, condition{field: field, value: value, hasValue: hasValue})
+	}
+
+	template := config.Key
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("correlation rule %s has an unterminated field in key %q", config.Name, config.Key)
+		}
+		field := template[start+1 : start+end]
+		if field == "" {
+			return nil, fmt.Errorf("correlation rule %s has an empty field in key %q", config.Name, config.Key)
+		}
+		r.literals = append(r.literals, template[:start])
+		r.fields = append(r.fields, field)
+		template = template[start+end+1:]
+	}
+	r.literals = append(r.literals, template)
+
+	if len(r.fields) == 0 {
+		return nil, fmt.Errorf("correlation rule %s has no fields in key %q", config.Name, config.Key)
+	}
+	return r, nil
+}
+
+// key returns the correlation key of a webhook, or false if the rule does
+// not apply to it or a field of the key is missing
+func (r *rule) key(adapter, eventType string, payload map[string]interface{}) (string, bool) {
+	if adapter != r.adapter {
+		return "", false
+	}
+	if r.eventTypes != nil && !r.eventTypes[eventType] {
+		return "", false
+	}
+
+	for _, cond := range r.conditions {
+		value, ok := fieldValue(payload, cond.field)
+		if !ok || (cond.hasValue && value != cond.value) {
+			return "", false
+		}
+	}
+
+	var key strings.Builder
+	for i, field := range r.fields {
+		value, ok := fieldValue(payload, field)
+		if !ok {
+			return "", false
+		}
+		key.WriteString(r.literals[i])
+		key.WriteString(value)
+	}
+	key.WriteString(r.literals[len(r.fields)])
+	return key.String(), true
+}
+
+// fieldValue returns the scalar value of a field given by a dotted path.
+// Array elements are selected by index, e.g. pull_requests.0.number. Keys
+// containing dots, e.g. sonar.analysis.repository, are matched as well.
+// Empty strings and non-scalar values are treated as missing.
+func fieldValue(payload interface{}, path string) (string, bool) {
+	value, ok := lookup(payload, strings.Split(path, "."))
+	if !ok {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case json.Number:
+		return v.String(), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// lookup resolves path segments in a decoded JSON value
+func lookup(value interface{}, segments []string) (interface{}, bool) {
+	if len(segments) == 0 {
+		return value, true
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// Prefer the longest key so dotted keys are found
+		for n := len(segments); n > 0; n-- {
+			child, ok := v[strings.Join(segments[:n], ".")]
+			if !ok {
+				continue
+			}
+			if found, ok := lookup(child, segments[n:]); ok {
+				return found, true
+			}
+		}
+	case []interface{}:
+		index, err := strconv.Atoi(segments[0])
+		if err == nil && index >= 0 && index < len(v) {
+			return lookup(v[index], segments[1:])
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,95 @@
+package correlation
+
+import (
+	"testing"
+
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleKey(t *testing.T) {
+	r, err := compileRule(interfaces.CorrelationRule{
+		Name:       "pull-request",
+		Adapter:    "github",
+		EventTypes: []string{"pull_request"},
+		Match:      []string{"action=opened", "pull_request.url"},
+		Key:        "github:{repository.full_name}#{pull_request.number}",
+	})
+	require.NoError(t, err)
+
+	payload, err := decodePayload([]byte(`{
+		"action": "opened",
+		"repository": {"full_name": "org/repo"},
+		"pull_request": {"number": 42, "url": "https://api.github.com/repos/org/repo/pulls/42"}
+	}`))
+	require.NoError(t, err)
+
+	key, ok := r.key("github", "pull_request", payload)
+	assert.True(t, ok)
+	assert.Equal(t, "github:org/repo#42", key)
+
+	_, ok = r.key("gitlab", "pull_request", payload)
+	assert.False(t, ok, "other adapters")
+	_, ok = r.key("github", "push", payload)
+	assert.False(t, ok, "other event types")
+
+	payload["action"] = "closed"
+	_, ok = r.key("github", "pull_request", payload)
+	assert.False(t, ok, "unmatched condition")
+
+	payload["action"] = "opened"
+	delete(payload, "repository")
+	_, ok = r.key("github", "pull_request", payload)
+	assert.False(t, ok, "missing key field")
+}
+
+func TestFieldValue(t *testing.T) {
+	payload, err := decodePayload([]byte(`{
+		"check_run": {"pull_requests": [{"number": 7}]},
+		"properties": {"sonar.analysis.repository": "org/repo"},
+		"draft": false,
+		"empty": "",
+		"nested": {"a": 1}
+	}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		path  string
+		value string
+		ok    bool
+	}{
+		{"check_run.pull_requests.0.number", "7", true},
+		{"check_run.pull_requests.1.number", "", false},
+		{"properties.sonar.analysis.repository", "org/repo", true},
+		{"draft", "false", true},
+		{"empty", "", false},
+		{"nested", "", false},
+		{"missing", "", false},
+	}
+	for _, tt := range tests {
+		value, ok := fieldValue(payload, tt.path)
+		assert.Equal(t, tt.ok, ok, tt.path)
+		assert.Equal(t, tt.value, value, tt.path)
+	}
+}
+
+func TestCompileRuleErrors(t *testing.T) {
+	invalid := []interfaces.CorrelationRule{
+		{Adapter: "github", Key: "{id}"},
+		{Name: "no-adapter", Key: "{id}"},
+		{Name: "no-fields", Adapter: "github", Key: "static"},
+		{Name: "unterminated", Adapter: "github", Key: "github:{id"},
+		{Name: "empty-field", Adapter: "github", Key: "github:{}"},
+		{Name: "empty-condition", Adapter: "github", Match: []string{"=x"}, Key: "{id}"},
+	}
+	for _, config := range invalid {
+		_, err := compileRule(config)
+		assert.Error(t, err, config.Name)
+	}
+
+	for _, config := range DefaultRules() {
+		_, err := compileRule(config)
+		assert.NoError(t, err, config.Name)
+	}
+}
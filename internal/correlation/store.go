@@ -0,0 +1,51 @@
+package correlation
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrKeyNotFound is returned when no context is bound to a correlation key
+var ErrKeyNotFound = errors.New("correlation key not found")
+
+// Store keeps the context each correlation key is bound to
+type Store interface {
+	// Get returns the ID of the context bound to a key
+	Get(ctx context.Context, key string) (string, error)
+
+	// Put binds a key to a context, replacing an earlier binding
+	Put(ctx context.Context, key, contextID string) error
+}
+
+// MemoryStore keeps bindings in memory. They are lost when the server stops.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	contexts map[string]string
+}
+
+// NewMemoryStore creates a new in-memory binding store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{contexts: make(map[string]string)}
+}
+
+// Get returns the ID of the context bound to a key
+func (s *MemoryStore) Get(ctx context.Context, key string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	contextID, ok := s.contexts[key]
+	if !ok {
+		return "", ErrKeyNotFound
+	}
+	return contextID, nil
+}
+
+// Put binds a key to a context
+func (s *MemoryStore) Put(ctx context.Context, key, contextID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.contexts[key] = contextID
+	return nil
+}
@@ -0,0 +1,56 @@
+package correlation
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	_, err := store.Get(ctx, "github:org/repo#1")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	require.NoError(t, store.Put(ctx, "github:org/repo#1", "ctx-1"))
+	require.NoError(t, store.Put(ctx, "github:org/repo#1", "ctx-2"))
+
+	contextID, err := store.Get(ctx, "github:org/repo#1")
+	require.NoError(t, err)
+	assert.Equal(t, "ctx-2", contextID)
+}
+
+func TestPostgresStore(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	store := NewPostgresStore(sqlx.NewDb(mockDB, "postgres"))
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO mcp.context_correlations")).
+		WithArgs("github:org/repo#1", "ctx-1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, store.Put(ctx, "github:org/repo#1", "ctx-1"))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT context_id FROM mcp.context_correlations WHERE key = $1")).
+		WithArgs("github:org/repo#1").
+		WillReturnRows(sqlmock.NewRows([]string{"context_id"}).AddRow("ctx-1"))
+	contextID, err := store.Get(ctx, "github:org/repo#1")
+	require.NoError(t, err)
+	assert.Equal(t, "ctx-1", contextID)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.context_correlations")).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows([]string{"context_id"}))
+	_, err = store.Get(ctx, "missing")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
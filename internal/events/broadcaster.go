@@ -0,0 +1,161 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// DefaultReplaySize is the number of recent events a broadcaster keeps for
+// listeners resuming a stream
+const DefaultReplaySize = 1000
+
+// listenerBuffer is the number of events a listener may fall behind before
+// it is dropped
+const listenerBuffer = 256
+
+// tailer is implemented by buses that share events between servers, so
+// that every server's listeners see every event rather than those the
+// server handles
+type tailer interface {
+	Tail(handler Handler)
+}
+
+// Broadcaster fans the events of a bus out to listeners, such as clients
+// watching the event stream. It keeps the most recent events so listeners
+// that reconnect can resume where they left off.
+type Broadcaster struct {
+	mu        sync.Mutex
+	replay    []*mcp.Event
+	next      int
+	full      bool
+	listeners map[*Listener]struct{}
+}
+
+// Listener receives the events of a broadcaster that match its filter
+type Listener struct {
+	filter      mcp.EventFilter
+	events      chan *mcp.Event
+	broadcaster *Broadcaster
+}
+
+// NewBroadcaster creates a broadcaster keeping replaySize recent events
+func NewBroadcaster(replaySize int) *Broadcaster {
+	if replaySize <= 0 {
+		replaySize = DefaultReplaySize
+	}
+	return &Broadcaster{
+		replay:    make([]*mcp.Event, replaySize),
+		listeners: make(map[*Listener]struct{}),
+	}
+}
+
+// Attach broadcasts the events of the given types published on a bus
+func (b *Broadcaster) Attach(bus Bus, eventTypes []EventType) {
+	if t, ok := bus.(tailer); ok {
+		t.Tail(b.Broadcast)
+		return
+	}
+	bus.SubscribeMultiple(eventTypes, b.Broadcast)
+}
+
+// Broadcast records an event and sends it to the listeners whose filter
+// matches it. Listeners too far behind are dropped rather than slowing down
+// the bus; they can resume from the recorded events.
+func (b *Broadcaster) Broadcast(ctx context.Context, event *mcp.Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.replay[b.next] = event
+	b.next = (b.next + 1) % len(b.replay)
+	if b.next == 0 {
+		b.full = true
+	}
+
+	for listener := range b.listeners {
+		if !listener.filter.MatchEvent(*event) {
+			continue
+		}
+		select {
+		case listener.events <- event:
+		default:
+			delete(b.listeners, listener)
+			close(listener.events)
+		}
+	}
+	return nil
+}
+
+// Listen registers a listener for the events matching a filter. It returns
+// the recorded events to replay first: those after the event with ID
+// lastEventID, or all matching recorded events if that event is no longer
+// recorded. Without lastEventID, only events in the filter's time window
+// are replayed, so a plain listener receives new events only.
+func (b *Broadcaster) Listen(filter mcp.EventFilter, lastEventID string) ([]*mcp.Event, *Listener) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	recorded := b.recorded()
+	var replay []*mcp.Event
+	switch {
+	case lastEventID != "":
+		start := 0
+		for i, event := range recorded {
+			if event.ID == lastEventID {
+				start = i + 1
+				break
+			}
+		}
+		replay = matching(recorded[start:], filter)
+	case !filter.After.IsZero():
+		replay = matching(recorded, filter)
+	}
+
+	listener := &Listener{
+		filter:      filter,
+		events:      make(chan *mcp.Event, listenerBuffer),
+		broadcaster: b,
+	}
+	b.listeners[listener] = struct{}{}
+	return replay, listener
+}
+
+// recorded returns the recorded events, oldest first
+func (b *Broadcaster) recorded() []*mcp.Event {
+	if !b.full {
+		return append([]*mcp.Event(nil), b.replay[:b.next]...)
+	}
+	recorded := make([]*mcp.Event, 0, len(b.replay))
+	recorded = append(recorded, b.replay[b.next:]...)
+	return append(recorded, b.replay[:b.next]...)
+}
+
+// matching returns the events matching a filter
+func matching(events []*mcp.Event, filter mcp.EventFilter) []*mcp.Event {
+	var matched []*mcp.Event
+	for _, event := range events {
+		if filter.MatchEvent(*event) {
+			matched = append(matched, event)
+		}
+	}
+	return matched
+}
+
+// Events returns the channel of the listener's events. It is closed when the
+// listener is closed or falls too far behind.
+func (l *Listener) Events() <-chan *mcp.Event {
+	return l.events
+}
+
+// Close stops sending events to the listener
+func (l *Listener) Close() {
+	b := l.broadcaster
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.listeners[l]; ok {
+		delete(b.listeners, l)
+		close(l.events)
+	}
+}
@@ -0,0 +1,144 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// broadcast broadcasts events of a type with the given IDs
+func broadcast(t *testing.T, broadcaster *Broadcaster, eventType EventType, ids ...string) {
+	t.Helper()
+	for _, id := range ids {
+		require.NoError(t, broadcaster.Broadcast(context.Background(), &mcp.Event{
+			ID:        id,
+			Type:      string(eventType),
+			Timestamp: time.Now(),
+		}))
+	}
+}
+
+// eventIDs returns the IDs of events
+func eventIDs(events []*mcp.Event) []string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+// receive receives the events a listener has been sent
+func receive(listener *Listener) []*mcp.Event {
+	var received []*mcp.Event
+	for {
+		select {
+		case event, ok := <-listener.Events():
+			if !ok {
+				return received
+			}
+			received = append(received, event)
+		default:
+			return received
+		}
+	}
+}
+
+func TestBroadcasterFiltersEvents(t *testing.T) {
+	broadcaster := NewBroadcaster(10)
+	broadcast(t, broadcaster, EventContextUpdated, "before-listening")
+
+	replay, listener := broadcaster.Listen(mcp.EventFilter{Types: []string{string(EventAgentError)}}, "")
+	defer listener.Close()
+	assert.Empty(t, replay, "listeners without a resume point receive new events only")
+
+	broadcast(t, broadcaster, EventAgentError, "error-1")
+	broadcast(t, broadcaster, EventContextUpdated, "update-1")
+	broadcast(t, broadcaster, EventAgentError, "error-2")
+
+	assert.Equal(t, []string{"error-1", "error-2"}, eventIDs(receive(listener)))
+}
+
+func TestBroadcasterResumesAfterLastEventID(t *testing.T) {
+	broadcaster := NewBroadcaster(3)
+	broadcast(t, broadcaster, EventContextUpdated, "1", "2", "3", "4")
+
+	replay, listener := broadcaster.Listen(mcp.EventFilter{}, "3")
+	listener.Close()
+	assert.Equal(t, []string{"4"}, eventIDs(replay))
+
+	// Events no longer recorded are replaced by everything recorded
+	replay, listener = broadcaster.Listen(mcp.EventFilter{}, "1")
+	listener.Close()
+	assert.Equal(t, []string{"2", "3", "4"}, eventIDs(replay))
+
+	// A time window replays the recorded events in it
+	replay, listener = broadcaster.Listen(mcp.EventFilter{After: time.Now().Add(-time.Minute)}, "")
+	listener.Close()
+	assert.Equal(t, []string{"2", "3", "4"}, eventIDs(replay))
+}
+
+func TestBroadcasterDropsSlowListeners(t *testing.T) {
+	broadcaster := NewBroadcaster(10)
+	_, slow := broadcaster.Listen(mcp.EventFilter{}, "")
+	_, closed := broadcaster.Listen(mcp.EventFilter{}, "")
+	closed.Close()
+	closed.Close()
+
+	for i := 0; i <= listenerBuffer; i++ {
+		broadcast(t, broadcaster, EventContextUpdated, fmt.Sprintf("event-%d", i))
+	}
+
+	received := receive(slow)
+	assert.Len(t, received, listenerBuffer)
+	_, ok := <-slow.Events()
+	assert.False(t, ok, "the slow listener should be dropped")
+	slow.Close()
+}
+
+func TestBroadcasterAttachesToBuses(t *testing.T) {
+	bus := NewEventBus(1)
+	defer bus.Close()
+	broadcaster := NewBroadcaster(10)
+	broadcaster.Attach(bus, AllEventTypes)
+	_, listener := broadcaster.Listen(mcp.EventFilter{}, "")
+	defer listener.Close()
+
+	require.NoError(t, PublishContextEvent(bus, context.Background(), EventContextCreated, "ctx-1", "agent-1", "", nil))
+	select {
+	case event := <-listener.Events():
+		assert.Equal(t, "context.created", event.Type)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event was not broadcast")
+	}
+}
+
+func TestBroadcasterTailsRedisBus(t *testing.T) {
+	server := miniredis.RunT(t)
+	publisher := newTestRedisBus(t, server, "server-1")
+	watcher := newTestRedisBus(t, server, "server-2")
+
+	// Another server handles the events, but every server streams them
+	publisher.Subscribe(EventAgentConnected, func(ctx context.Context, event *mcp.Event) error { return nil })
+	broadcaster := NewBroadcaster(10)
+	broadcaster.Attach(watcher, AllEventTypes)
+	_, listener := broadcaster.Listen(mcp.EventFilter{AgentIDs: []string{"agent-1"}}, "")
+	defer listener.Close()
+
+	require.NoError(t, publisher.Publish(context.Background(), &mcp.Event{Type: string(EventAgentConnected), AgentID: "agent-2"}))
+	event := &mcp.Event{Type: string(EventAgentConnected), AgentID: "agent-1"}
+	require.NoError(t, publisher.Publish(context.Background(), event))
+
+	select {
+	case received := <-listener.Events():
+		assert.Equal(t, event.ID, received.ID)
+		assert.Equal(t, "agent-1", received.AgentID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event was not tailed")
+	}
+}
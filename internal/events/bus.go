@@ -0,0 +1,144 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ErrQueueFull is returned when an event is published on an in-memory bus
+// whose queue is full
+var ErrQueueFull = errors.New("event queue is full")
+
+// Bus distributes events to the handlers subscribed to their type.
+// EventBus delivers events within the process; RedisBus delivers each event
+// at least once to one of the servers sharing a stream, so handlers of a
+// RedisBus must tolerate duplicates.
+type Bus interface {
+	// Subscribe registers a handler for an event type
+	Subscribe(eventType EventType, handler Handler, options ...SubscribeOption) SubscriptionID
+
+	// SubscribeMultiple registers a handler for multiple event types
+	SubscribeMultiple(eventTypes []EventType, handler Handler, options ...SubscribeOption) SubscriptionID
+
+	// Unsubscribe removes a subscription from all its event types
+	Unsubscribe(id SubscriptionID)
+
+	// Publish queues an event for its handlers. An error means the event
+	// was not queued.
+	Publish(ctx context.Context, event *mcp.Event) error
+
+	// Close stops delivering events
+	Close()
+}
+
+// registry holds the handlers subscribed to each event type
+type registry struct {
+	mu            sync.RWMutex
+	subscriptions map[EventType][]*subscription
+	lastID        SubscriptionID
+
+	// timeout limits handlers subscribed without their own timeout
+	timeout time.Duration
+}
+
+// newRegistry creates an empty handler registry
+func newRegistry() *registry {
+	return &registry{subscriptions: make(map[EventType][]*subscription)}
+}
+
+// setTimeout sets the timeout of handlers subscribed without their own
+func (r *registry) setTimeout(timeout time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timeout = timeout
+}
+
+// subscribe registers a handler for event types
+func (r *registry) subscribe(eventTypes []EventType, handler Handler, options []SubscribeOption) SubscriptionID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastID++
+	s := newSubscription(r.lastID, eventTypes, handler, options)
+	for _, eventType := range eventTypes {
+		r.subscriptions[eventType] = append(r.subscriptions[eventType], s)
+		log.Printf("Subscribed handler %s to event type: %s", s.name, eventType)
+	}
+	return s.id
+}
+
+// unsubscribe removes a subscription from all its event types
+func (r *registry) unsubscribe(id SubscriptionID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for eventType, subscriptions := range r.subscriptions {
+		for i, s := range subscriptions {
+			if s.id == id {
+				r.subscriptions[eventType] = append(subscriptions[:i:i], subscriptions[i+1:]...)
+				log.Printf("Unsubscribed handler %s from event type: %s", s.name, eventType)
+				break
+			}
+		}
+	}
+}
+
+// handle calls the handlers of an event. All handlers are called, each
+// recovered from panics and limited by its timeout and retry policy; the
+// events that failed are returned as dead letters.
+func (r *registry) handle(ctx context.Context, event *mcp.Event) []*DeadLetter {
+	eventType := EventType(event.Type)
+
+	r.mu.RLock()
+	subscriptions := r.subscriptions[eventType]
+	timeout := r.timeout
+	r.mu.RUnlock()
+
+	// Handlers run after the publisher has moved on, so its cancellation
+	// doesn't apply to them
+	ctx = context.WithoutCancel(ctx)
+
+	var letters []*DeadLetter
+	for _, s := range subscriptions {
+		handlerCtx, span := observability.StartSpan(ctx, "event.handle")
+		span.SetAttributes(
+			attribute.String("event.type", string(eventType)),
+			attribute.String("event.handler", s.name),
+		)
+
+		attempts, err := s.call(handlerCtx, event, timeout)
+		span.SetAttributes(attribute.Int("event.attempts", attempts))
+		if err != nil {
+			span.RecordError(err)
+			letters = append(letters, &DeadLetter{
+				Event:        event,
+				Subscription: s.id,
+				Handler:      s.name,
+				Attempts:     attempts,
+				Err:          err,
+				FailedAt:     time.Now(),
+			})
+		}
+
+		span.End()
+	}
+
+	return letters
+}
+
+// deadLetterErrors joins the errors of dead letters
+func deadLetterErrors(letters []*DeadLetter) error {
+	errs := make([]error, 0, len(letters))
+	for _, letter := range letters {
+		errs = append(errs, fmt.Errorf("%s: %w", letter.Handler, letter.Err))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,294 @@
+// Package cloudevents encodes events as CloudEvents 1.0
+// (https://github.com/cloudevents/spec). Events of the core bus
+// (mcp.Event), adapter events and system events are converted to one
+// envelope, which is sent over HTTP in binary or structured mode and
+// written to brokers in the structured JSON format.
+package cloudevents
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SpecVersion is the version of the CloudEvents specification implemented
+const SpecVersion = "1.0"
+
+// Content types
+const (
+	// ContentType is the media type of events in the structured JSON format
+	ContentType = "application/cloudevents+json"
+	// JSONContentType is the media type of event data
+	JSONContentType = "application/json"
+)
+
+// Extensions set by the converters
+const (
+	// AgentIDExtension holds the ID of the agent that generated an event
+	AgentIDExtension = "agentid"
+	// SessionIDExtension holds the ID of the user session of an event
+	SessionIDExtension = "sessionid"
+)
+
+// ErrInvalidEvent indicates an event doesn't conform to the specification
+var ErrInvalidEvent = errors.New("invalid CloudEvent")
+
+// contextAttributes are the attributes defined by the specification, which
+// can't be used as extension names
+var contextAttributes = map[string]bool{
+	"specversion":     true,
+	"id":              true,
+	"source":          true,
+	"type":            true,
+	"subject":         true,
+	"time":            true,
+	"datacontenttype": true,
+	"dataschema":      true,
+	"data":            true,
+	"data_base64":     true,
+}
+
+// Event is a CloudEvents 1.0 event
+type Event struct {
+	// SpecVersion is the version of the specification, always "1.0"
+	SpecVersion string
+	// ID identifies the event. Together with Source it is unique, so
+	// consumers discard events whose source and ID they have already seen.
+	ID string
+	// Source identifies where the event happened, such as "mcp-server" or
+	// "mcp-server/adapters/github"
+	Source string
+	// Type is the type of the event, such as "context.updated"
+	Type string
+	// Subject is what the event is about in its source, such as a context ID
+	Subject string
+	// Time is when the event happened
+	Time time.Time
+	// DataContentType is the media type of Data
+	DataContentType string
+	// DataSchema is the URI of the schema Data adheres to
+	DataSchema string
+	// Data is the payload of the event, encoded as DataContentType
+	Data []byte
+	// Extensions are additional string attributes
+	Extensions map[string]string
+}
+
+// SetExtension sets an extension attribute. Empty values are removed.
+func (e *Event) SetExtension(name, value string) {
+	if value == "" {
+		delete(e.Extensions, name)
+		return
+	}
+	if e.Extensions == nil {
+		e.Extensions = make(map[string]string)
+	}
+	e.Extensions[name] = value
+}
+
+// Extension returns an extension attribute, or an empty string if it isn't set
+func (e *Event) Extension(name string) string {
+	return e.Extensions[name]
+}
+
+// SetData encodes a value as the JSON data of the event
+func (e *Event) SetData(value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode data of event %s: %w", e.Type, err)
+	}
+	e.Data = data
+	e.DataContentType = JSONContentType
+	return nil
+}
+
+// DecodeData decodes the JSON data of the event into a value
+func (e *Event) DecodeData(value interface{}) error {
+	if !isJSON(e.DataContentType) {
+		return fmt.Errorf("data of event %s is %s, not JSON", e.ID, e.DataContentType)
+	}
+	return json.Unmarshal(e.Data, value)
+}
+
+// Validate checks the event has the required attributes and valid extension
+// names
+func (e *Event) Validate() error {
+	switch {
+	case e.SpecVersion != SpecVersion:
+		return fmt.Errorf("%w: unsupported specversion %q", ErrInvalidEvent, e.SpecVersion)
+	case e.ID == "":
+		return fmt.Errorf("%w: id is required", ErrInvalidEvent)
+	case e.Source == "":
+		return fmt.Errorf("%w: source is required", ErrInvalidEvent)
+	case e.Type == "":
+		return fmt.Errorf("%w: type is required", ErrInvalidEvent)
+	}
+	for name := range e.Extensions {
+		if !validExtensionName(name) {
+			return fmt.Errorf("%w: invalid extension name %q", ErrInvalidEvent, name)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON encodes the event in the structured JSON format. JSON data is
+// embedded as is; other data is base64-encoded.
+func (e Event) MarshalJSON() ([]byte, error) {
+	attributes := make(map[string]interface{}, 8+len(e.Extensions))
+	for name, value := range e.Extensions {
+		attributes[name] = value
+	}
+	attributes["specversion"] = e.SpecVersion
+	attributes["id"] = e.ID
+	attributes["source"] = e.Source
+	attributes["type"] = e.Type
+	if e.Subject != "" {
+		attributes["subject"] = e.Subject
+	}
+	if !e.Time.IsZero() {
+		attributes["time"] = e.Time.UTC().Format(time.RFC3339Nano)
+	}
+	if e.DataContentType != "" {
+		attributes["datacontenttype"] = e.DataContentType
+	}
+	if e.DataSchema != "" {
+		attributes["dataschema"] = e.DataSchema
+	}
+	if e.Data != nil {
+		if isJSON(e.DataContentType) && json.Valid(e.Data) {
+			attributes["data"] = json.RawMessage(e.Data)
+		} else {
+			attributes["data_base64"] = base64.StdEncoding.EncodeToString(e.Data)
+		}
+	}
+	return json.Marshal(attributes)
+}
+
+// UnmarshalJSON decodes an event in the structured JSON format. Attributes
+// other than those of the specification are kept as extensions.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+
+	*e = Event{}
+	for name, raw := range attributes {
+		switch name {
+		case "data":
+			e.Data = []byte(raw)
+			continue
+		case "data_base64":
+			var encoded string
+			if err := json.Unmarshal(raw, &encoded); err != nil {
+				return fmt.Errorf("%w: data_base64 must be a string", ErrInvalidEvent)
+			}
+			decoded, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return fmt.Errorf("%w: invalid data_base64: %v", ErrInvalidEvent, err)
+			}
+			e.Data = decoded
+			continue
+		}
+
+		value, err := attributeString(raw)
+		if err != nil {
+			return fmt.Errorf("%w: attribute %s: %v", ErrInvalidEvent, name, err)
+		}
+		if err := e.setAttribute(name, value); err != nil {
+			return err
+		}
+	}
+
+	// Data without a content type is JSON in the structured format
+	if e.Data != nil && e.DataContentType == "" && attributes["data"] != nil {
+		e.DataContentType = JSONContentType
+	}
+	return e.Validate()
+}
+
+// setAttribute sets a context attribute or an extension from its string
+// representation
+func (e *Event) setAttribute(name, value string) error {
+	switch name {
+	case "specversion":
+		e.SpecVersion = value
+	case "id":
+		e.ID = value
+	case "source":
+		e.Source = value
+	case "type":
+		e.Type = value
+	case "subject":
+		e.Subject = value
+	case "time":
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return fmt.Errorf("%w: invalid time %q", ErrInvalidEvent, value)
+		}
+		e.Time = parsed
+	case "datacontenttype":
+		e.DataContentType = value
+	case "dataschema":
+		e.DataSchema = value
+	default:
+		e.SetExtension(name, value)
+	}
+	return nil
+}
+
+// attributeString returns the string representation of a JSON attribute
+// value. Extensions may also be numbers or booleans.
+func attributeString(raw json.RawMessage) (string, error) {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value, nil
+	}
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && (raw[0] == '{' || raw[0] == '[' || raw[0] == '"') {
+		return "", errors.New("must be a string, number or boolean")
+	}
+	return string(raw), nil
+}
+
+// extensionNames returns the names of the extensions of an event, sorted
+func (e *Event) extensionNames() []string {
+	names := make([]string, 0, len(e.Extensions))
+	for name := range e.Extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validExtensionName checks an extension name consists of lowercase letters
+// and digits and isn't a context attribute
+func validExtensionName(name string) bool {
+	if name == "" || contextAttributes[name] {
+		return false
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// isJSON checks a media type is JSON. Data without a content type is JSON.
+func isJSON(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == JSONContentType || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,222 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	adapterEvents "github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/events/system"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvent(t *testing.T) *Event {
+	t.Helper()
+
+	event, err := FromMCPEvent(&mcp.Event{
+		ID:        "evt-1",
+		Source:    "mcp-server",
+		Type:      "context.updated",
+		Timestamp: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		Data:      map[string]interface{}{"context_id": "ctx-1", "tokens": 42},
+		AgentID:   "agent-1",
+	})
+	require.NoError(t, err)
+	return event
+}
+
+func TestFromMCPEvent(t *testing.T) {
+	event := testEvent(t)
+
+	assert.Equal(t, SpecVersion, event.SpecVersion)
+	assert.Equal(t, "evt-1", event.ID)
+	assert.Equal(t, "mcp-server", event.Source)
+	assert.Equal(t, "context.updated", event.Type)
+	assert.Equal(t, "ctx-1", event.Subject)
+	assert.Equal(t, JSONContentType, event.DataContentType)
+	assert.Equal(t, "agent-1", event.Extension(AgentIDExtension))
+	assert.Empty(t, event.Extension(SessionIDExtension))
+	assert.JSONEq(t, `{"context_id":"ctx-1","tokens":42}`, string(event.Data))
+
+	converted, err := ToMCPEvent(event)
+	require.NoError(t, err)
+	assert.Equal(t, "evt-1", converted.ID)
+	assert.Equal(t, "agent-1", converted.AgentID)
+	assert.True(t, converted.Timestamp.Equal(event.Time))
+	assert.Equal(t, map[string]interface{}{"context_id": "ctx-1", "tokens": float64(42)}, converted.Data)
+}
+
+func TestStructuredJSON(t *testing.T) {
+	event := testEvent(t)
+
+	encoded, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"specversion": "1.0",
+		"id": "evt-1",
+		"source": "mcp-server",
+		"type": "context.updated",
+		"subject": "ctx-1",
+		"time": "2024-05-01T12:00:00Z",
+		"datacontenttype": "application/json",
+		"agentid": "agent-1",
+		"data": {"context_id": "ctx-1", "tokens": 42}
+	}`, string(encoded))
+
+	var decoded Event
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, event.Extensions, decoded.Extensions)
+	assert.JSONEq(t, string(event.Data), string(decoded.Data))
+	assert.True(t, event.Time.Equal(decoded.Time))
+
+	// Binary data is base64-encoded
+	event.DataContentType = "application/octet-stream"
+	event.Data = []byte{0xff, 0x00}
+	encoded, err = json.Marshal(event)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"data_base64":"/wA="`)
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, []byte{0xff, 0x00}, decoded.Data)
+}
+
+func TestValidate(t *testing.T) {
+	for name, raw := range map[string]string{
+		"no specversion":    `{"id":"1","source":"s","type":"t"}`,
+		"other specversion": `{"specversion":"0.3","id":"1","source":"s","type":"t"}`,
+		"no id":             `{"specversion":"1.0","source":"s","type":"t"}`,
+		"no type":           `{"specversion":"1.0","id":"1","source":"s"}`,
+		"invalid extension": `{"specversion":"1.0","id":"1","source":"s","type":"t","Agent-ID":"a"}`,
+		"object extension":  `{"specversion":"1.0","id":"1","source":"s","type":"t","ext":{}}`,
+	} {
+		var event Event
+		assert.ErrorIs(t, json.Unmarshal([]byte(raw), &event), ErrInvalidEvent, name)
+	}
+}
+
+func TestHTTPBinding(t *testing.T) {
+	event := testEvent(t)
+	event.SetExtension(SessionIDExtension, `session "1" 100%`)
+
+	for _, mode := range []Mode{Binary, Structured} {
+		var received *Event
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			received, err = ReadRequest(r)
+			require.NoError(t, err)
+		}))
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, nil)
+		require.NoError(t, err)
+		require.NoError(t, WriteRequest(req, event, mode))
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		server.Close()
+
+		require.NotNil(t, received, mode)
+		assert.Equal(t, event.ID, received.ID, mode)
+		assert.Equal(t, event.Subject, received.Subject, mode)
+		assert.Equal(t, event.Extensions, received.Extensions, mode)
+		assert.Equal(t, JSONContentType, received.DataContentType, mode)
+		assert.JSONEq(t, string(event.Data), string(received.Data), mode)
+	}
+
+	header, body, err := Encode(event, Binary)
+	require.NoError(t, err)
+	assert.Equal(t, "context.updated", header.Get("Ce-Type"))
+	assert.Equal(t, "session %221%22 100%25", header.Get("Ce-Sessionid"))
+	assert.Equal(t, JSONContentType, header.Get("Content-Type"))
+	assert.JSONEq(t, string(event.Data), string(body))
+
+	_, err = Decode(http.Header{"Content-Type": {"application/json"}}, body)
+	assert.ErrorIs(t, err, ErrNotCloudEvent)
+}
+
+func TestAdapterAndSystemEventsConvertAlike(t *testing.T) {
+	timestamp := time.Now().UTC()
+	adapterEvent := adapterEvents.NewAdapterEvent("github", adapterEvents.EventTypeAdapterHealthChanged, nil).
+		WithMetadata("oldStatus", "healthy").
+		WithMetadata("newStatus", "unhealthy")
+	adapterEvent.Timestamp = timestamp
+	systemEvent := &system.AdapterHealthChangedEvent{
+		BaseEvent:   system.BaseEvent{Type: system.EventTypeAdapterHealthChanged, Timestamp: timestamp},
+		AdapterType: "github",
+		OldStatus:   "healthy",
+		NewStatus:   "unhealthy",
+	}
+
+	fromAdapter, err := FromAdapterEvent(adapterEvent)
+	require.NoError(t, err)
+	fromSystem, err := FromSystemEvent(systemEvent)
+	require.NoError(t, err)
+
+	assert.Equal(t, adapterEvent.ID, fromAdapter.ID)
+	for _, event := range []*Event{fromAdapter, fromSystem} {
+		assert.Equal(t, "adapter.health.changed", event.Type)
+		assert.Equal(t, "mcp-server/adapters/github", event.Source)
+		assert.True(t, timestamp.Equal(event.Time))
+		assert.JSONEq(t, `{"adapter":"github","old_status":"healthy","new_status":"unhealthy"}`, string(event.Data))
+	}
+
+	generic, err := FromAdapterEvent(adapterEvents.NewAdapterEvent("jira", adapterEvents.EventTypeAdapterInitialized, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "adapter.generic", generic.Type)
+	assert.Contains(t, string(generic.Data), `"event_type":"adapter.initialized"`)
+
+	startup, err := FromSystemEvent(&system.BaseEvent{Type: system.EventTypeSystemStartup, Timestamp: timestamp})
+	require.NoError(t, err)
+	assert.Equal(t, "system.startup", startup.Type)
+	assert.Nil(t, startup.Data)
+	assert.NoError(t, startup.Validate())
+}
+
+func TestSchemasCoverConvertedData(t *testing.T) {
+	types := SchemaTypes()
+	assert.Contains(t, types, "context.updated")
+	assert.Contains(t, types, "tool.action.executed")
+	assert.Contains(t, types, "adapter.health.changed")
+
+	_, ok := Schema("../cloudevents")
+	assert.False(t, ok)
+
+	// Every event type the adapter converter produces has a schema, and
+	// converted data has the properties the schema requires
+	for _, eventType := range []adapterEvents.EventType{
+		adapterEvents.EventTypeOperationSuccess,
+		adapterEvents.EventTypeOperationFailure,
+		adapterEvents.EventTypeWebhookReceived,
+		adapterEvents.EventTypeAdapterHealthChanged,
+		adapterEvents.EventTypeAdapterClosed,
+	} {
+		event, err := FromAdapterEvent(adapterEvents.NewAdapterEvent("github", eventType, nil))
+		require.NoError(t, err)
+
+		raw, ok := Schema(event.Type)
+		require.True(t, ok, "%s should have a schema", event.Type)
+		var schema struct {
+			Title    string   `json:"title"`
+			Required []string `json:"required"`
+		}
+		require.NoError(t, json.Unmarshal(raw, &schema))
+		assert.Equal(t, event.Type, schema.Title)
+
+		var data map[string]interface{}
+		require.NoError(t, event.DecodeData(&data))
+		for _, property := range schema.Required {
+			assert.Contains(t, data, property, "%s data should have %s", event.Type, property)
+		}
+	}
+
+	for _, eventType := range types {
+		raw, ok := Schema(eventType)
+		require.True(t, ok)
+		assert.True(t, json.Valid(raw), eventType)
+		assert.True(t, strings.Contains(string(raw), `"$schema"`), eventType)
+	}
+}
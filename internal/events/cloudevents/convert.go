@@ -0,0 +1,217 @@
+package cloudevents
+
+import (
+	"fmt"
+	"time"
+
+	adapterEvents "github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/events/system"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/google/uuid"
+)
+
+// DefaultSource is the source of events of the server itself
+const DefaultSource = "mcp-server"
+
+// AdapterSource returns the source of the events of an adapter
+func AdapterSource(adapterType string) string {
+	return DefaultSource + "/adapters/" + adapterType
+}
+
+// New creates an event with a new ID, stamped with the current time
+func New(source, eventType string) *Event {
+	return &Event{
+		SpecVersion: SpecVersion,
+		ID:          uuid.New().String(),
+		Source:      source,
+		Type:        eventType,
+		Time:        time.Now().UTC(),
+	}
+}
+
+// FromMCPEvent converts an event of the core bus. The context ID in its
+// data, if any, becomes the subject, and the agent and session IDs become
+// extensions.
+func FromMCPEvent(event *mcp.Event) (*Event, error) {
+	source := event.Source
+	if source == "" {
+		source = DefaultSource
+	}
+
+	converted := &Event{
+		SpecVersion: SpecVersion,
+		ID:          event.ID,
+		Source:      source,
+		Type:        event.Type,
+		Time:        event.Timestamp,
+	}
+	if converted.ID == "" {
+		converted.ID = uuid.New().String()
+	}
+	if data, ok := event.Data.(map[string]interface{}); ok {
+		if contextID, ok := data["context_id"].(string); ok {
+			converted.Subject = contextID
+		}
+	}
+	converted.SetExtension(AgentIDExtension, event.AgentID)
+	converted.SetExtension(SessionIDExtension, event.SessionID)
+
+	if event.Data != nil {
+		if err := converted.SetData(event.Data); err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+// ToMCPEvent converts an event to an event of the core bus. JSON data is
+// decoded into generic values.
+func ToMCPEvent(event *Event) (*mcp.Event, error) {
+	converted := &mcp.Event{
+		ID:        event.ID,
+		Source:    event.Source,
+		Type:      event.Type,
+		Timestamp: event.Time,
+		AgentID:   event.Extension(AgentIDExtension),
+		SessionID: event.Extension(SessionIDExtension),
+	}
+	if len(event.Data) > 0 {
+		if err := event.DecodeData(&converted.Data); err != nil {
+			return nil, fmt.Errorf("failed to decode data of event %s: %w", event.ID, err)
+		}
+	}
+	return converted, nil
+}
+
+// AdapterEventType returns the type of the events converted from adapter
+// events of a type. Types without a specific meaning are adapter.generic;
+// the original type is kept in the data.
+func AdapterEventType(eventType adapterEvents.EventType) string {
+	switch eventType {
+	case adapterEvents.EventTypeOperationSuccess:
+		return string(system.EventTypeAdapterOperationSuccess)
+	case adapterEvents.EventTypeOperationFailure:
+		return string(system.EventTypeAdapterOperationFailure)
+	case adapterEvents.EventTypeWebhookReceived:
+		return string(system.EventTypeWebhookReceived)
+	case adapterEvents.EventTypeAdapterHealthChanged:
+		return string(system.EventTypeAdapterHealthChanged)
+	default:
+		return string(system.EventTypeAdapterGeneric)
+	}
+}
+
+// FromAdapterEvent converts an adapter event. Its data has the same shape as
+// that of the system event the event bridge publishes for it.
+func FromAdapterEvent(event *adapterEvents.AdapterEvent) (*Event, error) {
+	eventType := AdapterEventType(event.EventType)
+	data := map[string]interface{}{"adapter": event.AdapterType}
+	contextID := metadataString(event.Metadata, "contextId")
+
+	switch eventType {
+	case string(system.EventTypeAdapterOperationSuccess):
+		data["operation"] = metadataString(event.Metadata, "operation")
+		data["context_id"] = contextID
+		data["result"] = event.Payload
+	case string(system.EventTypeAdapterOperationFailure):
+		data["operation"] = metadataString(event.Metadata, "operation")
+		data["context_id"] = contextID
+		data["error"] = metadataString(event.Metadata, "error")
+	case string(system.EventTypeWebhookReceived):
+		data["event_type"] = metadataString(event.Metadata, "eventType")
+		data["context_id"] = contextID
+		data["payload"] = event.Payload
+	case string(system.EventTypeAdapterHealthChanged):
+		data["old_status"] = metadataString(event.Metadata, "oldStatus")
+		data["new_status"] = metadataString(event.Metadata, "newStatus")
+	default:
+		data["event_type"] = string(event.EventType)
+		data["payload"] = event.Payload
+		data["metadata"] = event.Metadata
+	}
+
+	converted := &Event{
+		SpecVersion: SpecVersion,
+		ID:          event.ID,
+		Source:      AdapterSource(event.AdapterType),
+		Type:        eventType,
+		Subject:     contextID,
+		Time:        event.Timestamp,
+	}
+	if converted.ID == "" {
+		converted.ID = uuid.New().String()
+	}
+	if err := converted.SetData(data); err != nil {
+		return nil, err
+	}
+	return converted, nil
+}
+
+// FromSystemEvent converts a system event. System events have no ID, so a
+// new one is generated.
+func FromSystemEvent(event system.Event) (*Event, error) {
+	converted := New(DefaultSource, string(event.GetType()))
+	converted.Time = event.GetTimestamp()
+
+	var data map[string]interface{}
+	switch e := event.(type) {
+	case *system.AdapterOperationSuccessEvent:
+		data = map[string]interface{}{
+			"adapter":    e.AdapterType,
+			"operation":  e.Operation,
+			"context_id": e.ContextID,
+			"result":     e.Result,
+		}
+		converted.Subject = e.ContextID
+	case *system.AdapterOperationFailureEvent:
+		data = map[string]interface{}{
+			"adapter":    e.AdapterType,
+			"operation":  e.Operation,
+			"context_id": e.ContextID,
+			"error":      e.Error,
+		}
+		converted.Subject = e.ContextID
+	case *system.WebhookReceivedEvent:
+		data = map[string]interface{}{
+			"adapter":    e.AdapterType,
+			"event_type": e.EventType,
+			"context_id": e.ContextID,
+			"payload":    e.Payload,
+		}
+		converted.Subject = e.ContextID
+	case *system.AdapterHealthChangedEvent:
+		data = map[string]interface{}{
+			"adapter":    e.AdapterType,
+			"old_status": e.OldStatus,
+			"new_status": e.NewStatus,
+		}
+	case *system.AdapterGenericEvent:
+		data = map[string]interface{}{
+			"adapter":    e.AdapterType,
+			"event_type": e.EventType,
+			"payload":    e.Payload,
+			"metadata":   e.Metadata,
+		}
+	default:
+		// Events without data of their own, such as system.startup
+		return converted, nil
+	}
+
+	if adapterType, _ := data["adapter"].(string); adapterType != "" {
+		converted.Source = AdapterSource(adapterType)
+	}
+	if err := converted.SetData(data); err != nil {
+		return nil, err
+	}
+	return converted, nil
+}
+
+// metadataString returns a metadata value as a string, or an empty string if
+// it isn't set
+func metadataString(metadata map[string]interface{}, key string) string {
+	value, ok := metadata[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,164 @@
+package cloudevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Mode is a content mode of the HTTP binding
+type Mode string
+
+// Content modes
+const (
+	// Binary mode sends attributes as ce- headers and the data as the body
+	Binary Mode = "binary"
+	// Structured mode sends the event in the structured JSON format
+	Structured Mode = "structured"
+)
+
+// headerPrefix prefixes the headers of attributes in binary mode
+const headerPrefix = "Ce-"
+
+// ErrNotCloudEvent indicates a request doesn't carry a CloudEvent
+var ErrNotCloudEvent = errors.New("request is not a CloudEvent")
+
+// Encode encodes an event for an HTTP message in a content mode and returns
+// its headers and body
+func Encode(event *Event, mode Mode) (http.Header, []byte, error) {
+	if err := event.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	header := make(http.Header)
+	switch mode {
+	case Structured:
+		body, err := json.Marshal(event)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode event %s: %w", event.ID, err)
+		}
+		header.Set("Content-Type", ContentType)
+		return header, body, nil
+
+	case Binary:
+		setHeader(header, "specversion", event.SpecVersion)
+		setHeader(header, "id", event.ID)
+		setHeader(header, "source", event.Source)
+		setHeader(header, "type", event.Type)
+		setHeader(header, "subject", event.Subject)
+		if !event.Time.IsZero() {
+			setHeader(header, "time", event.Time.UTC().Format(time.RFC3339Nano))
+		}
+		setHeader(header, "dataschema", event.DataSchema)
+		for _, name := range event.extensionNames() {
+			setHeader(header, name, event.Extensions[name])
+		}
+		if event.DataContentType != "" {
+			header.Set("Content-Type", event.DataContentType)
+		}
+		return header, event.Data, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported content mode %q", mode)
+	}
+}
+
+// WriteRequest sets the headers and body of a request to an event encoded in
+// a content mode
+func WriteRequest(req *http.Request, event *Event, mode Mode) error {
+	header, body, err := Encode(event, mode)
+	if err != nil {
+		return err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
+
+// ReadRequest reads an event from a request in either content mode. Batches
+// aren't supported.
+func ReadRequest(req *http.Request) (*Event, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event: %w", err)
+	}
+	return Decode(req.Header, body)
+}
+
+// Decode decodes an event from the headers and body of an HTTP message
+func Decode(header http.Header, body []byte) (*Event, error) {
+	contentType := header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType == ContentType {
+		var event Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, err
+		}
+		return &event, nil
+	}
+
+	if header.Get(headerPrefix+"Specversion") == "" {
+		return nil, ErrNotCloudEvent
+	}
+
+	event := &Event{DataContentType: contentType}
+	if len(body) > 0 {
+		event.Data = body
+	}
+	for name, values := range header {
+		if !strings.HasPrefix(name, headerPrefix) || len(values) == 0 {
+			continue
+		}
+		value, err := url.PathUnescape(values[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid header %s", ErrInvalidEvent, name)
+		}
+		attribute := strings.ToLower(strings.TrimPrefix(name, headerPrefix))
+		if attribute == "datacontenttype" {
+			continue
+		}
+		if err := event.setAttribute(attribute, value); err != nil {
+			return nil, err
+		}
+	}
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// setHeader sets the header of an attribute in binary mode. Empty values are
+// omitted.
+func setHeader(header http.Header, attribute, value string) {
+	if value == "" {
+		return
+	}
+	header.Set(headerPrefix+attribute, encodeHeaderValue(value))
+}
+
+// encodeHeaderValue percent-encodes the characters the HTTP binding requires
+// to be encoded: those outside printable ASCII, '"' and '%'
+func encodeHeaderValue(value string) string {
+	var encoded strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c < 0x20 || c > 0x7e || c == '"' || c == '%' {
+			fmt.Fprintf(&encoded, "%%%02X", c)
+			continue
+		}
+		encoded.WriteByte(c)
+	}
+	return encoded.String()
+}
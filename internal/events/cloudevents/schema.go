@@ -0,0 +1,39 @@
+package cloudevents
+
+import (
+	"embed"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+)
+
+// schemaFiles are the JSON schemas of the data of event types, named after
+// the types
+//
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// Schema returns the JSON schema of the data of an event type, or false if
+// the type has no schema
+func Schema(eventType string) (json.RawMessage, bool) {
+	if strings.ContainsAny(eventType, "/\\") {
+		return nil, false
+	}
+	schema, err := schemaFiles.ReadFile(path.Join("schemas", eventType+".json"))
+	if err != nil {
+		return nil, false
+	}
+	return schema, true
+}
+
+// SchemaTypes returns the event types that have a schema, sorted
+func SchemaTypes() []string {
+	entries, _ := schemaFiles.ReadDir("schemas")
+	types := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		types = append(types, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	sort.Strings(types)
+	return types
+}
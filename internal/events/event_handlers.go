@@ -24,7 +24,7 @@ func NewContextEventHandler(contextManager interfaces.ContextManager) *ContextEv
 }
 
 // RegisterWithEventBus registers this handler with the event bus
-func (h *ContextEventHandler) RegisterWithEventBus(bus *EventBus) {
+func (h *ContextEventHandler) RegisterWithEventBus(bus Bus) {
 	// Register for context events
 	contextEvents := []EventType{
 		EventContextCreated,
@@ -92,8 +92,8 @@ func (h *ContextEventHandler) HandleContextEvent(ctx context.Context, event *mcp
 		}
 		existingContext.Metadata["access_count"] = accessCount
 		
-		// Update context
-		_, err = h.contextManager.UpdateContext(ctx, contextID, existingContext, nil)
+		// Update context metadata, leaving its content as is
+		_, err = h.contextManager.UpdateContext(ctx, contextID, &mcp.Context{Metadata: existingContext.Metadata}, nil)
 		if err != nil {
 			return fmt.Errorf("failed to update context metadata: %w", err)
 		}
@@ -128,8 +128,8 @@ func (h *ContextEventHandler) HandleContextEvent(ctx context.Context, event *mcp
 		existingContext.Metadata["summary"] = summary
 		existingContext.Metadata["summarized_at"] = time.Now()
 		
-		// Update context
-		_, err = h.contextManager.UpdateContext(ctx, contextID, existingContext, nil)
+		// Update context metadata, leaving its content as is
+		_, err = h.contextManager.UpdateContext(ctx, contextID, &mcp.Context{Metadata: existingContext.Metadata}, nil)
 		if err != nil {
 			return fmt.Errorf("failed to update context summary: %w", err)
 		}
@@ -141,7 +141,9 @@ func (h *ContextEventHandler) HandleContextEvent(ctx context.Context, event *mcp
 	}
 }
 
-// HandleToolEvent handles tool events
+// HandleToolEvent appends executed tool actions to their context. Actions
+// the tool API ran synchronously were recorded before the event was
+// published and are marked recorded.
 func (h *ContextEventHandler) HandleToolEvent(ctx context.Context, event *mcp.Event) error {
 	// Extract context ID from event data
 	var contextID string
@@ -150,6 +152,9 @@ func (h *ContextEventHandler) HandleToolEvent(ctx context.Context, event *mcp.Ev
 	var result interface{}
 	
 	if data, ok := event.Data.(map[string]interface{}); ok {
+		if recorded, _ := data["recorded"].(bool); recorded {
+			return nil
+		}
 		if id, ok := data["context_id"].(string); ok {
 			contextID = id
 		}
@@ -168,12 +173,6 @@ func (h *ContextEventHandler) HandleToolEvent(ctx context.Context, event *mcp.Ev
 		return fmt.Errorf("tool event missing required data: %v", event)
 	}
 	
-	// Get existing context
-	existingContext, err := h.contextManager.GetContext(ctx, contextID)
-	if err != nil {
-		return fmt.Errorf("failed to get context for tool event: %w", err)
-	}
-	
 	// Create tool action context item
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
@@ -192,11 +191,10 @@ func (h *ContextEventHandler) HandleToolEvent(ctx context.Context, event *mcp.Ev
 		},
 	}
 	
-	// Add tool action to context
-	existingContext.Content = append(existingContext.Content, toolAction)
-	
-	// Update context
-	_, err = h.contextManager.UpdateContext(ctx, contextID, existingContext, nil)
+	// Append tool action to context
+	_, err = h.contextManager.UpdateContext(ctx, contextID, &mcp.Context{
+		Content: []mcp.ContextItem{toolAction},
+	}, nil)
 	if err != nil {
 		return fmt.Errorf("failed to update context with tool action: %w", err)
 	}
@@ -215,7 +213,7 @@ func NewAgentEventHandler() *AgentEventHandler {
 }
 
 // RegisterWithEventBus registers this handler with the event bus
-func (h *AgentEventHandler) RegisterWithEventBus(bus *EventBus) {
+func (h *AgentEventHandler) RegisterWithEventBus(bus Bus) {
 	// Register for agent events
 	agentEvents := []EventType{
 		EventAgentConnected,
@@ -262,7 +260,7 @@ func NewSystemEventHandler() *SystemEventHandler {
 }
 
 // RegisterWithEventBus registers this handler with the event bus
-func (h *SystemEventHandler) RegisterWithEventBus(bus *EventBus) {
+func (h *SystemEventHandler) RegisterWithEventBus(bus Bus) {
 	// Register for system events
 	systemEvents := []EventType{
 		EventSystemStartup,
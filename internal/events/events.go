@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/S-Corkum/mcp-server/internal/observability"
 	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -32,10 +35,12 @@ const (
 	
 	// Tool events
 	EventToolActionExecuted EventType = "tool.action.executed"
+	EventToolActionFailed   EventType = "tool.action.failed"
 	EventToolDataQueried    EventType = "tool.data.queried"
 	
 	// Adapter events
 	EventGitHubWebhookReceived EventType = "github.webhook.received"
+	EventAdapterHealthChanged  EventType = "adapter.health.changed"
 	
 	// Agent events
 	EventAgentConnected    EventType = "agent.connected"
@@ -52,18 +57,60 @@ const (
 	EventSystemHealthCheck EventType = "system.health_check"
 )
 
-// Handler is a function that handles an event
+// AllEventTypes are the event types published on the bus
+var AllEventTypes = []EventType{
+	EventContextCreated,
+	EventContextUpdated,
+	EventContextDeleted,
+	EventContextRetrieved,
+	EventContextSummarized,
+	EventContextTruncated,
+	EventEmbeddingStored,
+	EventEmbeddingDeleted,
+	EventEmbeddingSearched,
+	EventToolActionExecuted,
+	EventToolActionFailed,
+	EventToolDataQueried,
+	EventGitHubWebhookReceived,
+	EventAdapterHealthChanged,
+	EventAgentConnected,
+	EventAgentDisconnected,
+	EventAgentError,
+	EventSessionStarted,
+	EventSessionEnded,
+	EventSystemStartup,
+	EventSystemShutdown,
+	EventSystemHealthCheck,
+}
+
+// Handler is a function that handles an event. Handlers should return when
+// their context is done, which happens when they time out: a handler that
+// times out is abandoned and may keep running alongside later events.
 type Handler func(ctx context.Context, event *mcp.Event) error
 
-// EventBus is a central event hub that distributes events to registered handlers
+// queueSize is the number of events each partition of an EventBus queues
+const queueSize = 1000
+
+// EventBus is an in-memory Bus that distributes events to the handlers
+// registered in this process. Events are partitioned by key, such as their
+// context ID: each partition has one worker, so events with the same key are
+// handled one at a time in the order they were published, while events with
+// other keys are handled in parallel. Events without a key are spread over
+// the partitions. Events that handlers fail to handle are sent to the
+// dead-letter sink.
 type EventBus struct {
-	handlers     map[EventType][]Handler
-	handlerMutex sync.RWMutex
+	handlers    *registry
+	deadLetters DeadLetterSink
+	
+	// Asynchronous processing, one queue per worker
+	partitions    []chan eventQueueItem
+	metricsClient partitionMetrics
 	
-	// Asynchronous processing
-	eventQueue    chan eventQueueItem
-	metricsClient *observability.MetricsClient
-	workers       int
+	mu            sync.RWMutex
+	partitionKeys []string
+	
+	// next is the partition of the next event without a key
+	next atomic.Uint32
 }
 
 // eventQueueItem represents an item in the event queue
@@ -72,70 +119,93 @@ type eventQueueItem struct {
 	event *mcp.Event
 }
 
-// NewEventBus creates a new event bus
+// NewEventBus creates a new event bus with a partition per worker. Events
+// are partitioned by DefaultPartitionKeys.
 func NewEventBus(workers int) *EventBus {
 	if workers <= 0 {
 		workers = 4 // Default to 4 workers
 	}
 	
 	bus := &EventBus{
-		handlers:     make(map[EventType][]Handler),
-		eventQueue:   make(chan eventQueueItem, 1000),
+		handlers:      newRegistry(),
+		deadLetters:   logDeadLetters,
+		partitions:    make([]chan eventQueueItem, workers),
 		metricsClient: observability.NewMetricsClient(),
-		workers:      workers,
+		partitionKeys: DefaultPartitionKeys,
 	}
 	
-	// Start worker goroutines
-	for i := 0; i < workers; i++ {
-		go bus.processEvents()
+	// Start a worker goroutine per partition
+	for i := range bus.partitions {
+		bus.partitions[i] = make(chan eventQueueItem, queueSize)
+		go bus.processEvents(i)
 	}
 	
 	return bus
 }
 
-// Subscribe registers a handler for an event type
-func (bus *EventBus) Subscribe(eventType EventType, handler Handler) {
-	bus.handlerMutex.Lock()
-	defer bus.handlerMutex.Unlock()
+// WithPartitionKeys sets the fields events are partitioned by. The first
+// field an event has is its key. agent_id, session_id and source are fields
+// of the event; other keys are fields of its data, such as context_id.
+func (bus *EventBus) WithPartitionKeys(keys []string) *EventBus {
+	if len(keys) == 0 {
+		keys = DefaultPartitionKeys
+	}
 	
-	bus.handlers[eventType] = append(bus.handlers[eventType], handler)
-	log.Printf("Subscribed handler to event type: %s", eventType)
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	bus.partitionKeys = keys
+	return bus
 }
 
-// SubscribeMultiple registers a handler for multiple event types
-func (bus *EventBus) SubscribeMultiple(eventTypes []EventType, handler Handler) {
-	for _, eventType := range eventTypes {
-		bus.Subscribe(eventType, handler)
-	}
+// WithHandlerTimeout limits how long handlers subscribed without their own
+// timeout may take. Zero means no limit.
+func (bus *EventBus) WithHandlerTimeout(timeout time.Duration) *EventBus {
+	bus.handlers.setTimeout(timeout)
+	return bus
 }
 
-// Unsubscribe removes a handler for an event type
-func (bus *EventBus) Unsubscribe(eventType EventType, handler Handler) {
-	bus.handlerMutex.Lock()
-	defer bus.handlerMutex.Unlock()
-	
-	handlers := bus.handlers[eventType]
-	for i, h := range handlers {
-		if fmt.Sprintf("%p", h) == fmt.Sprintf("%p", handler) {
-			// Remove handler
-			bus.handlers[eventType] = append(handlers[:i], handlers[i+1:]...)
-			log.Printf("Unsubscribed handler from event type: %s", eventType)
-			return
-		}
+// WithDeadLetterSink sets the sink of the events handlers fail to handle,
+// after their retries. By default they are logged.
+func (bus *EventBus) WithDeadLetterSink(sink DeadLetterSink) *EventBus {
+	if sink == nil {
+		sink = logDeadLetters
 	}
+	
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	bus.deadLetters = sink
+	return bus
 }
 
-// Publish publishes an event to all registered handlers
-func (bus *EventBus) Publish(ctx context.Context, event *mcp.Event) {
+// Subscribe registers a handler for an event type
+func (bus *EventBus) Subscribe(eventType EventType, handler Handler, options ...SubscribeOption) SubscriptionID {
+	return bus.handlers.subscribe([]EventType{eventType}, handler, options)
+}
+
+// SubscribeMultiple registers a handler for multiple event types
+func (bus *EventBus) SubscribeMultiple(eventTypes []EventType, handler Handler, options ...SubscribeOption) SubscriptionID {
+	return bus.handlers.subscribe(eventTypes, handler, options)
+}
+
+// Unsubscribe removes a subscription from all its event types
+func (bus *EventBus) Unsubscribe(id SubscriptionID) {
+	bus.handlers.unsubscribe(id)
+}
+
+// Publish queues an event for the registered handlers in the partition of
+// its key. ErrQueueFull is returned if the partition's queue is full.
+func (bus *EventBus) Publish(ctx context.Context, event *mcp.Event) error {
 	// Initialize timestamp if not set
 	if event.Timestamp.IsZero() {
 		event.Timestamp = time.Now()
 	}
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
 	
 	// Validate event
 	if event.Type == "" {
-		log.Printf("Warning: event published with empty type: %v", event)
-		return
+		return fmt.Errorf("event published with empty type: %v", event)
 	}
 	
 	// Create trace span
@@ -149,62 +219,67 @@ func (bus *EventBus) Publish(ctx context.Context, event *mcp.Event) {
 	defer span.End()
 	
 	// Queue event for async processing
+	partition := bus.partition(event)
+	queue := bus.partitions[partition]
 	select {
-	case bus.eventQueue <- eventQueueItem{ctx: ctx, event: event}:
-		// Event queued successfully
+	case queue <- eventQueueItem{ctx: ctx, event: event}:
+		bus.recordQueueDepth(partition)
+		return nil
 	default:
-		// Queue is full, log warning
-		log.Printf("Warning: event queue is full, dropping event: %v", event)
-		span.RecordError(fmt.Errorf("event queue full"))
+		log.Printf("Warning: event queue is full, event %s not published", event.Type)
+		span.RecordError(ErrQueueFull)
+		return ErrQueueFull
 	}
 }
 
-// processEvents processes events from the queue
-func (bus *EventBus) processEvents() {
-	for item := range bus.eventQueue {
-		eventType := EventType(item.event.Type)
+// partition returns the partition of an event
+func (bus *EventBus) partition(event *mcp.Event) int {
+	bus.mu.RLock()
+	keys := bus.partitionKeys
+	bus.mu.RUnlock()
+	
+	if key := partitionKey(event, keys); key != "" {
+		return partitionOf(key, len(bus.partitions))
+	}
+	return int(bus.next.Add(1) % uint32(len(bus.partitions)))
+}
+
+// processEvents processes the events of a partition in order
+func (bus *EventBus) processEvents(partition int) {
+	labels := map[string]string{"partition": strconv.Itoa(partition)}
+	for item := range bus.partitions[partition] {
+		bus.recordQueueDepth(partition)
 		
-		// Get handlers
-		bus.handlerMutex.RLock()
-		handlers := bus.handlers[eventType]
-		bus.handlerMutex.RUnlock()
+		start := time.Now()
+		letters := bus.handlers.handle(item.ctx, item.event)
+		bus.metricsClient.RecordTimer(handlerDurationMetric, time.Since(start), labels)
 		
-		// Process event with each handler
-		for _, handler := range handlers {
-			startTime := time.Now()
-			
-			// Create trace span for handler
-			ctx, span := observability.StartSpan(item.ctx, "event.handle")
-			span.SetAttributes(
-				attribute.String("event.type", string(eventType)),
-				attribute.String("event.handler", fmt.Sprintf("%p", handler)),
-			)
-			
-			// Call handler
-			err := handler(ctx, item.event)
-			
-			// Record metrics
-			_ = time.Since(startTime)
-			// NOTE: Metrics recording is currently disabled
-			
-			// Handle error
-			if err != nil {
-				log.Printf("Error handling event %s: %v", eventType, err)
-				span.RecordError(err)
+		if len(letters) > 0 {
+			bus.mu.RLock()
+			sink := bus.deadLetters
+			bus.mu.RUnlock()
+			for _, letter := range letters {
+				sink.DeadLetter(context.WithoutCancel(item.ctx), letter)
 			}
-			
-			span.End()
 		}
 	}
 }
 
+// recordQueueDepth records the number of events queued in a partition
+func (bus *EventBus) recordQueueDepth(partition int) {
+	bus.metricsClient.RecordGauge(queueDepthMetric, float64(len(bus.partitions[partition])),
+		map[string]string{"partition": strconv.Itoa(partition)})
+}
+
 // Close shuts down the event bus
 func (bus *EventBus) Close() {
-	close(bus.eventQueue)
+	for _, queue := range bus.partitions {
+		close(queue)
+	}
 }
 
 // PublishContextEvent publishes a context event
-func PublishContextEvent(bus *EventBus, ctx context.Context, eventType EventType, contextID string, agentID string, modelID string, data map[string]interface{}) {
+func PublishContextEvent(bus Bus, ctx context.Context, eventType EventType, contextID string, agentID string, modelID string, data map[string]interface{}) error {
 	if data == nil {
 		data = make(map[string]interface{})
 	}
@@ -222,11 +297,11 @@ func PublishContextEvent(bus *EventBus, ctx context.Context, eventType EventType
 	}
 	
 	// Publish event
-	bus.Publish(ctx, event)
+	return bus.Publish(ctx, event)
 }
 
 // PublishToolEvent publishes a tool event
-func PublishToolEvent(bus *EventBus, ctx context.Context, eventType EventType, tool string, action string, contextID string, data map[string]interface{}) {
+func PublishToolEvent(bus Bus, ctx context.Context, eventType EventType, tool string, action string, contextID string, data map[string]interface{}) error {
 	if data == nil {
 		data = make(map[string]interface{})
 	}
@@ -245,5 +320,5 @@ func PublishToolEvent(bus *EventBus, ctx context.Context, eventType EventType, t
 	}
 	
 	// Publish event
-	bus.Publish(ctx, event)
+	return bus.Publish(ctx, event)
 }
@@ -0,0 +1,63 @@
+package events
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// DefaultPartitionKeys are the fields events are partitioned by unless
+// others are configured: the context of context and tool events, then the
+// session
+var DefaultPartitionKeys = []string{"context_id", "session_id"}
+
+// Metrics of the partitions of an EventBus
+const (
+	// queueDepthMetric is the number of events queued in a partition
+	queueDepthMetric = "event_bus_partition_queue_depth"
+	// handlerDurationMetric is the time the handlers of an event took
+	handlerDurationMetric = "event_bus_partition_handler_duration"
+)
+
+// partitionMetrics records the metrics of partitions.
+// observability.MetricsClient implements it.
+type partitionMetrics interface {
+	RecordGauge(name string, value float64, labels map[string]string)
+	RecordTimer(name string, duration time.Duration, labels map[string]string)
+}
+
+// partitionKey returns the value of the first of keys that an event has, or
+// an empty string if it has none. agent_id, session_id and source are fields
+// of the event; other keys are fields of its data.
+func partitionKey(event *mcp.Event, keys []string) string {
+	for _, key := range keys {
+		var value string
+		switch key {
+		case "agent_id":
+			value = event.AgentID
+		case "session_id":
+			value = event.SessionID
+		case "source":
+			value = event.Source
+		default:
+			if data, ok := event.Data.(map[string]interface{}); ok {
+				if field, ok := data[key]; ok && field != nil {
+					value = fmt.Sprint(field)
+				}
+			}
+		}
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// partitionOf returns the partition of a key
+func partitionOf(key string, partitions int) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return int(hash.Sum32() % uint32(partitions))
+}
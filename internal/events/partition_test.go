@@ -0,0 +1,165 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedMetrics records the partition metrics of a bus
+type recordedMetrics struct {
+	mu     sync.Mutex
+	gauges map[string]int
+	timers map[string]int
+}
+
+func (m *recordedMetrics) RecordGauge(name string, value float64, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.gauges == nil {
+		m.gauges = make(map[string]int)
+	}
+	m.gauges[name+"/"+labels["partition"]]++
+}
+
+func (m *recordedMetrics) RecordTimer(name string, duration time.Duration, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.timers == nil {
+		m.timers = make(map[string]int)
+	}
+	m.timers[name+"/"+labels["partition"]]++
+}
+
+// contextEvent returns a context event with a sequence number
+func contextEvent(contextID string, seq int) *mcp.Event {
+	return &mcp.Event{
+		Type: string(EventContextUpdated),
+		Data: map[string]interface{}{"context_id": contextID, "seq": seq},
+	}
+}
+
+// keysInPartitions returns two keys in different partitions
+func keysInPartitions(partitions int) (string, string) {
+	first := "ctx-0"
+	for i := 1; ; i++ {
+		key := fmt.Sprintf("ctx-%d", i)
+		if partitionOf(key, partitions) != partitionOf(first, partitions) {
+			return first, key
+		}
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	event := &mcp.Event{
+		Source:    "github",
+		AgentID:   "agent-1",
+		SessionID: "session-1",
+		Data:      map[string]interface{}{"context_id": "ctx-1", "pull_request": 42},
+	}
+
+	assert.Equal(t, "ctx-1", partitionKey(event, DefaultPartitionKeys))
+	assert.Equal(t, "42", partitionKey(event, []string{"pull_request"}))
+	assert.Equal(t, "agent-1", partitionKey(event, []string{"agent_id"}))
+	assert.Equal(t, "github", partitionKey(event, []string{"source"}))
+
+	// Later keys are used when the first ones are missing
+	event.Data = nil
+	assert.Equal(t, "session-1", partitionKey(event, DefaultPartitionKeys))
+	event.SessionID = ""
+	assert.Empty(t, partitionKey(event, DefaultPartitionKeys))
+}
+
+func TestEventBusOrdersEventsWithTheSameKey(t *testing.T) {
+	bus := NewEventBus(4)
+	defer bus.Close()
+
+	const perContext = 50
+	contexts := []string{"ctx-a", "ctx-b", "ctx-c"}
+	var mu sync.Mutex
+	handled := make(map[string][]int)
+	var wg sync.WaitGroup
+	wg.Add(perContext * len(contexts))
+	bus.Subscribe(EventContextUpdated, func(ctx context.Context, event *mcp.Event) error {
+		defer wg.Done()
+		data := event.Data.(map[string]interface{})
+		// Uneven handling times would reorder events handled in parallel
+		time.Sleep(time.Duration(data["seq"].(int)%3) * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		contextID := data["context_id"].(string)
+		handled[contextID] = append(handled[contextID], data["seq"].(int))
+		return nil
+	})
+
+	for seq := 0; seq < perContext; seq++ {
+		for _, contextID := range contexts {
+			require.NoError(t, bus.Publish(context.Background(), contextEvent(contextID, seq)))
+		}
+	}
+	wg.Wait()
+
+	for _, contextID := range contexts {
+		require.Len(t, handled[contextID], perContext, contextID)
+		for seq, got := range handled[contextID] {
+			assert.Equal(t, seq, got, "events of %s should be handled in order", contextID)
+		}
+	}
+}
+
+func TestEventBusHandlesOtherKeysInParallel(t *testing.T) {
+	bus := NewEventBus(4)
+	defer bus.Close()
+	metrics := &recordedMetrics{}
+	bus.metricsClient = metrics
+	blocked, other := keysInPartitions(4)
+
+	release := make(chan struct{})
+	done := make(chan string, 3)
+	bus.Subscribe(EventContextUpdated, func(ctx context.Context, event *mcp.Event) error {
+		contextID := event.Data.(map[string]interface{})["context_id"].(string)
+		if contextID == blocked {
+			<-release
+		}
+		done <- contextID
+		return nil
+	})
+
+	require.NoError(t, bus.Publish(context.Background(), contextEvent(blocked, 0)))
+	require.NoError(t, bus.Publish(context.Background(), contextEvent(blocked, 1)))
+	require.NoError(t, bus.Publish(context.Background(), contextEvent(other, 0)))
+
+	// The other context isn't held up by the blocked one
+	select {
+	case contextID := <-done:
+		assert.Equal(t, other, contextID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("an event with another key was not handled")
+	}
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		select {
+		case contextID := <-done:
+			assert.Equal(t, blocked, contextID)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the blocked events were not handled")
+		}
+	}
+
+	partition := fmt.Sprint(partitionOf(blocked, 4))
+	require.Eventually(t, func() bool {
+		metrics.mu.Lock()
+		defer metrics.mu.Unlock()
+		return metrics.timers[handlerDurationMetric+"/"+partition] == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	assert.Positive(t, metrics.gauges[queueDepthMetric+"/"+partition])
+}
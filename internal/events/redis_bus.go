@@ -0,0 +1,528 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/cache"
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Defaults of the Redis event bus
+const (
+	DefaultStream        = "mcp:events"
+	DefaultGroup         = "mcp-server"
+	DefaultMaxLen        = 100000
+	DefaultClaimIdle     = time.Minute
+	DefaultMaxDeliveries = 5
+)
+
+// deadLetterSuffix names the stream events are moved to after their last
+// delivery, e.g. mcp:events:dead
+const deadLetterSuffix = ":dead"
+
+// readBlock bounds how long a read waits for new events, and so how long
+// Close waits for readers
+const readBlock = 2 * time.Second
+
+// readCount is the number of events read or reclaimed at once
+const readCount = 10
+
+// RedisBus is a Bus backed by a Redis stream. Servers sharing the stream
+// and consumer group share its events: each event is delivered to one
+// server and acknowledged once all its handlers succeed. Events of servers
+// that stop or fail to handle them are reclaimed by another server once idle
+// for ClaimIdle, and moved to a dead-letter stream after MaxDeliveries.
+//
+// A server consumes events once a handler is subscribed, so servers that
+// only publish do not take events from those handling them.
+type RedisBus struct {
+	handlers *registry
+	client   redis.UniversalClient
+	config   interfaces.EventBusConfig
+	logger   *observability.Logger
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	startOnce sync.Once
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewRedisBus connects to Redis with the connection settings of the config
+// and creates the consumer group if needed
+func NewRedisBus(config interfaces.EventBusConfig, logger *observability.Logger) (*RedisBus, error) {
+	if logger == nil {
+		logger = observability.NewLogger("event-bus")
+	}
+
+	client, err := newRedisClient(config.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	config = withDefaults(config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	err = client.XGroupCreateMkStream(ctx, config.Stream, config.Group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		client.Close()
+		return nil, fmt.Errorf("failed to create consumer group %s: %w", config.Group, err)
+	}
+
+	busCtx, busCancel := context.WithCancel(context.Background())
+	return &RedisBus{
+		handlers: newRegistry(),
+		client:   client,
+		config:   config,
+		logger:   logger,
+		ctx:      busCtx,
+		cancel:   busCancel,
+	}, nil
+}
+
+// newRedisClient creates a client for a single Redis server or a cluster
+func newRedisClient(config cache.RedisConfig) (redis.UniversalClient, error) {
+	if config.Type == "redis_cluster" || len(config.Addresses) > 0 {
+		addrs := config.Addresses
+		if len(addrs) == 0 && config.Address != "" {
+			addrs = []string{config.Address}
+		}
+		if len(addrs) == 0 {
+			return nil, errors.New("no Redis addresses configured")
+		}
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        addrs,
+			Username:     config.Username,
+			Password:     config.Password,
+			MaxRetries:   config.MaxRetries,
+			DialTimeout:  time.Duration(config.DialTimeout) * time.Second,
+			ReadTimeout:  time.Duration(config.ReadTimeout) * time.Second,
+			WriteTimeout: time.Duration(config.WriteTimeout) * time.Second,
+			PoolSize:     config.PoolSize,
+			MinIdleConns: config.MinIdleConns,
+			PoolTimeout:  time.Duration(config.PoolTimeout) * time.Second,
+		}), nil
+	}
+
+	if config.Address == "" {
+		return nil, errors.New("no Redis address configured")
+	}
+	return redis.NewClient(&redis.Options{
+		Addr:         config.Address,
+		Username:     config.Username,
+		Password:     config.Password,
+		DB:           config.Database,
+		MaxRetries:   config.MaxRetries,
+		DialTimeout:  time.Duration(config.DialTimeout) * time.Second,
+		ReadTimeout:  time.Duration(config.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(config.WriteTimeout) * time.Second,
+		PoolSize:     config.PoolSize,
+		MinIdleConns: config.MinIdleConns,
+		PoolTimeout:  time.Duration(config.PoolTimeout) * time.Second,
+	}), nil
+}
+
+// withDefaults fills in the unset fields of a config
+func withDefaults(config interfaces.EventBusConfig) interfaces.EventBusConfig {
+	if config.Stream == "" {
+		config.Stream = DefaultStream
+	}
+	if config.Group == "" {
+		config.Group = DefaultGroup
+	}
+	if config.Consumer == "" {
+		hostname, err := os.Hostname()
+		if err != nil || hostname == "" {
+			hostname = uuid.New().String()
+		}
+		config.Consumer = hostname
+	}
+	if config.Workers <= 0 {
+		config.Workers = 4
+	}
+	if config.MaxLen <= 0 {
+		config.MaxLen = DefaultMaxLen
+	}
+	if config.ClaimIdle <= 0 {
+		config.ClaimIdle = DefaultClaimIdle
+	}
+	if config.MaxDeliveries <= 0 {
+		config.MaxDeliveries = DefaultMaxDeliveries
+	}
+	return config
+}
+
+// WithHandlerTimeout limits how long handlers subscribed without their own
+// timeout may take. Zero means no limit.
+func (b *RedisBus) WithHandlerTimeout(timeout time.Duration) *RedisBus {
+	b.handlers.setTimeout(timeout)
+	return b
+}
+
+// Subscribe registers a handler for an event type. The first subscription
+// starts consuming events.
+func (b *RedisBus) Subscribe(eventType EventType, handler Handler, options ...SubscribeOption) SubscriptionID {
+	return b.SubscribeMultiple([]EventType{eventType}, handler, options...)
+}
+
+// SubscribeMultiple registers a handler for multiple event types
+func (b *RedisBus) SubscribeMultiple(eventTypes []EventType, handler Handler, options ...SubscribeOption) SubscriptionID {
+	id := b.handlers.subscribe(eventTypes, handler, options)
+	b.startOnce.Do(b.start)
+	return id
+}
+
+// Unsubscribe removes a subscription from all its event types
+func (b *RedisBus) Unsubscribe(id SubscriptionID) {
+	b.handlers.unsubscribe(id)
+}
+
+// Publish adds an event to the stream, trimming the stream to about MaxLen
+// events
+func (b *RedisBus) Publish(ctx context.Context, event *mcp.Event) error {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	if event.Type == "" {
+		return fmt.Errorf("event published with empty type: %v", event)
+	}
+
+	ctx, span := observability.StartSpan(ctx, "event.publish")
+	span.SetAttributes(
+		attribute.String("event.type", event.Type),
+		attribute.String("event.agent_id", event.AgentID),
+		attribute.String("event.session_id", event.SessionID),
+		attribute.String("event.source", event.Source),
+	)
+	defer span.End()
+
+	// Entries are CloudEvents in the structured JSON format, so other
+	// consumers of the stream can read them
+	cloudEvent, err := cloudevents.FromMCPEvent(event)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	data, err := json.Marshal(cloudEvent)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to encode event %s: %w", event.Type, err)
+	}
+
+	err = b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: b.config.Stream,
+		MaxLen: b.config.MaxLen,
+		Approx: true,
+		Values: map[string]interface{}{"type": event.Type, "event": data},
+	}).Err()
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to publish event %s: %w", event.Type, err)
+	}
+	return nil
+}
+
+// Close stops consuming events, waits for events being handled and closes
+// the connection to Redis. Unacknowledged events are reclaimed by other
+// servers.
+func (b *RedisBus) Close() {
+	b.closeOnce.Do(func() {
+		b.cancel()
+		b.wg.Wait()
+		b.client.Close()
+	})
+}
+
+// start starts the readers and the reclaimer
+func (b *RedisBus) start() {
+	for i := 0; i < b.config.Workers; i++ {
+		b.wg.Add(1)
+		go b.read()
+	}
+
+	b.wg.Add(1)
+	go b.reclaim()
+
+	b.logger.Info("Consuming events", map[string]interface{}{
+		"stream":   b.config.Stream,
+		"group":    b.config.Group,
+		"consumer": b.config.Consumer,
+	})
+}
+
+// read handles new events until the bus is closed
+func (b *RedisBus) read() {
+	defer b.wg.Done()
+
+	for b.ctx.Err() == nil {
+		streams, err := b.client.XReadGroup(b.ctx, &redis.XReadGroupArgs{
+			Group:    b.config.Group,
+			Consumer: b.config.Consumer,
+			Streams:  []string{b.config.Stream, ">"},
+			Count:    readCount,
+			Block:    readBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || b.ctx.Err() != nil {
+				continue
+			}
+			b.logger.Warn("Failed to read events", map[string]interface{}{
+				"stream": b.config.Stream,
+				"error":  err.Error(),
+			})
+			b.sleep(readBlock)
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, message := range stream.Messages {
+				b.handle(message)
+			}
+		}
+	}
+}
+
+// Tail calls a handler with every event added to the stream from now on,
+// including the events other servers handle. Unlike subscribed handlers, it
+// reads outside the consumer group: its errors are ignored and the events it
+// sees are neither acknowledged nor retried.
+func (b *RedisBus) Tail(handler Handler) {
+	// Start after the last entry rather than at "$", which would skip the
+	// entries added between two reads
+	lastID := "0-0"
+	entries, err := b.client.XRevRangeN(b.ctx, b.config.Stream, "+", "-", 1).Result()
+	if err == nil && len(entries) > 0 {
+		lastID = entries[0].ID
+	} else if err != nil {
+		lastID = "$"
+	}
+
+	b.wg.Add(1)
+	go b.tail(lastID, handler)
+}
+
+// tail reads the events after lastID until the bus is closed
+func (b *RedisBus) tail(lastID string, handler Handler) {
+	defer b.wg.Done()
+
+	for b.ctx.Err() == nil {
+		streams, err := b.client.XRead(b.ctx, &redis.XReadArgs{
+			Streams: []string{b.config.Stream, lastID},
+			Count:   readCount,
+			Block:   readBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || b.ctx.Err() != nil {
+				continue
+			}
+			b.logger.Warn("Failed to tail events", map[string]interface{}{
+				"stream": b.config.Stream,
+				"error":  err.Error(),
+			})
+			b.sleep(readBlock)
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, message := range stream.Messages {
+				lastID = message.ID
+				event, err := decodeEvent(message)
+				if err != nil {
+					b.logger.Warn("Skipped undecodable event", map[string]interface{}{
+						"entry": message.ID,
+						"error": err.Error(),
+					})
+					continue
+				}
+				handler(b.ctx, event)
+			}
+		}
+	}
+}
+
+// reclaim takes over events that were not acknowledged within ClaimIdle,
+// because their handlers failed or their server stopped
+func (b *RedisBus) reclaim() {
+	defer b.wg.Done()
+
+	interval := b.config.ClaimIdle / 2
+	for b.sleep(interval) {
+		if err := b.reclaimPending(); err != nil && b.ctx.Err() == nil {
+			b.logger.Warn("Failed to reclaim events", map[string]interface{}{
+				"stream": b.config.Stream,
+				"error":  err.Error(),
+			})
+		}
+	}
+}
+
+// reclaimPending claims idle events and handles them, dead-lettering
+// events that were delivered MaxDeliveries times
+func (b *RedisBus) reclaimPending() error {
+	pending, err := b.client.XPendingExt(b.ctx, &redis.XPendingExtArgs{
+		Stream: b.config.Stream,
+		Group:  b.config.Group,
+		Idle:   b.config.ClaimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  readCount,
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	deliveries := make(map[string]int64, len(pending))
+	for _, entry := range pending {
+		ids = append(ids, entry.ID)
+		deliveries[entry.ID] = entry.RetryCount
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Claiming fails for events another server claimed first
+	messages, err := b.client.XClaim(b.ctx, &redis.XClaimArgs{
+		Stream:   b.config.Stream,
+		Group:    b.config.Group,
+		Consumer: b.config.Consumer,
+		MinIdle:  b.config.ClaimIdle,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		if deliveries[message.ID] >= int64(b.config.MaxDeliveries) {
+			b.deadLetter(message, fmt.Errorf("event not handled after %d deliveries", deliveries[message.ID]))
+			continue
+		}
+		b.handle(message)
+	}
+	return nil
+}
+
+// handle calls the handlers of an event and acknowledges it if they succeed.
+// Events that fail stay pending and are reclaimed later.
+func (b *RedisBus) handle(message redis.XMessage) {
+	// Handlers finish when the bus is closed, so that their events are
+	// acknowledged
+	ctx := context.Background()
+
+	event, err := decodeEvent(message)
+	if err != nil {
+		b.deadLetter(message, err)
+		return
+	}
+
+	// Events a handler fails are redelivered until MaxDeliveries, then
+	// moved to the dead-letter stream
+	if letters := b.handlers.handle(ctx, event); len(letters) > 0 {
+		b.logger.Warn("Failed to handle event, it will be retried", map[string]interface{}{
+			"id":    message.ID,
+			"type":  event.Type,
+			"error": deadLetterErrors(letters).Error(),
+		})
+		return
+	}
+
+	if err := b.client.XAck(ctx, b.config.Stream, b.config.Group, message.ID).Err(); err != nil {
+		b.logger.Warn("Failed to acknowledge event", map[string]interface{}{
+			"id":    message.ID,
+			"type":  event.Type,
+			"error": err.Error(),
+		})
+	}
+}
+
+// deadLetter moves an event to the dead-letter stream and acknowledges it
+func (b *RedisBus) deadLetter(message redis.XMessage, cause error) {
+	ctx := context.Background()
+
+	values := make(map[string]interface{}, len(message.Values)+2)
+	for field, value := range message.Values {
+		values[field] = value
+	}
+	values["id"] = message.ID
+	values["error"] = cause.Error()
+
+	err := b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: b.config.Stream + deadLetterSuffix,
+		MaxLen: b.config.MaxLen,
+		Approx: true,
+		Values: values,
+	}).Err()
+	if err != nil {
+		// The event stays pending and is dead-lettered again later
+		b.logger.Error("Failed to dead-letter event", map[string]interface{}{
+			"id":    message.ID,
+			"error": err.Error(),
+		})
+		return
+	}
+
+	b.logger.Error("Dead-lettered event", map[string]interface{}{
+		"id":     message.ID,
+		"type":   message.Values["type"],
+		"error":  cause.Error(),
+		"stream": b.config.Stream + deadLetterSuffix,
+	})
+
+	if err := b.client.XAck(ctx, b.config.Stream, b.config.Group, message.ID).Err(); err != nil {
+		b.logger.Warn("Failed to acknowledge dead-lettered event", map[string]interface{}{
+			"id":    message.ID,
+			"error": err.Error(),
+		})
+	}
+}
+
+// sleep waits for d, returning false if the bus is closed first
+func (b *RedisBus) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-b.ctx.Done():
+		return false
+	}
+}
+
+// decodeEvent decodes the event of a stream entry
+func decodeEvent(message redis.XMessage) (*mcp.Event, error) {
+	data, ok := message.Values["event"].(string)
+	if !ok {
+		return nil, fmt.Errorf("stream entry %s has no event", message.ID)
+	}
+
+	var cloudEvent cloudevents.Event
+	if err := json.Unmarshal([]byte(data), &cloudEvent); err != nil {
+		return nil, fmt.Errorf("failed to decode event of stream entry %s: %w", message.ID, err)
+	}
+	return cloudevents.ToMCPEvent(&cloudEvent)
+}
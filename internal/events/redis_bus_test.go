@@ -0,0 +1,181 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/cache"
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/S-Corkum/mcp-server/internal/interfaces"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRedisBus creates a bus on a miniredis server
+func newTestRedisBus(t *testing.T, server *miniredis.Miniredis, consumer string) *RedisBus {
+	bus, err := NewRedisBus(interfaces.EventBusConfig{
+		Consumer:      consumer,
+		Workers:       2,
+		ClaimIdle:     100 * time.Millisecond,
+		MaxDeliveries: 3,
+		Redis:         cache.RedisConfig{Address: server.Addr()},
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(bus.Close)
+	return bus
+}
+
+func TestRedisBusDeliversEachEventOnce(t *testing.T) {
+	server := miniredis.RunT(t)
+	first := newTestRedisBus(t, server, "server-1")
+	second := newTestRedisBus(t, server, "server-2")
+
+	var mu sync.Mutex
+	handled := make(map[string]int)
+	handler := func(ctx context.Context, event *mcp.Event) error {
+		data := event.Data.(map[string]interface{})
+		mu.Lock()
+		handled[data["context_id"].(string)]++
+		mu.Unlock()
+		return nil
+	}
+	first.Subscribe(EventToolActionExecuted, handler)
+	second.Subscribe(EventToolActionExecuted, handler)
+
+	contextIDs := []string{"ctx-1", "ctx-2", "ctx-3", "ctx-4", "ctx-5"}
+	for _, contextID := range contextIDs {
+		require.NoError(t, PublishToolEvent(first, context.Background(), EventToolActionExecuted, "xray", "scan_artifact", contextID, nil))
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(handled) == len(contextIDs)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Acknowledged events are not delivered again
+	time.Sleep(300 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	for _, contextID := range contextIDs {
+		assert.Equal(t, 1, handled[contextID], contextID)
+	}
+}
+
+func TestRedisBusRetriesFailedEvents(t *testing.T) {
+	server := miniredis.RunT(t)
+	bus := newTestRedisBus(t, server, "server-1")
+
+	var attempts atomic.Int32
+	bus.Subscribe(EventContextSummarized, func(ctx context.Context, event *mcp.Event) error {
+		if attempts.Add(1) < 2 {
+			return errors.New("context store unavailable")
+		}
+		return nil
+	})
+
+	require.NoError(t, PublishContextEvent(bus, context.Background(), EventContextSummarized, "ctx-1", "agent-1", "", nil))
+
+	require.Eventually(t, func() bool { return attempts.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(300 * time.Millisecond)
+	assert.EqualValues(t, 2, attempts.Load(), "the event should be acknowledged after it was handled")
+}
+
+func TestRedisBusDeadLettersEvents(t *testing.T) {
+	server := miniredis.RunT(t)
+	bus := newTestRedisBus(t, server, "server-1")
+
+	var attempts atomic.Int32
+	bus.Subscribe(EventAgentError, func(ctx context.Context, event *mcp.Event) error {
+		attempts.Add(1)
+		return errors.New("always fails")
+	})
+
+	require.NoError(t, bus.Publish(context.Background(), &mcp.Event{Type: string(EventAgentError), AgentID: "agent-1"}))
+
+	require.Eventually(t, func() bool {
+		entries, err := server.Stream(DefaultStream + deadLetterSuffix)
+		return err == nil && len(entries) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 3, attempts.Load())
+}
+
+func TestRedisBusReclaimsEventsOfStoppedServers(t *testing.T) {
+	server := miniredis.RunT(t)
+	stopped := newTestRedisBus(t, server, "stopped")
+
+	// The stopped server read the event but never acknowledged it
+	block := make(chan struct{})
+	stopped.Subscribe(EventToolActionExecuted, func(ctx context.Context, event *mcp.Event) error {
+		<-block
+		return errors.New("stopped")
+	})
+	require.NoError(t, PublishToolEvent(stopped, context.Background(), EventToolActionExecuted, "jira", "create_issue", "ctx-1", nil))
+
+	running := newTestRedisBus(t, server, "running")
+	handled := make(chan string, 1)
+	running.Subscribe(EventToolActionExecuted, func(ctx context.Context, event *mcp.Event) error {
+		handled <- event.Data.(map[string]interface{})["tool"].(string)
+		return nil
+	})
+
+	select {
+	case tool := <-handled:
+		assert.Equal(t, "jira", tool)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event of the stopped server was not reclaimed")
+	}
+	close(block)
+}
+
+func TestRedisBusPublish(t *testing.T) {
+	server := miniredis.RunT(t)
+	bus := newTestRedisBus(t, server, "server-1")
+
+	assert.Error(t, bus.Publish(context.Background(), &mcp.Event{}), "events need a type")
+
+	event := &mcp.Event{Type: string(EventSystemStartup), AgentID: "agent-1"}
+	require.NoError(t, bus.Publish(context.Background(), event))
+	assert.NotEmpty(t, event.ID, "published events should get an ID")
+	entries, err := server.Stream(DefaultStream)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// Entries are CloudEvents, which decode to the published event
+	fields := make(map[string]interface{})
+	for i := 0; i+1 < len(entries[0].Values); i += 2 {
+		fields[entries[0].Values[i]] = entries[0].Values[i+1]
+	}
+	assert.Equal(t, "system.startup", fields["type"])
+	var cloudEvent cloudevents.Event
+	require.NoError(t, json.Unmarshal([]byte(fields["event"].(string)), &cloudEvent))
+	assert.Equal(t, event.ID, cloudEvent.ID)
+	assert.Equal(t, "agent-1", cloudEvent.Extension(cloudevents.AgentIDExtension))
+
+	decoded, err := decodeEvent(redis.XMessage{ID: entries[0].ID, Values: fields})
+	require.NoError(t, err)
+	assert.Equal(t, event.ID, decoded.ID)
+	assert.Equal(t, "system.startup", decoded.Type)
+
+	server.Close()
+	assert.Error(t, bus.Publish(context.Background(), &mcp.Event{Type: string(EventSystemStartup)}))
+}
+
+func TestEventBusReportsFullQueue(t *testing.T) {
+	bus := &EventBus{
+		handlers:      newRegistry(),
+		partitions:    []chan eventQueueItem{make(chan eventQueueItem, 1)},
+		metricsClient: &recordedMetrics{},
+	}
+
+	require.NoError(t, bus.Publish(context.Background(), &mcp.Event{Type: string(EventSystemStartup)}))
+	assert.ErrorIs(t, bus.Publish(context.Background(), &mcp.Event{Type: string(EventSystemStartup)}), ErrQueueFull)
+}
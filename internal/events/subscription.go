@@ -0,0 +1,179 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/resilience"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+var (
+	// ErrHandlerTimeout is returned when a handler doesn't finish within its
+	// timeout
+	ErrHandlerTimeout = errors.New("event handler timed out")
+	// ErrHandlerPanic is returned when a handler panics
+	ErrHandlerPanic = errors.New("event handler panicked")
+)
+
+// SubscriptionID identifies a subscription to a bus. Subscribe returns it and
+// Unsubscribe takes it, as handlers can't be compared.
+type SubscriptionID uint64
+
+// SubscribeOption configures how a subscribed handler is called
+type SubscribeOption func(*subscription)
+
+// WithName names a handler in logs, traces and dead letters. Handlers are
+// named after their function by default.
+func WithName(name string) SubscribeOption {
+	return func(s *subscription) {
+		s.name = name
+	}
+}
+
+// WithTimeout limits how long each call of a handler may take. When the
+// timeout passes the handler's context is canceled and the call fails with
+// ErrHandlerTimeout right away, whatever the handler later returns. The
+// partition doesn't wait for the handler, so a handler ignoring its context
+// may still be running alongside a retry or the next event with the same key.
+// It overrides the timeout of the bus.
+func WithTimeout(timeout time.Duration) SubscribeOption {
+	return func(s *subscription) {
+		s.timeout = timeout
+	}
+}
+
+// WithRetry retries a handler that fails. Start from
+// resilience.DefaultRetryConfig: a config without MaxRetries or
+// MaxElapsedTime retries forever. Retries hold up the events queued after the
+// event, so keep them short.
+func WithRetry(config resilience.RetryConfig) SubscribeOption {
+	return func(s *subscription) {
+		s.retry = &config
+	}
+}
+
+// DeadLetter is an event a handler failed to handle
+type DeadLetter struct {
+	Event        *mcp.Event
+	Subscription SubscriptionID
+	Handler      string
+	Attempts     int
+	Err          error
+	FailedAt     time.Time
+}
+
+// DeadLetterSink receives the events handlers failed to handle, after their
+// retries
+type DeadLetterSink interface {
+	DeadLetter(ctx context.Context, letter *DeadLetter)
+}
+
+// DeadLetterFunc is a function that receives dead letters
+type DeadLetterFunc func(ctx context.Context, letter *DeadLetter)
+
+// DeadLetter calls the function
+func (f DeadLetterFunc) DeadLetter(ctx context.Context, letter *DeadLetter) {
+	f(ctx, letter)
+}
+
+// logDeadLetters is the sink of a bus without one: dead letters are only
+// logged
+var logDeadLetters = DeadLetterFunc(func(ctx context.Context, letter *DeadLetter) {
+	log.Printf("Error handling event %s with %s after %d attempts: %v",
+		letter.Event.Type, letter.Handler, letter.Attempts, letter.Err)
+})
+
+// subscription is a handler subscribed to event types
+type subscription struct {
+	id         SubscriptionID
+	name       string
+	eventTypes []EventType
+	handler    Handler
+	timeout    time.Duration
+	retry      *resilience.RetryConfig
+}
+
+// newSubscription creates a subscription with options
+func newSubscription(id SubscriptionID, eventTypes []EventType, handler Handler, options []SubscribeOption) *subscription {
+	s := &subscription{
+		id:         id,
+		name:       handlerName(handler),
+		eventTypes: eventTypes,
+		handler:    handler,
+	}
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+// handlerName returns the name of a handler's function
+func handlerName(handler Handler) string {
+	if fn := runtime.FuncForPC(reflect.ValueOf(handler).Pointer()); fn != nil {
+		return fn.Name()
+	}
+	return "handler"
+}
+
+// call calls the handler, retrying it if it has a retry policy. It returns
+// the number of attempts and the error of the last one.
+func (s *subscription) call(ctx context.Context, event *mcp.Event, timeout time.Duration) (int, error) {
+	if s.timeout > 0 {
+		timeout = s.timeout
+	}
+
+	if s.retry == nil {
+		return 1, s.attempt(ctx, event, timeout)
+	}
+
+	attempts := 0
+	err := resilience.Retry(ctx, *s.retry, func() error {
+		attempts++
+		return s.attempt(ctx, event, timeout)
+	})
+	return attempts, err
+}
+
+// attempt calls the handler once, recovering it from panics. With a timeout,
+// the handler runs in its own goroutine and is abandoned when its context is
+// done, so that a handler ignoring its context can't hold up the partition.
+func (s *subscription) attempt(ctx context.Context, event *mcp.Event, timeout time.Duration) error {
+	if timeout <= 0 {
+		return s.recover(ctx, event)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.recover(ctx, event)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%w after %s: %w", ErrHandlerTimeout, timeout, ctx.Err())
+		}
+		return ctx.Err()
+	}
+}
+
+// recover calls the handler, turning a panic into an error
+func (s *subscription) recover(ctx context.Context, event *mcp.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v\n%s", ErrHandlerPanic, r, debug.Stack())
+		}
+	}()
+	return s.handler(ctx, event)
+}
@@ -0,0 +1,217 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/resilience"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deadLetters collects the dead letters of a bus
+func deadLetters(bus *EventBus) chan *DeadLetter {
+	letters := make(chan *DeadLetter, 10)
+	bus.WithDeadLetterSink(DeadLetterFunc(func(ctx context.Context, letter *DeadLetter) {
+		letters <- letter
+	}))
+	return letters
+}
+
+// nextLetter waits for a dead letter
+func nextLetter(t *testing.T, letters chan *DeadLetter) *DeadLetter {
+	t.Helper()
+	select {
+	case letter := <-letters:
+		return letter
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event was dead-lettered")
+		return nil
+	}
+}
+
+func TestEventBusRecoversPanickingHandlers(t *testing.T) {
+	bus := NewEventBus(1)
+	defer bus.Close()
+	letters := deadLetters(bus)
+
+	handled := make(chan string, 2)
+	bus.Subscribe(EventContextSummarized, func(ctx context.Context, event *mcp.Event) error {
+		panic("summary failed")
+	}, WithName("summarizer"))
+	bus.Subscribe(EventContextSummarized, func(ctx context.Context, event *mcp.Event) error {
+		handled <- event.ID
+		return nil
+	})
+
+	for _, id := range []string{"first", "second"} {
+		require.NoError(t, bus.Publish(context.Background(), &mcp.Event{ID: id, Type: string(EventContextSummarized)}))
+	}
+
+	// The worker survives the panics and keeps calling the other handler
+	for _, id := range []string{"first", "second"} {
+		letter := nextLetter(t, letters)
+		assert.Equal(t, id, letter.Event.ID)
+		assert.Equal(t, "summarizer", letter.Handler)
+		assert.Equal(t, 1, letter.Attempts)
+		assert.ErrorIs(t, letter.Err, ErrHandlerPanic)
+		assert.Contains(t, letter.Err.Error(), "summary failed")
+		assert.Equal(t, id, <-handled)
+	}
+}
+
+func TestEventBusTimesOutSlowHandlers(t *testing.T) {
+	bus := NewEventBus(1).WithHandlerTimeout(time.Hour)
+	defer bus.Close()
+	letters := deadLetters(bus)
+
+	bus.Subscribe(EventToolActionExecuted, func(ctx context.Context, event *mcp.Event) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, WithTimeout(20*time.Millisecond))
+
+	handled := make(chan struct{}, 1)
+	bus.Subscribe(EventToolDataQueried, func(ctx context.Context, event *mcp.Event) error {
+		handled <- struct{}{}
+		return nil
+	})
+
+	// The publisher's context ending doesn't cancel the handlers
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, bus.Publish(ctx, &mcp.Event{Type: string(EventToolActionExecuted)}))
+	require.NoError(t, bus.Publish(ctx, &mcp.Event{Type: string(EventToolDataQueried)}))
+	cancel()
+
+	letter := nextLetter(t, letters)
+	assert.ErrorIs(t, letter.Err, ErrHandlerTimeout)
+	assert.ErrorIs(t, letter.Err, context.DeadlineExceeded)
+
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the next event was not handled after the timeout")
+	}
+}
+
+func TestEventBusAbandonsTimedOutHandlers(t *testing.T) {
+	bus := NewEventBus(4)
+	defer bus.Close()
+	letters := deadLetters(bus)
+
+	retry := resilience.DefaultRetryConfig()
+	retry.InitialInterval = time.Millisecond
+	retry.MaxRetries = 1
+
+	// The handler ignores its context and succeeds once released, long after
+	// its timeout
+	release := make(chan struct{})
+	defer close(release)
+	var mu sync.Mutex
+	var calls []string
+	handled := make(chan struct{}, 1)
+	bus.Subscribe(EventContextUpdated, func(ctx context.Context, event *mcp.Event) error {
+		seq := event.Data.(map[string]interface{})["seq"].(int)
+		mu.Lock()
+		calls = append(calls, fmt.Sprintf("seq-%d", seq))
+		mu.Unlock()
+
+		if seq == 2 {
+			handled <- struct{}{}
+			return nil
+		}
+		<-release
+		return nil
+	}, WithTimeout(20*time.Millisecond), WithRetry(retry))
+
+	require.NoError(t, bus.Publish(context.Background(), contextEvent("ctx-1", 1)))
+	require.NoError(t, bus.Publish(context.Background(), contextEvent("ctx-1", 2)))
+
+	letter := nextLetter(t, letters)
+	assert.Equal(t, 1, letter.Event.Data.(map[string]interface{})["seq"])
+	assert.ErrorIs(t, letter.Err, ErrHandlerTimeout)
+	assert.ErrorIs(t, letter.Err, context.DeadlineExceeded)
+	assert.Equal(t, 2, letter.Attempts)
+
+	// The partition moves on while the timed out calls are still running
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the next event with the same key was held up by the timed out handler")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"seq-1", "seq-1", "seq-2"}, calls)
+}
+
+func TestEventBusRetriesFailingHandlers(t *testing.T) {
+	bus := NewEventBus(1)
+	defer bus.Close()
+	letters := deadLetters(bus)
+
+	retry := resilience.DefaultRetryConfig()
+	retry.InitialInterval = time.Millisecond
+
+	var calls atomic.Int32
+	handled := make(chan struct{}, 1)
+	bus.Subscribe(EventContextUpdated, func(ctx context.Context, event *mcp.Event) error {
+		if calls.Add(1) < 3 {
+			return errors.New("store unavailable")
+		}
+		handled <- struct{}{}
+		return nil
+	}, WithRetry(retry))
+
+	var failures atomic.Int32
+	bus.Subscribe(EventContextDeleted, func(ctx context.Context, event *mcp.Event) error {
+		failures.Add(1)
+		return errors.New("always fails")
+	}, WithRetry(retry))
+
+	require.NoError(t, bus.Publish(context.Background(), &mcp.Event{Type: string(EventContextUpdated)}))
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the handler was not retried")
+	}
+	assert.Equal(t, int32(3), calls.Load())
+
+	require.NoError(t, bus.Publish(context.Background(), &mcp.Event{Type: string(EventContextDeleted)}))
+	letter := nextLetter(t, letters)
+	assert.Equal(t, retry.MaxRetries+1, letter.Attempts)
+	assert.Equal(t, int32(retry.MaxRetries+1), failures.Load())
+	assert.EqualError(t, letter.Err, "always fails")
+	assert.Empty(t, letters, "only the failing handler is dead-lettered")
+}
+
+// counter is a handler subscribed by its method value
+type counter struct {
+	calls atomic.Int32
+}
+
+func (c *counter) handle(ctx context.Context, event *mcp.Event) error {
+	c.calls.Add(1)
+	return nil
+}
+
+func TestEventBusUnsubscribesBySubscription(t *testing.T) {
+	bus := NewEventBus(1)
+	defer bus.Close()
+
+	// The method values of two counters share a function pointer
+	first, second := &counter{}, &counter{}
+	id := bus.SubscribeMultiple([]EventType{EventAgentConnected, EventAgentDisconnected}, first.handle)
+	bus.SubscribeMultiple([]EventType{EventAgentConnected, EventAgentDisconnected}, second.handle)
+	bus.Unsubscribe(id)
+
+	require.NoError(t, bus.Publish(context.Background(), &mcp.Event{Type: string(EventAgentConnected)}))
+	require.NoError(t, bus.Publish(context.Background(), &mcp.Event{Type: string(EventAgentDisconnected)}))
+	require.Eventually(t, func() bool { return second.calls.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Zero(t, first.calls.Load())
+}
@@ -0,0 +1,238 @@
+package eventstore
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// segmentLayout is the date layout of the names of segment files
+const segmentLayout = "2006-01-02"
+
+// FileStore appends events to newline-delimited JSON files in a directory,
+// one file per day of event time, named events-YYYY-MM-DD.ndjson. It is meant
+// for single servers without a database; servers sharing the Redis event bus
+// should use the Postgres store, so that their events are queried together.
+type FileStore struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+// NewFileStore creates a new event store writing to a directory, creating
+// the directory if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, errors.New("the file event store needs a directory")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create event store directory %s: %w", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Append stores an event in the file of its day
+func (s *FileStore) Append(ctx context.Context, event *mcp.Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event %s: %w", event.ID, err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.segmentPath(event.Timestamp), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to store event %s: %w", event.ID, err)
+	}
+	if _, err := file.Write(line); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to store event %s: %w", event.ID, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to store event %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+// Query lists the events matching a query, oldest first. Only the files of
+// the days in the filter's time window are read.
+func (s *FileStore) Query(ctx context.Context, query Query) (*Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	segments, err := s.segments()
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*mcp.Event
+	for _, day := range segments {
+		if !query.Filter.After.IsZero() && day.Before(truncateDay(query.Filter.After)) {
+			continue
+		}
+		if !query.Filter.Before.IsZero() && day.After(query.Filter.Before) {
+			continue
+		}
+		segment, err := s.readSegment(day)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range segment {
+			if query.Filter.MatchEvent(*event) {
+				events = append(events, event)
+			}
+		}
+	}
+
+	sortEvents(events)
+	return page(events, query)
+}
+
+// Delete deletes the events recorded before a time. Files left empty are
+// removed, and files with events left are rewritten.
+func (s *FileStore) Delete(ctx context.Context, options DeleteOptions) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments, err := s.segments()
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int64
+	for _, day := range segments {
+		if !day.Before(options.Before) {
+			continue
+		}
+		segment, err := s.readSegment(day)
+		if err != nil {
+			return deleted, err
+		}
+
+		var kept []*mcp.Event
+		for _, event := range segment {
+			if !options.matches(event) {
+				kept = append(kept, event)
+			}
+		}
+		if len(kept) == len(segment) {
+			continue
+		}
+		if err := s.writeSegment(day, kept); err != nil {
+			return deleted, err
+		}
+		deleted += int64(len(segment) - len(kept))
+	}
+	return deleted, nil
+}
+
+// segmentPath returns the path of the file of a day
+func (s *FileStore) segmentPath(day time.Time) string {
+	return filepath.Join(s.dir, "events-"+day.UTC().Format(segmentLayout)+".ndjson")
+}
+
+// segments returns the days that have a file, oldest first
+func (s *FileStore) segments() ([]time.Time, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event files: %w", err)
+	}
+
+	var days []time.Time
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "events-") || !strings.HasSuffix(name, ".ndjson") {
+			continue
+		}
+		day, err := time.Parse(segmentLayout, strings.TrimSuffix(strings.TrimPrefix(name, "events-"), ".ndjson"))
+		if err != nil {
+			continue
+		}
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days, nil
+}
+
+// readSegment reads the events in the file of a day. A truncated last line,
+// left by a crash while appending, is skipped.
+func (s *FileStore) readSegment(day time.Time) ([]*mcp.Event, error) {
+	path := s.segmentPath(day)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var events []*mcp.Event
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var event mcp.Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		events = append(events, &event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event file %s: %w", path, err)
+	}
+	return events, nil
+}
+
+// writeSegment replaces the file of a day with events, removing it if there
+// are none
+func (s *FileStore) writeSegment(day time.Time, events []*mcp.Event) error {
+	path := s.segmentPath(day)
+	if len(events) == 0 {
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to remove event file %s: %w", path, err)
+		}
+		return nil
+	}
+
+	temp, err := os.CreateTemp(s.dir, ".events-*")
+	if err != nil {
+		return fmt.Errorf("failed to rewrite event file %s: %w", path, err)
+	}
+	defer os.Remove(temp.Name())
+
+	writer := bufio.NewWriter(temp)
+	encoder := json.NewEncoder(writer)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			temp.Close()
+			return fmt.Errorf("failed to rewrite event file %s: %w", path, err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		temp.Close()
+		return fmt.Errorf("failed to rewrite event file %s: %w", path, err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("failed to rewrite event file %s: %w", path, err)
+	}
+	if err := os.Rename(temp.Name(), path); err != nil {
+		return fmt.Errorf("failed to rewrite event file %s: %w", path, err)
+	}
+	return nil
+}
+
+// truncateDay returns the start of the UTC day of a time
+func truncateDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,172 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// eventColumns are the mcp.events columns of an event
+const eventColumns = `id, source, type, data, timestamp, agent_id, session_id`
+
+// PostgresStore keeps events in the mcp.events table, as rows of kind event.
+// Inbox deliveries share the table as rows of kind webhook.
+type PostgresStore struct {
+	db *sqlx.DB
+}
+
+// NewPostgresStore creates a new event store backed by Postgres
+func NewPostgresStore(db *sqlx.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// eventRow is a row of the mcp.events table
+type eventRow struct {
+	ID        string         `db:"id"`
+	Source    string         `db:"source"`
+	Type      string         `db:"type"`
+	Data      []byte         `db:"data"`
+	Timestamp time.Time      `db:"timestamp"`
+	AgentID   sql.NullString `db:"agent_id"`
+	SessionID sql.NullString `db:"session_id"`
+}
+
+// Append stores an event. Events delivered more than once, such as by the
+// Redis event bus, are stored once.
+func (s *PostgresStore) Append(ctx context.Context, event *mcp.Event) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("failed to encode data of event %s: %w", event.ID, err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO mcp.events (
+			id, kind, source, type, data, timestamp, agent_id, session_id,
+			status, processed, created_at, updated_at
+		) VALUES ($1, 'event', $2, $3, $4, $5, $6, $7, 'stored', TRUE, $5, $5)
+		ON CONFLICT (id) DO NOTHING`,
+		event.ID, event.Source, event.Type, data, event.Timestamp,
+		sql.NullString{String: event.AgentID, Valid: event.AgentID != ""},
+		sql.NullString{String: event.SessionID, Valid: event.SessionID != ""})
+	if err != nil {
+		return fmt.Errorf("failed to store event %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+// Query lists the events matching a query, oldest first
+func (s *PostgresStore) Query(ctx context.Context, query Query) (*Page, error) {
+	conditions, args := filterConditions(query.Filter)
+	if query.Cursor != "" {
+		position, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, position.timestamp, position.id)
+		conditions = append(conditions, fmt.Sprintf("(timestamp, id) > ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	sqlQuery := `SELECT ` + eventColumns + ` FROM mcp.events WHERE ` + strings.Join(conditions, " AND ") +
+		` ORDER BY timestamp, id`
+	if query.Limit > 0 {
+		// One more event tells whether there is a next page
+		args = append(args, query.Limit+1)
+		sqlQuery += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	var rows []eventRow
+	if err := s.db.SelectContext(ctx, &rows, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+
+	result := &Page{Events: make([]*mcp.Event, 0, len(rows))}
+	for i := range rows {
+		if query.Limit > 0 && i == query.Limit {
+			result.NextCursor = encodeCursor(result.Events[i-1])
+			break
+		}
+		event, err := rows[i].toEvent()
+		if err != nil {
+			return nil, err
+		}
+		result.Events = append(result.Events, event)
+	}
+	return result, nil
+}
+
+// Delete deletes the events recorded before a time
+func (s *PostgresStore) Delete(ctx context.Context, options DeleteOptions) (int64, error) {
+	args := []interface{}{options.Before}
+	query := `DELETE FROM mcp.events WHERE kind = 'event' AND timestamp < $1`
+	if len(options.Types) > 0 {
+		args = append(args, pq.Array(options.Types))
+		query += fmt.Sprintf(" AND type = ANY($%d)", len(args))
+	}
+	if len(options.ExceptTypes) > 0 {
+		args = append(args, pq.Array(options.ExceptTypes))
+		query += fmt.Sprintf(" AND NOT (type = ANY($%d))", len(args))
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete events: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete events: %w", err)
+	}
+	return deleted, nil
+}
+
+// filterConditions returns the conditions and arguments selecting the events
+// matching a filter
+func filterConditions(filter mcp.EventFilter) ([]string, []interface{}) {
+	conditions := []string{"kind = 'event'"}
+	var args []interface{}
+	for _, field := range []struct {
+		column string
+		values []string
+	}{
+		{"source", filter.Sources},
+		{"type", filter.Types},
+		{"agent_id", filter.AgentIDs},
+		{"session_id", filter.SessionIDs},
+	} {
+		if len(field.values) > 0 {
+			args = append(args, pq.Array(field.values))
+			conditions = append(conditions, fmt.Sprintf("%s = ANY($%d)", field.column, len(args)))
+		}
+	}
+	if !filter.After.IsZero() {
+		args = append(args, filter.After)
+		conditions = append(conditions, fmt.Sprintf("timestamp >= $%d", len(args)))
+	}
+	if !filter.Before.IsZero() {
+		args = append(args, filter.Before)
+		conditions = append(conditions, fmt.Sprintf("timestamp <= $%d", len(args)))
+	}
+	return conditions, args
+}
+
+// toEvent converts a row to an event
+func (r *eventRow) toEvent() (*mcp.Event, error) {
+	event := &mcp.Event{
+		ID:        r.ID,
+		Source:    r.Source,
+		Type:      r.Type,
+		Timestamp: r.Timestamp,
+		AgentID:   r.AgentID.String,
+		SessionID: r.SessionID.String,
+	}
+	if err := json.Unmarshal(r.Data, &event.Data); err != nil {
+		return nil, fmt.Errorf("failed to decode data of event %s: %w", r.ID, err)
+	}
+	return event, nil
+}
@@ -0,0 +1,280 @@
+// Package eventstore keeps a history of events. A recorder appends every
+// event published on the event bus and every adapter event to an append-only
+// store, in Postgres or in files, deletes them once their retention policy
+// expires, and serves queries and exports of the history. Events handlers
+// failed to handle are recorded as dead letters.
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	adapterEvents "github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/adapters/resilience"
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/google/uuid"
+)
+
+// exportPageSize is the number of events read at a time by exports
+const exportPageSize = 1000
+
+// DeadLetterType is the type of the events recorded for dead letters
+const DeadLetterType = "event.dead_lettered"
+
+// RetentionPolicy keeps the events of some types for a different time than
+// the default
+type RetentionPolicy struct {
+	// Types are the event types the policy applies to
+	Types []string
+	// MaxAge is the time events are kept. Zero keeps them forever.
+	MaxAge time.Duration
+}
+
+// Config holds configuration for recording events
+type Config struct {
+	// Retention is the time events are kept unless a policy applies to
+	// their type. Zero keeps them forever.
+	Retention time.Duration
+	// Policies are the retention policies of particular event types
+	Policies []RetentionPolicy
+	// PruneInterval is how often expired events are deleted
+	PruneInterval time.Duration
+}
+
+// DefaultConfig returns the default recording configuration. Events are
+// kept for 90 days and expired events are deleted hourly.
+func DefaultConfig() Config {
+	return Config{
+		Retention:     90 * 24 * time.Hour,
+		PruneInterval: time.Hour,
+	}
+}
+
+// Recorder records events in a store
+type Recorder struct {
+	store  Store
+	config Config
+	logger *observability.Logger
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRecorder creates a new recorder. Call Start to start deleting expired
+// events.
+func NewRecorder(store Store, config Config, logger *observability.Logger) *Recorder {
+	if config.PruneInterval <= 0 {
+		config.PruneInterval = DefaultConfig().PruneInterval
+	}
+	if logger == nil {
+		logger = observability.NewLogger("eventstore")
+	}
+	return &Recorder{
+		store:  store,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Attach records the events of the given types published on a bus. With
+// the Redis bus, each event is recorded by the server that handles it.
+func (r *Recorder) Attach(bus events.Bus, eventTypes []events.EventType) {
+	bus.SubscribeMultiple(eventTypes, r.Record,
+		events.WithName("eventstore.Recorder.Record"),
+		events.WithRetry(resilience.DefaultRetryConfig()))
+}
+
+// DeadLetter records an event a handler failed to handle, so failures can be
+// found in the history. It lets the recorder be the dead-letter sink of an
+// events.EventBus.
+func (r *Recorder) DeadLetter(ctx context.Context, letter *events.DeadLetter) {
+	r.logger.Error("Event handler failed", map[string]interface{}{
+		"event_id":   letter.Event.ID,
+		"event_type": letter.Event.Type,
+		"handler":    letter.Handler,
+		"attempts":   letter.Attempts,
+		"error":      letter.Err.Error(),
+	})
+
+	r.Record(ctx, &mcp.Event{
+		ID:        uuid.New().String(),
+		Source:    "mcp-server",
+		Type:      DeadLetterType,
+		Timestamp: letter.FailedAt,
+		AgentID:   letter.Event.AgentID,
+		SessionID: letter.Event.SessionID,
+		Data: map[string]interface{}{
+			"event_id":   letter.Event.ID,
+			"event_type": letter.Event.Type,
+			"handler":    letter.Handler,
+			"attempts":   letter.Attempts,
+			"error":      letter.Err.Error(),
+		},
+	})
+}
+
+// Record stores an event. Failures are returned so the bus can retry the
+// event.
+func (r *Recorder) Record(ctx context.Context, event *mcp.Event) error {
+	if err := r.store.Append(ctx, event); err != nil {
+		r.logger.Error("Failed to record event", map[string]interface{}{
+			"event_id":   event.ID,
+			"event_type": event.Type,
+			"error":      err.Error(),
+		})
+		return err
+	}
+	return nil
+}
+
+// Handle stores an adapter event, in the form it has as a CloudEvent. It
+// lets the recorder listen to the adapters' event bus.
+func (r *Recorder) Handle(ctx context.Context, event *adapterEvents.AdapterEvent) error {
+	cloudEvent, err := cloudevents.FromAdapterEvent(event)
+	if err != nil {
+		return fmt.Errorf("failed to convert adapter event %s: %w", event.ID, err)
+	}
+	converted, err := cloudevents.ToMCPEvent(cloudEvent)
+	if err != nil {
+		return fmt.Errorf("failed to convert adapter event %s: %w", event.ID, err)
+	}
+	return r.Record(ctx, converted)
+}
+
+// Query lists the recorded events matching a query, oldest first
+func (r *Recorder) Query(ctx context.Context, query Query) (*Page, error) {
+	return r.store.Query(ctx, query)
+}
+
+// Export writes the recorded events matching a filter to w as
+// newline-delimited structured CloudEvents, oldest first. It returns the
+// number of events written.
+func (r *Recorder) Export(ctx context.Context, w io.Writer, filter mcp.EventFilter) (int, error) {
+	encoder := json.NewEncoder(w)
+	query := Query{Filter: filter, Limit: exportPageSize}
+	written := 0
+	for {
+		result, err := r.store.Query(ctx, query)
+		if err != nil {
+			return written, err
+		}
+		for _, event := range result.Events {
+			cloudEvent, err := cloudevents.FromMCPEvent(event)
+			if err != nil {
+				return written, fmt.Errorf("failed to convert event %s: %w", event.ID, err)
+			}
+			if err := encoder.Encode(cloudEvent); err != nil {
+				return written, err
+			}
+			written++
+		}
+		if result.NextCursor == "" {
+			return written, nil
+		}
+		query.Cursor = result.NextCursor
+	}
+}
+
+// Prune deletes the events whose retention policy expired. It returns the
+// number of events deleted.
+func (r *Recorder) Prune(ctx context.Context) (int64, error) {
+	now := time.Now()
+	var deleted int64
+	var overridden []string
+	for _, policy := range r.config.Policies {
+		overridden = append(overridden, policy.Types...)
+		if policy.MaxAge <= 0 || len(policy.Types) == 0 {
+			continue
+		}
+		n, err := r.store.Delete(ctx, DeleteOptions{Before: now.Add(-policy.MaxAge), Types: policy.Types})
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+	}
+
+	if r.config.Retention > 0 {
+		n, err := r.store.Delete(ctx, DeleteOptions{Before: now.Add(-r.config.Retention), ExceptTypes: overridden})
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// Start starts deleting expired events periodically
+func (r *Recorder) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go r.prune(ctx)
+}
+
+// Stop stops deleting expired events, waiting until a deletion in progress
+// finishes or ctx is done
+func (r *Recorder) Stop(ctx context.Context) error {
+	r.mu.Lock()
+	cancel := r.cancel
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// prune deletes expired events until ctx is done
+func (r *Recorder) prune(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.config.PruneInterval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := r.Prune(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Warn("Failed to delete expired events", map[string]interface{}{
+				"error": err.Error(),
+			})
+		} else if deleted > 0 {
+			r.logger.Info("Deleted expired events", map[string]interface{}{
+				"deleted": deleted,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
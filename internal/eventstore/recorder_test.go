@@ -0,0 +1,143 @@
+package eventstore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	adapterEvents "github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderRecordsBusAndAdapterEvents(t *testing.T) {
+	store := NewMemoryStore()
+	recorder := NewRecorder(store, DefaultConfig(), nil)
+
+	bus := events.NewEventBus(1)
+	defer bus.Close()
+	recorder.Attach(bus, events.AllEventTypes)
+	adapterBus := adapterEvents.NewEventBus(observability.NewLogger("test"))
+	adapterBus.SubscribeAll(recorder)
+
+	ctx := context.Background()
+	require.NoError(t, events.PublishContextEvent(bus, ctx, events.EventContextUpdated, "ctx-1", "agent-1", "", nil))
+	require.NoError(t, adapterBus.Emit(ctx, adapterEvents.NewAdapterEvent("github", adapterEvents.EventTypeWebhookReceived, nil).
+		WithMetadata("eventType", "push")))
+
+	require.Eventually(t, func() bool {
+		page, err := store.Query(ctx, Query{})
+		return err == nil && len(page.Events) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	agentEvents, err := recorder.Query(ctx, Query{Filter: mcp.EventFilter{AgentIDs: []string{"agent-1"}}})
+	require.NoError(t, err)
+	require.Len(t, agentEvents.Events, 1)
+	assert.Equal(t, "context.updated", agentEvents.Events[0].Type)
+
+	webhooks, err := recorder.Query(ctx, Query{Filter: mcp.EventFilter{Sources: []string{cloudevents.AdapterSource("github")}}})
+	require.NoError(t, err)
+	require.Len(t, webhooks.Events, 1)
+	assert.Equal(t, "webhook.received", webhooks.Events[0].Type)
+}
+
+func TestRecorderPrunesByPolicy(t *testing.T) {
+	store := NewMemoryStore()
+	recorder := NewRecorder(store, Config{
+		Retention: 90 * 24 * time.Hour,
+		Policies: []RetentionPolicy{
+			{Types: []string{"adapter.health.changed"}, MaxAge: 24 * time.Hour},
+			{Types: []string{"tool.action.executed"}},
+		},
+	}, nil)
+
+	ctx := context.Background()
+	now := time.Now()
+	for _, event := range []*mcp.Event{
+		testEvent("recent-health", "adapter.health.changed", "", now.Add(-time.Hour)),
+		testEvent("old-health", "adapter.health.changed", "", now.Add(-48*time.Hour)),
+		testEvent("old-context", "context.created", "agent-1", now.Add(-100*24*time.Hour)),
+		testEvent("recent-context", "context.created", "agent-1", now.Add(-80*24*time.Hour)),
+		testEvent("old-action", "tool.action.executed", "agent-1", now.Add(-400*24*time.Hour)),
+	} {
+		require.NoError(t, recorder.Record(ctx, event))
+	}
+
+	deleted, err := recorder.Prune(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	page, err := store.Query(ctx, Query{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old-action", "recent-context", "recent-health"}, eventIDs(page.Events),
+		"policies without a max age keep events forever")
+
+	recorder.Start()
+	assert.NoError(t, recorder.Stop(ctx))
+}
+
+func TestRecorderExportsNDJSON(t *testing.T) {
+	store := NewMemoryStore()
+	recorder := NewRecorder(store, DefaultConfig(), nil)
+
+	ctx := context.Background()
+	base := time.Now().Add(-time.Hour)
+	total := exportPageSize + 5
+	for i := 0; i < total; i++ {
+		require.NoError(t, store.Append(ctx, testEvent(fmt.Sprintf("event-%04d", i), "tool.action.executed", "agent-1", base.Add(time.Duration(i)*time.Millisecond))))
+	}
+	require.NoError(t, store.Append(ctx, testEvent("other-agent", "tool.action.executed", "agent-2", base)))
+
+	var buf bytes.Buffer
+	written, err := recorder.Export(ctx, &buf, mcp.EventFilter{AgentIDs: []string{"agent-1"}})
+	require.NoError(t, err)
+	assert.Equal(t, total, written)
+
+	scanner := bufio.NewScanner(&buf)
+	lines := 0
+	for scanner.Scan() {
+		var event cloudevents.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		assert.Equal(t, fmt.Sprintf("event-%04d", lines), event.ID)
+		assert.Equal(t, "agent-1", event.Extension(cloudevents.AgentIDExtension))
+		lines++
+	}
+	assert.Equal(t, total, lines)
+}
+
+func TestRecorderRecordsDeadLetters(t *testing.T) {
+	store := NewMemoryStore()
+	recorder := NewRecorder(store, DefaultConfig(), nil)
+
+	bus := events.NewEventBus(1).WithDeadLetterSink(recorder)
+	defer bus.Close()
+	bus.Subscribe(events.EventContextSummarized, func(ctx context.Context, event *mcp.Event) error {
+		panic("summary failed")
+	}, events.WithName("summarizer"))
+
+	ctx := context.Background()
+	require.NoError(t, events.PublishContextEvent(bus, ctx, events.EventContextSummarized, "ctx-1", "agent-1", "", nil))
+
+	var page *Page
+	require.Eventually(t, func() bool {
+		var err error
+		page, err = recorder.Query(ctx, Query{Filter: mcp.EventFilter{Types: []string{DeadLetterType}}})
+		return err == nil && len(page.Events) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	letter := page.Events[0]
+	assert.Equal(t, "agent-1", letter.AgentID)
+	data := letter.Data.(map[string]interface{})
+	assert.Equal(t, "context.summarized", data["event_type"])
+	assert.Equal(t, "summarizer", data["handler"])
+	assert.Equal(t, 1, data["attempts"])
+	assert.Contains(t, data["error"], "summary failed")
+}
@@ -0,0 +1,228 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// ErrInvalidCursor indicates a cursor wasn't returned by the store
+var ErrInvalidCursor = errors.New("invalid event cursor")
+
+// Store is an append-only store of events
+type Store interface {
+	// Append stores an event
+	Append(ctx context.Context, event *mcp.Event) error
+
+	// Query lists the events matching a query, oldest first
+	Query(ctx context.Context, query Query) (*Page, error)
+
+	// Delete deletes the events recorded before a time. It returns the
+	// number of events deleted.
+	Delete(ctx context.Context, options DeleteOptions) (int64, error)
+}
+
+// Query selects a page of stored events
+type Query struct {
+	// Filter selects the events
+	Filter mcp.EventFilter
+	// Limit is the maximum number of events returned
+	Limit int
+	// Cursor is the NextCursor of the previous page, or empty for the first
+	// page
+	Cursor string
+}
+
+// Page is a page of stored events
+type Page struct {
+	Events []*mcp.Event
+	// NextCursor selects the events after this page. It is empty when the
+	// page is the last one.
+	NextCursor string
+}
+
+// DeleteOptions selects the events deleted by a retention policy
+type DeleteOptions struct {
+	// Before is the time before which events are deleted
+	Before time.Time
+	// Types restricts the deletion to events of these types
+	Types []string
+	// ExceptTypes excludes events of these types, which have their own
+	// retention policy
+	ExceptTypes []string
+}
+
+// matches reports whether a stored event is selected
+func (o DeleteOptions) matches(event *mcp.Event) bool {
+	if !event.Timestamp.Before(o.Before) {
+		return false
+	}
+	if len(o.Types) > 0 && !contains(o.Types, event.Type) {
+		return false
+	}
+	return !contains(o.ExceptTypes, event.Type)
+}
+
+// cursor is the position of an event in the order of the store
+type cursor struct {
+	timestamp time.Time
+	id        string
+}
+
+// after reports whether an event comes after the cursor
+func (c cursor) after(event *mcp.Event) bool {
+	if !event.Timestamp.Equal(c.timestamp) {
+		return event.Timestamp.After(c.timestamp)
+	}
+	return event.ID > c.id
+}
+
+// encodeCursor returns the cursor of the events after an event
+func encodeCursor(event *mcp.Event) string {
+	raw := event.Timestamp.UTC().Format(time.RFC3339Nano) + "|" + event.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor reads a cursor returned by encodeCursor
+func decodeCursor(encoded string) (cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor{}, ErrInvalidCursor
+	}
+	timestamp, id, found := strings.Cut(string(raw), "|")
+	if !found || id == "" {
+		return cursor{}, ErrInvalidCursor
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return cursor{}, ErrInvalidCursor
+	}
+	return cursor{timestamp: parsed, id: id}, nil
+}
+
+// sortEvents sorts events in the order of the store: by time, then by ID
+func sortEvents(events []*mcp.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Timestamp.Equal(events[j].Timestamp) {
+			return events[i].Timestamp.Before(events[j].Timestamp)
+		}
+		return events[i].ID < events[j].ID
+	})
+}
+
+// page returns the page of sorted events selected by a query
+func page(events []*mcp.Event, query Query) (*Page, error) {
+	start := 0
+	if query.Cursor != "" {
+		position, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = sort.Search(len(events), func(i int) bool { return position.after(events[i]) })
+	}
+
+	var selected []*mcp.Event
+	for _, event := range events[start:] {
+		if !query.Filter.MatchEvent(*event) {
+			continue
+		}
+		if query.Limit > 0 && len(selected) == query.Limit {
+			return &Page{Events: selected, NextCursor: encodeCursor(selected[len(selected)-1])}, nil
+		}
+		selected = append(selected, event)
+	}
+	return &Page{Events: selected}, nil
+}
+
+// contains reports whether values contains value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// copyEvent returns a copy of an event, so stored events aren't changed by
+// their publishers
+func copyEvent(event *mcp.Event) *mcp.Event {
+	copied := *event
+	return &copied
+}
+
+// MemoryStore keeps events in memory. They are lost when the server stops,
+// so it is only used when no database or file store is configured.
+type MemoryStore struct {
+	mu     sync.Mutex
+	events []*mcp.Event
+	ids    map[string]struct{}
+}
+
+// NewMemoryStore creates a new in-memory event store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{ids: make(map[string]struct{})}
+}
+
+// Append stores an event. An event already stored is not stored again.
+func (s *MemoryStore) Append(ctx context.Context, event *mcp.Event) error {
+	if event.ID == "" {
+		return fmt.Errorf("event of type %s has no ID", event.Type)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.ids[event.ID]; ok {
+		return nil
+	}
+	s.ids[event.ID] = struct{}{}
+
+	// Events are mostly appended in order
+	stored := copyEvent(event)
+	i := len(s.events)
+	for i > 0 && (cursor{timestamp: stored.Timestamp, id: stored.ID}).after(s.events[i-1]) {
+		i--
+	}
+	s.events = append(s.events, nil)
+	copy(s.events[i+1:], s.events[i:])
+	s.events[i] = stored
+	return nil
+}
+
+// Query lists the events matching a query, oldest first
+func (s *MemoryStore) Query(ctx context.Context, query Query) (*Page, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return page(s.events, query)
+}
+
+// Delete deletes the events recorded before a time
+func (s *MemoryStore) Delete(ctx context.Context, options DeleteOptions) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.events[:0]
+	var deleted int64
+	for _, event := range s.events {
+		if options.matches(event) {
+			delete(s.ids, event.ID)
+			deleted++
+			continue
+		}
+		kept = append(kept, event)
+	}
+	for i := len(kept); i < len(s.events); i++ {
+		s.events[i] = nil
+	}
+	s.events = kept
+	return deleted, nil
+}
@@ -0,0 +1,193 @@
+package eventstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEvent returns an event of an agent
+func testEvent(id, eventType, agentID string, timestamp time.Time) *mcp.Event {
+	return &mcp.Event{
+		ID:        id,
+		Source:    "mcp-server",
+		Type:      eventType,
+		Timestamp: timestamp,
+		Data:      map[string]interface{}{"context_id": "ctx-" + id},
+		AgentID:   agentID,
+	}
+}
+
+// eventIDs returns the IDs of events
+func eventIDs(events []*mcp.Event) []string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+// testStore checks the behavior shared by the stores
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+	base := time.Date(2024, 5, 1, 23, 59, 0, 0, time.UTC)
+
+	// Appended out of order, on both sides of midnight
+	for _, event := range []*mcp.Event{
+		testEvent("b", "tool.action.executed", "agent-1", base.Add(time.Minute)),
+		testEvent("a", "context.created", "agent-1", base),
+		testEvent("c", "tool.action.executed", "agent-2", base.Add(time.Minute)),
+		testEvent("d", "tool.action.executed", "agent-1", base.Add(2*time.Minute)),
+		testEvent("e", "context.updated", "agent-1", base.Add(48*time.Hour)),
+	} {
+		require.NoError(t, store.Append(ctx, event))
+	}
+
+	all, err := store.Query(ctx, Query{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, eventIDs(all.Events))
+	assert.Empty(t, all.NextCursor)
+	assert.Equal(t, "ctx-a", all.Events[0].Data.(map[string]interface{})["context_id"])
+
+	// Pages of an agent's actions
+	query := Query{
+		Filter: mcp.EventFilter{Types: []string{"tool.action.executed"}, AgentIDs: []string{"agent-1"}},
+		Limit:  1,
+	}
+	first, err := store.Query(ctx, query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, eventIDs(first.Events))
+	require.NotEmpty(t, first.NextCursor)
+
+	query.Cursor = first.NextCursor
+	second, err := store.Query(ctx, query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d"}, eventIDs(second.Events))
+	assert.Empty(t, second.NextCursor, "the last page has no cursor")
+
+	window, err := store.Query(ctx, Query{Filter: mcp.EventFilter{After: base.Add(time.Minute), Before: base.Add(time.Hour)}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c", "d"}, eventIDs(window.Events))
+
+	_, err = store.Query(ctx, Query{Cursor: "not a cursor"})
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	// Events of types with their own policy are deleted separately
+	deleted, err := store.Delete(ctx, DeleteOptions{Before: base.Add(2 * time.Minute), ExceptTypes: []string{"context.created"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	deleted, err = store.Delete(ctx, DeleteOptions{Before: base.Add(time.Hour), Types: []string{"context.created"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	left, err := store.Query(ctx, Query{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d", "e"}, eventIDs(left.Events))
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	testStore(t, store)
+
+	// Redelivered events are stored once
+	event := testEvent("f", "context.created", "agent-1", time.Now())
+	require.NoError(t, store.Append(context.Background(), event))
+	require.NoError(t, store.Append(context.Background(), event))
+	page, err := store.Query(context.Background(), Query{Filter: mcp.EventFilter{Types: []string{"context.created"}}})
+	require.NoError(t, err)
+	assert.Len(t, page.Events, 1)
+
+	assert.Error(t, store.Append(context.Background(), &mcp.Event{Type: "context.created"}))
+}
+
+func TestFileStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "events")
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+	testStore(t, store)
+
+	// The emptied day was removed, and events are kept in their day's file
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.Equal(t, []string{"events-2024-05-02.ndjson", "events-2024-05-03.ndjson"}, names)
+
+	// A line truncated by a crash is skipped
+	file, err := os.OpenFile(filepath.Join(dir, "events-2024-05-03.ndjson"), os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"id":"trunc`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	reopened, err := NewFileStore(dir)
+	require.NoError(t, err)
+	page, err := reopened.Query(context.Background(), Query{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d", "e"}, eventIDs(page.Events))
+
+	_, err = NewFileStore("")
+	assert.Error(t, err)
+}
+
+func TestPostgresStore(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	store := NewPostgresStore(sqlx.NewDb(mockDB, "postgres"))
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	event := testEvent("3f2c7c4e-8a8e-4c4f-9d0b-2d6a3f1e9b10", "tool.action.executed", "agent-1", now)
+	columns := []string{"id", "source", "type", "data", "timestamp", "agent_id", "session_id"}
+
+	mock.ExpectExec(regexp.QuoteMeta("ON CONFLICT (id) DO NOTHING")).
+		WithArgs(event.ID, "mcp-server", "tool.action.executed", []byte(`{"context_id":"ctx-`+event.ID+`"}`), now,
+			"agent-1", nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, store.Append(ctx, event))
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.events WHERE kind = 'event' AND type = ANY($1) AND agent_id = ANY($2) AND timestamp >= $3 ORDER BY timestamp, id LIMIT $4")).
+		WithArgs(pq.Array([]string{"tool.action.executed"}), pq.Array([]string{"agent-1"}), now.Add(-time.Hour), 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(event.ID, "mcp-server", "tool.action.executed", []byte(`{"context_id":"ctx-1"}`), now, "agent-1", nil).
+			AddRow("5d1e0a2b-7c3f-4e9a-8b6d-1f2e3a4b5c6d", "mcp-server", "tool.action.executed", []byte(`null`), now, "agent-1", nil))
+	page, err := store.Query(ctx, Query{
+		Filter: mcp.EventFilter{Types: []string{"tool.action.executed"}, AgentIDs: []string{"agent-1"}, After: now.Add(-time.Hour)},
+		Limit:  1,
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Events, 1)
+	assert.Equal(t, "agent-1", page.Events[0].AgentID)
+	assert.Equal(t, map[string]interface{}{"context_id": "ctx-1"}, page.Events[0].Data)
+	require.NotEmpty(t, page.NextCursor)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.events WHERE kind = 'event' AND (timestamp, id) > ($1, $2) ORDER BY timestamp, id LIMIT $3")).
+		WithArgs(now, event.ID, 2).
+		WillReturnRows(sqlmock.NewRows(columns))
+	page, err = store.Query(ctx, Query{Limit: 1, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	assert.Empty(t, page.Events)
+	assert.Empty(t, page.NextCursor)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM mcp.events WHERE kind = 'event' AND timestamp < $1 AND NOT (type = ANY($2))")).
+		WithArgs(now, pq.Array([]string{"adapter.health.changed"})).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	deleted, err := store.Delete(ctx, DeleteOptions{Before: now, ExceptTypes: []string{"adapter.health.changed"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
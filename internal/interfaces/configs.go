@@ -2,6 +2,8 @@ package interfaces
 
 import (
 	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/cache"
 )
 
 // CoreConfig holds configuration for the core engine
@@ -12,6 +14,81 @@ type CoreConfig struct {
 	MaxToolDuration  time.Duration `mapstructure:"max_tool_duration"`
 	DefaultModelID   string        `mapstructure:"default_model_id"`
 	LogEvents        bool          `mapstructure:"log_events"`
+	Correlation      CorrelationConfig `mapstructure:"correlation"`
+	EventBus         EventBusConfig    `mapstructure:"event_bus"`
+	EventStore       EventStoreConfig  `mapstructure:"event_store"`
+	Subscriptions    SubscriptionsConfig `mapstructure:"subscriptions"`
+
+	// Adapters holds the configuration of each adapter, keyed by adapter
+	// type. It is the adapters section and is not read from the engine section.
+	Adapters map[string]interface{} `mapstructure:"-"`
+}
+
+// EventBusConfig holds configuration for the event bus. The memory bus
+// delivers events within one server; the redis bus delivers each event to
+// one of the servers sharing a Redis stream.
+type EventBusConfig struct {
+	Type          string        `mapstructure:"type"`           // "memory" or "redis"
+	Stream        string        `mapstructure:"stream"`         // Redis stream events are added to
+	Group         string        `mapstructure:"group"`          // Consumer group shared by the servers
+	Consumer      string        `mapstructure:"consumer"`       // Consumer name, the hostname by default
+	Workers       int           `mapstructure:"workers"`        // Goroutines handling events
+	MaxLen        int64         `mapstructure:"max_len"`        // Approximate number of events retained
+	ClaimIdle     time.Duration `mapstructure:"claim_idle"`     // Idle time after which unacknowledged events are reclaimed
+	MaxDeliveries int           `mapstructure:"max_deliveries"` // Deliveries before an event is dead-lettered
+	PartitionKeys []string      `mapstructure:"partition_keys"` // Fields the memory bus orders events by, context_id then session_id by default
+
+	// Redis holds the connection settings of the redis bus. They are the
+	// settings of the cache and are not read from the event bus section.
+	Redis cache.RedisConfig `mapstructure:"-"`
+}
+
+// EventStoreConfig holds configuration for the event store, which records
+// every event. The postgres store keeps events in the mcp.events table; the
+// file store appends them to daily newline-delimited JSON files in Path.
+// Without a type, events are kept in Postgres when a database is configured,
+// and in memory otherwise.
+type EventStoreConfig struct {
+	Type          string                 `mapstructure:"type"`           // "postgres", "file" or "memory"
+	Path          string                 `mapstructure:"path"`           // Directory of the file store
+	Retention     time.Duration          `mapstructure:"retention"`      // Time events are kept; zero keeps them forever
+	Policies      []EventRetentionPolicy `mapstructure:"policies"`       // Retention of particular event types
+	PruneInterval time.Duration          `mapstructure:"prune_interval"` // How often expired events are deleted
+}
+
+// EventRetentionPolicy keeps the events of some types for a different time
+// than the event store's retention
+type EventRetentionPolicy struct {
+	Types  []string      `mapstructure:"types"`
+	MaxAge time.Duration `mapstructure:"max_age"`
+}
+
+// SubscriptionsConfig holds configuration for the subscriptions of external
+// consumers to events
+type SubscriptionsConfig struct {
+	// AllowPrivateTargets allows subscriptions to loopback, link-local and
+	// private addresses, which are refused by default
+	AllowPrivateTargets bool `mapstructure:"allow_private_targets"`
+}
+
+// CorrelationConfig holds configuration for recording webhooks in the
+// contexts they belong to
+type CorrelationConfig struct {
+	Enabled bool              `mapstructure:"enabled"`
+	AgentID string            `mapstructure:"agent_id"`
+	Rules   []CorrelationRule `mapstructure:"rules"`
+}
+
+// CorrelationRule maps the fields of a webhook payload to a correlation key.
+// Key is a template of payload fields, e.g.
+// "github:{repository.full_name}#{pull_request.number}". Match holds
+// conditions of the form field=value that must all hold.
+type CorrelationRule struct {
+	Name       string   `mapstructure:"name"`
+	Adapter    string   `mapstructure:"adapter"`
+	EventTypes []string `mapstructure:"event_types"`
+	Match      []string `mapstructure:"match"`
+	Key        string   `mapstructure:"key"`
 }
 
 // APIConfig holds configuration for the API server
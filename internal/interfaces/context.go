@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// ContextManager defines the interface for managing contexts
+type ContextManager interface {
+	// CreateContext creates a new context
+	CreateContext(ctx context.Context, context *mcp.Context) (*mcp.Context, error)
+
+	// GetContext gets a context by ID
+	GetContext(ctx context.Context, contextID string) (*mcp.Context, error)
+
+	// UpdateContext updates a context, appending its content unless the options replace it
+	UpdateContext(ctx context.Context, contextID string, context *mcp.Context, options *mcp.ContextUpdateOptions) (*mcp.Context, error)
+
+	// DeleteContext deletes a context
+	DeleteContext(ctx context.Context, contextID string) error
+
+	// ListContexts lists the contexts of an agent
+	ListContexts(ctx context.Context, agentID, sessionID string, options map[string]interface{}) ([]*mcp.Context, error)
+
+	// SearchInContext searches the content of a context
+	SearchInContext(ctx context.Context, contextID, query string) ([]mcp.ContextItem, error)
+
+	// SummarizeContext summarizes a context
+	SummarizeContext(ctx context.Context, contextID string) (string, error)
+}
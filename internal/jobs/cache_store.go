@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/cache"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// CacheStore keeps jobs in Redis through the cache. Jobs expire after the
+// configured TTL.
+type CacheStore struct {
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewCacheStore creates a new job store backed by the cache
+func NewCacheStore(cacheClient cache.Cache, ttl time.Duration) *CacheStore {
+	if ttl <= 0 {
+		ttl = 7 * 24 * time.Hour
+	}
+
+	return &CacheStore{
+		cache: cacheClient,
+		ttl:   ttl,
+	}
+}
+
+// Create stores a new job
+func (s *CacheStore) Create(ctx context.Context, job *mcp.Job) error {
+	if err := s.cache.Set(ctx, jobKey(job.ID), job, s.ttl); err != nil {
+		return fmt.Errorf("failed to store job %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+// Get gets a job by ID
+func (s *CacheStore) Get(ctx context.Context, id string) (*mcp.Job, error) {
+	var job mcp.Job
+	if err := s.cache.Get(ctx, jobKey(id), &job); err != nil {
+		if errors.Is(err, cache.ErrNotFound) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get job %s: %w", id, err)
+	}
+	return &job, nil
+}
+
+// Update replaces a stored job
+func (s *CacheStore) Update(ctx context.Context, job *mcp.Job) error {
+	exists, err := s.cache.Exists(ctx, jobKey(job.ID))
+	if err != nil {
+		return fmt.Errorf("failed to update job %s: %w", job.ID, err)
+	}
+	if !exists {
+		return ErrJobNotFound
+	}
+
+	if err := s.cache.Set(ctx, jobKey(job.ID), job, s.ttl); err != nil {
+		return fmt.Errorf("failed to update job %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+// jobKey returns the cache key of a job
+func jobKey(id string) string {
+	return fmt.Sprintf("job:%s", id)
+}
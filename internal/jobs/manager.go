@@ -0,0 +1,403 @@
+// Package jobs runs tool actions asynchronously. Jobs are persisted in a
+// Store so their status, progress and result can be polled while the action
+// runs, which may take longer than an HTTP request is allowed to.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/adapters/resilience"
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/google/uuid"
+)
+
+// Job errors
+var (
+	// ErrJobFinished indicates a job can't be cancelled because it has finished
+	ErrJobFinished = errors.New("job has already finished")
+
+	// ErrJobCancelled is the cause of the cancellation of a cancelled job
+	ErrJobCancelled = errors.New("job cancelled")
+
+	// ErrShutdown indicates the manager is shutting down
+	ErrShutdown = errors.New("job manager is shutting down")
+)
+
+// ActionExecutor executes tool actions
+type ActionExecutor interface {
+	ExecuteAction(ctx context.Context, contextID string, adapterType string, action string, params map[string]interface{}) (interface{}, error)
+}
+
+// ActionClassifier is implemented by executors that know which actions only
+// read data. Jobs retry only those actions: an action that changes data may
+// have taken effect before it failed. Actions of executors that don't
+// implement it are never retried.
+type ActionClassifier interface {
+	IsReadOnlyAction(ctx context.Context, adapterType string, action string) bool
+}
+
+// Config holds configuration for the job manager
+type Config struct {
+	// Timeout is the maximum duration of a job, including retries
+	Timeout time.Duration
+	// Retry configures the retries of read-only actions failing with
+	// retryable errors
+	Retry resilience.RetryConfig
+	// MaxConcurrent is the maximum number of jobs running at once. Other
+	// jobs stay pending until one finishes.
+	MaxConcurrent int
+}
+
+// DefaultConfig returns the default job manager configuration
+func DefaultConfig() Config {
+	retry := resilience.DefaultRetryConfig()
+	retry.MaxElapsedTime = 5 * time.Minute
+
+	return Config{
+		Timeout:       30 * time.Minute,
+		Retry:         retry,
+		MaxConcurrent: 10,
+	}
+}
+
+// Manager runs jobs and tracks them in a store
+type Manager struct {
+	store    Store
+	executor ActionExecutor
+	config   Config
+	logger   *observability.Logger
+	eventBus events.Bus
+
+	slots chan struct{}
+	wg    sync.WaitGroup
+
+	// updates serializes read-modify-write updates of jobs
+	updates sync.Mutex
+
+	mu      sync.Mutex
+	running map[string]context.CancelCauseFunc
+	closed  bool
+}
+
+// NewManager creates a new job manager
+func NewManager(store Store, executor ActionExecutor, config Config, logger *observability.Logger) *Manager {
+	defaults := DefaultConfig()
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+	if config.MaxConcurrent <= 0 {
+		config.MaxConcurrent = defaults.MaxConcurrent
+	}
+	if config.Retry.InitialInterval <= 0 {
+		config.Retry = defaults.Retry
+	}
+	if logger == nil {
+		logger = observability.NewLogger("jobs")
+	}
+
+	return &Manager{
+		store:    store,
+		executor: executor,
+		config:   config,
+		logger:   logger,
+		slots:    make(chan struct{}, config.MaxConcurrent),
+		running:  make(map[string]context.CancelCauseFunc),
+	}
+}
+
+// WithEventBus publishes an EventToolActionExecuted event on the bus when a
+// job succeeds, and an EventToolActionFailed event when it fails. The context
+// event handler appends executed actions to the job's context.
+func (m *Manager) WithEventBus(eventBus events.Bus) *Manager {
+	m.eventBus = eventBus
+	return m
+}
+
+// Submit creates a pending job for an action and starts running it. The job
+// keeps running after ctx is done.
+func (m *Manager) Submit(ctx context.Context, tool, action, contextID string, params map[string]interface{}) (*mcp.Job, error) {
+	now := time.Now().UTC()
+	job := &mcp.Job{
+		ID:        uuid.New().String(),
+		Tool:      tool,
+		Action:    action,
+		ContextID: contextID,
+		Params:    params,
+		Status:    mcp.JobStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, ErrShutdown
+	}
+	if err := m.store.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	runCtx, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+	m.running[job.ID] = cancel
+	m.wg.Add(1)
+	go m.run(runCtx, job.ID)
+
+	return job, nil
+}
+
+// Get gets a job by ID
+func (m *Manager) Get(ctx context.Context, id string) (*mcp.Job, error) {
+	return m.store.Get(ctx, id)
+}
+
+// Cancel cancels a pending or running job. Jobs running on another server
+// are marked cancelled, and their result is discarded when they finish.
+func (m *Manager) Cancel(ctx context.Context, id string) (*mcp.Job, error) {
+	job, err := m.updateJob(ctx, id, func(job *mcp.Job) error {
+		if job.Status.IsTerminal() {
+			return ErrJobFinished
+		}
+		job.Status = mcp.JobStatusCancelled
+		job.CompletedAt = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		return job, err
+	}
+
+	m.mu.Lock()
+	if cancel, ok := m.running[id]; ok {
+		cancel(ErrJobCancelled)
+	}
+	m.mu.Unlock()
+
+	m.logger.Info("Cancelled job", map[string]interface{}{
+		"job_id": id,
+		"tool":   job.Tool,
+		"action": job.Action,
+	})
+
+	return job, nil
+}
+
+// Shutdown stops accepting jobs, interrupts running jobs and waits for them
+// to be marked failed
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	m.closed = true
+	for _, cancel := range m.running {
+		cancel(ErrShutdown)
+	}
+	m.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run runs a job once a slot is free
+func (m *Manager) run(ctx context.Context, id string) {
+	defer m.wg.Done()
+	defer func() {
+		m.mu.Lock()
+		if cancel, ok := m.running[id]; ok {
+			cancel(nil)
+			delete(m.running, id)
+		}
+		m.mu.Unlock()
+	}()
+
+	// The job's context is only used to update it once it's done
+	storeCtx := context.WithoutCancel(ctx)
+
+	select {
+	case m.slots <- struct{}{}:
+		defer func() { <-m.slots }()
+	case <-ctx.Done():
+		m.finish(storeCtx, ctx, id, 0, nil, context.Cause(ctx))
+		return
+	}
+
+	job, err := m.updateJob(storeCtx, id, func(job *mcp.Job) error {
+		if job.Status.IsTerminal() {
+			return ErrJobFinished
+		}
+		job.Status = mcp.JobStatusRunning
+		job.StartedAt = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, ErrJobFinished) {
+			m.logger.Error("Failed to start job", map[string]interface{}{
+				"job_id": id,
+				"error":  err.Error(),
+			})
+		}
+		return
+	}
+
+	ctx = WithProgressReporter(ctx, func(progress int, message string) {
+		m.reportProgress(storeCtx, id, progress, message)
+	})
+
+	tool, action, contextID, params := job.Tool, job.Action, job.ContextID, job.Params
+	readOnly := false
+	if classifier, ok := m.executor.(ActionClassifier); ok {
+		readOnly = classifier.IsReadOnlyAction(ctx, tool, action)
+	}
+	var attempts atomic.Int32
+	retry := m.config.Retry
+	retry.RetryIfFn = func(err error) bool {
+		return readOnly && ctx.Err() == nil && adapterErrors.IsRetryable(err)
+	}
+	result, err := resilience.ExecuteWithTimeout(ctx, resilience.TimeoutConfig{Timeout: m.config.Timeout},
+		func(ctx context.Context) (interface{}, error) {
+			return resilience.RetryWithResult(ctx, retry, func() (interface{}, error) {
+				attempts.Add(1)
+				return m.executor.ExecuteAction(ctx, contextID, tool, action, params)
+			})
+		})
+	// Report why the job was interrupted rather than the timeout wrapper's error
+	if err != nil && context.Cause(ctx) != nil {
+		err = context.Cause(ctx)
+	}
+
+	m.finish(storeCtx, ctx, id, int(attempts.Load()), result, err)
+}
+
+// finish records the outcome of a job. Jobs cancelled in the meantime keep
+// their status and the result is discarded.
+func (m *Manager) finish(storeCtx, ctx context.Context, id string, attempts int, result interface{}, err error) {
+	job, updateErr := m.updateJob(storeCtx, id, func(job *mcp.Job) error {
+		if job.Status.IsTerminal() {
+			return ErrJobFinished
+		}
+
+		job.Attempts = attempts
+		job.CompletedAt = time.Now().UTC()
+		switch {
+		case err == nil:
+			job.Status = mcp.JobStatusSucceeded
+			job.Progress = 100
+			job.Result = result
+		case errors.Is(context.Cause(ctx), ErrJobCancelled):
+			job.Status = mcp.JobStatusCancelled
+		default:
+			job.Status = mcp.JobStatusFailed
+			job.Error = err.Error()
+			job.ErrorCode = adapterErrors.GetErrorCode(err)
+			if errors.Is(err, context.DeadlineExceeded) {
+				job.ErrorCode = adapterErrors.ErrCodeTimeoutError
+			}
+		}
+		return nil
+	})
+	if updateErr != nil {
+		if !errors.Is(updateErr, ErrJobFinished) {
+			m.logger.Error("Failed to record job result", map[string]interface{}{
+				"job_id": id,
+				"error":  updateErr.Error(),
+			})
+		}
+		return
+	}
+
+	m.logger.Info("Job finished", map[string]interface{}{
+		"job_id":   id,
+		"tool":     job.Tool,
+		"action":   job.Action,
+		"status":   string(job.Status),
+		"attempts": job.Attempts,
+	})
+
+	if m.eventBus == nil {
+		return
+	}
+
+	var publishErr error
+	switch job.Status {
+	case mcp.JobStatusSucceeded:
+		publishErr = events.PublishToolEvent(m.eventBus, storeCtx, events.EventToolActionExecuted, job.Tool, job.Action, job.ContextID, map[string]interface{}{
+			"job_id": job.ID,
+			"params": job.Params,
+			"result": job.Result,
+		})
+	case mcp.JobStatusFailed:
+		publishErr = events.PublishToolEvent(m.eventBus, storeCtx, events.EventToolActionFailed, job.Tool, job.Action, job.ContextID, map[string]interface{}{
+			"job_id":     job.ID,
+			"params":     job.Params,
+			"error":      job.Error,
+			"error_code": job.ErrorCode,
+		})
+	}
+	if publishErr != nil {
+		m.logger.Warn("Failed to publish job result", map[string]interface{}{
+			"job_id": id,
+			"error":  publishErr.Error(),
+		})
+	}
+}
+
+// reportProgress records the progress reported by a running job
+func (m *Manager) reportProgress(ctx context.Context, id string, progress int, message string) {
+	if progress < 0 {
+		progress = 0
+	}
+	if progress > 100 {
+		progress = 100
+	}
+
+	_, err := m.updateJob(ctx, id, func(job *mcp.Job) error {
+		if job.Status != mcp.JobStatusRunning {
+			return ErrJobFinished
+		}
+		job.Progress = progress
+		job.Message = message
+		return nil
+	})
+	if err != nil && !errors.Is(err, ErrJobFinished) {
+		m.logger.Warn("Failed to record job progress", map[string]interface{}{
+			"job_id": id,
+			"error":  err.Error(),
+		})
+	}
+}
+
+// updateJob applies an update to a stored job. The job is not stored when
+// the update returns an error.
+func (m *Manager) updateJob(ctx context.Context, id string, update func(job *mcp.Job) error) (*mcp.Job, error) {
+	m.updates.Lock()
+	defer m.updates.Unlock()
+
+	job, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := update(job); err != nil {
+		return job, err
+	}
+
+	job.UpdatedAt = time.Now().UTC()
+	if err := m.store.Update(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to update job %s: %w", id, err)
+	}
+	return job, nil
+}
@@ -0,0 +1,285 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/contexts"
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/storage/providers"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// executorFunc adapts a function to ActionExecutor
+type executorFunc func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error)
+
+func (f executorFunc) ExecuteAction(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+	return f(ctx, contextID, tool, action, params)
+}
+
+// classifiedExecutor is an executor that only reads data with some actions
+type classifiedExecutor struct {
+	executorFunc
+	readOnly map[string]bool
+}
+
+func (e classifiedExecutor) IsReadOnlyAction(ctx context.Context, tool, action string) bool {
+	return e.readOnly[action]
+}
+
+// testConfig returns a config retrying quickly
+func testConfig() Config {
+	config := DefaultConfig()
+	config.Retry.InitialInterval = time.Millisecond
+	config.Retry.MaxInterval = time.Millisecond
+	return config
+}
+
+// waitForStatus waits for a job to reach a status
+func waitForStatus(t *testing.T, manager *Manager, id string, status mcp.JobStatus) *mcp.Job {
+	t.Helper()
+
+	var job *mcp.Job
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = manager.Get(context.Background(), id)
+		require.NoError(t, err)
+		return job.Status == status
+	}, 5*time.Second, 5*time.Millisecond, "job should be %s", status)
+	return job
+}
+
+// contextsManager returns a context manager keeping contexts in memory, with
+// a context
+func contextsManager(t *testing.T, contextID string) *contexts.Manager {
+	t.Helper()
+
+	manager := contexts.NewManager(providers.NewMemoryContextStorage())
+	_, err := manager.CreateContext(context.Background(), &mcp.Context{ID: contextID, AgentID: "agent", ModelID: "model"})
+	require.NoError(t, err)
+	return manager
+}
+
+func TestJobSucceeds(t *testing.T) {
+	release := make(chan struct{})
+	executor := executorFunc(func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+		ReportProgress(ctx, 40, "scanning")
+		<-release
+		return map[string]interface{}{"scan_id": params["component_id"]}, nil
+	})
+
+	bus := events.NewEventBus(1)
+	defer bus.Close()
+	contexts := contextsManager(t, "ctx-1")
+	events.NewContextEventHandler(contexts).RegisterWithEventBus(bus)
+
+	manager := NewManager(NewMemoryStore(), executor, testConfig(), nil).WithEventBus(bus)
+	job, err := manager.Submit(context.Background(), "xray", "scan_artifact", "ctx-1", map[string]interface{}{"component_id": "app:1.0"})
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusPending, job.Status)
+	assert.NotEmpty(t, job.ID)
+
+	running := waitForStatus(t, manager, job.ID, mcp.JobStatusRunning)
+	require.Eventually(t, func() bool {
+		running, _ = manager.Get(context.Background(), job.ID)
+		return running.Progress == 40
+	}, 5*time.Second, 5*time.Millisecond)
+	assert.Equal(t, "scanning", running.Message)
+	assert.False(t, running.StartedAt.IsZero())
+
+	close(release)
+	done := waitForStatus(t, manager, job.ID, mcp.JobStatusSucceeded)
+	assert.Equal(t, 100, done.Progress)
+	assert.Equal(t, 1, done.Attempts)
+	assert.Equal(t, map[string]interface{}{"scan_id": "app:1.0"}, done.Result)
+	assert.False(t, done.CompletedAt.IsZero())
+
+	var stored *mcp.Context
+	require.Eventually(t, func() bool {
+		stored, err = contexts.GetContext(context.Background(), "ctx-1")
+		require.NoError(t, err)
+		return len(stored.Content) == 1
+	}, 5*time.Second, 5*time.Millisecond, "the executed action should be appended to the context")
+	item := stored.Content[0]
+	assert.Equal(t, "tool", item.Role)
+	assert.Equal(t, "xray", item.Metadata["tool"])
+	assert.Equal(t, "scan_artifact", item.Metadata["action"])
+
+	_, err = manager.Cancel(context.Background(), job.ID)
+	assert.ErrorIs(t, err, ErrJobFinished)
+}
+
+func TestJobRetriesRetryableErrors(t *testing.T) {
+	var calls atomic.Int32
+	executor := classifiedExecutor{
+		executorFunc: func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+			if calls.Add(1) == 1 {
+				return nil, adapterErrors.NewServiceUnavailableError(tool, action, errors.New("busy"), nil)
+			}
+			return "ok", nil
+		},
+		readOnly: map[string]bool{"get_test_report": true},
+	}
+
+	manager := NewManager(NewMemoryStore(), executor, testConfig(), nil)
+	job, err := manager.Submit(context.Background(), "jenkins", "get_test_report", "ctx-1", nil)
+	require.NoError(t, err)
+
+	done := waitForStatus(t, manager, job.ID, mcp.JobStatusSucceeded)
+	assert.Equal(t, 2, done.Attempts)
+	assert.Equal(t, "ok", done.Result)
+}
+
+func TestJobDoesNotRetryActionsChangingData(t *testing.T) {
+	var calls atomic.Int32
+	executor := classifiedExecutor{
+		executorFunc: func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+			calls.Add(1)
+			return nil, adapterErrors.NewServiceUnavailableError(tool, action, errors.New("busy"), nil)
+		},
+		readOnly: map[string]bool{"get_test_report": true},
+	}
+
+	manager := NewManager(NewMemoryStore(), executor, testConfig(), nil)
+	job, err := manager.Submit(context.Background(), "jenkins", "trigger_build", "ctx-1", nil)
+	require.NoError(t, err)
+
+	done := waitForStatus(t, manager, job.ID, mcp.JobStatusFailed)
+	assert.Equal(t, 1, done.Attempts, "the build may have been triggered before the error")
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, adapterErrors.ErrCodeServiceUnavailable, done.ErrorCode)
+}
+
+func TestJobFails(t *testing.T) {
+	executor := executorFunc(func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+		return nil, adapterErrors.NewInvalidParameterError(tool, action, errors.New("bad job name"), nil)
+	})
+
+	manager := NewManager(NewMemoryStore(), executor, testConfig(), nil)
+	job, err := manager.Submit(context.Background(), "jenkins", "trigger_build", "ctx-1", nil)
+	require.NoError(t, err)
+
+	done := waitForStatus(t, manager, job.ID, mcp.JobStatusFailed)
+	assert.Equal(t, 1, done.Attempts, "validation errors are not retried")
+	assert.Equal(t, adapterErrors.ErrCodeInvalidParameter, done.ErrorCode)
+	assert.Contains(t, done.Error, "bad job name")
+	assert.Nil(t, done.Result)
+}
+
+func TestJobTimesOut(t *testing.T) {
+	executor := executorFunc(func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	config := testConfig()
+	config.Timeout = 20 * time.Millisecond
+	manager := NewManager(NewMemoryStore(), executor, config, nil)
+	job, err := manager.Submit(context.Background(), "harness", "run_pipeline", "ctx-1", nil)
+	require.NoError(t, err)
+
+	done := waitForStatus(t, manager, job.ID, mcp.JobStatusFailed)
+	assert.Equal(t, adapterErrors.ErrCodeTimeoutError, done.ErrorCode)
+}
+
+func TestCancelJob(t *testing.T) {
+	started := make(chan struct{})
+	executor := executorFunc(func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	config := testConfig()
+	config.MaxConcurrent = 1
+	manager := NewManager(NewMemoryStore(), executor, config, nil)
+
+	running, err := manager.Submit(context.Background(), "harness", "run_pipeline", "ctx-1", nil)
+	require.NoError(t, err)
+	<-started
+
+	// The second job waits for the first one's slot
+	pending, err := manager.Submit(context.Background(), "harness", "run_pipeline", "ctx-1", nil)
+	require.NoError(t, err)
+	job, err := manager.Get(context.Background(), pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusPending, job.Status)
+
+	cancelled, err := manager.Cancel(context.Background(), pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusCancelled, cancelled.Status)
+
+	cancelled, err = manager.Cancel(context.Background(), running.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusCancelled, cancelled.Status)
+
+	require.NoError(t, manager.Shutdown(context.Background()))
+	for _, id := range []string{running.ID, pending.ID} {
+		job, err := manager.Get(context.Background(), id)
+		require.NoError(t, err)
+		assert.Equal(t, mcp.JobStatusCancelled, job.Status)
+		assert.Nil(t, job.Result)
+	}
+
+	_, err = manager.Cancel(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestShutdownInterruptsJobs(t *testing.T) {
+	started := make(chan struct{})
+	executor := executorFunc(func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	manager := NewManager(NewMemoryStore(), executor, testConfig(), nil)
+	job, err := manager.Submit(context.Background(), "harness", "run_pipeline", "ctx-1", nil)
+	require.NoError(t, err)
+	<-started
+
+	require.NoError(t, manager.Shutdown(context.Background()))
+
+	done, err := manager.Get(context.Background(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusFailed, done.Status)
+	assert.Equal(t, ErrShutdown.Error(), done.Error)
+
+	_, err = manager.Submit(context.Background(), "harness", "run_pipeline", "ctx-1", nil)
+	assert.ErrorIs(t, err, ErrShutdown)
+}
+
+func TestJobFailurePublished(t *testing.T) {
+	executor := executorFunc(func(ctx context.Context, contextID, tool, action string, params map[string]interface{}) (interface{}, error) {
+		return nil, adapterErrors.NewInvalidParameterError(tool, action, errors.New("bad job name"), nil)
+	})
+
+	bus := events.NewEventBus(1)
+	defer bus.Close()
+	published := make(chan *mcp.Event, 1)
+	bus.Subscribe(events.EventToolActionFailed, func(ctx context.Context, event *mcp.Event) error {
+		published <- event
+		return nil
+	})
+
+	manager := NewManager(NewMemoryStore(), executor, testConfig(), nil).WithEventBus(bus)
+	job, err := manager.Submit(context.Background(), "jenkins", "trigger_build", "ctx-1", nil)
+	require.NoError(t, err)
+
+	select {
+	case event := <-published:
+		data := event.Data.(map[string]interface{})
+		assert.Equal(t, job.ID, data["job_id"])
+		assert.Equal(t, "ctx-1", data["context_id"])
+		assert.Equal(t, adapterErrors.ErrCodeInvalidParameter, data["error_code"])
+		assert.Contains(t, data["error"], "bad job name")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the failed job should be published")
+	}
+}
@@ -0,0 +1,175 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+)
+
+// PostgresStore keeps jobs in the mcp.jobs table
+type PostgresStore struct {
+	db *sqlx.DB
+}
+
+// NewPostgresStore creates a new job store backed by Postgres
+func NewPostgresStore(db *sqlx.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// jobRow is a row of the mcp.jobs table
+type jobRow struct {
+	ID          string           `db:"id"`
+	Tool        string           `db:"tool"`
+	Action      string           `db:"action"`
+	ContextID   string           `db:"context_id"`
+	Params      []byte           `db:"params"`
+	Status      string           `db:"status"`
+	Progress    int              `db:"progress"`
+	Message     string           `db:"message"`
+	Result      sql.Null[[]byte] `db:"result"`
+	Error       string           `db:"error"`
+	ErrorCode   string           `db:"error_code"`
+	Attempts    int              `db:"attempts"`
+	CreatedAt   time.Time        `db:"created_at"`
+	UpdatedAt   time.Time        `db:"updated_at"`
+	StartedAt   sql.NullTime     `db:"started_at"`
+	CompletedAt sql.NullTime     `db:"completed_at"`
+}
+
+// Create stores a new job
+func (s *PostgresStore) Create(ctx context.Context, job *mcp.Job) error {
+	row, err := toJobRow(job)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.NamedExecContext(ctx, `
+		INSERT INTO mcp.jobs (
+			id, tool, action, context_id, params, status, progress, message,
+			result, error, error_code, attempts, created_at, updated_at, started_at, completed_at
+		) VALUES (
+			:id, :tool, :action, :context_id, :params, :status, :progress, :message,
+			:result, :error, :error_code, :attempts, :created_at, :updated_at, :started_at, :completed_at
+		)`, row)
+	if err != nil {
+		return fmt.Errorf("failed to store job %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+// Get gets a job by ID
+func (s *PostgresStore) Get(ctx context.Context, id string) (*mcp.Job, error) {
+	var row jobRow
+	err := s.db.GetContext(ctx, &row, `
+		SELECT id, tool, action, context_id, params, status, progress, message,
+			result, error, error_code, attempts, created_at, updated_at, started_at, completed_at
+		FROM mcp.jobs WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get job %s: %w", id, err)
+	}
+	return row.toJob()
+}
+
+// Update replaces a stored job
+func (s *PostgresStore) Update(ctx context.Context, job *mcp.Job) error {
+	row, err := toJobRow(job)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.NamedExecContext(ctx, `
+		UPDATE mcp.jobs SET
+			status = :status, progress = :progress, message = :message, result = :result,
+			error = :error, error_code = :error_code, attempts = :attempts, updated_at = :updated_at,
+			started_at = :started_at, completed_at = :completed_at
+		WHERE id = :id`, row)
+	if err != nil {
+		return fmt.Errorf("failed to update job %s: %w", job.ID, err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update job %s: %w", job.ID, err)
+	}
+	if updated == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+// toJobRow converts a job to a row
+func toJobRow(job *mcp.Job) (*jobRow, error) {
+	params, err := json.Marshal(job.Params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode params of job %s: %w", job.ID, err)
+	}
+
+	var result sql.Null[[]byte]
+	if job.Result != nil {
+		if result.V, err = json.Marshal(job.Result); err != nil {
+			return nil, fmt.Errorf("failed to encode result of job %s: %w", job.ID, err)
+		}
+		result.Valid = true
+	}
+
+	return &jobRow{
+		ID:          job.ID,
+		Tool:        job.Tool,
+		Action:      job.Action,
+		ContextID:   job.ContextID,
+		Params:      params,
+		Status:      string(job.Status),
+		Progress:    job.Progress,
+		Message:     job.Message,
+		Result:      result,
+		Error:       job.Error,
+		ErrorCode:   job.ErrorCode,
+		Attempts:    job.Attempts,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+		StartedAt:   sql.NullTime{Time: job.StartedAt, Valid: !job.StartedAt.IsZero()},
+		CompletedAt: sql.NullTime{Time: job.CompletedAt, Valid: !job.CompletedAt.IsZero()},
+	}, nil
+}
+
+// toJob converts a row to a job
+func (r *jobRow) toJob() (*mcp.Job, error) {
+	job := &mcp.Job{
+		ID:          r.ID,
+		Tool:        r.Tool,
+		Action:      r.Action,
+		ContextID:   r.ContextID,
+		Status:      mcp.JobStatus(r.Status),
+		Progress:    r.Progress,
+		Message:     r.Message,
+		Error:       r.Error,
+		ErrorCode:   r.ErrorCode,
+		Attempts:    r.Attempts,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+		StartedAt:   r.StartedAt.Time,
+		CompletedAt: r.CompletedAt.Time,
+	}
+
+	if len(r.Params) > 0 {
+		if err := json.Unmarshal(r.Params, &job.Params); err != nil {
+			return nil, fmt.Errorf("failed to decode params of job %s: %w", r.ID, err)
+		}
+	}
+	if r.Result.Valid {
+		if err := json.Unmarshal(r.Result.V, &job.Result); err != nil {
+			return nil, fmt.Errorf("failed to decode result of job %s: %w", r.ID, err)
+		}
+	}
+
+	return job, nil
+}
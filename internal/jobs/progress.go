@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"context"
+)
+
+// progressKey is the context key of the progress reporter of a job
+type progressKey struct{}
+
+// WithProgressReporter returns a context reporting progress to report. The
+// manager sets it on the context of the jobs it runs; tests of adapters use it
+// to record the progress they report.
+func WithProgressReporter(ctx context.Context, report func(progress int, message string)) context.Context {
+	return context.WithValue(ctx, progressKey{}, report)
+}
+
+// ReportProgress reports the completion percentage of the job running an
+// action. Adapters running long actions call it with the context they were
+// given; it does nothing when the action isn't running as a job.
+func ReportProgress(ctx context.Context, progress int, message string) {
+	if report, ok := ctx.Value(progressKey{}).(func(int, string)); ok {
+		report(progress, message)
+	}
+}
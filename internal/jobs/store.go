@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// ErrJobNotFound indicates a job doesn't exist
+var ErrJobNotFound = errors.New("job not found")
+
+// Store persists jobs
+type Store interface {
+	// Create stores a new job
+	Create(ctx context.Context, job *mcp.Job) error
+
+	// Get gets a job by ID, returning ErrJobNotFound if it doesn't exist
+	Get(ctx context.Context, id string) (*mcp.Job, error)
+
+	// Update replaces a stored job
+	Update(ctx context.Context, job *mcp.Job) error
+}
+
+// MemoryStore keeps jobs in memory. Jobs are lost when the server stops, so
+// it is only used when neither Postgres nor Redis is available.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	jobs map[string]mcp.Job
+}
+
+// NewMemoryStore creates a new in-memory job store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		jobs: make(map[string]mcp.Job),
+	}
+}
+
+// Create stores a new job
+func (s *MemoryStore) Create(ctx context.Context, job *mcp.Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs[job.ID] = *job
+	return nil
+}
+
+// Get gets a job by ID
+func (s *MemoryStore) Get(ctx context.Context, id string) (*mcp.Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	return &job, nil
+}
+
+// Update replaces a stored job
+func (s *MemoryStore) Update(ctx context.Context, job *mcp.Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.jobs[job.ID]; !ok {
+		return ErrJobNotFound
+	}
+	s.jobs[job.ID] = *job
+	return nil
+}
@@ -0,0 +1,120 @@
+package jobs
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/S-Corkum/mcp-server/internal/cache"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testJob returns a pending job
+func testJob() *mcp.Job {
+	now := time.Now().UTC().Truncate(time.Second)
+	return &mcp.Job{
+		ID:        "3f2c7c4e-8a8e-4c4f-9d0b-2d6a3f1e9b10",
+		Tool:      "xray",
+		Action:    "scan_artifact",
+		ContextID: "ctx-1",
+		Params:    map[string]interface{}{"component_id": "app:1.0"},
+		Status:    mcp.JobStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// testStore checks that a store round-trips jobs
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+	job := testJob()
+
+	_, err := store.Get(ctx, job.ID)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+	assert.ErrorIs(t, store.Update(ctx, job), ErrJobNotFound)
+
+	require.NoError(t, store.Create(ctx, job))
+	stored, err := store.Get(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.Params, stored.Params)
+	assert.Equal(t, mcp.JobStatusPending, stored.Status)
+
+	job.Status = mcp.JobStatusSucceeded
+	job.Result = map[string]interface{}{"scan_id": "42"}
+	job.Progress = 100
+	require.NoError(t, store.Update(ctx, job))
+
+	stored, err = store.Get(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusSucceeded, stored.Status)
+	assert.Equal(t, 100, stored.Progress)
+	assert.Equal(t, job.Result, stored.Result)
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestCacheStore(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	redisCache, err := cache.NewRedisCache(cache.RedisConfig{Address: mr.Addr()})
+	require.NoError(t, err)
+	defer redisCache.Close()
+
+	store := NewCacheStore(redisCache, time.Hour)
+	testStore(t, store)
+
+	assert.True(t, mr.Exists("job:"+testJob().ID))
+	assert.Equal(t, time.Hour, mr.TTL("job:"+testJob().ID))
+}
+
+func TestPostgresStore(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	store := NewPostgresStore(sqlx.NewDb(mockDB, "postgres"))
+	ctx := context.Background()
+	job := testJob()
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO mcp.jobs")).
+		WithArgs(job.ID, "xray", "scan_artifact", "ctx-1", []byte(`{"component_id":"app:1.0"}`), "pending", 0, "",
+			nil, "", "", 0, job.CreatedAt, job.UpdatedAt, nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, store.Create(ctx, job))
+
+	columns := []string{"id", "tool", "action", "context_id", "params", "status", "progress", "message",
+		"result", "error", "error_code", "attempts", "created_at", "updated_at", "started_at", "completed_at"}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.jobs WHERE id = $1")).
+		WithArgs(job.ID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(job.ID, "xray", "scan_artifact", "ctx-1",
+			[]byte(`{"component_id":"app:1.0"}`), "succeeded", 100, "", []byte(`{"scan_id":"42"}`), "", "", 1,
+			job.CreatedAt, job.UpdatedAt, job.CreatedAt, job.UpdatedAt))
+	stored, err := store.Get(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusSucceeded, stored.Status)
+	assert.Equal(t, job.Params, stored.Params)
+	assert.Equal(t, map[string]interface{}{"scan_id": "42"}, stored.Result)
+	assert.Equal(t, job.CreatedAt, stored.StartedAt)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.jobs WHERE id = $1")).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows(columns))
+	_, err = store.Get(ctx, "missing")
+	assert.ErrorIs(t, err, ErrJobNotFound)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE mcp.jobs SET")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, store.Update(ctx, job), ErrJobNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,6 +2,8 @@ package safety
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -83,6 +85,43 @@ func NewGitHubChecker() *GitHubChecker {
 	return &GitHubChecker{}
 }
 
+// GitLabChecker implements safety checks for GitLab operations
+type GitLabChecker struct{}
+
+// IsSafeOperation implements the Checker interface for GitLab
+func (c *GitLabChecker) IsSafeOperation(operation string, params map[string]interface{}) (bool, error) {
+	// List of restricted GitLab operations
+	restrictedOps := []string{
+		"delete_repository",
+		"delete_project",
+		"delete_group",
+		"delete_branch_protection",
+		"unprotect_branch",
+		"delete_webhook",
+		"erase_job",
+	}
+	
+	// Check if operation is explicitly restricted
+	for _, restrictedOp := range restrictedOps {
+		if operation == restrictedOp {
+			return false, ErrRestrictedOperation
+		}
+	}
+	
+	// No GitLab operation that deletes anything is allowed
+	if strings.Contains(operation, "delete") {
+		return false, ErrRestrictedOperation
+	}
+	
+	// All other operations are considered safe
+	return true, nil
+}
+
+// NewGitLabChecker creates a new GitLab safety checker
+func NewGitLabChecker() *GitLabChecker {
+	return &GitLabChecker{}
+}
+
 // ArtifactoryChecker implements safety checks for Artifactory operations
 type ArtifactoryChecker struct{}
 
@@ -207,6 +246,297 @@ func NewHarnessChecker() *HarnessChecker {
 	return &HarnessChecker{}
 }
 
+// DefaultProtectedNamespaces are the Kubernetes namespaces that cannot be modified by default
+var DefaultProtectedNamespaces = []string{"kube-system", "kube-public", "kube-node-lease"}
+
+// KubernetesChecker implements safety checks for Kubernetes operations
+type KubernetesChecker struct {
+	protectedNamespaces map[string]bool
+}
+
+// IsSafeOperation implements the Checker interface for Kubernetes
+func (c *KubernetesChecker) IsSafeOperation(operation string, params map[string]interface{}) (bool, error) {
+	// Read operations are always allowed
+	if strings.HasPrefix(operation, "list_") ||
+		strings.HasPrefix(operation, "describe_") ||
+		strings.HasPrefix(operation, "get_") {
+		return true, nil
+	}
+	
+	// Only explicitly supported mutations are allowed
+	if operation != "rollout_restart" && operation != "scale" {
+		return false, ErrRestrictedOperation
+	}
+	
+	// Mutations without a namespace target the default namespace
+	namespace, _ := params["namespace"].(string)
+	if namespace == "" {
+		namespace = "default"
+	}
+	
+	if c.protectedNamespaces[namespace] {
+		return false, fmt.Errorf("%w: namespace %s is protected", ErrRestrictedOperation, namespace)
+	}
+	
+	return true, nil
+}
+
+// NewKubernetesChecker creates a new Kubernetes safety checker. When no
+// namespaces are given, DefaultProtectedNamespaces are protected.
+func NewKubernetesChecker(protectedNamespaces ...string) *KubernetesChecker {
+	if len(protectedNamespaces) == 0 {
+		protectedNamespaces = DefaultProtectedNamespaces
+	}
+	
+	protected := make(map[string]bool, len(protectedNamespaces))
+	for _, namespace := range protectedNamespaces {
+		protected[namespace] = true
+	}
+	
+	return &KubernetesChecker{protectedNamespaces: protected}
+}
+
+// DefaultMaxBulkIncidents is the default number of incidents that may be
+// acknowledged or resolved in a single operation
+const DefaultMaxBulkIncidents = 5
+
+// PagerDutyChecker implements safety checks for incident management operations
+type PagerDutyChecker struct {
+	maxBulkIncidents int
+}
+
+// IsSafeOperation implements the Checker interface for PagerDuty
+func (c *PagerDutyChecker) IsSafeOperation(operation string, params map[string]interface{}) (bool, error) {
+	// Read operations and notes are always allowed
+	if strings.HasPrefix(operation, "list_") ||
+		strings.HasPrefix(operation, "get_") ||
+		operation == "add_note" {
+		return true, nil
+	}
+	
+	switch operation {
+	case "acknowledge_incident":
+		return c.checkIncidentUpdate(params)
+	case "resolve_incident":
+		// Resolutions must be explained so the incident timeline records why
+		if resolution, _ := params["resolution"].(string); strings.TrimSpace(resolution) == "" {
+			return false, fmt.Errorf("%w: resolving an incident requires a resolution", ErrRestrictedOperation)
+		}
+		return c.checkIncidentUpdate(params)
+	case "send_event":
+		// Triggering alerts is allowed; acknowledging and resolving them must
+		// target a single alert by dedup key
+		action, _ := params["event_action"].(string)
+		if action == "" || action == "trigger" {
+			return true, nil
+		}
+		if action != "acknowledge" && action != "resolve" {
+			return false, fmt.Errorf("%w: unknown event action %s", ErrRestrictedOperation, action)
+		}
+		if dedupKey, _ := params["dedup_key"].(string); dedupKey == "" {
+			return false, fmt.Errorf("%w: %s events require a dedup_key", ErrRestrictedOperation, action)
+		}
+		return true, nil
+	}
+	
+	// Everything else, such as merging or deleting incidents, is restricted
+	return false, ErrRestrictedOperation
+}
+
+// checkIncidentUpdate limits how many incidents a single update may change
+func (c *PagerDutyChecker) checkIncidentUpdate(params map[string]interface{}) (bool, error) {
+	count := 0
+	if id, _ := params["incident_id"].(string); id != "" {
+		count++
+	}
+	switch ids := params["incident_ids"].(type) {
+	case []string:
+		count += len(ids)
+	case []interface{}:
+		count += len(ids)
+	}
+	
+	if count == 0 {
+		return false, fmt.Errorf("%w: no incident specified", ErrRestrictedOperation)
+	}
+	if count > c.maxBulkIncidents {
+		return false, fmt.Errorf("%w: %d incidents exceeds the limit of %d per operation",
+			ErrRestrictedOperation, count, c.maxBulkIncidents)
+	}
+	
+	return true, nil
+}
+
+// NewPagerDutyChecker creates a new PagerDuty safety checker. A limit of zero
+// uses DefaultMaxBulkIncidents.
+func NewPagerDutyChecker(maxBulkIncidents int) *PagerDutyChecker {
+	if maxBulkIncidents <= 0 {
+		maxBulkIncidents = DefaultMaxBulkIncidents
+	}
+	return &PagerDutyChecker{maxBulkIncidents: maxBulkIncidents}
+}
+
+// DefaultProductionLabels are the Argo CD application labels, as key=value,
+// that mark an application as production
+var DefaultProductionLabels = []string{"environment=production", "env=production"}
+
+// ArgoCDChecker implements safety checks for Argo CD operations. Syncs and
+// rollbacks of production applications require an approval reference.
+type ArgoCDChecker struct {
+	productionLabels map[string]string
+}
+
+// IsSafeOperation implements the Checker interface for Argo CD. The adapter
+// passes the labels of the target application as app_labels.
+func (c *ArgoCDChecker) IsSafeOperation(operation string, params map[string]interface{}) (bool, error) {
+	// Read operations are always allowed
+	if strings.HasPrefix(operation, "list_") ||
+		strings.HasPrefix(operation, "get_") {
+		return true, nil
+	}
+	
+	// Only explicitly supported mutations are allowed
+	if operation != "sync_application" && operation != "rollback_application" {
+		return false, ErrRestrictedOperation
+	}
+	
+	label, production := c.productionLabel(params["app_labels"])
+	if !production {
+		return true, nil
+	}
+	
+	if approval, _ := params["approval_ref"].(string); strings.TrimSpace(approval) == "" {
+		return false, fmt.Errorf("%w: application is labeled %s and requires an approval_ref", ErrRestrictedOperation, label)
+	}
+	
+	return true, nil
+}
+
+// productionLabel returns the first production label the application carries
+func (c *ArgoCDChecker) productionLabel(labels interface{}) (string, bool) {
+	var values map[string]string
+	switch l := labels.(type) {
+	case map[string]string:
+		values = l
+	case map[string]interface{}:
+		values = make(map[string]string, len(l))
+		for key, value := range l {
+			values[key], _ = value.(string)
+		}
+	}
+	
+	for key, value := range values {
+		if expected, ok := c.productionLabels[key]; ok && strings.EqualFold(value, expected) {
+			return key + "=" + value, true
+		}
+	}
+	return "", false
+}
+
+// NewArgoCDChecker creates a new Argo CD safety checker. Labels are given as
+// key=value; when none are given, DefaultProductionLabels are used.
+func NewArgoCDChecker(productionLabels ...string) *ArgoCDChecker {
+	if len(productionLabels) == 0 {
+		productionLabels = DefaultProductionLabels
+	}
+	
+	labels := make(map[string]string, len(productionLabels))
+	for _, label := range productionLabels {
+		key, value, _ := strings.Cut(label, "=")
+		labels[key] = value
+	}
+	
+	return &ArgoCDChecker{productionLabels: labels}
+}
+
+// DefaultStatefulResourceTypes are the Terraform resource types whose deletion
+// loses data. A trailing * matches any type with the given prefix.
+var DefaultStatefulResourceTypes = []string{
+	// AWS
+	"aws_db_instance", "aws_rds_cluster", "aws_rds_cluster_instance", "aws_dynamodb_table",
+	"aws_s3_bucket", "aws_efs_file_system", "aws_ebs_volume", "aws_elasticache_cluster",
+	"aws_elasticache_replication_group", "aws_redshift_cluster", "aws_docdb_cluster",
+	"aws_neptune_cluster", "aws_opensearch_domain", "aws_elasticsearch_domain",
+	"aws_msk_cluster", "aws_kinesis_stream", "aws_kms_key", "aws_secretsmanager_secret",
+	// Google Cloud
+	"google_sql_database_instance", "google_sql_database", "google_storage_bucket",
+	"google_bigquery_dataset", "google_bigquery_table", "google_spanner_instance",
+	"google_spanner_database", "google_compute_disk", "google_redis_instance",
+	"google_kms_crypto_key", "google_filestore_instance",
+	// Azure
+	"azurerm_storage_account", "azurerm_mssql_server", "azurerm_mssql_database",
+	"azurerm_postgresql_server", "azurerm_postgresql_flexible_server", "azurerm_mysql_server",
+	"azurerm_mysql_flexible_server", "azurerm_cosmosdb_account", "azurerm_managed_disk",
+	"azurerm_redis_cache", "azurerm_key_vault",
+	// Kubernetes
+	"kubernetes_persistent_volume", "kubernetes_persistent_volume_claim",
+}
+
+// TerraformChecker implements safety checks for Terraform plans. Plans that
+// delete or replace stateful resources are flagged, and applying or
+// destroying infrastructure is not supported.
+type TerraformChecker struct {
+	statefulTypes []string
+}
+
+// IsSafeOperation implements the Checker interface for Terraform. The adapter
+// passes the resources a plan deletes or replaces as deleted_resources, a map
+// of resource address to resource type.
+func (c *TerraformChecker) IsSafeOperation(operation string, params map[string]interface{}) (bool, error) {
+	// Only plan inspection is supported
+	if strings.HasPrefix(operation, "apply") || strings.HasPrefix(operation, "destroy") {
+		return false, ErrRestrictedOperation
+	}
+	
+	var deleted map[string]string
+	switch d := params["deleted_resources"].(type) {
+	case map[string]string:
+		deleted = d
+	case map[string]interface{}:
+		deleted = make(map[string]string, len(d))
+		for address, resourceType := range d {
+			deleted[address], _ = resourceType.(string)
+		}
+	}
+	
+	var stateful []string
+	for address, resourceType := range deleted {
+		if c.IsStateful(resourceType) {
+			stateful = append(stateful, address)
+		}
+	}
+	
+	if len(stateful) > 0 {
+		sort.Strings(stateful)
+		return false, fmt.Errorf("%w: plan deletes stateful resources: %s", ErrRestrictedOperation, strings.Join(stateful, ", "))
+	}
+	
+	return true, nil
+}
+
+// IsStateful returns whether a resource type holds data that is lost on deletion
+func (c *TerraformChecker) IsStateful(resourceType string) bool {
+	for _, pattern := range c.statefulTypes {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(resourceType, prefix) {
+				return true
+			}
+		} else if resourceType == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// NewTerraformChecker creates a new Terraform safety checker. When no
+// stateful resource types are given, DefaultStatefulResourceTypes are used.
+func NewTerraformChecker(statefulTypes ...string) *TerraformChecker {
+	if len(statefulTypes) == 0 {
+		statefulTypes = DefaultStatefulResourceTypes
+	}
+	return &TerraformChecker{statefulTypes: statefulTypes}
+}
+
 // DefaultAdapterChecker implements a default safety checker that allows all operations
 type DefaultAdapterChecker struct{}
 
@@ -220,10 +550,20 @@ func GetCheckerForAdapter(adapterName string) Checker {
 	switch adapterName {
 	case "github":
 		return NewGitHubChecker()
+	case "gitlab":
+		return NewGitLabChecker()
 	case "artifactory":
 		return NewArtifactoryChecker()
 	case "harness":
 		return NewHarnessChecker()
+	case "kubernetes":
+		return NewKubernetesChecker()
+	case "pagerduty":
+		return NewPagerDutyChecker(DefaultMaxBulkIncidents)
+	case "argocd":
+		return NewArgoCDChecker()
+	case "terraform":
+		return NewTerraformChecker()
 	default:
 		// Return a dummy checker that allows everything for other adapters
 		return &DefaultAdapterChecker{}
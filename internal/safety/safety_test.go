@@ -38,6 +38,41 @@ func TestGitHubChecker(t *testing.T) {
 	}
 }
 
+func TestGitLabChecker(t *testing.T) {
+	checker := NewGitLabChecker()
+	
+	tests := []struct {
+		operation string
+		params    map[string]interface{}
+		expected  bool
+	}{
+		// Safe operations
+		{"create_issue", nil, true},
+		{"merge_pull_request", nil, true},
+		{"retry_pipeline", nil, true},
+		
+		// Unsafe operations
+		{"delete_project", nil, false},
+		{"unprotect_branch", nil, false},
+		{"delete_branch", nil, false},
+	}
+	
+	for _, test := range tests {
+		result, err := checker.IsSafeOperation(test.operation, test.params)
+		
+		if result != test.expected {
+			t.Errorf("IsSafeOperation(%s) = %v, expected %v (error: %v)",
+				test.operation, result, test.expected, err)
+		}
+		
+		// If expected unsafe, should return an error
+		if !test.expected && err == nil {
+			t.Errorf("IsSafeOperation(%s) should return an error for unsafe operations",
+				test.operation)
+		}
+	}
+}
+
 func TestArtifactoryChecker(t *testing.T) {
 	checker := NewArtifactoryChecker()
 	
@@ -108,9 +143,194 @@ func TestHarnessChecker(t *testing.T) {
 	}
 }
 
+func TestKubernetesChecker(t *testing.T) {
+	checker := NewKubernetesChecker()
+	
+	tests := []struct {
+		operation string
+		params    map[string]interface{}
+		expected  bool
+	}{
+		// Safe operations
+		{"list_pods", map[string]interface{}{"namespace": "kube-system"}, true},
+		{"get_pod_logs", map[string]interface{}{"namespace": "kube-system"}, true},
+		{"rollout_restart", map[string]interface{}{"namespace": "payments"}, true},
+		{"scale", nil, true},
+		
+		// Unsafe operations
+		{"rollout_restart", map[string]interface{}{"namespace": "kube-system"}, false},
+		{"scale", map[string]interface{}{"namespace": "kube-public"}, false},
+		{"delete_pod", map[string]interface{}{"namespace": "payments"}, false},
+	}
+	
+	for _, test := range tests {
+		result, err := checker.IsSafeOperation(test.operation, test.params)
+		
+		if result != test.expected {
+			t.Errorf("IsSafeOperation(%s) = %v, expected %v (error: %v)",
+				test.operation, result, test.expected, err)
+		}
+		
+		// If expected unsafe, should return an error
+		if !test.expected && err == nil {
+			t.Errorf("IsSafeOperation(%s) should return an error for unsafe operations",
+				test.operation)
+		}
+	}
+	
+	// Custom protected namespaces replace the defaults
+	checker = NewKubernetesChecker("production")
+	if safe, _ := checker.IsSafeOperation("scale", map[string]interface{}{"namespace": "production"}); safe {
+		t.Errorf("scale in a custom protected namespace should be restricted")
+	}
+	if safe, _ := checker.IsSafeOperation("scale", map[string]interface{}{"namespace": "kube-system"}); !safe {
+		t.Errorf("scale in kube-system should be allowed when it is not protected")
+	}
+}
+
+func TestPagerDutyChecker(t *testing.T) {
+	checker := NewPagerDutyChecker(2)
+	
+	tests := []struct {
+		operation string
+		params    map[string]interface{}
+		expected  bool
+	}{
+		// Safe operations
+		{"list_incidents", nil, true},
+		{"get_incident", map[string]interface{}{"incident_id": "P1"}, true},
+		{"add_note", map[string]interface{}{"incident_id": "P1"}, true},
+		{"acknowledge_incident", map[string]interface{}{"incident_id": "P1"}, true},
+		{"acknowledge_incident", map[string]interface{}{"incident_ids": []interface{}{"P1", "P2"}}, true},
+		{"resolve_incident", map[string]interface{}{"incident_id": "P1", "resolution": "Rolled back"}, true},
+		{"send_event", map[string]interface{}{"event_action": "trigger"}, true},
+		{"send_event", map[string]interface{}{"event_action": "resolve", "dedup_key": "disk-full"}, true},
+		
+		// Unsafe operations
+		{"acknowledge_incident", nil, false},
+		{"acknowledge_incident", map[string]interface{}{"incident_ids": []string{"P1", "P2", "P3"}}, false},
+		{"resolve_incident", map[string]interface{}{"incident_id": "P1"}, false},
+		{"resolve_incident", map[string]interface{}{"incident_id": "P1", "resolution": "  "}, false},
+		{"send_event", map[string]interface{}{"event_action": "resolve"}, false},
+		{"merge_incidents", map[string]interface{}{"incident_id": "P1"}, false},
+	}
+	
+	for _, test := range tests {
+		result, err := checker.IsSafeOperation(test.operation, test.params)
+		
+		if result != test.expected {
+			t.Errorf("IsSafeOperation(%s, %v) = %v, expected %v (error: %v)",
+				test.operation, test.params, result, test.expected, err)
+		}
+		
+		// If expected unsafe, should return an error
+		if !test.expected && err == nil {
+			t.Errorf("IsSafeOperation(%s) should return an error for unsafe operations",
+				test.operation)
+		}
+	}
+}
+
+func TestArgoCDChecker(t *testing.T) {
+	checker := NewArgoCDChecker()
+	
+	production := map[string]interface{}{"environment": "production", "team": "payments"}
+	staging := map[string]string{"environment": "staging"}
+	
+	tests := []struct {
+		operation string
+		params    map[string]interface{}
+		expected  bool
+	}{
+		// Safe operations
+		{"list_applications", nil, true},
+		{"get_resource_diff", map[string]interface{}{"app_labels": production}, true},
+		{"sync_application", map[string]interface{}{"app_labels": staging}, true},
+		{"sync_application", nil, true},
+		{"sync_application", map[string]interface{}{"app_labels": production, "approval_ref": "CHG-42"}, true},
+		{"rollback_application", map[string]interface{}{"app_labels": map[string]string{"env": "Production"}, "approval_ref": "CHG-42"}, true},
+		
+		// Unsafe operations
+		{"sync_application", map[string]interface{}{"app_labels": production}, false},
+		{"sync_application", map[string]interface{}{"app_labels": production, "approval_ref": " "}, false},
+		{"rollback_application", map[string]interface{}{"app_labels": map[string]string{"env": "production"}}, false},
+		{"delete_application", map[string]interface{}{"app_labels": staging}, false},
+	}
+	
+	for _, test := range tests {
+		result, err := checker.IsSafeOperation(test.operation, test.params)
+		
+		if result != test.expected {
+			t.Errorf("IsSafeOperation(%s, %v) = %v, expected %v (error: %v)",
+				test.operation, test.params, result, test.expected, err)
+		}
+		
+		// If expected unsafe, should return an error
+		if !test.expected && err == nil {
+			t.Errorf("IsSafeOperation(%s) should return an error for unsafe operations",
+				test.operation)
+		}
+	}
+	
+	// Custom production labels replace the defaults
+	checker = NewArgoCDChecker("tier=critical")
+	if safe, _ := checker.IsSafeOperation("sync_application", map[string]interface{}{"app_labels": map[string]string{"tier": "critical"}}); safe {
+		t.Errorf("sync of an application with a custom production label should be restricted")
+	}
+	if safe, _ := checker.IsSafeOperation("sync_application", map[string]interface{}{"app_labels": production}); !safe {
+		t.Errorf("sync should be allowed when environment=production is not a production label")
+	}
+}
+
+func TestTerraformChecker(t *testing.T) {
+	checker := NewTerraformChecker()
+	
+	tests := []struct {
+		operation string
+		params    map[string]interface{}
+		expected  bool
+	}{
+		// Safe operations
+		{"show_plan", nil, true},
+		{"show_plan", map[string]interface{}{"deleted_resources": map[string]string{"aws_instance.web": "aws_instance"}}, true},
+		{"get_run_plan", map[string]interface{}{"deleted_resources": map[string]string{}}, true},
+		{"list_runs", nil, true},
+		
+		// Unsafe operations
+		{"show_plan", map[string]interface{}{"deleted_resources": map[string]string{"aws_db_instance.main": "aws_db_instance"}}, false},
+		{"get_run_plan", map[string]interface{}{"deleted_resources": map[string]interface{}{"module.data.google_storage_bucket.logs": "google_storage_bucket"}}, false},
+		{"apply_plan", nil, false},
+		{"destroy", nil, false},
+	}
+	
+	for _, test := range tests {
+		result, err := checker.IsSafeOperation(test.operation, test.params)
+		
+		if result != test.expected {
+			t.Errorf("IsSafeOperation(%s, %v) = %v, expected %v (error: %v)",
+				test.operation, test.params, result, test.expected, err)
+		}
+		
+		// If expected unsafe, should return an error
+		if !test.expected && err == nil {
+			t.Errorf("IsSafeOperation(%s) should return an error for unsafe operations",
+				test.operation)
+		}
+	}
+	
+	// Custom types replace the defaults and support prefix patterns
+	checker = NewTerraformChecker("vault_*")
+	if !checker.IsStateful("vault_kv_secret_v2") {
+		t.Errorf("vault_kv_secret_v2 should match the vault_* pattern")
+	}
+	if checker.IsStateful("aws_db_instance") {
+		t.Errorf("aws_db_instance should not be stateful when the defaults are replaced")
+	}
+}
+
 func TestGetCheckerForAdapter(t *testing.T) {
 	// Test known adapters
-	adapters := []string{"github", "artifactory", "harness"}
+	adapters := []string{"github", "gitlab", "artifactory", "harness", "kubernetes", "pagerduty", "argocd", "terraform"}
 	for _, adapter := range adapters {
 		checker := GetCheckerForAdapter(adapter)
 		if checker == nil {
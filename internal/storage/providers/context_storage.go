@@ -2,15 +2,21 @@ package providers
 
 import (
 	"context"
+	"errors"
+
 	"github.com/S-Corkum/mcp-server/pkg/mcp"
 )
 
+// ErrContextNotFound indicates a context doesn't exist
+var ErrContextNotFound = errors.New("context not found")
+
 // ContextStorage defines the interface for context storage providers
 type ContextStorage interface {
 	// StoreContext stores a context
 	StoreContext(ctx context.Context, contextData *mcp.Context) error
 	
-	// GetContext retrieves a context by ID
+	// GetContext retrieves a context by ID, returning ErrContextNotFound if
+	// it doesn't exist
 	GetContext(ctx context.Context, contextID string) (*mcp.Context, error)
 	
 	// DeleteContext deletes a context
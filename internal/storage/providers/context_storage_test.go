@@ -0,0 +1,98 @@
+package providers
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testContext returns a context with an item
+func testContext() *mcp.Context {
+	now := time.Now().UTC().Truncate(time.Second)
+	return &mcp.Context{
+		ID:        "ctx-1",
+		AgentID:   "agent-1",
+		ModelID:   "model-1",
+		SessionID: "session-1",
+		Content:   []mcp.ContextItem{{Role: "user", Content: "hello", Timestamp: now, Tokens: 2}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func TestMemoryContextStorage(t *testing.T) {
+	storage := NewMemoryContextStorage()
+	ctx := context.Background()
+	contextData := testContext()
+
+	_, err := storage.GetContext(ctx, contextData.ID)
+	assert.ErrorIs(t, err, ErrContextNotFound)
+
+	require.NoError(t, storage.StoreContext(ctx, contextData))
+	contextData.Content[0].Content = "changed"
+
+	stored, err := storage.GetContext(ctx, contextData.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", stored.Content[0].Content, "stored contexts don't share their content")
+
+	listed, err := storage.ListContexts(ctx, "agent-1", "session-1")
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+	listed, err = storage.ListContexts(ctx, "agent-1", "session-2")
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	require.NoError(t, storage.DeleteContext(ctx, contextData.ID))
+	assert.ErrorIs(t, storage.DeleteContext(ctx, contextData.ID), ErrContextNotFound)
+}
+
+func TestPostgresContextStorage(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	storage := NewPostgresContextStorage(sqlx.NewDb(mockDB, "postgres"))
+	ctx := context.Background()
+	contextData := testContext()
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO mcp.contexts")).
+		WithArgs("ctx-1", "agent-1", "session-1", sqlmock.AnyArg(), contextData.CreatedAt, contextData.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, storage.StoreContext(ctx, contextData))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT data FROM mcp.contexts WHERE id = $1")).
+		WithArgs("ctx-1").
+		WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow([]byte(`{"id":"ctx-1","agent_id":"agent-1","content":[{"role":"user","content":"hello"}]}`)))
+	stored, err := storage.GetContext(ctx, "ctx-1")
+	require.NoError(t, err)
+	assert.Equal(t, "agent-1", stored.AgentID)
+	assert.Equal(t, "hello", stored.Content[0].Content)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT data FROM mcp.contexts WHERE id = $1")).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows([]string{"data"}))
+	_, err = storage.GetContext(ctx, "missing")
+	assert.ErrorIs(t, err, ErrContextNotFound)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.contexts")).
+		WithArgs("agent-1", "").
+		WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow([]byte(`{"id":"ctx-1"}`)).AddRow([]byte(`{"id":"ctx-2"}`)))
+	listed, err := storage.ListContexts(ctx, "agent-1", "")
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, "ctx-2", listed[1].ID)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM mcp.contexts WHERE id = $1")).
+		WithArgs("missing").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, storage.DeleteContext(ctx, "missing"), ErrContextNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,92 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// MemoryContextStorage keeps contexts in memory. Contexts are lost when the
+// server stops, so it is only used when no database is available.
+type MemoryContextStorage struct {
+	mu       sync.RWMutex
+	contexts map[string][]byte
+}
+
+// NewMemoryContextStorage creates a new in-memory context storage provider
+func NewMemoryContextStorage() *MemoryContextStorage {
+	return &MemoryContextStorage{
+		contexts: make(map[string][]byte),
+	}
+}
+
+// StoreContext stores a context. Contexts are kept encoded so that callers
+// can't change them without storing them again.
+func (s *MemoryContextStorage) StoreContext(ctx context.Context, contextData *mcp.Context) error {
+	data, err := json.Marshal(contextData)
+	if err != nil {
+		return fmt.Errorf("failed to serialize context data: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.contexts[contextData.ID] = data
+	return nil
+}
+
+// GetContext retrieves a context by ID
+func (s *MemoryContextStorage) GetContext(ctx context.Context, contextID string) (*mcp.Context, error) {
+	s.mu.RLock()
+	data, ok := s.contexts[contextID]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, ErrContextNotFound
+	}
+
+	var contextData mcp.Context
+	if err := json.Unmarshal(data, &contextData); err != nil {
+		return nil, fmt.Errorf("failed to deserialize context data: %w", err)
+	}
+	return &contextData, nil
+}
+
+// DeleteContext deletes a context
+func (s *MemoryContextStorage) DeleteContext(ctx context.Context, contextID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.contexts[contextID]; !ok {
+		return ErrContextNotFound
+	}
+	delete(s.contexts, contextID)
+	return nil
+}
+
+// ListContexts lists contexts for an agent and optionally a session, oldest
+// first
+func (s *MemoryContextStorage) ListContexts(ctx context.Context, agentID string, sessionID string) ([]*mcp.Context, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var contexts []*mcp.Context
+	for _, data := range s.contexts {
+		var contextData mcp.Context
+		if err := json.Unmarshal(data, &contextData); err != nil {
+			return nil, fmt.Errorf("failed to deserialize context data: %w", err)
+		}
+		if contextData.AgentID != agentID || (sessionID != "" && contextData.SessionID != sessionID) {
+			continue
+		}
+		contexts = append(contexts, &contextData)
+	}
+
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].CreatedAt.Before(contexts[j].CreatedAt)
+	})
+	return contexts, nil
+}
@@ -0,0 +1,100 @@
+package providers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+)
+
+// PostgresContextStorage keeps contexts in the mcp.contexts table, with the
+// whole context as a JSON document
+type PostgresContextStorage struct {
+	db *sqlx.DB
+}
+
+// NewPostgresContextStorage creates a new context storage provider backed by
+// Postgres
+func NewPostgresContextStorage(db *sqlx.DB) *PostgresContextStorage {
+	return &PostgresContextStorage{db: db}
+}
+
+// StoreContext stores a context, replacing it if it exists
+func (s *PostgresContextStorage) StoreContext(ctx context.Context, contextData *mcp.Context) error {
+	data, err := json.Marshal(contextData)
+	if err != nil {
+		return fmt.Errorf("failed to serialize context data: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO mcp.contexts (id, agent_id, session_id, data, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data, updated_at = EXCLUDED.updated_at`,
+		contextData.ID, contextData.AgentID, contextData.SessionID, data, contextData.CreatedAt, contextData.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store context %s: %w", contextData.ID, err)
+	}
+	return nil
+}
+
+// GetContext retrieves a context by ID
+func (s *PostgresContextStorage) GetContext(ctx context.Context, contextID string) (*mcp.Context, error) {
+	var data []byte
+	err := s.db.GetContext(ctx, &data, `SELECT data FROM mcp.contexts WHERE id = $1`, contextID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrContextNotFound
+		}
+		return nil, fmt.Errorf("failed to get context %s: %w", contextID, err)
+	}
+
+	var contextData mcp.Context
+	if err := json.Unmarshal(data, &contextData); err != nil {
+		return nil, fmt.Errorf("failed to deserialize context data: %w", err)
+	}
+	return &contextData, nil
+}
+
+// DeleteContext deletes a context
+func (s *PostgresContextStorage) DeleteContext(ctx context.Context, contextID string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM mcp.contexts WHERE id = $1`, contextID)
+	if err != nil {
+		return fmt.Errorf("failed to delete context %s: %w", contextID, err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete context %s: %w", contextID, err)
+	}
+	if deleted == 0 {
+		return ErrContextNotFound
+	}
+	return nil
+}
+
+// ListContexts lists contexts for an agent and optionally a session, oldest
+// first
+func (s *PostgresContextStorage) ListContexts(ctx context.Context, agentID string, sessionID string) ([]*mcp.Context, error) {
+	var rows [][]byte
+	err := s.db.SelectContext(ctx, &rows, `
+		SELECT data FROM mcp.contexts
+		WHERE agent_id = $1 AND ($2 = '' OR session_id = $2)
+		ORDER BY created_at`, agentID, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contexts of agent %s: %w", agentID, err)
+	}
+
+	contexts := make([]*mcp.Context, 0, len(rows))
+	for _, data := range rows {
+		var contextData mcp.Context
+		if err := json.Unmarshal(data, &contextData); err != nil {
+			return nil, fmt.Errorf("failed to deserialize context data: %w", err)
+		}
+		contexts = append(contexts, &contextData)
+	}
+	return contexts, nil
+}
@@ -3,12 +3,14 @@ package providers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	s3client "github.com/S-Corkum/mcp-server/internal/storage"
 	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
 // S3ContextStorage implements context storage using AWS S3
@@ -55,6 +57,10 @@ func (s *S3ContextStorage) GetContext(ctx context.Context, contextID string) (*m
 	// Download from S3
 	data, err := s.s3Client.DownloadFile(ctx, key)
 	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrContextNotFound
+		}
 		return nil, fmt.Errorf("failed to download context from S3: %w", err)
 	}
 	
@@ -0,0 +1,515 @@
+// Package subscriptions sends events to external consumers. A subscription
+// registers a URL, an event filter and a secret; matching events are queued
+// as deliveries and posted to the URL as CloudEvents by a pool of workers,
+// signed with the secret. Failed deliveries are retried with exponential backoff, and
+// subscriptions whose deliveries keep failing are disabled.
+package subscriptions
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/google/uuid"
+)
+
+// Headers of deliveries
+const (
+	// SignatureHeader holds the hex-encoded HMAC-SHA256 of the body
+	SignatureHeader = "X-MCP-Signature"
+	// EventHeader holds the event type
+	EventHeader = "X-MCP-Event"
+	// DeliveryHeader holds the delivery ID, which is the same for every
+	// attempt of a delivery
+	DeliveryHeader = "X-MCP-Delivery"
+)
+
+// ErrInvalidSubscription indicates a subscription can't be created
+var ErrInvalidSubscription = errors.New("invalid subscription")
+
+// DefaultEventTypes are the event types subscriptions can receive
+var DefaultEventTypes = []events.EventType{
+	events.EventContextCreated,
+	events.EventContextUpdated,
+	events.EventContextDeleted,
+	events.EventContextSummarized,
+	events.EventContextTruncated,
+	events.EventToolActionExecuted,
+	events.EventToolActionFailed,
+	events.EventToolDataQueried,
+	events.EventAdapterHealthChanged,
+	events.EventAgentConnected,
+	events.EventAgentDisconnected,
+	events.EventAgentError,
+	events.EventSessionStarted,
+	events.EventSessionEnded,
+}
+
+// Config holds configuration for sending deliveries
+type Config struct {
+	// Workers is the number of deliveries sent at once
+	Workers int
+	// MaxAttempts is the number of failed attempts after which a delivery
+	// is given up
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt. The delay
+	// doubles after every failed attempt, up to MaxBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts
+	MaxBackoff time.Duration
+	// PollInterval is how often idle workers look for due deliveries
+	PollInterval time.Duration
+	// Timeout is the maximum time a consumer may take to respond
+	Timeout time.Duration
+	// DisableAfter is the number of consecutive failed attempts after which
+	// a subscription is disabled
+	DisableAfter int
+	// AllowPrivateTargets allows subscriptions to loopback, link-local and
+	// private addresses, such as consumers running next to the server
+	AllowPrivateTargets bool
+}
+
+// DefaultConfig returns the default delivery configuration. Deliveries are
+// attempted for about an hour, and a subscription is disabled after 20
+// failed attempts in a row.
+func DefaultConfig() Config {
+	return Config{
+		Workers:        4,
+		MaxAttempts:    8,
+		InitialBackoff: 30 * time.Second,
+		MaxBackoff:     15 * time.Minute,
+		PollInterval:   time.Second,
+		Timeout:        10 * time.Second,
+		DisableAfter:   20,
+	}
+}
+
+// Manager manages subscriptions and sends their deliveries
+type Manager struct {
+	store  Store
+	client *http.Client
+	config Config
+	logger *observability.Logger
+
+	// wake wakes idle workers when a delivery is queued
+	wake chan struct{}
+
+	mu         sync.Mutex
+	eventTypes map[string]bool
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewManager creates a new subscription manager. Call Start to start sending
+// deliveries.
+func NewManager(store Store, config Config, logger *observability.Logger) *Manager {
+	defaults := DefaultConfig()
+	if config.Workers <= 0 {
+		config.Workers = defaults.Workers
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaults.MaxAttempts
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = config.InitialBackoff
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaults.PollInterval
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+	if config.DisableAfter <= 0 {
+		config.DisableAfter = defaults.DisableAfter
+	}
+	if logger == nil {
+		logger = observability.NewLogger("subscriptions")
+	}
+
+	return &Manager{
+		store:  store,
+		client: newClient(config),
+		config: config,
+		logger: logger,
+		wake:   make(chan struct{}, config.Workers),
+	}
+}
+
+// Subscribe queues deliveries for the events of the given types published
+// on a bus
+func (m *Manager) Subscribe(bus events.Bus, eventTypes []events.EventType) {
+	m.mu.Lock()
+	if m.eventTypes == nil {
+		m.eventTypes = make(map[string]bool, len(eventTypes))
+	}
+	for _, eventType := range eventTypes {
+		m.eventTypes[string(eventType)] = true
+	}
+	m.mu.Unlock()
+
+	bus.SubscribeMultiple(eventTypes, m.Enqueue)
+}
+
+// Create validates and stores a new active subscription. Unless private
+// targets are allowed, its URL must not target a loopback, link-local or
+// private address.
+func (m *Manager) Create(ctx context.Context, subscription *mcp.Subscription) (*mcp.Subscription, error) {
+	if err := validateTarget(subscription.URL, m.config.AllowPrivateTargets); err != nil {
+		return nil, err
+	}
+	if subscription.Secret == "" {
+		return nil, fmt.Errorf("%w: secret is required to sign deliveries", ErrInvalidSubscription)
+	}
+
+	// Subscriptions to types that are never delivered are likely typos
+	m.mu.Lock()
+	for _, eventType := range subscription.Filter.Types {
+		if m.eventTypes != nil && !m.eventTypes[eventType] {
+			m.mu.Unlock()
+			return nil, fmt.Errorf("%w: event type %s can't be subscribed to", ErrInvalidSubscription, eventType)
+		}
+	}
+	m.mu.Unlock()
+
+	now := time.Now().UTC()
+	subscription.ID = uuid.New().String()
+	subscription.Status = mcp.SubscriptionActive
+	subscription.ConsecutiveFailures = 0
+	subscription.CreatedAt = now
+	subscription.UpdatedAt = now
+	if err := m.store.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("Created subscription", map[string]interface{}{
+		"subscriptionID": subscription.ID,
+		"url":            subscription.URL,
+		"types":          subscription.Filter.Types,
+	})
+	return subscription, nil
+}
+
+// Get gets a subscription by ID
+func (m *Manager) Get(ctx context.Context, id string) (*mcp.Subscription, error) {
+	return m.store.GetSubscription(ctx, id)
+}
+
+// List lists subscriptions, oldest first
+func (m *Manager) List(ctx context.Context) ([]*mcp.Subscription, error) {
+	return m.store.ListSubscriptions(ctx)
+}
+
+// Delete deletes a subscription and its delivery log
+func (m *Manager) Delete(ctx context.Context, id string) error {
+	return m.store.DeleteSubscription(ctx, id)
+}
+
+// Enable activates a disabled subscription. Deliveries given up while it
+// was disabled are not sent.
+func (m *Manager) Enable(ctx context.Context, id string) (*mcp.Subscription, error) {
+	subscription, err := m.store.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Status = mcp.SubscriptionActive
+	subscription.ConsecutiveFailures = 0
+	subscription.UpdatedAt = time.Now().UTC()
+	if err := m.store.UpdateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// Deliveries lists the deliveries of a subscription, most recent first
+func (m *Manager) Deliveries(ctx context.Context, id string, limit, offset int) ([]*mcp.SubscriptionDelivery, error) {
+	if _, err := m.store.GetSubscription(ctx, id); err != nil {
+		return nil, err
+	}
+	return m.store.ListDeliveries(ctx, id, limit, offset)
+}
+
+// Enqueue queues a delivery of an event for every active subscription whose
+// filter matches it
+func (m *Manager) Enqueue(ctx context.Context, event *mcp.Event) error {
+	subscriptions, err := m.store.ListSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	now := time.Now().UTC()
+	for _, subscription := range subscriptions {
+		if subscription.Status != mcp.SubscriptionActive || !subscription.Filter.MatchEvent(*event) {
+			continue
+		}
+
+		// Every subscription receives the same CloudEvent, so consumers
+		// subscribed more than once can discard duplicates by its ID
+		if payload == nil {
+			cloudEvent, err := cloudevents.FromMCPEvent(event)
+			if err != nil {
+				return err
+			}
+			if payload, err = json.Marshal(cloudEvent); err != nil {
+				return fmt.Errorf("failed to encode event %s: %w", event.Type, err)
+			}
+		}
+
+		delivery := &mcp.SubscriptionDelivery{
+			ID:             uuid.New().String(),
+			SubscriptionID: subscription.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         mcp.SubscriptionDeliveryPending,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+			NextAttemptAt:  now,
+		}
+		if err := m.store.CreateDelivery(ctx, delivery); err != nil {
+			return err
+		}
+		m.notify()
+	}
+	return nil
+}
+
+// Start starts the workers sending deliveries
+func (m *Manager) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	for n := 0; n < m.config.Workers; n++ {
+		m.wg.Add(1)
+		go m.work(ctx)
+	}
+}
+
+// Stop stops the workers, waiting until they finish the deliveries they are
+// sending or ctx is done
+func (m *Manager) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	cancel := m.cancel
+	m.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// notify wakes an idle worker
+func (m *Manager) notify() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// work claims and sends deliveries until ctx is done
+func (m *Manager) work(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Deliveries are leased for as long as a consumer may take to respond
+		deliveries, err := m.store.ClaimDeliveries(ctx, time.Now().UTC(), 1, 2*m.config.Timeout)
+		if err != nil && ctx.Err() == nil {
+			m.logger.Error("Failed to claim subscription deliveries", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		for _, delivery := range deliveries {
+			m.deliver(ctx, delivery)
+		}
+
+		if len(deliveries) > 0 && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver sends a claimed delivery and records the outcome
+func (m *Manager) deliver(ctx context.Context, delivery *mcp.SubscriptionDelivery) {
+	subscription, err := m.store.GetSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		// Deliveries of deleted subscriptions are deleted with them; others
+		// are claimed again after their lease
+		if !errors.Is(err, ErrSubscriptionNotFound) {
+			m.logger.Error("Failed to get subscription of delivery", map[string]interface{}{
+				"deliveryID": delivery.ID,
+				"error":      err.Error(),
+			})
+		}
+		return
+	}
+
+	recordCtx := context.WithoutCancel(ctx)
+	now := time.Now().UTC()
+	delivery.UpdatedAt = now
+
+	if subscription.Status != mcp.SubscriptionActive {
+		delivery.Status = mcp.SubscriptionDeliveryFailed
+		delivery.Error = "subscription is disabled"
+		m.updateDelivery(recordCtx, delivery)
+		return
+	}
+
+	status, err := m.send(ctx, subscription, delivery)
+	// Deliveries interrupted by Stop are sent again after their lease
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	now = time.Now().UTC()
+	delivery.UpdatedAt = now
+	delivery.ResponseStatus = status
+	if err == nil {
+		delivery.Status = mcp.SubscriptionDeliverySucceeded
+		delivery.Error = ""
+		delivery.DeliveredAt = now
+	} else {
+		delivery.Attempts++
+		delivery.Error = err.Error()
+		if delivery.Attempts >= m.config.MaxAttempts {
+			delivery.Status = mcp.SubscriptionDeliveryFailed
+			m.logger.Error("Gave up subscription delivery", map[string]interface{}{
+				"deliveryID":     delivery.ID,
+				"subscriptionID": subscription.ID,
+				"eventType":      delivery.EventType,
+				"attempts":       delivery.Attempts,
+				"error":          err.Error(),
+			})
+		} else {
+			delivery.Status = mcp.SubscriptionDeliveryPending
+			delivery.NextAttemptAt = now.Add(m.backoff(delivery.Attempts))
+			m.logger.Warn("Subscription delivery failed, retrying", map[string]interface{}{
+				"deliveryID":     delivery.ID,
+				"subscriptionID": subscription.ID,
+				"attempts":       delivery.Attempts,
+				"nextAttemptAt":  delivery.NextAttemptAt,
+				"error":          err.Error(),
+			})
+		}
+	}
+	m.updateDelivery(recordCtx, delivery)
+
+	updated, recordErr := m.store.RecordAttempt(recordCtx, subscription.ID, err == nil, m.config.DisableAfter)
+	if recordErr != nil {
+		if !errors.Is(recordErr, ErrSubscriptionNotFound) {
+			m.logger.Error("Failed to record subscription delivery attempt", map[string]interface{}{
+				"subscriptionID": subscription.ID,
+				"error":          recordErr.Error(),
+			})
+		}
+		return
+	}
+	if updated.Status == mcp.SubscriptionDisabled && updated.ConsecutiveFailures == m.config.DisableAfter {
+		m.logger.Warn("Disabled subscription after repeated failed deliveries", map[string]interface{}{
+			"subscriptionID": subscription.ID,
+			"url":            subscription.URL,
+			"failures":       updated.ConsecutiveFailures,
+		})
+	}
+}
+
+// send posts a delivery to the subscription's URL and returns the response
+// status. Responses other than 2xx are errors.
+func (m *Manager) send(ctx context.Context, subscription *mcp.Subscription, delivery *mcp.SubscriptionDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", cloudevents.ContentType)
+	req.Header.Set("User-Agent", "mcp-server")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, delivery.Payload))
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("consumer responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// updateDelivery stores the outcome of a delivery
+func (m *Manager) updateDelivery(ctx context.Context, delivery *mcp.SubscriptionDelivery) {
+	if err := m.store.UpdateDelivery(ctx, delivery); err != nil && !errors.Is(err, ErrDeliveryNotFound) {
+		m.logger.Error("Failed to record subscription delivery", map[string]interface{}{
+			"deliveryID": delivery.ID,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// backoff returns the delay before the attempt following the given number of
+// failed attempts
+func (m *Manager) backoff(attempts int) time.Duration {
+	delay := float64(m.config.InitialBackoff) * math.Pow(2, float64(attempts-1))
+	if delay > float64(m.config.MaxBackoff) {
+		return m.config.MaxBackoff
+	}
+	return time.Duration(delay)
+}
+
+// Sign returns the signature of a delivery body: the hex-encoded
+// HMAC-SHA256 of the body keyed with the subscription's secret, as
+// pkg/client signs X-MCP-Signature. Consumers verify it by computing the
+// same over the raw body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,333 @@
+package subscriptions
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/events"
+	"github.com/S-Corkum/mcp-server/internal/events/cloudevents"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig returns a config retrying quickly. Private targets are allowed
+// as the consumers of the tests listen on the loopback interface.
+func testConfig() Config {
+	return Config{
+		Workers:             2,
+		MaxAttempts:         3,
+		InitialBackoff:      time.Millisecond,
+		MaxBackoff:          time.Millisecond,
+		PollInterval:        5 * time.Millisecond,
+		Timeout:             time.Second,
+		DisableAfter:        5,
+		AllowPrivateTargets: true,
+	}
+}
+
+// consumer records the requests it receives and responds with the statuses
+// it is given, then 200
+type consumer struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (c *consumer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests = append(c.requests, r)
+	c.bodies = append(c.bodies, body)
+
+	status := http.StatusOK
+	if len(c.statuses) > 0 {
+		status, c.statuses = c.statuses[0], c.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (c *consumer) received() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.requests)
+}
+
+// waitForDelivery waits for the only delivery of a subscription to reach a
+// status
+func waitForDelivery(t *testing.T, manager *Manager, subscriptionID string, status mcp.SubscriptionDeliveryStatus) *mcp.SubscriptionDelivery {
+	t.Helper()
+
+	var delivery *mcp.SubscriptionDelivery
+	require.Eventually(t, func() bool {
+		deliveries, err := manager.Deliveries(context.Background(), subscriptionID, 0, 0)
+		require.NoError(t, err)
+		if len(deliveries) != 1 {
+			return false
+		}
+		delivery = deliveries[0]
+		return delivery.Status == status
+	}, 5*time.Second, 5*time.Millisecond, "delivery should be %s", status)
+	return delivery
+}
+
+func newSubscription(t *testing.T, manager *Manager, url string, types ...string) *mcp.Subscription {
+	t.Helper()
+
+	subscription, err := manager.Create(context.Background(), &mcp.Subscription{
+		URL:    url,
+		Secret: "s3cret",
+		Filter: mcp.EventFilter{Types: types},
+	})
+	require.NoError(t, err)
+	return subscription
+}
+
+func TestManagerSendsSignedDeliveries(t *testing.T) {
+	target := &consumer{}
+	server := httptest.NewServer(target)
+	defer server.Close()
+
+	bus := events.NewEventBus(1)
+	defer bus.Close()
+	manager := NewManager(NewMemoryStore(), testConfig(), nil)
+	manager.Subscribe(bus, DefaultEventTypes)
+	manager.Start()
+	defer manager.Stop(context.Background())
+
+	subscription := newSubscription(t, manager, server.URL, string(events.EventContextUpdated))
+	event := &mcp.Event{Source: "mcp-server", Type: string(events.EventContextUpdated), Data: map[string]interface{}{"context_id": "ctx-1"}}
+	require.NoError(t, bus.Publish(context.Background(), event))
+
+	delivery := waitForDelivery(t, manager, subscription.ID, mcp.SubscriptionDeliverySucceeded)
+	assert.Equal(t, http.StatusOK, delivery.ResponseStatus)
+	assert.Equal(t, 0, delivery.Attempts)
+	assert.False(t, delivery.DeliveredAt.IsZero())
+
+	target.mu.Lock()
+	defer target.mu.Unlock()
+	require.Len(t, target.requests, 1)
+	request, body := target.requests[0], target.bodies[0]
+	assert.Equal(t, Sign("s3cret", body), request.Header.Get(SignatureHeader))
+	assert.Equal(t, "context.updated", request.Header.Get(EventHeader))
+	assert.Equal(t, delivery.ID, request.Header.Get(DeliveryHeader))
+	assert.JSONEq(t, string(delivery.Payload), string(body))
+
+	// Deliveries are CloudEvents in the structured format
+	cloudEvent, err := cloudevents.Decode(request.Header, body)
+	require.NoError(t, err)
+	assert.Equal(t, event.ID, cloudEvent.ID)
+	assert.Equal(t, "context.updated", cloudEvent.Type)
+	assert.Equal(t, "ctx-1", cloudEvent.Subject)
+}
+
+func TestManagerFiltersEvents(t *testing.T) {
+	manager := NewManager(NewMemoryStore(), testConfig(), nil)
+	manager.Subscribe(events.NewEventBus(1), DefaultEventTypes)
+
+	tools := newSubscription(t, manager, "http://consumer.test/tools", string(events.EventToolActionExecuted))
+	all := newSubscription(t, manager, "http://consumer.test/all")
+	disabled := newSubscription(t, manager, "http://consumer.test/disabled")
+	disabled.Status = mcp.SubscriptionDisabled
+	require.NoError(t, manager.store.UpdateSubscription(context.Background(), disabled))
+
+	ctx := context.Background()
+	require.NoError(t, manager.Enqueue(ctx, &mcp.Event{Source: "mcp-server", Type: string(events.EventToolActionExecuted)}))
+	require.NoError(t, manager.Enqueue(ctx, &mcp.Event{Source: "mcp-server", Type: string(events.EventContextCreated)}))
+
+	for id, expected := range map[string]int{tools.ID: 1, all.ID: 2, disabled.ID: 0} {
+		deliveries, err := manager.Deliveries(ctx, id, 0, 0)
+		require.NoError(t, err)
+		assert.Len(t, deliveries, expected)
+	}
+}
+
+func TestManagerRejectsInvalidSubscriptions(t *testing.T) {
+	manager := NewManager(NewMemoryStore(), testConfig(), nil)
+	manager.Subscribe(events.NewEventBus(1), DefaultEventTypes)
+
+	for name, subscription := range map[string]*mcp.Subscription{
+		"relative url": {URL: "/hooks", Secret: "s3cret"},
+		"other scheme": {URL: "ftp://consumer.test", Secret: "s3cret"},
+		"no secret":    {URL: "https://consumer.test"},
+		"unknown type": {URL: "https://consumer.test", Secret: "s3cret", Filter: mcp.EventFilter{Types: []string{"context.udpated"}}},
+	} {
+		_, err := manager.Create(context.Background(), subscription)
+		assert.ErrorIs(t, err, ErrInvalidSubscription, name)
+	}
+}
+
+func TestManagerRejectsPrivateTargets(t *testing.T) {
+	config := testConfig()
+	config.AllowPrivateTargets = false
+	manager := NewManager(NewMemoryStore(), config, nil)
+
+	for _, url := range []string{
+		"http://127.0.0.1:8080/hooks",
+		"http://localhost/hooks",
+		"http://api.localhost/hooks",
+		"http://10.0.0.12/hooks",
+		"https://192.168.1.1/hooks",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[::1]/hooks",
+		"http://[fe80::1]/hooks",
+		"http://[fd00::1]/hooks",
+		"http://0.0.0.0/hooks",
+	} {
+		_, err := manager.Create(context.Background(), &mcp.Subscription{URL: url, Secret: "s3cret"})
+		assert.ErrorIs(t, err, ErrInvalidSubscription, url)
+	}
+
+	for _, url := range []string{"https://consumer.test/hooks", "http://203.0.113.10/hooks"} {
+		_, err := manager.Create(context.Background(), &mcp.Subscription{URL: url, Secret: "s3cret"})
+		assert.NoError(t, err, url)
+	}
+
+	allowed := NewManager(NewMemoryStore(), testConfig(), nil)
+	_, err := allowed.Create(context.Background(), &mcp.Subscription{URL: "http://127.0.0.1:8080/hooks", Secret: "s3cret"})
+	assert.NoError(t, err, "private targets can be allowed")
+}
+
+func TestManagerRefusesToDeliverToPrivateAddresses(t *testing.T) {
+	target := &consumer{}
+	server := httptest.NewServer(target)
+	defer server.Close()
+
+	// The subscription was stored before its target was checked, or its
+	// host resolves to a private address
+	config := testConfig()
+	config.AllowPrivateTargets = false
+	config.MaxAttempts = 1
+	store := NewMemoryStore()
+	manager := NewManager(store, config, nil)
+	subscription := &mcp.Subscription{ID: "sub-1", URL: server.URL, Secret: "s3cret", Status: mcp.SubscriptionActive}
+	require.NoError(t, store.CreateSubscription(context.Background(), subscription))
+	manager.Start()
+	defer manager.Stop(context.Background())
+
+	require.NoError(t, manager.Enqueue(context.Background(), &mcp.Event{Type: string(events.EventContextCreated)}))
+
+	delivery := waitForDelivery(t, manager, subscription.ID, mcp.SubscriptionDeliveryFailed)
+	assert.Contains(t, delivery.Error, ErrPrivateTarget.Error())
+	assert.Zero(t, target.received())
+}
+
+func TestManagerRetriesFailedDeliveries(t *testing.T) {
+	target := &consumer{statuses: []int{http.StatusInternalServerError, http.StatusBadGateway}}
+	server := httptest.NewServer(target)
+	defer server.Close()
+
+	manager := NewManager(NewMemoryStore(), testConfig(), nil)
+	manager.Start()
+	defer manager.Stop(context.Background())
+
+	subscription := newSubscription(t, manager, server.URL)
+	require.NoError(t, manager.Enqueue(context.Background(), &mcp.Event{Type: string(events.EventContextCreated)}))
+
+	delivery := waitForDelivery(t, manager, subscription.ID, mcp.SubscriptionDeliverySucceeded)
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.Empty(t, delivery.Error)
+	assert.Equal(t, 3, target.received())
+
+	target.mu.Lock()
+	ids := map[string]bool{}
+	for _, request := range target.requests {
+		ids[request.Header.Get(DeliveryHeader)] = true
+	}
+	target.mu.Unlock()
+	assert.Len(t, ids, 1, "every attempt should carry the same delivery ID")
+
+	updated, err := manager.Get(context.Background(), subscription.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, updated.ConsecutiveFailures)
+}
+
+func TestManagerDisablesFailingSubscriptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	config := testConfig()
+	config.MaxAttempts = 10
+	config.DisableAfter = 3
+	manager := NewManager(NewMemoryStore(), config, nil)
+	manager.Start()
+	defer manager.Stop(context.Background())
+
+	subscription := newSubscription(t, manager, server.URL)
+	require.NoError(t, manager.Enqueue(context.Background(), &mcp.Event{Type: string(events.EventContextCreated)}))
+
+	delivery := waitForDelivery(t, manager, subscription.ID, mcp.SubscriptionDeliveryFailed)
+	assert.Equal(t, "subscription is disabled", delivery.Error)
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.ResponseStatus)
+
+	disabled, err := manager.Get(context.Background(), subscription.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.SubscriptionDisabled, disabled.Status)
+	assert.Equal(t, 3, disabled.ConsecutiveFailures)
+
+	// Disabled subscriptions receive no new events
+	require.NoError(t, manager.Enqueue(context.Background(), &mcp.Event{Type: string(events.EventContextCreated)}))
+	deliveries, err := manager.Deliveries(context.Background(), subscription.ID, 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+
+	enabled, err := manager.Enable(context.Background(), subscription.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.SubscriptionActive, enabled.Status)
+	assert.Equal(t, 0, enabled.ConsecutiveFailures)
+}
+
+func TestManagerGivesUpDeliveries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	manager := NewManager(NewMemoryStore(), testConfig(), nil)
+	manager.Start()
+	defer manager.Stop(context.Background())
+
+	subscription := newSubscription(t, manager, server.URL)
+	require.NoError(t, manager.Enqueue(context.Background(), &mcp.Event{Type: string(events.EventContextCreated)}))
+
+	delivery := waitForDelivery(t, manager, subscription.ID, mcp.SubscriptionDeliveryFailed)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, "consumer responded with 404 Not Found", delivery.Error)
+
+	updated, err := manager.Get(context.Background(), subscription.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.SubscriptionActive, updated.Status)
+	assert.Equal(t, 3, updated.ConsecutiveFailures)
+}
+
+func TestManagerDeleteRemovesDeliveries(t *testing.T) {
+	manager := NewManager(NewMemoryStore(), testConfig(), nil)
+	ctx := context.Background()
+
+	subscription := newSubscription(t, manager, "http://consumer.test")
+	require.NoError(t, manager.Enqueue(ctx, &mcp.Event{Type: string(events.EventContextCreated)}))
+	require.NoError(t, manager.Delete(ctx, subscription.ID))
+
+	_, err := manager.Deliveries(ctx, subscription.ID, 0, 0)
+	assert.ErrorIs(t, err, ErrSubscriptionNotFound)
+	claimed, err := manager.store.ClaimDeliveries(ctx, time.Now().UTC(), 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+	assert.ErrorIs(t, manager.Delete(ctx, subscription.ID), ErrSubscriptionNotFound)
+}
+
+func TestSign(t *testing.T) {
+	// HMAC-SHA256 of "{}" keyed with "secret"
+	assert.Equal(t, "77325902caca812dc259733aacd046b73817372c777b8d95b402647474516e13", Sign("secret", []byte("{}")))
+	assert.NotEqual(t, Sign("secret", []byte("{}")), Sign("other", []byte("{}")))
+}
@@ -0,0 +1,326 @@
+package subscriptions
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+)
+
+// subscriptionColumns are the mcp.subscriptions columns of a subscription
+const subscriptionColumns = `id, url, description, filter, secret, status, consecutive_failures,
+	created_at, updated_at`
+
+// deliveryColumns are the mcp.subscription_deliveries columns of a delivery
+const deliveryColumns = `id, subscription_id, event_type, payload, status, attempts, response_status,
+	error, created_at, updated_at, next_attempt_at, delivered_at`
+
+// PostgresStore keeps subscriptions in the mcp.subscriptions table and their
+// deliveries in mcp.subscription_deliveries
+type PostgresStore struct {
+	db *sqlx.DB
+}
+
+// NewPostgresStore creates a new subscription store backed by Postgres
+func NewPostgresStore(db *sqlx.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// subscriptionRow is a row of the mcp.subscriptions table
+type subscriptionRow struct {
+	ID                  string         `db:"id"`
+	URL                 string         `db:"url"`
+	Description         sql.NullString `db:"description"`
+	Filter              []byte         `db:"filter"`
+	Secret              string         `db:"secret"`
+	Status              string         `db:"status"`
+	ConsecutiveFailures int            `db:"consecutive_failures"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+}
+
+// deliveryRow is a row of the mcp.subscription_deliveries table
+type deliveryRow struct {
+	ID             string         `db:"id"`
+	SubscriptionID string         `db:"subscription_id"`
+	EventType      string         `db:"event_type"`
+	Payload        []byte         `db:"payload"`
+	Status         string         `db:"status"`
+	Attempts       int            `db:"attempts"`
+	ResponseStatus sql.NullInt64  `db:"response_status"`
+	Error          sql.NullString `db:"error"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+	NextAttemptAt  time.Time      `db:"next_attempt_at"`
+	DeliveredAt    sql.NullTime   `db:"delivered_at"`
+}
+
+// CreateSubscription stores a new subscription
+func (s *PostgresStore) CreateSubscription(ctx context.Context, subscription *mcp.Subscription) error {
+	row, err := toSubscriptionRow(subscription)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.NamedExecContext(ctx, `
+		INSERT INTO mcp.subscriptions (`+subscriptionColumns+`)
+		VALUES (
+			:id, :url, :description, :filter, :secret, :status, :consecutive_failures,
+			:created_at, :updated_at
+		)`, row)
+	if err != nil {
+		return fmt.Errorf("failed to store subscription %s: %w", subscription.ID, err)
+	}
+	return nil
+}
+
+// GetSubscription gets a subscription by ID
+func (s *PostgresStore) GetSubscription(ctx context.Context, id string) (*mcp.Subscription, error) {
+	var row subscriptionRow
+	err := s.db.GetContext(ctx, &row, `SELECT `+subscriptionColumns+` FROM mcp.subscriptions WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to get subscription %s: %w", id, err)
+	}
+	return row.toSubscription()
+}
+
+// ListSubscriptions lists subscriptions, oldest first
+func (s *PostgresStore) ListSubscriptions(ctx context.Context) ([]*mcp.Subscription, error) {
+	var rows []subscriptionRow
+	err := s.db.SelectContext(ctx, &rows, `SELECT `+subscriptionColumns+` FROM mcp.subscriptions ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	subscriptions := make([]*mcp.Subscription, 0, len(rows))
+	for i := range rows {
+		subscription, err := rows[i].toSubscription()
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscription replaces a stored subscription
+func (s *PostgresStore) UpdateSubscription(ctx context.Context, subscription *mcp.Subscription) error {
+	row, err := toSubscriptionRow(subscription)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.NamedExecContext(ctx, `
+		UPDATE mcp.subscriptions SET
+			url = :url, description = :description, filter = :filter, secret = :secret,
+			status = :status, consecutive_failures = :consecutive_failures, updated_at = :updated_at
+		WHERE id = :id`, row)
+	if err != nil {
+		return fmt.Errorf("failed to update subscription %s: %w", subscription.ID, err)
+	}
+	return checkUpdated(result, ErrSubscriptionNotFound)
+}
+
+// DeleteSubscription deletes a subscription. Its deliveries are deleted by
+// the foreign key.
+func (s *PostgresStore) DeleteSubscription(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM mcp.subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete subscription %s: %w", id, err)
+	}
+	return checkUpdated(result, ErrSubscriptionNotFound)
+}
+
+// RecordAttempt records the outcome of a delivery attempt. The failures of
+// deliveries sent at the same time are all counted.
+func (s *PostgresStore) RecordAttempt(ctx context.Context, id string, succeeded bool, disableAfter int) (*mcp.Subscription, error) {
+	var row subscriptionRow
+	err := s.db.GetContext(ctx, &row, `
+		UPDATE mcp.subscriptions SET
+			consecutive_failures = CASE WHEN $2 THEN 0 ELSE consecutive_failures + 1 END,
+			status = CASE WHEN NOT $2 AND consecutive_failures + 1 >= $3 THEN 'disabled' ELSE status END,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+subscriptionColumns, id, succeeded, disableAfter)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to record delivery attempt of subscription %s: %w", id, err)
+	}
+	return row.toSubscription()
+}
+
+// CreateDelivery stores a new delivery
+func (s *PostgresStore) CreateDelivery(ctx context.Context, delivery *mcp.SubscriptionDelivery) error {
+	_, err := s.db.NamedExecContext(ctx, `
+		INSERT INTO mcp.subscription_deliveries (`+deliveryColumns+`)
+		VALUES (
+			:id, :subscription_id, :event_type, :payload, :status, :attempts, :response_status,
+			:error, :created_at, :updated_at, :next_attempt_at, :delivered_at
+		)`, toDeliveryRow(delivery))
+	if err != nil {
+		return fmt.Errorf("failed to store subscription delivery %s: %w", delivery.ID, err)
+	}
+	return nil
+}
+
+// UpdateDelivery replaces a stored delivery and releases its lease
+func (s *PostgresStore) UpdateDelivery(ctx context.Context, delivery *mcp.SubscriptionDelivery) error {
+	result, err := s.db.NamedExecContext(ctx, `
+		UPDATE mcp.subscription_deliveries SET
+			status = :status, attempts = :attempts, response_status = :response_status, error = :error,
+			updated_at = :updated_at, next_attempt_at = :next_attempt_at, delivered_at = :delivered_at,
+			locked_until = NULL
+		WHERE id = :id`, toDeliveryRow(delivery))
+	if err != nil {
+		return fmt.Errorf("failed to update subscription delivery %s: %w", delivery.ID, err)
+	}
+	return checkUpdated(result, ErrDeliveryNotFound)
+}
+
+// ClaimDeliveries leases deliveries that are due. Rows locked by other
+// servers claiming deliveries at the same time are skipped.
+func (s *PostgresStore) ClaimDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*mcp.SubscriptionDelivery, error) {
+	var rows []deliveryRow
+	err := s.db.SelectContext(ctx, &rows, `
+		UPDATE mcp.subscription_deliveries SET status = 'processing', locked_until = $2, updated_at = $1
+		WHERE id IN (
+			SELECT id FROM mcp.subscription_deliveries
+			WHERE (status = 'pending' AND next_attempt_at <= $1)
+				OR (status = 'processing' AND locked_until <= $1)
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+deliveryColumns, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim subscription deliveries: %w", err)
+	}
+
+	deliveries := make([]*mcp.SubscriptionDelivery, 0, len(rows))
+	for i := range rows {
+		deliveries = append(deliveries, rows[i].toDelivery())
+	}
+	return deliveries, nil
+}
+
+// ListDeliveries lists the deliveries of a subscription, most recent first
+func (s *PostgresStore) ListDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*mcp.SubscriptionDelivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM mcp.subscription_deliveries
+		WHERE subscription_id = $1 ORDER BY created_at DESC`
+	args := []interface{}{subscriptionID}
+	if limit > 0 {
+		args = append(args, limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if offset > 0 {
+		args = append(args, offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	var rows []deliveryRow
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list deliveries of subscription %s: %w", subscriptionID, err)
+	}
+
+	deliveries := make([]*mcp.SubscriptionDelivery, 0, len(rows))
+	for i := range rows {
+		deliveries = append(deliveries, rows[i].toDelivery())
+	}
+	return deliveries, nil
+}
+
+// checkUpdated returns notFound if a statement affected no rows
+func checkUpdated(result sql.Result, notFound error) error {
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return notFound
+	}
+	return nil
+}
+
+// toSubscriptionRow converts a subscription to a row
+func toSubscriptionRow(subscription *mcp.Subscription) (*subscriptionRow, error) {
+	filter, err := json.Marshal(subscription.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode filter of subscription %s: %w", subscription.ID, err)
+	}
+
+	return &subscriptionRow{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		Description:         sql.NullString{String: subscription.Description, Valid: subscription.Description != ""},
+		Filter:              filter,
+		Secret:              subscription.Secret,
+		Status:              string(subscription.Status),
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}, nil
+}
+
+// toSubscription converts a row to a subscription
+func (r *subscriptionRow) toSubscription() (*mcp.Subscription, error) {
+	subscription := &mcp.Subscription{
+		ID:                  r.ID,
+		URL:                 r.URL,
+		Description:         r.Description.String,
+		Secret:              r.Secret,
+		Status:              mcp.SubscriptionStatus(r.Status),
+		ConsecutiveFailures: r.ConsecutiveFailures,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
+	}
+	if err := json.Unmarshal(r.Filter, &subscription.Filter); err != nil {
+		return nil, fmt.Errorf("failed to decode filter of subscription %s: %w", r.ID, err)
+	}
+	return subscription, nil
+}
+
+// toDeliveryRow converts a delivery to a row
+func toDeliveryRow(delivery *mcp.SubscriptionDelivery) *deliveryRow {
+	return &deliveryRow{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: sql.NullInt64{Int64: int64(delivery.ResponseStatus), Valid: delivery.ResponseStatus != 0},
+		Error:          sql.NullString{String: delivery.Error, Valid: delivery.Error != ""},
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		DeliveredAt:    sql.NullTime{Time: delivery.DeliveredAt, Valid: !delivery.DeliveredAt.IsZero()},
+	}
+}
+
+// toDelivery converts a row to a delivery
+func (r *deliveryRow) toDelivery() *mcp.SubscriptionDelivery {
+	return &mcp.SubscriptionDelivery{
+		ID:             r.ID,
+		SubscriptionID: r.SubscriptionID,
+		EventType:      r.EventType,
+		Payload:        r.Payload,
+		Status:         mcp.SubscriptionDeliveryStatus(r.Status),
+		Attempts:       r.Attempts,
+		ResponseStatus: int(r.ResponseStatus.Int64),
+		Error:          r.Error.String,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+		NextAttemptAt:  r.NextAttemptAt,
+		DeliveredAt:    r.DeliveredAt.Time,
+	}
+}
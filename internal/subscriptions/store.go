@@ -0,0 +1,257 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// Store errors
+var (
+	// ErrSubscriptionNotFound indicates a subscription doesn't exist
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+
+	// ErrDeliveryNotFound indicates a delivery doesn't exist
+	ErrDeliveryNotFound = errors.New("subscription delivery not found")
+)
+
+// Store persists subscriptions and their deliveries
+type Store interface {
+	// CreateSubscription stores a new subscription
+	CreateSubscription(ctx context.Context, subscription *mcp.Subscription) error
+
+	// GetSubscription gets a subscription by ID, returning
+	// ErrSubscriptionNotFound if it doesn't exist
+	GetSubscription(ctx context.Context, id string) (*mcp.Subscription, error)
+
+	// ListSubscriptions lists subscriptions, oldest first
+	ListSubscriptions(ctx context.Context) ([]*mcp.Subscription, error)
+
+	// UpdateSubscription replaces a stored subscription
+	UpdateSubscription(ctx context.Context, subscription *mcp.Subscription) error
+
+	// DeleteSubscription deletes a subscription and its deliveries
+	DeleteSubscription(ctx context.Context, id string) error
+
+	// RecordAttempt records the outcome of a delivery attempt. A success
+	// resets the subscription's consecutive failures; a failure increments
+	// them and disables the subscription once they reach disableAfter.
+	RecordAttempt(ctx context.Context, id string, succeeded bool, disableAfter int) (*mcp.Subscription, error)
+
+	// CreateDelivery stores a new delivery
+	CreateDelivery(ctx context.Context, delivery *mcp.SubscriptionDelivery) error
+
+	// UpdateDelivery replaces a stored delivery and releases its lease
+	UpdateDelivery(ctx context.Context, delivery *mcp.SubscriptionDelivery) error
+
+	// ClaimDeliveries leases up to limit deliveries that are due at now,
+	// oldest first, and marks them as processing. Deliveries whose lease
+	// expired are claimed again.
+	ClaimDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*mcp.SubscriptionDelivery, error)
+
+	// ListDeliveries lists the deliveries of a subscription, most recent first
+	ListDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*mcp.SubscriptionDelivery, error)
+}
+
+// memoryDelivery is a delivery kept by the memory store
+type memoryDelivery struct {
+	delivery    mcp.SubscriptionDelivery
+	lockedUntil time.Time
+}
+
+// MemoryStore keeps subscriptions in memory. They are lost when the server
+// stops, so it is only used when no database is available.
+type MemoryStore struct {
+	mu            sync.Mutex
+	subscriptions map[string]*mcp.Subscription
+	deliveries    map[string]*memoryDelivery
+}
+
+// NewMemoryStore creates a new in-memory subscription store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		subscriptions: make(map[string]*mcp.Subscription),
+		deliveries:    make(map[string]*memoryDelivery),
+	}
+}
+
+// CreateSubscription stores a new subscription
+func (s *MemoryStore) CreateSubscription(ctx context.Context, subscription *mcp.Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *subscription
+	s.subscriptions[subscription.ID] = &stored
+	return nil
+}
+
+// GetSubscription gets a subscription by ID
+func (s *MemoryStore) GetSubscription(ctx context.Context, id string) (*mcp.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.subscriptions[id]
+	if !ok {
+		return nil, ErrSubscriptionNotFound
+	}
+	subscription := *stored
+	return &subscription, nil
+}
+
+// ListSubscriptions lists subscriptions, oldest first
+func (s *MemoryStore) ListSubscriptions(ctx context.Context) ([]*mcp.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subscriptions := make([]*mcp.Subscription, 0, len(s.subscriptions))
+	for _, stored := range s.subscriptions {
+		subscription := *stored
+		subscriptions = append(subscriptions, &subscription)
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].CreatedAt.Before(subscriptions[j].CreatedAt)
+	})
+	return subscriptions, nil
+}
+
+// UpdateSubscription replaces a stored subscription
+func (s *MemoryStore) UpdateSubscription(ctx context.Context, subscription *mcp.Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[subscription.ID]; !ok {
+		return ErrSubscriptionNotFound
+	}
+	stored := *subscription
+	s.subscriptions[subscription.ID] = &stored
+	return nil
+}
+
+// DeleteSubscription deletes a subscription and its deliveries
+func (s *MemoryStore) DeleteSubscription(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[id]; !ok {
+		return ErrSubscriptionNotFound
+	}
+	delete(s.subscriptions, id)
+	for deliveryID, stored := range s.deliveries {
+		if stored.delivery.SubscriptionID == id {
+			delete(s.deliveries, deliveryID)
+		}
+	}
+	return nil
+}
+
+// RecordAttempt records the outcome of a delivery attempt
+func (s *MemoryStore) RecordAttempt(ctx context.Context, id string, succeeded bool, disableAfter int) (*mcp.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.subscriptions[id]
+	if !ok {
+		return nil, ErrSubscriptionNotFound
+	}
+	if succeeded {
+		stored.ConsecutiveFailures = 0
+	} else {
+		stored.ConsecutiveFailures++
+		if stored.ConsecutiveFailures >= disableAfter {
+			stored.Status = mcp.SubscriptionDisabled
+		}
+	}
+	stored.UpdatedAt = time.Now().UTC()
+
+	subscription := *stored
+	return &subscription, nil
+}
+
+// CreateDelivery stores a new delivery
+func (s *MemoryStore) CreateDelivery(ctx context.Context, delivery *mcp.SubscriptionDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deliveries[delivery.ID] = &memoryDelivery{delivery: *delivery}
+	return nil
+}
+
+// UpdateDelivery replaces a stored delivery and releases its lease
+func (s *MemoryStore) UpdateDelivery(ctx context.Context, delivery *mcp.SubscriptionDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.deliveries[delivery.ID]; !ok {
+		return ErrDeliveryNotFound
+	}
+	s.deliveries[delivery.ID] = &memoryDelivery{delivery: *delivery}
+	return nil
+}
+
+// ClaimDeliveries leases deliveries that are due
+func (s *MemoryStore) ClaimDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*mcp.SubscriptionDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*memoryDelivery
+	for _, stored := range s.deliveries {
+		switch stored.delivery.Status {
+		case mcp.SubscriptionDeliveryPending:
+			if !stored.delivery.NextAttemptAt.After(now) {
+				due = append(due, stored)
+			}
+		case mcp.SubscriptionDeliveryProcessing:
+			if !stored.lockedUntil.After(now) {
+				due = append(due, stored)
+			}
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].delivery.NextAttemptAt.Before(due[j].delivery.NextAttemptAt)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]*mcp.SubscriptionDelivery, 0, len(due))
+	for _, stored := range due {
+		stored.delivery.Status = mcp.SubscriptionDeliveryProcessing
+		stored.delivery.UpdatedAt = now
+		stored.lockedUntil = now.Add(lease)
+
+		delivery := stored.delivery
+		claimed = append(claimed, &delivery)
+	}
+	return claimed, nil
+}
+
+// ListDeliveries lists the deliveries of a subscription, most recent first
+func (s *MemoryStore) ListDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*mcp.SubscriptionDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deliveries []*mcp.SubscriptionDelivery
+	for _, stored := range s.deliveries {
+		if stored.delivery.SubscriptionID != subscriptionID {
+			continue
+		}
+		delivery := stored.delivery
+		deliveries = append(deliveries, &delivery)
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+
+	if offset >= len(deliveries) {
+		return []*mcp.SubscriptionDelivery{}, nil
+	}
+	deliveries = deliveries[offset:]
+	if limit > 0 && len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
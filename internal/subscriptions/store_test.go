@@ -0,0 +1,165 @@
+package subscriptions
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var subscriptionColumnNames = []string{"id", "url", "description", "filter", "secret", "status",
+	"consecutive_failures", "created_at", "updated_at"}
+
+var deliveryColumnNames = []string{"id", "subscription_id", "event_type", "payload", "status", "attempts",
+	"response_status", "error", "created_at", "updated_at", "next_attempt_at", "delivered_at"}
+
+func testDelivery(id, subscriptionID string, now time.Time) *mcp.SubscriptionDelivery {
+	return &mcp.SubscriptionDelivery{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		EventType:      "context.updated",
+		Payload:        []byte(`{"type":"context.updated"}`),
+		Status:         mcp.SubscriptionDeliveryPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		NextAttemptAt:  now,
+	}
+}
+
+func TestMemoryStoreClaimDeliveries(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	now := time.Now().UTC()
+
+	require.NoError(t, store.CreateSubscription(ctx, &mcp.Subscription{ID: "sub-1", Status: mcp.SubscriptionActive}))
+	due := testDelivery("due", "sub-1", now.Add(-time.Second))
+	later := testDelivery("later", "sub-1", now)
+	later.NextAttemptAt = now.Add(time.Hour)
+	require.NoError(t, store.CreateDelivery(ctx, due))
+	require.NoError(t, store.CreateDelivery(ctx, later))
+
+	claimed, err := store.ClaimDeliveries(ctx, now, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "due", claimed[0].ID)
+	assert.Equal(t, mcp.SubscriptionDeliveryProcessing, claimed[0].Status)
+
+	// Leased deliveries are claimed again once the lease expires
+	claimed, err = store.ClaimDeliveries(ctx, now, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+	claimed, err = store.ClaimDeliveries(ctx, now.Add(2*time.Minute), 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "due", claimed[0].ID)
+
+	listed, err := store.ListDeliveries(ctx, "sub-1", 1, 0)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "later", listed[0].ID)
+}
+
+func TestMemoryStoreRecordAttempt(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	require.NoError(t, store.CreateSubscription(ctx, &mcp.Subscription{ID: "sub-1", Status: mcp.SubscriptionActive}))
+
+	subscription, err := store.RecordAttempt(ctx, "sub-1", false, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, subscription.ConsecutiveFailures)
+	assert.Equal(t, mcp.SubscriptionActive, subscription.Status)
+
+	subscription, err = store.RecordAttempt(ctx, "sub-1", true, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, subscription.ConsecutiveFailures)
+
+	store.RecordAttempt(ctx, "sub-1", false, 2)
+	subscription, err = store.RecordAttempt(ctx, "sub-1", false, 2)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.SubscriptionDisabled, subscription.Status)
+
+	_, err = store.RecordAttempt(ctx, "missing", true, 2)
+	assert.ErrorIs(t, err, ErrSubscriptionNotFound)
+}
+
+func TestPostgresStore(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	store := NewPostgresStore(sqlx.NewDb(mockDB, "postgres"))
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	subscription := &mcp.Subscription{
+		ID:        "sub-1",
+		URL:       "https://consumer.test/events",
+		Filter:    mcp.EventFilter{Types: []string{"context.updated"}},
+		Secret:    "s3cret",
+		Status:    mcp.SubscriptionActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	filter := `{"sources":null,"types":["context.updated"],"after":"0001-01-01T00:00:00Z","before":"0001-01-01T00:00:00Z"}`
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO mcp.subscriptions")).
+		WithArgs("sub-1", "https://consumer.test/events", nil, []byte(filter), "s3cret", "active", 0, now, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, store.CreateSubscription(ctx, subscription))
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.subscriptions WHERE id = $1")).
+		WithArgs("sub-1").
+		WillReturnRows(sqlmock.NewRows(subscriptionColumnNames).AddRow("sub-1", "https://consumer.test/events",
+			nil, []byte(filter), "s3cret", "active", 0, now, now))
+	stored, err := store.GetSubscription(ctx, "sub-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"context.updated"}, stored.Filter.Types)
+	assert.Equal(t, "s3cret", stored.Secret)
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE mcp.subscriptions SET")).
+		WithArgs("sub-1", false, 20).
+		WillReturnRows(sqlmock.NewRows(subscriptionColumnNames).AddRow("sub-1", "https://consumer.test/events",
+			nil, []byte(filter), "s3cret", "disabled", 20, now, now))
+	disabled, err := store.RecordAttempt(ctx, "sub-1", false, 20)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.SubscriptionDisabled, disabled.Status)
+	assert.Equal(t, 20, disabled.ConsecutiveFailures)
+
+	delivery := testDelivery("del-1", "sub-1", now)
+	mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).
+		WithArgs(now, now.Add(time.Minute), 5).
+		WillReturnRows(sqlmock.NewRows(deliveryColumnNames).AddRow("del-1", "sub-1", "context.updated",
+			delivery.Payload, "processing", 0, nil, nil, now, now, now, nil))
+	claimed, err := store.ClaimDeliveries(ctx, now, 5, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, mcp.SubscriptionDeliveryProcessing, claimed[0].Status)
+	assert.True(t, claimed[0].DeliveredAt.IsZero())
+
+	delivery.Status = mcp.SubscriptionDeliverySucceeded
+	delivery.ResponseStatus = 204
+	delivery.DeliveredAt = now
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE mcp.subscription_deliveries SET")).
+		WithArgs("succeeded", 0, 204, nil, now, now, now, "del-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, store.UpdateDelivery(ctx, delivery))
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE subscription_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3")).
+		WithArgs("sub-1", 20, 40).
+		WillReturnRows(sqlmock.NewRows(deliveryColumnNames))
+	listed, err := store.ListDeliveries(ctx, "sub-1", 20, 40)
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM mcp.subscriptions WHERE id = $1")).
+		WithArgs("missing").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, store.DeleteSubscription(ctx, "missing"), ErrSubscriptionNotFound)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,80 @@
+package subscriptions
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateTarget indicates a delivery would be sent to a loopback,
+// link-local or private address
+var ErrPrivateTarget = errors.New("private target")
+
+// isPrivateIP reports whether ip is an address of the server or of its
+// network rather than of an external consumer
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsPrivate() || ip.IsUnspecified()
+}
+
+// validateTarget checks the URL of a subscription. Hosts given as addresses
+// and localhost names are checked here; names resolving to private addresses
+// are refused when deliveries are sent, by checkDial.
+func validateTarget(rawURL string, allowPrivate bool) error {
+	target, err := url.Parse(rawURL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Hostname() == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidSubscription)
+	}
+	if allowPrivate {
+		return nil
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(target.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: url must not target %s", ErrInvalidSubscription, host)
+	}
+	if ip := net.ParseIP(host); ip != nil && isPrivateIP(ip) {
+		return fmt.Errorf("%w: url must not target the loopback, link-local or private address %s", ErrInvalidSubscription, ip)
+	}
+	return nil
+}
+
+// checkDial refuses connections to private addresses. It runs once the host
+// of a delivery has been resolved, so it also covers names resolving to
+// private addresses and redirects to them.
+func checkDial(network, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || isPrivateIP(ip) {
+		return fmt.Errorf("%w: refusing to deliver to %s", ErrPrivateTarget, host)
+	}
+	return nil
+}
+
+// newClient returns the HTTP client sending deliveries. Unless private
+// targets are allowed, it refuses to connect to private addresses and
+// doesn't use the proxy of the environment, which would connect on its
+// behalf.
+func newClient(config Config) *http.Client {
+	if config.AllowPrivateTargets {
+		return &http.Client{Timeout: config.Timeout}
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   checkDial,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: config.Timeout, Transport: transport}
+}
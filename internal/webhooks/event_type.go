@@ -0,0 +1,23 @@
+package webhooks
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// eventTypeHeaders are the headers services send the event type of a webhook
+// in, in order of precedence
+var eventTypeHeaders = []string{"X-GitHub-Event", "X-Gitlab-Event", "X-Event-Key", "X-Event-Type"}
+
+// EventType returns the event type of a delivery from its headers, or from
+// the event_type query parameter for services that can't set headers. It
+// returns an empty string if the service doesn't send one, in which case
+// adapters take the event type from the payload.
+func EventType(header http.Header, query url.Values) string {
+	for _, name := range eventTypeHeaders {
+		if eventType := header.Get(name); eventType != "" {
+			return eventType
+		}
+	}
+	return query.Get("event_type")
+}
@@ -0,0 +1,91 @@
+package fixture
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/internal/adapters/core"
+	"github.com/S-Corkum/mcp-server/internal/adapters/events"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSecret is the webhook secret fixtures are signed with in adapter
+// tests. Adapters under test must be configured with it.
+const TestSecret = "fixture-secret"
+
+// Handle passes a fixture to an adapter the way the server does: the
+// delivery is verified by the adapter if it authenticates webhooks, then
+// handled with the event type of the delivery.
+func Handle(ctx context.Context, adapter core.Adapter, f *Fixture) error {
+	payload := f.Payload()
+	if receiver, ok := adapter.(core.WebhookReceiver); ok {
+		var err error
+		payload, err = receiver.ReceiveWebhook(f.Header, f.Query, payload)
+		if err != nil {
+			return err
+		}
+	}
+	return adapter.HandleWebhook(ctx, f.EventType(), payload)
+}
+
+// Run runs a table test of the fixtures in dir, typically
+// testdata/webhooks. Each fixture is signed with TestSecret and handled by a
+// new adapter created on an event bus recording its events. The adapter must
+// emit one event with the event type expected for the fixture, keyed by
+// file name. Every fixture in dir needs an expectation.
+func Run(t *testing.T, dir string, newAdapter func(t *testing.T, eventBus *events.EventBus) core.Adapter, expected map[string]string) {
+	t.Helper()
+
+	fixtures, err := LoadDir(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures, "no fixtures in %s", dir)
+
+	seen := make(map[string]bool, len(fixtures))
+	for _, f := range fixtures {
+		seen[f.Name] = true
+
+		t.Run(f.Name, func(t *testing.T) {
+			eventType, ok := expected[f.Name]
+			require.True(t, ok, "no expected event type for fixture %s", f.Name)
+
+			eventBus := events.NewEventBus(observability.NewLogger("fixture"))
+			recorder := &eventRecorder{}
+			eventBus.SubscribeAll(recorder)
+
+			adapter := newAdapter(t, eventBus)
+			require.NoError(t, Handle(context.Background(), adapter, f.Sign(TestSecret, time.Now())))
+
+			emitted := recorder.recorded()
+			require.Len(t, emitted, 1)
+			assert.Equal(t, eventType, emitted[0].Metadata["eventType"])
+		})
+	}
+
+	for name := range expected {
+		assert.True(t, seen[name], "fixture %s not found in %s", name, dir)
+	}
+}
+
+// eventRecorder records the events emitted by an adapter
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []*events.AdapterEvent
+}
+
+func (r *eventRecorder) Handle(ctx context.Context, event *events.AdapterEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+// recorded returns the events recorded so far
+func (r *eventRecorder) recorded() []*events.AdapterEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*events.AdapterEvent(nil), r.events...)
+}
@@ -0,0 +1,233 @@
+// Package fixture records webhook deliveries as fixture files and replays
+// them against a server or an adapter. A fixture is a JSON file holding the
+// headers, query and body of one delivery. Adapters keep their fixtures in
+// testdata/webhooks, where they drive the table tests of HandleWebhook.
+package fixture
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/S-Corkum/mcp-server/internal/webhooks"
+)
+
+// ignoredHeaders are headers that are not recorded because they are set by
+// the HTTP client or the proxies a delivery went through
+var ignoredHeaders = map[string]bool{
+	"Accept-Encoding":   true,
+	"Connection":        true,
+	"Content-Length":    true,
+	"X-Forwarded-For":   true,
+	"X-Forwarded-Host":  true,
+	"X-Forwarded-Proto": true,
+	"X-Real-Ip":         true,
+}
+
+// unsafeNameChars are the characters replaced in generated file names
+var unsafeNameChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// Fixture is a recorded webhook delivery
+type Fixture struct {
+	// Name is the file name of the fixture
+	Name string `json:"-"`
+
+	// Adapter is the adapter the delivery was sent to
+	Adapter string `json:"adapter"`
+
+	// Header holds the request headers
+	Header http.Header `json:"header,omitempty"`
+
+	// Query holds the query parameters of the webhook URL
+	Query url.Values `json:"query,omitempty"`
+
+	// Body is the body of JSON deliveries
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// RawBody is the body of other deliveries, e.g. form encoded Slack
+	// interactions
+	RawBody string `json:"raw_body,omitempty"`
+}
+
+// FromRequest creates a fixture from a delivery received for an adapter
+func FromRequest(adapter string, r *http.Request, body []byte) *Fixture {
+	fixture := &Fixture{
+		Adapter: adapter,
+		Header:  http.Header{},
+	}
+
+	for name, values := range r.Header {
+		if !ignoredHeaders[http.CanonicalHeaderKey(name)] {
+			fixture.Header[name] = append([]string(nil), values...)
+		}
+	}
+	if query := r.URL.Query(); len(query) > 0 {
+		fixture.Query = query
+	}
+
+	if json.Valid(body) {
+		fixture.Body = append(json.RawMessage(nil), body...)
+	} else {
+		fixture.RawBody = string(body)
+	}
+	return fixture
+}
+
+// Payload returns the body of the delivery
+func (f *Fixture) Payload() []byte {
+	if f.Body != nil {
+		return f.Body
+	}
+	return []byte(f.RawBody)
+}
+
+// EventType returns the event type the server reads from the delivery
+func (f *Fixture) EventType() string {
+	return webhooks.EventType(f.Header, f.Query)
+}
+
+// Clone returns a deep copy of the fixture
+func (f *Fixture) Clone() *Fixture {
+	clone := *f
+	clone.Header = f.Header.Clone()
+	if clone.Header == nil {
+		clone.Header = http.Header{}
+	}
+	clone.Query = url.Values{}
+	for name, values := range f.Query {
+		clone.Query[name] = append([]string(nil), values...)
+	}
+	return &clone
+}
+
+// NewRequest creates the request delivering the fixture to the webhook
+// endpoint of the server at baseURL
+func (f *Fixture) NewRequest(ctx context.Context, baseURL string) (*http.Request, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(baseURL, "/") + "/webhooks/" + url.PathEscape(f.Adapter))
+	if err != nil {
+		return nil, fmt.Errorf("invalid server URL %s: %w", baseURL, err)
+	}
+	endpoint.RawQuery = f.Query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(f.Payload()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = f.Header.Clone()
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+	if req.Header.Get("Content-Type") == "" && f.Body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// Load reads a fixture file
+func Load(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+	if fixture.Adapter == "" {
+		return nil, fmt.Errorf("fixture %s has no adapter", path)
+	}
+	fixture.Name = filepath.Base(path)
+	return &fixture, nil
+}
+
+// LoadDir reads the fixture files, *.json, of a directory sorted by name
+func LoadDir(dir string) ([]*Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	fixtures := make([]*Fixture, 0, len(paths))
+	for _, path := range paths {
+		fixture, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, nil
+}
+
+// LoadPath reads a fixture file, or the fixture files of a directory
+func LoadPath(path string) ([]*Fixture, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+	if info.IsDir() {
+		return LoadDir(path)
+	}
+
+	fixture, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return []*Fixture{fixture}, nil
+}
+
+// Save writes the fixture to dir. Fixtures without a name are named after
+// their adapter and event type, e.g. gitlab-merge-request-hook-3.json, without
+// overwriting existing files.
+func (f *Fixture) Save(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode fixture: %w", err)
+	}
+	data = append(data, '\n')
+
+	if f.Name != "" {
+		path := filepath.Join(dir, f.Name)
+		return path, os.WriteFile(path, data, 0o644)
+	}
+
+	prefix := f.Adapter
+	if eventType := f.EventType(); eventType != "" {
+		prefix += "-" + eventType
+	}
+	prefix = strings.ToLower(strings.Trim(unsafeNameChars.ReplaceAllString(prefix, "-"), "-"))
+
+	for n := 1; ; n++ {
+		name := fmt.Sprintf("%s-%d.json", prefix, n)
+		file, err := os.OpenFile(filepath.Join(dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to write fixture: %w", err)
+		}
+
+		_, err = file.Write(data)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to write fixture: %w", err)
+		}
+		f.Name = name
+		return file.Name(), nil
+	}
+}
@@ -0,0 +1,154 @@
+package fixture
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab?source=ci", strings.NewReader(`{"object_kind":"push"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+	req.Header.Set("X-Forwarded-For", "10.0.0.1")
+
+	recorded := FromRequest("gitlab", req, []byte(`{"object_kind":"push"}`))
+	assert.Empty(t, recorded.Header.Get("X-Forwarded-For"))
+	assert.Equal(t, "Push Hook", recorded.EventType())
+
+	path, err := recorded.Save(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "gitlab-push-hook-1.json"), path)
+
+	// Fixtures of the same event are not overwritten
+	second := FromRequest("gitlab", req, []byte(`{"object_kind":"push"}`))
+	path, err = second.Save(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "gitlab-push-hook-2.json"), path)
+
+	form := FromRequest("slack", httptest.NewRequest(http.MethodPost, "/webhooks/slack", nil), []byte("payload=%7B%7D"))
+	_, err = form.Save(dir)
+	require.NoError(t, err)
+
+	fixtures, err := LoadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 3)
+	assert.Equal(t, "gitlab-push-hook-1.json", fixtures[0].Name)
+	assert.Equal(t, "ci", fixtures[0].Query.Get("source"))
+	assert.JSONEq(t, `{"object_kind":"push"}`, string(fixtures[0].Payload()))
+	assert.Equal(t, "slack-1.json", fixtures[2].Name)
+	assert.Equal(t, "payload=%7B%7D", string(fixtures[2].Payload()))
+
+	loaded, err := LoadPath(filepath.Join(dir, "slack-1.json"))
+	require.NoError(t, err)
+	assert.Len(t, loaded, 1)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`{"header":{}}`), 0o644))
+	_, err = LoadDir(dir)
+	assert.ErrorContains(t, err, "has no adapter")
+}
+
+func TestSign(t *testing.T) {
+	now := time.Unix(1792404000, 0)
+	payload := []byte(`{"ok":true}`)
+
+	tests := []struct {
+		adapter string
+		query   url.Values
+		header  string
+		want    string
+	}{
+		{"github", nil, "X-Hub-Signature-256", "sha256=" + hmacHex("s3cret", payload)},
+		{"gitlab", nil, "X-Gitlab-Token", "s3cret"},
+		{"jira", nil, "X-Hub-Signature", "sha256=" + hmacHex("s3cret", payload)},
+		{"pagerduty", nil, "X-PagerDuty-Signature", "v1=" + hmacHex("s3cret", payload)},
+		{"prometheus", nil, "Authorization", "Bearer s3cret"},
+		{"slack", nil, "X-Slack-Signature", "v0=" + hmacHex("s3cret", []byte(`v0:1792404000:{"ok":true}`))},
+		{"jenkins", nil, "", ""},
+		{"jira", url.Values{"token": {"recorded"}}, "X-Hub-Signature", ""},
+		{"argocd", nil, "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.adapter, func(t *testing.T) {
+			f := &Fixture{Adapter: test.adapter, Header: http.Header{}, Query: test.query, Body: payload}
+			signed := f.Sign("s3cret", now)
+
+			if test.header != "" {
+				assert.Equal(t, test.want, signed.Header.Get(test.header))
+			}
+			assert.Empty(t, f.Header, "the fixture is not modified")
+		})
+	}
+
+	signed := (&Fixture{Adapter: "jenkins", Body: payload}).Sign("s3cret", now)
+	assert.Equal(t, "s3cret", signed.Query.Get("token"))
+
+	signed = (&Fixture{Adapter: "jira", Query: url.Values{"token": {"recorded"}}, Body: payload}).Sign("s3cret", now)
+	assert.Equal(t, "s3cret", signed.Query.Get("token"))
+	assert.Empty(t, signed.Header.Get("X-Hub-Signature"))
+
+	signed = (&Fixture{Adapter: "slack", Body: payload}).Sign("s3cret", now)
+	assert.Equal(t, "1792404000", signed.Header.Get("X-Slack-Request-Timestamp"))
+}
+
+func TestRecorderForwardsDeliveries(t *testing.T) {
+	var forwarded *http.Request
+	var forwardedBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		forwarded, forwardedBody = r, string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"id":"delivery-1"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	recorder := &Recorder{Dir: dir, Forward: server.URL, Logger: log.New(io.Discard, "", 0)}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/prometheus?tenant=a", strings.NewReader(`{"status":"firing"}`))
+	req.Header.Set("Authorization", "Bearer am-token")
+	rr := httptest.NewRecorder()
+	recorder.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+	assert.JSONEq(t, `{"id":"delivery-1"}`, rr.Body.String())
+
+	require.NotNil(t, forwarded)
+	assert.Equal(t, "/webhooks/prometheus", forwarded.URL.Path)
+	assert.Equal(t, "a", forwarded.URL.Query().Get("tenant"))
+	assert.Equal(t, "Bearer am-token", forwarded.Header.Get("Authorization"))
+	assert.Equal(t, `{"status":"firing"}`, forwardedBody)
+
+	fixtures, err := LoadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 1)
+	assert.Equal(t, "prometheus", fixtures[0].Adapter)
+
+	rr = httptest.NewRecorder()
+	recorder.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/webhooks/prometheus", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+}
+
+func TestNewRequest(t *testing.T) {
+	f := &Fixture{Adapter: "jenkins", Query: url.Values{"token": {"s3cret"}}, Body: []byte(`{}`)}
+
+	req, err := f.NewRequest(context.Background(), "http://localhost:8080/")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/webhooks/jenkins?token=s3cret", req.URL.String())
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+}
@@ -0,0 +1,113 @@
+package fixture
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// maxRecordedPayloadSize limits the size of recorded webhook payloads
+const maxRecordedPayloadSize = 10 << 20
+
+// Recorder is an HTTP handler that saves the webhook deliveries it receives
+// as fixtures. Point a service at the recorder, or at a tunnel to it, to
+// capture real deliveries.
+type Recorder struct {
+	// Dir is the directory fixtures are written to
+	Dir string
+
+	// Adapter is the adapter deliveries are recorded for. If empty, the last
+	// segment of the request path is used, e.g. gitlab for /webhooks/gitlab.
+	Adapter string
+
+	// Forward is the base URL of a server deliveries are forwarded to after
+	// they are recorded, e.g. http://localhost:8080. Its response is returned
+	// to the sender.
+	Forward string
+
+	// Client sends forwarded deliveries. http.DefaultClient is used if nil.
+	Client *http.Client
+
+	// Logger logs recorded deliveries. Nothing is logged if nil.
+	Logger *log.Logger
+}
+
+// ServeHTTP records a delivery
+func (rec *Recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	adapter := rec.Adapter
+	if adapter == "" {
+		adapter = r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	}
+	if adapter == "" {
+		http.Error(w, "adapter not found in path", http.StatusNotFound)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRecordedPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read payload", http.StatusBadRequest)
+		return
+	}
+
+	fixture := FromRequest(adapter, r, body)
+	path, err := fixture.Save(rec.Dir)
+	if err != nil {
+		rec.logf("Failed to record %s webhook: %v", adapter, err)
+		http.Error(w, "failed to record webhook", http.StatusInternalServerError)
+		return
+	}
+	rec.logf("Recorded %s webhook %q to %s", adapter, fixture.EventType(), path)
+
+	if rec.Forward == "" {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	rec.forward(w, r, fixture)
+}
+
+// forward sends a recorded delivery to the server and relays its response
+func (rec *Recorder) forward(w http.ResponseWriter, r *http.Request, fixture *Fixture) {
+	req, err := fixture.NewRequest(r.Context(), rec.Forward)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	client := rec.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		rec.logf("Failed to forward %s webhook: %v", fixture.Adapter, err)
+		http.Error(w, "failed to forward webhook", http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	var response bytes.Buffer
+	if _, err := io.Copy(&response, resp.Body); err != nil {
+		http.Error(w, "failed to forward webhook", http.StatusBadGateway)
+		return
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.WriteHeader(resp.StatusCode)
+	w.Write(response.Bytes())
+}
+
+// logf logs a message if the recorder has a logger
+func (rec *Recorder) logf(format string, args ...interface{}) {
+	if rec.Logger != nil {
+		rec.Logger.Output(2, fmt.Sprintf(format, args...))
+	}
+}
@@ -0,0 +1,80 @@
+package fixture
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// signer signs a delivery the way its service does. Signers must only
+// change the headers and query of the fixture.
+type signer func(f *Fixture, secret string, now time.Time)
+
+// signers are the signing schemes of the adapters, keyed by adapter type
+var signers = map[string]signer{
+	"argocd": func(f *Fixture, secret string, now time.Time) {
+		f.Header.Set("Authorization", "Bearer "+secret)
+	},
+	"github": func(f *Fixture, secret string, now time.Time) {
+		f.Header.Set("X-Hub-Signature-256", "sha256="+hmacHex(secret, f.Payload()))
+	},
+	"gitlab": func(f *Fixture, secret string, now time.Time) {
+		f.Header.Set("X-Gitlab-Token", secret)
+	},
+	"jenkins": func(f *Fixture, secret string, now time.Time) {
+		f.Query.Set("token", secret)
+	},
+	"jira": func(f *Fixture, secret string, now time.Time) {
+		// Jira Data Center deliveries carry the secret in the URL instead
+		if f.Query.Get("token") != "" {
+			f.Query.Set("token", secret)
+			return
+		}
+		f.Header.Set("X-Hub-Signature", "sha256="+hmacHex(secret, f.Payload()))
+	},
+	"kubernetes": func(f *Fixture, secret string, now time.Time) {
+		f.Header.Set("Authorization", "Bearer "+secret)
+	},
+	"pagerduty": func(f *Fixture, secret string, now time.Time) {
+		f.Header.Set("X-PagerDuty-Signature", "v1="+hmacHex(secret, f.Payload()))
+	},
+	"prometheus": func(f *Fixture, secret string, now time.Time) {
+		f.Header.Set("Authorization", "Bearer "+secret)
+	},
+	"slack": func(f *Fixture, secret string, now time.Time) {
+		timestamp := strconv.FormatInt(now.Unix(), 10)
+		base := append([]byte("v0:"+timestamp+":"), f.Payload()...)
+		f.Header.Set("X-Slack-Request-Timestamp", timestamp)
+		f.Header.Set("X-Slack-Signature", "v0="+hmacHex(secret, base))
+	},
+	"terraform": func(f *Fixture, secret string, now time.Time) {
+		mac := hmac.New(sha512.New, []byte(secret))
+		mac.Write(f.Payload())
+		f.Header.Set("X-TFE-Notification-Signature", hex.EncodeToString(mac.Sum(nil)))
+	},
+	"xray": func(f *Fixture, secret string, now time.Time) {
+		f.Header.Set("X-JFrog-Event-Auth", hmacHex(secret, f.Payload()))
+	},
+}
+
+// Sign returns a copy of the fixture signed with secret at time now, as the
+// service of its adapter would sign it. Signatures recorded with the secret
+// of another server are replaced. Fixtures of adapters that do not verify
+// deliveries are returned unchanged.
+func (f *Fixture) Sign(secret string, now time.Time) *Fixture {
+	signed := f.Clone()
+	if sign, ok := signers[f.Adapter]; ok && secret != "" {
+		sign(signed, secret, now)
+	}
+	return signed
+}
+
+// hmacHex returns the hex encoded HMAC-SHA256 of data
+func hmacHex(secret string, data []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,339 @@
+// Package webhooks is a durable inbox for webhooks. Deliveries are persisted
+// before they are acknowledged and handed to their adapter by a pool of
+// workers, which retries failed deliveries with exponential backoff. Services
+// like GitHub don't resend deliveries we dropped, so deliveries that keep
+// failing are moved to a dead-letter state to be inspected and replayed.
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/internal/observability"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/google/uuid"
+)
+
+// Inbox errors
+var (
+	// ErrDeliveryNotDead indicates a delivery can't be replayed because it
+	// hasn't been dead-lettered
+	ErrDeliveryNotDead = errors.New("webhook delivery is not dead-lettered")
+
+	// ErrInvalidPayload indicates a webhook payload isn't JSON
+	ErrInvalidPayload = errors.New("webhook payload must be JSON")
+)
+
+// Handler handles webhook events with the adapter they were sent to
+type Handler interface {
+	HandleWebhook(ctx context.Context, adapterType string, eventType string, payload []byte) error
+}
+
+// HandlerFunc adapts a function to Handler
+type HandlerFunc func(ctx context.Context, adapterType string, eventType string, payload []byte) error
+
+// HandleWebhook calls f
+func (f HandlerFunc) HandleWebhook(ctx context.Context, adapterType string, eventType string, payload []byte) error {
+	return f(ctx, adapterType, eventType, payload)
+}
+
+// Config holds configuration for the inbox
+type Config struct {
+	// Workers is the number of deliveries handled at once
+	Workers int
+	// MaxAttempts is the number of failed attempts after which a delivery
+	// is dead-lettered. Deliveries failing with an adapter error that isn't
+	// retryable are dead-lettered after the first attempt.
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt. The delay
+	// doubles after every failed attempt, up to MaxBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts
+	MaxBackoff time.Duration
+	// PollInterval is how often idle workers look for due deliveries
+	PollInterval time.Duration
+	// Lease is the maximum time an adapter may take to handle a delivery.
+	// Deliveries whose worker stopped are attempted again after the lease.
+	Lease time.Duration
+}
+
+// DefaultConfig returns the default inbox configuration. Deliveries are
+// attempted for about half an hour before they are dead-lettered.
+func DefaultConfig() Config {
+	return Config{
+		Workers:        4,
+		MaxAttempts:    10,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+		PollInterval:   time.Second,
+		Lease:          5 * time.Minute,
+	}
+}
+
+// Inbox persists webhook deliveries and dispatches them to their adapter
+type Inbox struct {
+	store   Store
+	handler Handler
+	config  Config
+	logger  *observability.Logger
+
+	// wake wakes idle workers when a delivery is received
+	wake chan struct{}
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewInbox creates a new inbox. Call Start to start dispatching deliveries.
+func NewInbox(store Store, handler Handler, config Config, logger *observability.Logger) *Inbox {
+	defaults := DefaultConfig()
+	if config.Workers <= 0 {
+		config.Workers = defaults.Workers
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaults.MaxAttempts
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = config.InitialBackoff
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaults.PollInterval
+	}
+	if config.Lease <= 0 {
+		config.Lease = defaults.Lease
+	}
+	if logger == nil {
+		logger = observability.NewLogger("webhooks")
+	}
+
+	return &Inbox{
+		store:   store,
+		handler: handler,
+		config:  config,
+		logger:  logger,
+		wake:    make(chan struct{}, config.Workers),
+	}
+}
+
+// Start starts the workers dispatching deliveries. Deliveries received
+// before the server stopped are dispatched as well.
+func (i *Inbox) Start() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	i.cancel = cancel
+	for n := 0; n < i.config.Workers; n++ {
+		i.wg.Add(1)
+		go i.work(ctx)
+	}
+}
+
+// Stop stops the workers, waiting until they finish the deliveries they are
+// handling or ctx is done. Deliveries interrupted by ctx are attempted again
+// once their lease expires.
+func (i *Inbox) Stop(ctx context.Context) error {
+	i.mu.Lock()
+	cancel := i.cancel
+	i.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		i.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Receive persists a delivery for an adapter. The delivery is acknowledged
+// once Receive returns and handled asynchronously.
+func (i *Inbox) Receive(ctx context.Context, adapterType string, eventType string, payload []byte) (*mcp.WebhookDelivery, error) {
+	if !json.Valid(payload) {
+		return nil, ErrInvalidPayload
+	}
+
+	now := time.Now().UTC()
+	delivery := &mcp.WebhookDelivery{
+		ID:            uuid.New().String(),
+		Adapter:       adapterType,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        mcp.WebhookDeliveryPending,
+		ReceivedAt:    now,
+		UpdatedAt:     now,
+		NextAttemptAt: now,
+	}
+	if err := i.store.Create(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	i.notify()
+	return delivery, nil
+}
+
+// Get gets a delivery by ID
+func (i *Inbox) Get(ctx context.Context, id string) (*mcp.WebhookDelivery, error) {
+	return i.store.Get(ctx, id)
+}
+
+// List lists deliveries, most recently received first
+func (i *Inbox) List(ctx context.Context, options ListOptions) ([]*mcp.WebhookDelivery, error) {
+	return i.store.List(ctx, options)
+}
+
+// Replay moves a dead-lettered delivery back to the inbox. It is attempted
+// again immediately, with a fresh budget of attempts.
+func (i *Inbox) Replay(ctx context.Context, id string) (*mcp.WebhookDelivery, error) {
+	delivery, err := i.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status != mcp.WebhookDeliveryDead {
+		return nil, ErrDeliveryNotDead
+	}
+
+	now := time.Now().UTC()
+	delivery.Status = mcp.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.UpdatedAt = now
+	delivery.NextAttemptAt = now
+	if err := i.store.Update(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	i.logger.Info("Replaying webhook delivery", map[string]interface{}{
+		"deliveryID": delivery.ID,
+		"adapter":    delivery.Adapter,
+	})
+
+	i.notify()
+	return delivery, nil
+}
+
+// notify wakes an idle worker
+func (i *Inbox) notify() {
+	select {
+	case i.wake <- struct{}{}:
+	default:
+	}
+}
+
+// work claims and handles deliveries until ctx is done
+func (i *Inbox) work(ctx context.Context) {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(i.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		deliveries, err := i.store.Claim(ctx, time.Now().UTC(), 1, i.config.Lease)
+		if err != nil && ctx.Err() == nil {
+			i.logger.Error("Failed to claim webhook deliveries", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		for _, delivery := range deliveries {
+			i.dispatch(ctx, delivery)
+		}
+
+		// Look for the next delivery right away after handling one
+		if len(deliveries) > 0 && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-i.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch hands a claimed delivery to its adapter and records the outcome
+func (i *Inbox) dispatch(ctx context.Context, delivery *mcp.WebhookDelivery) {
+	handleCtx, cancel := context.WithTimeout(ctx, i.config.Lease)
+	err := i.handler.HandleWebhook(handleCtx, delivery.Adapter, delivery.EventType, delivery.Payload)
+	cancel()
+
+	// Deliveries interrupted by Stop are left to be claimed again after
+	// their lease, without counting the attempt
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	delivery.UpdatedAt = now
+	if err == nil {
+		delivery.Status = mcp.WebhookDeliveryProcessed
+		delivery.Error = ""
+		delivery.ProcessedAt = now
+	} else {
+		delivery.Attempts++
+		delivery.Error = err.Error()
+		// Errors that can't be fixed by retrying are dead-lettered right away
+		if delivery.Attempts >= i.config.MaxAttempts || !adapterErrors.IsRetryable(err) {
+			delivery.Status = mcp.WebhookDeliveryDead
+			i.logger.Error("Dead-lettered webhook delivery", map[string]interface{}{
+				"deliveryID": delivery.ID,
+				"adapter":    delivery.Adapter,
+				"eventType":  delivery.EventType,
+				"attempts":   delivery.Attempts,
+				"errorCode":  adapterErrors.GetErrorCode(err),
+				"error":      err.Error(),
+			})
+		} else {
+			delivery.Status = mcp.WebhookDeliveryPending
+			delivery.NextAttemptAt = now.Add(i.backoff(delivery.Attempts))
+			i.logger.Warn("Webhook delivery failed, retrying", map[string]interface{}{
+				"deliveryID":    delivery.ID,
+				"adapter":       delivery.Adapter,
+				"attempts":      delivery.Attempts,
+				"nextAttemptAt": delivery.NextAttemptAt,
+				"error":         err.Error(),
+			})
+		}
+	}
+
+	// The outcome is recorded even if the inbox is stopping meanwhile
+	if err := i.store.Update(context.WithoutCancel(ctx), delivery); err != nil {
+		i.logger.Error("Failed to record webhook delivery", map[string]interface{}{
+			"deliveryID": delivery.ID,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// backoff returns the delay before the attempt following the given number of
+// failed attempts
+func (i *Inbox) backoff(attempts int) time.Duration {
+	delay := float64(i.config.InitialBackoff) * math.Pow(2, float64(attempts-1))
+	if delay > float64(i.config.MaxBackoff) {
+		return i.config.MaxBackoff
+	}
+	return time.Duration(delay)
+}
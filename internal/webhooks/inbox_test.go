@@ -0,0 +1,199 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	adapterErrors "github.com/S-Corkum/mcp-server/internal/adapters/errors"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig returns a config retrying quickly
+func testConfig() Config {
+	return Config{
+		Workers:        2,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		PollInterval:   5 * time.Millisecond,
+		Lease:          time.Second,
+	}
+}
+
+// waitForStatus waits for a delivery to reach a status
+func waitForStatus(t *testing.T, inbox *Inbox, id string, status mcp.WebhookDeliveryStatus) *mcp.WebhookDelivery {
+	t.Helper()
+
+	var delivery *mcp.WebhookDelivery
+	require.Eventually(t, func() bool {
+		var err error
+		delivery, err = inbox.Get(context.Background(), id)
+		require.NoError(t, err)
+		return delivery.Status == status
+	}, 5*time.Second, 5*time.Millisecond, "delivery should be %s", status)
+	return delivery
+}
+
+func TestInboxDispatchesDeliveries(t *testing.T) {
+	var mu sync.Mutex
+	var handled []string
+	handler := HandlerFunc(func(ctx context.Context, adapterType, eventType string, payload []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+		handled = append(handled, adapterType+"/"+eventType+":"+string(payload))
+		return nil
+	})
+
+	inbox := NewInbox(NewMemoryStore(), handler, testConfig(), nil)
+	inbox.Start()
+	defer inbox.Stop(context.Background())
+
+	delivery, err := inbox.Receive(context.Background(), "github", "push", []byte(`{"ref":"main"}`))
+	require.NoError(t, err)
+	assert.Equal(t, mcp.WebhookDeliveryPending, delivery.Status)
+
+	processed := waitForStatus(t, inbox, delivery.ID, mcp.WebhookDeliveryProcessed)
+	assert.Equal(t, 0, processed.Attempts)
+	assert.False(t, processed.ProcessedAt.IsZero())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{`github/push:{"ref":"main"}`}, handled)
+}
+
+func TestInboxRejectsInvalidPayloads(t *testing.T) {
+	inbox := NewInbox(NewMemoryStore(), HandlerFunc(nil), testConfig(), nil)
+
+	_, err := inbox.Receive(context.Background(), "github", "push", []byte("not json"))
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+}
+
+func TestInboxRetriesFailedDeliveries(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	handler := HandlerFunc(func(ctx context.Context, adapterType, eventType string, payload []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			return errors.New("adapter unavailable")
+		}
+		return nil
+	})
+
+	inbox := NewInbox(NewMemoryStore(), handler, testConfig(), nil)
+	inbox.Start()
+	defer inbox.Stop(context.Background())
+
+	delivery, err := inbox.Receive(context.Background(), "gitlab", "", []byte(`{}`))
+	require.NoError(t, err)
+
+	processed := waitForStatus(t, inbox, delivery.ID, mcp.WebhookDeliveryProcessed)
+	assert.Equal(t, 1, processed.Attempts)
+	assert.Empty(t, processed.Error)
+}
+
+func TestInboxDeadLettersAndReplays(t *testing.T) {
+	var mu sync.Mutex
+	fail := true
+	handler := HandlerFunc(func(ctx context.Context, adapterType, eventType string, payload []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			return errors.New("invalid payload")
+		}
+		return nil
+	})
+
+	inbox := NewInbox(NewMemoryStore(), handler, testConfig(), nil)
+	inbox.Start()
+	defer inbox.Stop(context.Background())
+
+	delivery, err := inbox.Receive(context.Background(), "jira", "", []byte(`{}`))
+	require.NoError(t, err)
+
+	_, err = inbox.Replay(context.Background(), delivery.ID)
+	assert.ErrorIs(t, err, ErrDeliveryNotDead)
+
+	dead := waitForStatus(t, inbox, delivery.ID, mcp.WebhookDeliveryDead)
+	assert.Equal(t, 3, dead.Attempts)
+	assert.Equal(t, "invalid payload", dead.Error)
+
+	deadLetters, err := inbox.List(context.Background(), ListOptions{Status: mcp.WebhookDeliveryDead})
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, delivery.ID, deadLetters[0].ID)
+
+	mu.Lock()
+	fail = false
+	mu.Unlock()
+
+	replayed, err := inbox.Replay(context.Background(), delivery.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.WebhookDeliveryPending, replayed.Status)
+	assert.Equal(t, 0, replayed.Attempts)
+
+	waitForStatus(t, inbox, delivery.ID, mcp.WebhookDeliveryProcessed)
+
+	_, err = inbox.Replay(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrDeliveryNotFound)
+}
+
+func TestInboxDeadLettersNonRetryableErrors(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	handler := HandlerFunc(func(ctx context.Context, adapterType, eventType string, payload []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return adapterErrors.NewInvalidRequestError(adapterType, "webhook", errors.New("missing build phase"), nil)
+	})
+
+	inbox := NewInbox(NewMemoryStore(), handler, testConfig(), nil)
+	inbox.Start()
+	defer inbox.Stop(context.Background())
+
+	delivery, err := inbox.Receive(context.Background(), "jenkins", "", []byte(`{}`))
+	require.NoError(t, err)
+
+	dead := waitForStatus(t, inbox, delivery.ID, mcp.WebhookDeliveryDead)
+	assert.Equal(t, 1, dead.Attempts)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, calls, "non-retryable errors should not be retried")
+}
+
+func TestInboxDispatchesDeliveriesReceivedBeforeStart(t *testing.T) {
+	store := NewMemoryStore()
+	handled := make(chan string, 1)
+	handler := HandlerFunc(func(ctx context.Context, adapterType, eventType string, payload []byte) error {
+		handled <- adapterType
+		return nil
+	})
+
+	// Deliveries persisted by a server that stopped are picked up on start
+	delivery, err := NewInbox(store, handler, testConfig(), nil).Receive(context.Background(), "pagerduty", "", []byte(`{}`))
+	require.NoError(t, err)
+
+	inbox := NewInbox(store, handler, testConfig(), nil)
+	inbox.Start()
+	defer inbox.Stop(context.Background())
+
+	assert.Equal(t, "pagerduty", <-handled)
+	waitForStatus(t, inbox, delivery.ID, mcp.WebhookDeliveryProcessed)
+}
+
+func TestInboxBackoff(t *testing.T) {
+	inbox := NewInbox(NewMemoryStore(), nil, Config{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}, nil)
+
+	assert.Equal(t, time.Second, inbox.backoff(1))
+	assert.Equal(t, 2*time.Second, inbox.backoff(2))
+	assert.Equal(t, 4*time.Second, inbox.backoff(3))
+	assert.Equal(t, 5*time.Second, inbox.backoff(4))
+}
@@ -0,0 +1,195 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+)
+
+// deliveryColumns are the mcp.events columns of a delivery
+const deliveryColumns = `id, source, type, data, timestamp, processed, processed_at, error,
+	retry_count, updated_at, status, next_attempt_at`
+
+// PostgresStore keeps deliveries in the mcp.events table. The source column
+// holds the adapter, type the event type and data the payload. The table also
+// holds the event store's events, so only rows of kind webhook are read.
+type PostgresStore struct {
+	db *sqlx.DB
+}
+
+// NewPostgresStore creates a new delivery store backed by Postgres
+func NewPostgresStore(db *sqlx.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// deliveryRow is a row of the mcp.events table
+type deliveryRow struct {
+	ID            string         `db:"id"`
+	Source        string         `db:"source"`
+	Type          string         `db:"type"`
+	Data          []byte         `db:"data"`
+	Timestamp     time.Time      `db:"timestamp"`
+	Processed     bool           `db:"processed"`
+	ProcessedAt   sql.NullTime   `db:"processed_at"`
+	Error         sql.NullString `db:"error"`
+	RetryCount    int            `db:"retry_count"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+	Status        string         `db:"status"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+}
+
+// Create stores a new delivery
+func (s *PostgresStore) Create(ctx context.Context, delivery *mcp.WebhookDelivery) error {
+	_, err := s.db.NamedExecContext(ctx, `
+		INSERT INTO mcp.events (
+			id, source, type, data, timestamp, processed, processed_at, error,
+			retry_count, created_at, updated_at, status, next_attempt_at
+		) VALUES (
+			:id, :source, :type, :data, :timestamp, :processed, :processed_at, :error,
+			:retry_count, :timestamp, :updated_at, :status, :next_attempt_at
+		)`, toDeliveryRow(delivery))
+	if err != nil {
+		return fmt.Errorf("failed to store webhook delivery %s: %w", delivery.ID, err)
+	}
+	return nil
+}
+
+// Get gets a delivery by ID
+func (s *PostgresStore) Get(ctx context.Context, id string) (*mcp.WebhookDelivery, error) {
+	var row deliveryRow
+	err := s.db.GetContext(ctx, &row, `SELECT `+deliveryColumns+` FROM mcp.events WHERE id = $1 AND kind = 'webhook'`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery %s: %w", id, err)
+	}
+	return row.toDelivery(), nil
+}
+
+// Update replaces a stored delivery and releases its lease
+func (s *PostgresStore) Update(ctx context.Context, delivery *mcp.WebhookDelivery) error {
+	result, err := s.db.NamedExecContext(ctx, `
+		UPDATE mcp.events SET
+			status = :status, processed = :processed, processed_at = :processed_at, error = :error,
+			retry_count = :retry_count, updated_at = :updated_at, next_attempt_at = :next_attempt_at,
+			locked_until = NULL
+		WHERE id = :id`, toDeliveryRow(delivery))
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery %s: %w", delivery.ID, err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery %s: %w", delivery.ID, err)
+	}
+	if updated == 0 {
+		return ErrDeliveryNotFound
+	}
+	return nil
+}
+
+// Claim leases deliveries that are due. Rows locked by other servers claiming
+// deliveries at the same time are skipped.
+func (s *PostgresStore) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*mcp.WebhookDelivery, error) {
+	var rows []deliveryRow
+	err := s.db.SelectContext(ctx, &rows, `
+		UPDATE mcp.events SET status = 'processing', locked_until = $2, updated_at = $1
+		WHERE id IN (
+			SELECT id FROM mcp.events
+			WHERE kind = 'webhook' AND (
+				(status = 'pending' AND next_attempt_at <= $1)
+				OR (status = 'processing' AND locked_until <= $1)
+			)
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+deliveryColumns, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]*mcp.WebhookDelivery, 0, len(rows))
+	for i := range rows {
+		deliveries = append(deliveries, rows[i].toDelivery())
+	}
+	return deliveries, nil
+}
+
+// List lists deliveries, most recently received first
+func (s *PostgresStore) List(ctx context.Context, options ListOptions) ([]*mcp.WebhookDelivery, error) {
+	conditions := []string{"kind = 'webhook'"}
+	var args []interface{}
+	if options.Status != "" {
+		args = append(args, string(options.Status))
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if options.Adapter != "" {
+		args = append(args, options.Adapter)
+		conditions = append(conditions, fmt.Sprintf("source = $%d", len(args)))
+	}
+
+	query := `SELECT ` + deliveryColumns + ` FROM mcp.events WHERE ` + strings.Join(conditions, " AND ")
+	query += " ORDER BY timestamp DESC"
+	if options.Limit > 0 {
+		args = append(args, options.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if options.Offset > 0 {
+		args = append(args, options.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	var rows []deliveryRow
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]*mcp.WebhookDelivery, 0, len(rows))
+	for i := range rows {
+		deliveries = append(deliveries, rows[i].toDelivery())
+	}
+	return deliveries, nil
+}
+
+// toDeliveryRow converts a delivery to a row
+func toDeliveryRow(delivery *mcp.WebhookDelivery) *deliveryRow {
+	return &deliveryRow{
+		ID:            delivery.ID,
+		Source:        delivery.Adapter,
+		Type:          delivery.EventType,
+		Data:          delivery.Payload,
+		Timestamp:     delivery.ReceivedAt,
+		Processed:     delivery.Status == mcp.WebhookDeliveryProcessed,
+		ProcessedAt:   sql.NullTime{Time: delivery.ProcessedAt, Valid: !delivery.ProcessedAt.IsZero()},
+		Error:         sql.NullString{String: delivery.Error, Valid: delivery.Error != ""},
+		RetryCount:    delivery.Attempts,
+		UpdatedAt:     delivery.UpdatedAt,
+		Status:        string(delivery.Status),
+		NextAttemptAt: delivery.NextAttemptAt,
+	}
+}
+
+// toDelivery converts a row to a delivery
+func (r *deliveryRow) toDelivery() *mcp.WebhookDelivery {
+	return &mcp.WebhookDelivery{
+		ID:            r.ID,
+		Adapter:       r.Source,
+		EventType:     r.Type,
+		Payload:       r.Data,
+		Status:        mcp.WebhookDeliveryStatus(r.Status),
+		Attempts:      r.RetryCount,
+		Error:         r.Error.String,
+		ReceivedAt:    r.Timestamp,
+		UpdatedAt:     r.UpdatedAt,
+		NextAttemptAt: r.NextAttemptAt,
+		ProcessedAt:   r.ProcessedAt.Time,
+	}
+}
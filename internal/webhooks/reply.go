@@ -0,0 +1,38 @@
+package webhooks
+
+import (
+	"encoding/json"
+)
+
+// replyFunc returns the answer to a request the sender expects to be
+// answered synchronously, or false for deliveries to persist
+type replyFunc func(payload []byte) (interface{}, bool)
+
+// replies are the synchronous answers of adapters, keyed by adapter type
+var replies = map[string]replyFunc{
+	"slack": slackReply,
+}
+
+// Reply returns the answer to a verified request that the sender expects in
+// the response, such as a URL verification handshake. Such requests are not
+// deliveries and are not persisted. It returns false for deliveries.
+func Reply(adapterType string, payload []byte) (interface{}, bool) {
+	reply, ok := replies[adapterType]
+	if !ok {
+		return nil, false
+	}
+	return reply(payload)
+}
+
+// slackReply answers the url_verification request the Events API sends when
+// the request URL is configured, by echoing its challenge
+func slackReply(payload []byte) (interface{}, bool) {
+	var request struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(payload, &request); err != nil || request.Type != "url_verification" {
+		return nil, false
+	}
+	return map[string]string{"challenge": request.Challenge}, true
+}
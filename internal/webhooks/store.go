@@ -0,0 +1,167 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// ErrDeliveryNotFound indicates a delivery doesn't exist
+var ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+// ListOptions filters listed deliveries
+type ListOptions struct {
+	// Status only lists deliveries with this status if set
+	Status mcp.WebhookDeliveryStatus
+	// Adapter only lists deliveries for this adapter if set
+	Adapter string
+	// Limit is the maximum number of deliveries to list
+	Limit int
+	// Offset is the number of deliveries to skip
+	Offset int
+}
+
+// Store persists webhook deliveries
+type Store interface {
+	// Create stores a new delivery
+	Create(ctx context.Context, delivery *mcp.WebhookDelivery) error
+
+	// Get gets a delivery by ID, returning ErrDeliveryNotFound if it doesn't exist
+	Get(ctx context.Context, id string) (*mcp.WebhookDelivery, error)
+
+	// Update replaces a stored delivery and releases its lease
+	Update(ctx context.Context, delivery *mcp.WebhookDelivery) error
+
+	// Claim leases up to limit deliveries that are due at now, oldest first,
+	// and marks them as processing. Processing deliveries whose lease has
+	// expired, because the worker handling them stopped, are claimed again.
+	Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*mcp.WebhookDelivery, error)
+
+	// List lists deliveries, most recently received first
+	List(ctx context.Context, options ListOptions) ([]*mcp.WebhookDelivery, error)
+}
+
+// memoryDelivery is a delivery kept by the memory store
+type memoryDelivery struct {
+	delivery    mcp.WebhookDelivery
+	lockedUntil time.Time
+}
+
+// MemoryStore keeps deliveries in memory. Deliveries are lost when the server
+// stops, so it is only used when no database is available.
+type MemoryStore struct {
+	mu         sync.Mutex
+	deliveries map[string]*memoryDelivery
+}
+
+// NewMemoryStore creates a new in-memory delivery store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		deliveries: make(map[string]*memoryDelivery),
+	}
+}
+
+// Create stores a new delivery
+func (s *MemoryStore) Create(ctx context.Context, delivery *mcp.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deliveries[delivery.ID] = &memoryDelivery{delivery: *delivery}
+	return nil
+}
+
+// Get gets a delivery by ID
+func (s *MemoryStore) Get(ctx context.Context, id string) (*mcp.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.deliveries[id]
+	if !ok {
+		return nil, ErrDeliveryNotFound
+	}
+	delivery := stored.delivery
+	return &delivery, nil
+}
+
+// Update replaces a stored delivery and releases its lease
+func (s *MemoryStore) Update(ctx context.Context, delivery *mcp.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.deliveries[delivery.ID]; !ok {
+		return ErrDeliveryNotFound
+	}
+	s.deliveries[delivery.ID] = &memoryDelivery{delivery: *delivery}
+	return nil
+}
+
+// Claim leases deliveries that are due
+func (s *MemoryStore) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*mcp.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*memoryDelivery
+	for _, stored := range s.deliveries {
+		switch stored.delivery.Status {
+		case mcp.WebhookDeliveryPending:
+			if !stored.delivery.NextAttemptAt.After(now) {
+				due = append(due, stored)
+			}
+		case mcp.WebhookDeliveryProcessing:
+			if !stored.lockedUntil.After(now) {
+				due = append(due, stored)
+			}
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].delivery.NextAttemptAt.Before(due[j].delivery.NextAttemptAt)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]*mcp.WebhookDelivery, 0, len(due))
+	for _, stored := range due {
+		stored.delivery.Status = mcp.WebhookDeliveryProcessing
+		stored.delivery.UpdatedAt = now
+		stored.lockedUntil = now.Add(lease)
+
+		delivery := stored.delivery
+		claimed = append(claimed, &delivery)
+	}
+	return claimed, nil
+}
+
+// List lists deliveries, most recently received first
+func (s *MemoryStore) List(ctx context.Context, options ListOptions) ([]*mcp.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deliveries []*mcp.WebhookDelivery
+	for _, stored := range s.deliveries {
+		if options.Status != "" && stored.delivery.Status != options.Status {
+			continue
+		}
+		if options.Adapter != "" && stored.delivery.Adapter != options.Adapter {
+			continue
+		}
+		delivery := stored.delivery
+		deliveries = append(deliveries, &delivery)
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ReceivedAt.After(deliveries[j].ReceivedAt)
+	})
+
+	if options.Offset >= len(deliveries) {
+		return []*mcp.WebhookDelivery{}, nil
+	}
+	deliveries = deliveries[options.Offset:]
+	if options.Limit > 0 && len(deliveries) > options.Limit {
+		deliveries = deliveries[:options.Limit]
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,131 @@
+package webhooks
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDelivery returns a pending delivery
+func testDelivery(id string, receivedAt time.Time) *mcp.WebhookDelivery {
+	return &mcp.WebhookDelivery{
+		ID:            id,
+		Adapter:       "github",
+		EventType:     "push",
+		Payload:       []byte(`{"ref":"main"}`),
+		Status:        mcp.WebhookDeliveryPending,
+		ReceivedAt:    receivedAt,
+		UpdatedAt:     receivedAt,
+		NextAttemptAt: receivedAt,
+	}
+}
+
+func TestMemoryStoreClaim(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	now := time.Now().UTC()
+
+	_, err := store.Get(ctx, "missing")
+	assert.ErrorIs(t, err, ErrDeliveryNotFound)
+	assert.ErrorIs(t, store.Update(ctx, testDelivery("missing", now)), ErrDeliveryNotFound)
+
+	later := testDelivery("later", now)
+	later.NextAttemptAt = now.Add(time.Hour)
+	require.NoError(t, store.Create(ctx, testDelivery("first", now.Add(-2*time.Second))))
+	require.NoError(t, store.Create(ctx, testDelivery("second", now.Add(-time.Second))))
+	require.NoError(t, store.Create(ctx, later))
+
+	claimed, err := store.Claim(ctx, now, 1, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "first", claimed[0].ID)
+	assert.Equal(t, mcp.WebhookDeliveryProcessing, claimed[0].Status)
+
+	claimed, err = store.Claim(ctx, now, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1, "leased and future deliveries are not claimed")
+	assert.Equal(t, "second", claimed[0].ID)
+
+	// Leases of workers that stopped expire
+	claimed, err = store.Claim(ctx, now.Add(2*time.Hour), 10, time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, claimed, 3)
+
+	listed, err := store.List(ctx, ListOptions{Status: mcp.WebhookDeliveryProcessing, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, "later", listed[0].ID)
+	assert.Equal(t, "second", listed[1].ID)
+
+	listed, err = store.List(ctx, ListOptions{Offset: 5})
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+}
+
+// deliveryColumnNames are the columns selected by the Postgres store
+var deliveryColumnNames = []string{"id", "source", "type", "data", "timestamp", "processed", "processed_at", "error",
+	"retry_count", "updated_at", "status", "next_attempt_at"}
+
+func TestPostgresStore(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	store := NewPostgresStore(sqlx.NewDb(mockDB, "postgres"))
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	delivery := testDelivery("3f2c7c4e-8a8e-4c4f-9d0b-2d6a3f1e9b10", now)
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO mcp.events")).
+		WithArgs(delivery.ID, "github", "push", []byte(`{"ref":"main"}`), now, false, nil, nil,
+			0, now, now, "pending", now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, store.Create(ctx, delivery))
+
+	mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).
+		WithArgs(now, now.Add(time.Minute), 5).
+		WillReturnRows(sqlmock.NewRows(deliveryColumnNames).AddRow(delivery.ID, "github", "push",
+			[]byte(`{"ref":"main"}`), now, false, nil, nil, 0, now, "processing", now))
+	claimed, err := store.Claim(ctx, now, 5, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, mcp.WebhookDeliveryProcessing, claimed[0].Status)
+	assert.JSONEq(t, `{"ref":"main"}`, string(claimed[0].Payload))
+
+	delivery.Status = mcp.WebhookDeliveryDead
+	delivery.Attempts = 10
+	delivery.Error = "invalid payload"
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE mcp.events SET")).
+		WithArgs("dead", false, nil, "invalid payload", 10, now, now, delivery.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, store.Update(ctx, delivery))
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.events WHERE kind = 'webhook' AND status = $1 AND source = $2 ORDER BY timestamp DESC LIMIT $3 OFFSET $4")).
+		WithArgs("dead", "github", 20, 40).
+		WillReturnRows(sqlmock.NewRows(deliveryColumnNames).AddRow(delivery.ID, "github", "push",
+			[]byte(`{"ref":"main"}`), now, false, nil, "invalid payload", 10, now, "dead", now))
+	listed, err := store.List(ctx, ListOptions{Status: mcp.WebhookDeliveryDead, Adapter: "github", Limit: 20, Offset: 40})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, 10, listed[0].Attempts)
+	assert.Equal(t, "invalid payload", listed[0].Error)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM mcp.events WHERE id = $1")).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows(deliveryColumnNames))
+	_, err = store.Get(ctx, "missing")
+	assert.ErrorIs(t, err, ErrDeliveryNotFound)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE mcp.events SET")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, store.Update(ctx, delivery), ErrDeliveryNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+)
+
+// JobError is returned by WaitForJob when a job fails or is cancelled
+type JobError struct {
+	Job *mcp.Job
+}
+
+// Error implements the error interface
+func (e *JobError) Error() string {
+	if e.Job.Status == mcp.JobStatusCancelled {
+		return fmt.Sprintf("job %s was cancelled", e.Job.ID)
+	}
+	return fmt.Sprintf("job %s failed: %s", e.Job.ID, e.Job.Error)
+}
+
+// StartToolAction starts a long-running tool action as a job. Use GetJob or
+// WaitForJob to get its result.
+func (c *Client) StartToolAction(ctx context.Context, contextID string, tool string, action string, params map[string]interface{}) (*mcp.Job, error) {
+	query := url.Values{}
+	query.Set("context_id", contextID)
+	query.Set("async", "true")
+	endpoint := fmt.Sprintf("%s/api/v1/tools/%s/actions/%s?%s", c.baseURL, url.PathEscape(tool), url.PathEscape(action), query.Encode())
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	return c.doJobRequest(ctx, "POST", endpoint, params, http.StatusAccepted, "start tool action")
+}
+
+// GetJob retrieves a job by ID
+func (c *Client) GetJob(ctx context.Context, jobID string) (*mcp.Job, error) {
+	endpoint := fmt.Sprintf("%s/api/v1/jobs/%s", c.baseURL, url.PathEscape(jobID))
+	return c.doJobRequest(ctx, "GET", endpoint, nil, http.StatusOK, "get job")
+}
+
+// CancelJob cancels a pending or running job
+func (c *Client) CancelJob(ctx context.Context, jobID string) (*mcp.Job, error) {
+	endpoint := fmt.Sprintf("%s/api/v1/jobs/%s/cancel", c.baseURL, url.PathEscape(jobID))
+	return c.doJobRequest(ctx, "POST", endpoint, nil, http.StatusOK, "cancel job")
+}
+
+// WaitForJob polls a job every pollInterval until it finishes or ctx is done.
+// It returns the succeeded job, or a *JobError with the job if it failed or
+// was cancelled.
+func (c *Client) WaitForJob(ctx context.Context, jobID string, pollInterval time.Duration) (*mcp.Job, error) {
+	if pollInterval <= 0 {
+		pollInterval = 2 * time.Second
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := c.GetJob(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case mcp.JobStatusSucceeded:
+			return job, nil
+		case mcp.JobStatusFailed, mcp.JobStatusCancelled:
+			return job, &JobError{Job: job}
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExecuteToolActionAndWait starts a tool action as a job and waits for it to
+// finish
+func (c *Client) ExecuteToolActionAndWait(ctx context.Context, contextID string, tool string, action string, params map[string]interface{}, pollInterval time.Duration) (*mcp.Job, error) {
+	job, err := c.StartToolAction(ctx, contextID, tool, action, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitForJob(ctx, job.ID, pollInterval)
+}
+
+// doJobRequest sends a request returning a job
+func (c *Client) doJobRequest(ctx context.Context, method string, endpoint string, body interface{}, expectedStatus int, operation string) (*mcp.Job, error) {
+	var reqBody *bytes.Buffer
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewBuffer(jsonData)
+	} else {
+		reqBody = &bytes.Buffer{}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		var errResp map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil {
+			for _, key := range []string{"message", "error"} {
+				if errorMsg, ok := errResp[key].(string); ok {
+					return nil, fmt.Errorf("failed to %s: %s", operation, errorMsg)
+				}
+			}
+		}
+		return nil, fmt.Errorf("failed to %s: status %d", operation, resp.StatusCode)
+	}
+
+	var job mcp.Job
+	if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
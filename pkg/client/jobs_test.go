@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/S-Corkum/mcp-server/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupJobServer creates a mock server running a job that succeeds after two polls
+func setupJobServer(t *testing.T, finalStatus mcp.JobStatus) *httptest.Server {
+	var polls atomic.Int32
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		job := mcp.Job{ID: "job-1", Tool: "xray", Action: "scan_artifact", ContextID: "ctx-1", Status: mcp.JobStatusPending}
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/tools/xray/actions/scan_artifact":
+			assert.Equal(t, "true", r.URL.Query().Get("async"))
+			assert.Equal(t, "ctx-1", r.URL.Query().Get("context_id"))
+			assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+
+			var params map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+			job.Params = params
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == "GET" && r.URL.Path == "/api/v1/jobs/job-1":
+			if polls.Add(1) < 3 {
+				job.Status = mcp.JobStatusRunning
+				job.Progress = 50
+			} else {
+				job.Status = finalStatus
+				job.Progress = 100
+				job.Result = map[string]interface{}{"scan_id": "42"}
+				if finalStatus == mcp.JobStatusFailed {
+					job.Result = nil
+					job.Error = "scan failed"
+				}
+			}
+		case r.Method == "POST" && r.URL.Path == "/api/v1/jobs/job-1/cancel":
+			job.Status = mcp.JobStatusCancelled
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"JOB_NOT_FOUND","message":"Job with ID missing not found"}`))
+			return
+		}
+
+		json.NewEncoder(w).Encode(job)
+	}))
+}
+
+func TestExecuteToolActionAndWait(t *testing.T) {
+	server := setupJobServer(t, mcp.JobStatusSucceeded)
+	defer server.Close()
+
+	client := NewClient(server.URL, WithAPIKey("test-key"))
+	job, err := client.ExecuteToolActionAndWait(context.Background(), "ctx-1", "xray", "scan_artifact",
+		map[string]interface{}{"component_id": "app:1.0"}, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusSucceeded, job.Status)
+	assert.Equal(t, map[string]interface{}{"scan_id": "42"}, job.Result)
+}
+
+func TestWaitForFailedJob(t *testing.T) {
+	server := setupJobServer(t, mcp.JobStatusFailed)
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	job, err := client.WaitForJob(context.Background(), "job-1", time.Millisecond)
+
+	var jobErr *JobError
+	require.True(t, errors.As(err, &jobErr))
+	assert.Equal(t, "job job-1 failed: scan failed", err.Error())
+	assert.Equal(t, mcp.JobStatusFailed, job.Status)
+}
+
+func TestWaitForJobContextDone(t *testing.T) {
+	server := setupJobServer(t, mcp.JobStatusSucceeded)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	client := NewClient(server.URL)
+	job, err := client.WaitForJob(ctx, "job-1", time.Hour)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, mcp.JobStatusRunning, job.Status)
+}
+
+func TestCancelJob(t *testing.T) {
+	server := setupJobServer(t, mcp.JobStatusSucceeded)
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	job, err := client.CancelJob(context.Background(), "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, mcp.JobStatusCancelled, job.Status)
+
+	_, err = client.GetJob(context.Background(), "missing")
+	assert.EqualError(t, err, "failed to get job: Job with ID missing not found")
+}
@@ -6,6 +6,10 @@ import (
 
 // Event represents an MCP event
 type Event struct {
+	// ID is the unique identifier for this event, assigned when it is
+	// published. Handlers receiving an event more than once see the same ID.
+	ID string `json:"id,omitempty"`
+
 	// Source is the source of the event (e.g., openai, anthropic, langchain)
 	Source string `json:"source"`
 
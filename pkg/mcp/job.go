@@ -0,0 +1,76 @@
+package mcp
+
+import (
+	"time"
+)
+
+// JobStatus is the status of an asynchronous tool action
+type JobStatus string
+
+// Job statuses
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCancelled JobStatus = "cancelled"
+)
+
+// IsTerminal reports whether a job with this status has finished
+func (s JobStatus) IsTerminal() bool {
+	return s == JobStatusSucceeded || s == JobStatusFailed || s == JobStatusCancelled
+}
+
+// Job represents a tool action running asynchronously
+type Job struct {
+	// ID is the unique identifier for this job
+	ID string `json:"id"`
+
+	// Tool is the tool running the action
+	Tool string `json:"tool"`
+
+	// Action is the action being run
+	Action string `json:"action"`
+
+	// ContextID is the context the action is recorded in
+	ContextID string `json:"context_id"`
+
+	// Params are the action parameters
+	Params map[string]interface{} `json:"params,omitempty"`
+
+	// Status is the status of the job
+	Status JobStatus `json:"status"`
+
+	// Progress is the completion percentage reported by the action
+	Progress int `json:"progress"`
+
+	// Message is the latest progress message reported by the action
+	Message string `json:"message,omitempty"`
+
+	// Result is the action result of a succeeded job
+	Result interface{} `json:"result,omitempty"`
+
+	// Error is the error of a failed job
+	Error string `json:"error,omitempty"`
+
+	// ErrorCode is the adapter error code of a failed job
+	ErrorCode string `json:"error_code,omitempty"`
+
+	// Attempts is the number of times the action was run
+	Attempts int `json:"attempts"`
+
+	// CreatedAt is when this job was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is when this job was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// StartedAt is when the action started running
+	StartedAt time.Time `json:"started_at,omitempty"`
+
+	// CompletedAt is when the job finished
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+
+	// Links contains HATEOAS links for RESTful navigation
+	Links map[string]string `json:"_links,omitempty"`
+}
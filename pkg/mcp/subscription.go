@@ -0,0 +1,109 @@
+package mcp
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SubscriptionStatus is the status of an event subscription
+type SubscriptionStatus string
+
+// Subscription statuses
+const (
+	// SubscriptionActive subscriptions receive matching events
+	SubscriptionActive SubscriptionStatus = "active"
+	// SubscriptionDisabled subscriptions were disabled after repeated
+	// failed deliveries and receive no events until enabled again
+	SubscriptionDisabled SubscriptionStatus = "disabled"
+)
+
+// Subscription registers a URL to which events matching a filter are sent.
+// Deliveries are signed with the subscription's secret.
+type Subscription struct {
+	// ID is the unique identifier for this subscription
+	ID string `json:"id"`
+
+	// URL is the URL events are posted to
+	URL string `json:"url"`
+
+	// Description describes the consumer of the events
+	Description string `json:"description,omitempty"`
+
+	// Filter selects the events sent to the URL
+	Filter EventFilter `json:"filter"`
+
+	// Secret signs deliveries. It is never returned by the API.
+	Secret string `json:"-"`
+
+	// Status is the status of the subscription
+	Status SubscriptionStatus `json:"status"`
+
+	// ConsecutiveFailures is the number of failed delivery attempts since
+	// the last successful one
+	ConsecutiveFailures int `json:"consecutive_failures"`
+
+	// CreatedAt is when the subscription was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is when the subscription was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Links contains HATEOAS links for RESTful navigation
+	Links map[string]string `json:"_links,omitempty"`
+}
+
+// SubscriptionDeliveryStatus is the status of an event delivery
+type SubscriptionDeliveryStatus string
+
+// Subscription delivery statuses
+const (
+	// SubscriptionDeliveryPending deliveries wait for their next attempt
+	SubscriptionDeliveryPending SubscriptionDeliveryStatus = "pending"
+	// SubscriptionDeliveryProcessing deliveries are being sent by a worker
+	SubscriptionDeliveryProcessing SubscriptionDeliveryStatus = "processing"
+	// SubscriptionDeliverySucceeded deliveries were accepted by the consumer
+	SubscriptionDeliverySucceeded SubscriptionDeliveryStatus = "succeeded"
+	// SubscriptionDeliveryFailed deliveries failed every attempt, or their
+	// subscription was disabled
+	SubscriptionDeliveryFailed SubscriptionDeliveryStatus = "failed"
+)
+
+// SubscriptionDelivery is an event sent to a subscription's URL
+type SubscriptionDelivery struct {
+	// ID is the unique identifier for this delivery, sent in the
+	// X-MCP-Delivery header so consumers can discard duplicates
+	ID string `json:"id"`
+
+	// SubscriptionID is the subscription the event is sent to
+	SubscriptionID string `json:"subscription_id"`
+
+	// EventType is the type of the event
+	EventType string `json:"event_type"`
+
+	// Payload is the event posted to the URL
+	Payload json.RawMessage `json:"payload"`
+
+	// Status is the status of the delivery
+	Status SubscriptionDeliveryStatus `json:"status"`
+
+	// Attempts is the number of failed attempts
+	Attempts int `json:"attempts"`
+
+	// ResponseStatus is the HTTP status of the last response, if any
+	ResponseStatus int `json:"response_status,omitempty"`
+
+	// Error is the error of the last failed attempt
+	Error string `json:"error,omitempty"`
+
+	// CreatedAt is when the event was queued for the subscription
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is when the delivery was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// NextAttemptAt is when a pending delivery is attempted next
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// DeliveredAt is when the consumer accepted the delivery
+	DeliveredAt time.Time `json:"delivered_at,omitempty"`
+}
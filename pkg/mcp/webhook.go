@@ -0,0 +1,61 @@
+package mcp
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery in the inbox
+type WebhookDeliveryStatus string
+
+// Webhook delivery statuses
+const (
+	// WebhookDeliveryPending deliveries wait for their next attempt
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryProcessing deliveries are being handled by a worker
+	WebhookDeliveryProcessing WebhookDeliveryStatus = "processing"
+	// WebhookDeliveryProcessed deliveries were handled by their adapter
+	WebhookDeliveryProcessed WebhookDeliveryStatus = "processed"
+	// WebhookDeliveryDead deliveries failed every attempt and wait to be replayed
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+// WebhookDelivery is a webhook received from an external service. Deliveries
+// are persisted before they are acknowledged and handled asynchronously.
+type WebhookDelivery struct {
+	// ID is the unique identifier for this delivery
+	ID string `json:"id"`
+
+	// Adapter is the adapter handling the delivery
+	Adapter string `json:"adapter"`
+
+	// EventType is the event type sent by the service, if any
+	EventType string `json:"event_type"`
+
+	// Payload is the verified JSON payload passed to the adapter
+	Payload json.RawMessage `json:"payload"`
+
+	// Status is the status of the delivery
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// Attempts is the number of times the adapter failed to handle the delivery
+	Attempts int `json:"attempts"`
+
+	// Error is the error of the last failed attempt
+	Error string `json:"error,omitempty"`
+
+	// ReceivedAt is when the delivery was received
+	ReceivedAt time.Time `json:"received_at"`
+
+	// UpdatedAt is when the delivery was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// NextAttemptAt is when a pending delivery is attempted next
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// ProcessedAt is when the delivery was handled
+	ProcessedAt time.Time `json:"processed_at,omitempty"`
+
+	// Links contains HATEOAS links for RESTful navigation
+	Links map[string]string `json:"_links,omitempty"`
+}
@@ -26,6 +26,22 @@ CREATE INDEX IF NOT EXISTS idx_events_source_type ON mcp.events(source, type);
 CREATE INDEX IF NOT EXISTS idx_events_processed ON mcp.events(processed);
 CREATE INDEX IF NOT EXISTS idx_events_timestamp ON mcp.events(timestamp);
 
+-- Delivery state of webhooks in the inbox. Pending deliveries are attempted at
+-- next_attempt_at; processing deliveries are leased to a worker until locked_until.
+ALTER TABLE mcp.events ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'pending';
+ALTER TABLE mcp.events ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+ALTER TABLE mcp.events ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP WITH TIME ZONE;
+CREATE INDEX IF NOT EXISTS idx_events_status_next_attempt ON mcp.events(status, next_attempt_at);
+
+-- The table also holds the event store: rows of kind 'webhook' are inbox
+-- deliveries and rows of kind 'event' are events recorded from the event bus.
+ALTER TABLE mcp.events ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'webhook';
+ALTER TABLE mcp.events ADD COLUMN IF NOT EXISTS agent_id VARCHAR(255);
+ALTER TABLE mcp.events ADD COLUMN IF NOT EXISTS session_id VARCHAR(255);
+ALTER TABLE mcp.events ALTER COLUMN source TYPE VARCHAR(255);
+CREATE INDEX IF NOT EXISTS idx_events_kind_timestamp ON mcp.events(kind, timestamp, id);
+CREATE INDEX IF NOT EXISTS idx_events_agent_id ON mcp.events(agent_id, timestamp) WHERE agent_id IS NOT NULL;
+
 -- Note: Context tables have been removed as they are no longer supported
 
 -- Integrations table
@@ -56,4 +72,84 @@ CREATE TABLE IF NOT EXISTS mcp.metrics (
 CREATE INDEX IF NOT EXISTS idx_metrics_name ON mcp.metrics(name);
 CREATE INDEX IF NOT EXISTS idx_metrics_timestamp ON mcp.metrics(timestamp);
 
--- Note: Vector extension and embeddings table have been removed as they are no longer supported
\ No newline at end of file
+-- Note: Vector extension and embeddings table have been removed as they are no longer supported
+
+-- Jobs table for asynchronous tool actions
+CREATE TABLE IF NOT EXISTS mcp.jobs (
+    id UUID PRIMARY KEY,
+    tool VARCHAR(100) NOT NULL,
+    action VARCHAR(100) NOT NULL,
+    context_id VARCHAR(255) NOT NULL,
+    params JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    progress INTEGER NOT NULL DEFAULT 0,
+    message TEXT NOT NULL DEFAULT '',
+    result JSONB,
+    error TEXT NOT NULL DEFAULT '',
+    error_code VARCHAR(50) NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create index on jobs
+CREATE INDEX IF NOT EXISTS idx_jobs_status ON mcp.jobs(status);
+CREATE INDEX IF NOT EXISTS idx_jobs_context_id ON mcp.jobs(context_id);
+
+-- Create contexts table, the contexts of agents with their content as JSON
+CREATE TABLE IF NOT EXISTS mcp.contexts (
+    id VARCHAR(255) PRIMARY KEY,
+    agent_id VARCHAR(255) NOT NULL,
+    session_id VARCHAR(255) NOT NULL DEFAULT '',
+    data JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_contexts_agent_session ON mcp.contexts(agent_id, session_id, created_at);
+
+-- Contexts webhooks are recorded in, keyed by correlation key
+CREATE TABLE IF NOT EXISTS mcp.context_correlations (
+    key VARCHAR(512) PRIMARY KEY,
+    context_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_context_correlations_context_id ON mcp.context_correlations(context_id);
+
+-- Create subscriptions table for outbound event webhooks
+CREATE TABLE IF NOT EXISTS mcp.subscriptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    url TEXT NOT NULL,
+    description TEXT,
+    filter JSONB NOT NULL,
+    secret TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create subscription deliveries table, the delivery log of subscriptions
+CREATE TABLE IF NOT EXISTS mcp.subscription_deliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id UUID NOT NULL REFERENCES mcp.subscriptions(id) ON DELETE CASCADE,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    response_status INTEGER,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    locked_until TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes on subscription deliveries
+CREATE INDEX IF NOT EXISTS idx_subscription_deliveries_due ON mcp.subscription_deliveries(status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_subscription_deliveries_subscription ON mcp.subscription_deliveries(subscription_id, created_at DESC);
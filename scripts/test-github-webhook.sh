@@ -1,7 +1,7 @@
 #!/bin/bash
 
 # Configuration
-WEBHOOK_URL="http://localhost:8080/webhook/github"
+WEBHOOK_URL="http://localhost:8080/webhooks/github"
 WEBHOOK_SECRET="test-webhook-secret"
 PAYLOAD='{"repository":{"full_name":"test/repo"}}'
 EVENT_TYPE="ping"